@@ -36,9 +36,9 @@ func buildRootCmd() *cobra.Command {
 		Example: `
   Displays the help menu for the "init" command.
   /code $ copilot init --help`,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			// If we don't set a Run() function the help menu doesn't show up.
-			// See https://github.com/spf13/cobra/issues/790
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Point every sub-command to the workspace root passed with the global flag before it runs.
+			return cli.SetWorkspaceRoot(cmd)
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -48,6 +48,7 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cli.BindWorkspaceFlag(cmd)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
@@ -63,6 +64,7 @@ func buildRootCmd() *cobra.Command {
 	//cmd.AddCommand(cli.BuildStorageCmd())
 
 	// "Operational" command group.
+	cmd.AddCommand(cli.BuildLockCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the operations that change the resources of an application in its audit log.
+package audit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+const (
+	// Each application has its own log group, and each recorded event is written to its own log stream
+	// so that concurrent commands don't compete for the sequence token of a shared log stream.
+	fmtLogGroupName  = "/copilot/%s/audit"
+	fmtLogStreamName = "%s/%s"
+
+	// Outcomes of a command.
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+)
+
+// Event is the record of a command that changed the resources of an application.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`           // Name of the command, for example "svc deploy".
+	Args    []string  `json:"args,omitempty"`    // Positional arguments and flags set by the caller.
+	Caller  Caller    `json:"caller"`            // Identity that ran the command.
+	App     string    `json:"app"`               // Name of the application.
+	Env     string    `json:"env,omitempty"`     // Name of the environment, empty if the command isn't scoped to one.
+	Service string    `json:"service,omitempty"` // Name of the service, empty if the command isn't scoped to one.
+	Stacks  []Stack   `json:"stacks,omitempty"`  // CloudFormation stacks of the resources changed by the command.
+	Outcome string    `json:"outcome"`           // Either OutcomeSucceeded or OutcomeFailed.
+	Error   string    `json:"error,omitempty"`   // Error of the command if it failed.
+}
+
+// Caller is the AWS identity that ran a command.
+type Caller struct {
+	ARN     string `json:"arn"`
+	Account string `json:"account"`
+	UserID  string `json:"userId"`
+}
+
+// Stack is a CloudFormation stack changed by a command.
+type Stack struct {
+	Name        string `json:"name"`
+	ChangeSetID string `json:"changeSetId,omitempty"` // Last change set executed on the stack, empty if the stack was deleted.
+}
+
+type logsClient interface {
+	WriteLogStream(logGroupName, logStreamName string, events []*cloudwatchlogs.Event) error
+	LogGroupEvents(logGroupName string, startTime int64) ([]*cloudwatchlogs.Event, error)
+}
+
+// Log is the audit log of applications, stored in a CloudWatch Logs log group per application.
+type Log struct {
+	client logsClient
+}
+
+// New returns a Log configured against the input session.
+func New(s *session.Session) *Log {
+	return &Log{
+		client: cloudwatchlogs.New(s),
+	}
+}
+
+// Record writes the event to the audit log of its application.
+func (l *Log) Record(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event: %w", err)
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Errorf("generate audit event ID: %w", err)
+	}
+	streamName := fmt.Sprintf(fmtLogStreamName, event.Time.UTC().Format("2006/01/02"), hex.EncodeToString(id))
+	if err := l.client.WriteLogStream(logGroupName(event.App), streamName, []*cloudwatchlogs.Event{
+		{
+			Message:   string(data),
+			Timestamp: toMillis(event.Time),
+		},
+	}); err != nil {
+		return fmt.Errorf("record audit event of application %s: %w", event.App, err)
+	}
+	return nil
+}
+
+// Events returns the events of the audit log of an application that happened at or after since,
+// from the oldest to the latest.
+func (l *Log) Events(appName string, since time.Time) ([]*Event, error) {
+	logEvents, err := l.client.LogGroupEvents(logGroupName(appName), toMillis(since))
+	if err != nil {
+		return nil, fmt.Errorf("get audit events of application %s: %w", appName, err)
+	}
+	var events []*Event
+	for _, logEvent := range logEvents {
+		var event Event
+		if err := json.Unmarshal([]byte(logEvent.Message), &event); err != nil {
+			return nil, fmt.Errorf("unmarshal audit event %s: %w", logEvent.Message, err)
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+func logGroupName(appName string) string {
+	return fmt.Sprintf(fmtLogGroupName, appName)
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
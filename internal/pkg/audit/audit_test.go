@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_Record(t *testing.T) {
+	mockEvent := &Event{
+		Time:    time.Date(2020, time.August, 20, 10, 30, 0, 0, time.UTC),
+		Command: "svc deploy",
+		Args:    []string{"--env=test", "--name=frontend"},
+		Caller: Caller{
+			ARN:     "arn:aws:iam::1234:user/alice",
+			Account: "1234",
+			UserID:  "AIDA1234",
+		},
+		App:     "phonetool",
+		Env:     "test",
+		Service: "frontend",
+		Stacks: []Stack{
+			{Name: "phonetool-test-frontend", ChangeSetID: "arn:aws:cloudformation:us-west-2:1234:changeSet/copilot-1/abc"},
+		},
+		Outcome: OutcomeSucceeded,
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MocklogsClient)
+		wantErr    error
+	}{
+		"writes the event in a new log stream of the log group of the application": {
+			mockClient: func(m *mocks.MocklogsClient) {
+				m.EXPECT().WriteLogStream("/copilot/phonetool/audit", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, streamName string, events []*cloudwatchlogs.Event) error {
+						require.Regexp(t, "^2020/08/20/[0-9a-f]{16}$", streamName)
+						require.Equal(t, []*cloudwatchlogs.Event{
+							{
+								Message:   `{"time":"2020-08-20T10:30:00Z","command":"svc deploy","args":["--env=test","--name=frontend"],"caller":{"arn":"arn:aws:iam::1234:user/alice","account":"1234","userId":"AIDA1234"},"app":"phonetool","env":"test","service":"frontend","stacks":[{"name":"phonetool-test-frontend","changeSetId":"arn:aws:cloudformation:us-west-2:1234:changeSet/copilot-1/abc"}],"outcome":"succeeded"}`,
+								Timestamp: 1597919400000,
+							},
+						}, events)
+						return nil
+					})
+			},
+		},
+		"wraps the error from writing the event": {
+			mockClient: func(m *mocks.MocklogsClient) {
+				m.EXPECT().WriteLogStream(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantErr: errors.New("record audit event of application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMocklogsClient(ctrl)
+			tc.mockClient(mockClient)
+			log := &Log{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := log.Record(mockEvent)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLog_Events(t *testing.T) {
+	since := time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.MocklogsClient)
+		wantEvents []*Event
+		wantErr    error
+	}{
+		"returns the events since the time": {
+			mockClient: func(m *mocks.MocklogsClient) {
+				m.EXPECT().LogGroupEvents("/copilot/phonetool/audit", int64(1597881600000)).Return([]*cloudwatchlogs.Event{
+					{Message: `{"time":"2020-08-20T10:30:00Z","command":"env delete","app":"phonetool","env":"test","outcome":"failed","error":"some error"}`},
+				}, nil)
+			},
+			wantEvents: []*Event{
+				{
+					Time:    time.Date(2020, time.August, 20, 10, 30, 0, 0, time.UTC),
+					Command: "env delete",
+					App:     "phonetool",
+					Env:     "test",
+					Outcome: OutcomeFailed,
+					Error:   "some error",
+				},
+			},
+		},
+		"wraps the error from getting the log events": {
+			mockClient: func(m *mocks.MocklogsClient) {
+				m.EXPECT().LogGroupEvents(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get audit events of application phonetool: some error"),
+		},
+		"errors if a log event is not an audit event": {
+			mockClient: func(m *mocks.MocklogsClient) {
+				m.EXPECT().LogGroupEvents(gomock.Any(), gomock.Any()).Return([]*cloudwatchlogs.Event{
+					{Message: "hello"},
+				}, nil)
+			},
+			wantErr: errors.New("unmarshal audit event hello: invalid character 'h' looking for beginning of value"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMocklogsClient(ctrl)
+			tc.mockClient(mockClient)
+			log := &Log{
+				client: mockClient,
+			}
+
+			// WHEN
+			events, err := log.Events("phonetool", since)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEvents, events)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/audit/audit.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MocklogsClient is a mock of logsClient interface
+type MocklogsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogsClientMockRecorder
+}
+
+// MocklogsClientMockRecorder is the mock recorder for MocklogsClient
+type MocklogsClientMockRecorder struct {
+	mock *MocklogsClient
+}
+
+// NewMocklogsClient creates a new mock instance
+func NewMocklogsClient(ctrl *gomock.Controller) *MocklogsClient {
+	mock := &MocklogsClient{ctrl: ctrl}
+	mock.recorder = &MocklogsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklogsClient) EXPECT() *MocklogsClientMockRecorder {
+	return m.recorder
+}
+
+// WriteLogStream mocks base method
+func (m *MocklogsClient) WriteLogStream(logGroupName, logStreamName string, events []*cloudwatchlogs.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteLogStream", logGroupName, logStreamName, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteLogStream indicates an expected call of WriteLogStream
+func (mr *MocklogsClientMockRecorder) WriteLogStream(logGroupName, logStreamName, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogStream", reflect.TypeOf((*MocklogsClient)(nil).WriteLogStream), logGroupName, logStreamName, events)
+}
+
+// LogGroupEvents mocks base method
+func (m *MocklogsClient) LogGroupEvents(logGroupName string, startTime int64) ([]*cloudwatchlogs.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupEvents", logGroupName, startTime)
+	ret0, _ := ret[0].([]*cloudwatchlogs.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupEvents indicates an expected call of LogGroupEvents
+func (mr *MocklogsClientMockRecorder) LogGroupEvents(logGroupName, startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupEvents", reflect.TypeOf((*MocklogsClient)(nil).LogGroupEvents), logGroupName, startTime)
+}
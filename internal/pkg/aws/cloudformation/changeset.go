@@ -49,6 +49,48 @@ type changeSetDescription struct {
 	executionStatus string
 	statusReason    string
 	changes         []*cloudformation.Change
+	parameters      []*cloudformation.Parameter
+}
+
+// ChangeSet is a change set created to review the changes to a stack before executing them.
+type ChangeSet struct {
+	Name       string
+	StackName  string
+	IsCreate   bool              // True if executing the change set creates the stack.
+	Resources  []ResourceChange  // Changes to the resources of the stack.
+	Parameters []ParameterChange // Changes to the parameter values of the stack.
+
+	conf *stackConfig // Configuration of the stack to protect when the change set is executed, nil if it's not protected.
+}
+
+// IsDestructive returns true if executing the change set removes or replaces resources of the stack.
+func (cs *ChangeSet) IsDestructive() bool {
+	for _, r := range cs.Resources {
+		if r.Action == cloudformation.ChangeActionRemove {
+			return true
+		}
+		if r.Replacement == cloudformation.ReplacementTrue || r.Replacement == cloudformation.ReplacementConditional {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceChange is a change to a resource of a stack.
+type ResourceChange struct {
+	Action      string // One of "Add", "Modify", "Remove", "Import" or "Dynamic".
+	LogicalID   string
+	PhysicalID  string
+	Type        string
+	Replacement string   // One of "True", "False" or "Conditional" if the resource is modified.
+	Properties  []string // Names of the modified properties.
+}
+
+// ParameterChange is a change to the value of a parameter of a stack.
+type ParameterChange struct {
+	Key      string
+	OldValue *string // Nil if the parameter is added.
+	NewValue *string // Nil if the parameter is removed.
 }
 
 func newCreateChangeSet(cfnClient changeSetAPI, stackName string) (*changeSet, error) {
@@ -87,7 +129,7 @@ func (cs *changeSet) String() string {
 
 // create creates a Change Set and waits until it's created.
 func (cs *changeSet) create(conf *stackConfig) error {
-	_, err := cs.client.CreateChangeSet(&cloudformation.CreateChangeSetInput{
+	in := &cloudformation.CreateChangeSetInput{
 		ChangeSetName: aws.String(cs.name),
 		StackName:     aws.String(cs.stackName),
 		ChangeSetType: aws.String(cs.csType.String()),
@@ -100,7 +142,14 @@ func (cs *changeSet) create(conf *stackConfig) error {
 			cloudformation.CapabilityCapabilityNamedIam,
 			cloudformation.CapabilityCapabilityAutoExpand,
 		}),
-	})
+	}
+	if conf.TemplateURL != "" {
+		in.TemplateBody, in.TemplateURL = nil, aws.String(conf.TemplateURL)
+	}
+	if conf.UsePreviousTemplate {
+		in.TemplateBody, in.UsePreviousTemplate = nil, aws.Bool(true)
+	}
+	_, err := cs.client.CreateChangeSet(in)
 	if err != nil {
 		return fmt.Errorf("create %s: %w", cs, err)
 	}
@@ -118,6 +167,7 @@ func (cs *changeSet) create(conf *stackConfig) error {
 func (cs *changeSet) describe() (*changeSetDescription, error) {
 	var executionStatus, statusReason string
 	var changes []*cloudformation.Change
+	var parameters []*cloudformation.Parameter
 	var nextToken *string
 	for {
 		out, err := cs.client.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
@@ -131,6 +181,9 @@ func (cs *changeSet) describe() (*changeSetDescription, error) {
 		executionStatus = aws.StringValue(out.ExecutionStatus)
 		statusReason = aws.StringValue(out.StatusReason)
 		changes = append(changes, out.Changes...)
+		if parameters == nil {
+			parameters = out.Parameters
+		}
 		nextToken = out.NextToken
 
 		if nextToken == nil { // no more results left
@@ -141,6 +194,7 @@ func (cs *changeSet) describe() (*changeSetDescription, error) {
 		executionStatus: executionStatus,
 		statusReason:    statusReason,
 		changes:         changes,
+		parameters:      parameters,
 	}, nil
 }
 
@@ -173,9 +227,17 @@ func (cs *changeSet) execute() error {
 	return nil
 }
 
-// createAndExecute calls create and then execute.
+// createAndExecute calls createNonEmpty and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
 func (cs *changeSet) createAndExecute(conf *stackConfig) error {
+	if err := cs.createNonEmpty(conf); err != nil {
+		return err
+	}
+	return cs.execute()
+}
+
+// createNonEmpty calls create. If the change set is empty, it deletes it and returns a ErrChangeSetEmpty.
+func (cs *changeSet) createNonEmpty(conf *stackConfig) error {
 	if err := cs.create(conf); err != nil {
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
@@ -194,7 +256,7 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return err
 	}
-	return cs.execute()
+	return nil
 }
 
 // delete removes the change set.
@@ -208,3 +270,83 @@ func (cs *changeSet) delete() error {
 	}
 	return nil
 }
+
+// review describes the change set and compares its parameters with the previous parameters of the stack.
+func (cs *changeSet) review(previousParams []*cloudformation.Parameter) (*ChangeSet, error) {
+	descr, err := cs.describe()
+	if err != nil {
+		return nil, err
+	}
+	review := &ChangeSet{
+		Name:       cs.name,
+		StackName:  cs.stackName,
+		IsCreate:   cs.csType == createChangeSetType,
+		Parameters: parameterChanges(previousParams, descr.parameters),
+	}
+	for _, change := range descr.changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		review.Resources = append(review.Resources, toResourceChange(change.ResourceChange))
+	}
+	return review, nil
+}
+
+func toResourceChange(change *cloudformation.ResourceChange) ResourceChange {
+	var properties []string
+	seen := make(map[string]bool)
+	for _, detail := range change.Details {
+		if detail.Target == nil || aws.StringValue(detail.Target.Attribute) != cloudformation.ResourceAttributeProperties {
+			continue
+		}
+		name := aws.StringValue(detail.Target.Name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		properties = append(properties, name)
+	}
+	return ResourceChange{
+		Action:      aws.StringValue(change.Action),
+		LogicalID:   aws.StringValue(change.LogicalResourceId),
+		PhysicalID:  aws.StringValue(change.PhysicalResourceId),
+		Type:        aws.StringValue(change.ResourceType),
+		Replacement: aws.StringValue(change.Replacement),
+		Properties:  properties,
+	}
+}
+
+// parameterChanges returns the parameters whose values differ between the previous and the next parameters.
+// The changes are in the order of the next parameters followed by the removed ones.
+func parameterChanges(previous, next []*cloudformation.Parameter) []ParameterChange {
+	oldValues := make(map[string]*string)
+	for _, param := range previous {
+		oldValues[aws.StringValue(param.ParameterKey)] = param.ParameterValue
+	}
+	var changes []ParameterChange
+	seen := make(map[string]bool)
+	for _, param := range next {
+		key := aws.StringValue(param.ParameterKey)
+		seen[key] = true
+		oldValue, ok := oldValues[key]
+		if ok && aws.StringValue(oldValue) == aws.StringValue(param.ParameterValue) {
+			continue
+		}
+		changes = append(changes, ParameterChange{
+			Key:      key,
+			OldValue: oldValue,
+			NewValue: param.ParameterValue,
+		})
+	}
+	for _, param := range previous {
+		key := aws.StringValue(param.ParameterKey)
+		if seen[key] {
+			continue
+		}
+		changes = append(changes, ParameterChange{
+			Key:      key,
+			OldValue: param.ParameterValue,
+		})
+	}
+	return changes
+}
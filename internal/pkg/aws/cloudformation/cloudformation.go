@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -48,6 +49,11 @@ func (c *CloudFormation) Create(stack *Stack) error {
 	status := stackStatus(aws.StringValue(descr.StackStatus))
 	if status.requiresCleanup() {
 		// If the stack exists, but failed to create, we'll clean it up and then re-create it.
+		if aws.BoolValue(descr.EnableTerminationProtection) {
+			if err := c.DisableTerminationProtection(stack.Name); err != nil {
+				return fmt.Errorf("cleanup previously failed stack %s: %w", stack.Name, err)
+			}
+		}
 		if err := c.Delete(stack.Name); err != nil {
 			return fmt.Errorf("cleanup previously failed stack %s: %w", stack.Name, err)
 		}
@@ -104,12 +110,111 @@ func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if err := c.Update(stack); err != nil {
 		return err
 	}
+	return c.WaitForUpdate(stack.Name)
+}
 
+// WaitForUpdate blocks until the stack is updated or until the max attempt window expires.
+func (c *CloudFormation) WaitForUpdate(stackName string) error {
 	err := c.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
-		StackName: aws.String(stack.Name),
+		StackName: aws.String(stackName),
 	}, waiters...)
 	if err != nil {
-		return fmt.Errorf("wait until stack %s update is complete: %w", stack.Name, err)
+		return fmt.Errorf("wait until stack %s update is complete: %w", stackName, err)
+	}
+	return nil
+}
+
+// CreateChangeSet creates a change set with the new configuration of the stack without executing it,
+// so that its changes can be reviewed first. If the stack doesn't exist, the change set creates it.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (*ChangeSet, error) {
+	newChangeSet := newCreateChangeSet
+	var previousParams []*cloudformation.Parameter
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if !errors.As(err, &stackNotFound) {
+			return nil, err
+		}
+	} else {
+		status := stackStatus(aws.StringValue(descr.StackStatus))
+		if status.inProgress() {
+			return nil, &errStackUpdateInProgress{
+				name: stack.Name,
+			}
+		}
+		if status.requiresCleanup() {
+			return nil, &errStackRequiresCleanup{
+				name: stack.Name,
+			}
+		}
+		newChangeSet = newUpdateChangeSet
+		previousParams = descr.Parameters
+	}
+	cs, err := newChangeSet(c.client, stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.createNonEmpty(stack.stackConfig); err != nil {
+		return nil, err
+	}
+	review, err := cs.review(previousParams)
+	if err != nil {
+		return nil, err
+	}
+	if stack.isProtected() {
+		review.conf = stack.stackConfig
+	}
+	return review, nil
+}
+
+// ExecuteChangeSet executes a change set returned by CreateChangeSet.
+// If the stack is configured with termination protection or a stack policy, they're applied to the stack
+// before an update so that they restrict it, or after a creation once the stack exists.
+func (c *CloudFormation) ExecuteChangeSet(cs *ChangeSet) error {
+	if cs.conf == nil {
+		return c.changeSet(cs).execute()
+	}
+	if !cs.IsCreate {
+		if err := c.protect(cs.StackName, cs.conf); err != nil {
+			return err
+		}
+	}
+	if err := c.changeSet(cs).execute(); err != nil {
+		return err
+	}
+	if cs.IsCreate {
+		return c.protect(cs.StackName, cs.conf)
+	}
+	return nil
+}
+
+// ExecuteChangeSetAndWait calls ExecuteChangeSet and then blocks until the stack is created or updated
+// or until the max attempt window expires.
+func (c *CloudFormation) ExecuteChangeSetAndWait(cs *ChangeSet) error {
+	if err := c.ExecuteChangeSet(cs); err != nil {
+		return err
+	}
+	if cs.IsCreate {
+		return c.WaitForCreate(cs.StackName)
+	}
+	return c.WaitForUpdate(cs.StackName)
+}
+
+// DeleteChangeSet removes a change set returned by CreateChangeSet without executing it.
+func (c *CloudFormation) DeleteChangeSet(cs *ChangeSet) error {
+	return c.changeSet(cs).delete()
+}
+
+// DisableTerminationProtection allows the stack to be deleted.
+// If the stack doesn't exist then do nothing.
+func (c *CloudFormation) DisableTerminationProtection(stackName string) error {
+	_, err := c.client.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+		EnableTerminationProtection: aws.Bool(false),
+		StackName:                   aws.String(stackName),
+	})
+	if err != nil && !stackDoesNotExist(err) {
+		return fmt.Errorf("disable termination protection of stack %s: %w", stackName, err)
 	}
 	return nil
 }
@@ -150,6 +255,54 @@ func (c *CloudFormation) DeleteAndWait(stackName string) error {
 	return nil
 }
 
+// ValidateDeployable returns a ErrStackRequiresRecovery if the stack was left in a failed state by a previous deployment,
+// along with the events of the resources that caused the failure. If the stack doesn't exist, it can be deployed.
+func (c *CloudFormation) ValidateDeployable(stackName string) error {
+	descr, err := c.Describe(stackName)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if errors.As(err, &stackNotFound) {
+			return nil
+		}
+		return err
+	}
+	status := stackStatus(aws.StringValue(descr.StackStatus))
+	if !status.requiresCleanup() && !status.requiresRollbackRecovery() {
+		return nil
+	}
+	events, err := c.Events(stackName)
+	if err != nil {
+		return err
+	}
+	return &ErrStackRequiresRecovery{
+		Name:   stackName,
+		Status: string(status),
+		Events: failureEvents(events),
+	}
+}
+
+// ContinueUpdateRollbackAndWait continues the rollback of a stack that failed to roll back an update,
+// and blocks until the rollback is complete or until the max attempt window expires.
+// The resources to skip are marked as rolled back without changing them, so they can drift from the template.
+func (c *CloudFormation) ContinueUpdateRollbackAndWait(stackName string, skipResources []string) error {
+	in := &cloudformation.ContinueUpdateRollbackInput{
+		StackName: aws.String(stackName),
+	}
+	if len(skipResources) > 0 {
+		in.ResourcesToSkip = aws.StringSlice(skipResources)
+	}
+	if _, err := c.client.ContinueUpdateRollback(in); err != nil {
+		return fmt.Errorf("continue update rollback of stack %s: %w", stackName, err)
+	}
+	err := c.client.WaitUntilStackRollbackCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	}, waiters...)
+	if err != nil {
+		return fmt.Errorf("wait until stack %s rollback is complete: %w", stackName, err)
+	}
+	return nil
+}
+
 // Describe returns a description of an existing stack.
 // If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) Describe(name string) (*StackDescription, error) {
@@ -198,12 +351,36 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 	return events, nil
 }
 
+// failureEvents returns the events of the resources that failed since the latest creation or update of the stack started.
+func failureEvents(events []StackEvent) []StackEvent {
+	start := 0
+	for i, event := range events {
+		if aws.StringValue(event.LogicalResourceId) != aws.StringValue(event.StackName) {
+			continue
+		}
+		switch aws.StringValue(event.ResourceStatus) {
+		case cloudformation.ResourceStatusCreateInProgress, cloudformation.ResourceStatusUpdateInProgress:
+			start = i
+		}
+	}
+	var failures []StackEvent
+	for _, event := range events[start:] {
+		if strings.HasSuffix(aws.StringValue(event.ResourceStatus), "FAILED") {
+			failures = append(failures, event)
+		}
+	}
+	return failures
+}
+
 func (c *CloudFormation) create(stack *Stack) error {
 	cs, err := newCreateChangeSet(c.client, stack.Name)
 	if err != nil {
 		return err
 	}
-	return cs.createAndExecute(stack.stackConfig)
+	if err := cs.createAndExecute(stack.stackConfig); err != nil {
+		return err
+	}
+	return c.protect(stack.Name, stack.stackConfig)
 }
 
 func (c *CloudFormation) update(stack *Stack) error {
@@ -211,5 +388,44 @@ func (c *CloudFormation) update(stack *Stack) error {
 	if err != nil {
 		return err
 	}
+	if err := c.protect(stack.Name, stack.stackConfig); err != nil {
+		return err
+	}
 	return cs.createAndExecute(stack.stackConfig)
 }
+
+// protect enables termination protection and sets the stack policy of an existing stack if they're configured.
+func (c *CloudFormation) protect(stackName string, conf *stackConfig) error {
+	if conf.TerminationProtection {
+		_, err := c.client.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+			EnableTerminationProtection: aws.Bool(true),
+			StackName:                   aws.String(stackName),
+		})
+		if err != nil {
+			return fmt.Errorf("enable termination protection of stack %s: %w", stackName, err)
+		}
+	}
+	if conf.StackPolicy != "" {
+		_, err := c.client.SetStackPolicy(&cloudformation.SetStackPolicyInput{
+			StackName:       aws.String(stackName),
+			StackPolicyBody: aws.String(conf.StackPolicy),
+		})
+		if err != nil {
+			return fmt.Errorf("set stack policy of stack %s: %w", stackName, err)
+		}
+	}
+	return nil
+}
+
+func (c *CloudFormation) changeSet(cs *ChangeSet) *changeSet {
+	csType := updateChangeSetType
+	if cs.IsCreate {
+		csType = createChangeSetType
+	}
+	return &changeSet{
+		name:      cs.Name,
+		stackName: cs.StackName,
+		csType:    csType,
+		client:    c.client,
+	}
+}
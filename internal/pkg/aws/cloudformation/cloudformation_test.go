@@ -201,7 +201,9 @@ func TestCloudFormation_WaitForCreate(t *testing.T) {
 }
 
 func TestCloudFormation_Update(t *testing.T) {
+	protectedStack := NewStack(mockStack.Name, mockStack.Template, WithTerminationProtection(), WithStackPolicy("policy"))
 	testCases := map[string]struct {
+		stack      *Stack
 		createMock func(ctrl *gomock.Controller) api
 		wantedErr  error
 	}{
@@ -235,6 +237,52 @@ func TestCloudFormation_Update(t *testing.T) {
 				return m
 			},
 		},
+		"protect the stack before updating it": {
+			stack: protectedStack,
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+						EnableTerminationProtection: aws.Bool(true),
+						StackName:                   aws.String(mockStack.Name),
+					}).Return(nil, nil),
+					m.EXPECT().SetStackPolicy(&cloudformation.SetStackPolicyInput{
+						StackName:       aws.String(mockStack.Name),
+						StackPolicyBody: aws.String("policy"),
+					}).Return(nil, nil),
+					m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, nil),
+				)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any())
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any())
+				return m
+			},
+		},
+		"fail if termination protection can't be enabled": {
+			stack: protectedStack,
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("enable termination protection of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -250,8 +298,13 @@ func TestCloudFormation_Update(t *testing.T) {
 				client: tc.createMock(ctrl),
 			}
 
+			stack := mockStack
+			if tc.stack != nil {
+				stack = tc.stack
+			}
+
 			// WHEN
-			err := c.Update(mockStack)
+			err := c.Update(stack)
 
 			// THEN
 			require.Equal(t, tc.wantedErr, err)
@@ -515,6 +568,599 @@ func TestCloudFormation_Events(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_ValidateDeployable(t *testing.T) {
+	stackEvent := func(logicalID, status, reason string) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			StackName:            aws.String(mockStack.Name),
+			LogicalResourceId:    aws.String(logicalID),
+			ResourceStatus:       aws.String(status),
+			ResourceStatusReason: aws.String(reason),
+		}
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"returns nil if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns nil if the stack can be deployed to": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateRollbackComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns the events of the latest deployment that caused the failure": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateRollbackFailed),
+						},
+					},
+				}, nil)
+				// Events are returned in reverse chronological order.
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent(mockStack.Name, cloudformation.StackStatusUpdateRollbackFailed, "The following resource(s) failed to update: [Service]."),
+						stackEvent("Service", cloudformation.ResourceStatusUpdateFailed, "Service was not stable"),
+						stackEvent("TaskDefinition", cloudformation.ResourceStatusUpdateFailed, "Invalid image"),
+						stackEvent(mockStack.Name, cloudformation.ResourceStatusUpdateInProgress, "User Initiated"),
+						stackEvent("Service", cloudformation.ResourceStatusCreateFailed, "Resource limit exceeded"),
+						stackEvent(mockStack.Name, cloudformation.ResourceStatusCreateInProgress, "User Initiated"),
+					},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackRequiresRecovery{
+				Name:   mockStack.Name,
+				Status: cloudformation.StackStatusUpdateRollbackFailed,
+				Events: []StackEvent{
+					StackEvent(*stackEvent("TaskDefinition", cloudformation.ResourceStatusUpdateFailed, "Invalid image")),
+					StackEvent(*stackEvent("Service", cloudformation.ResourceStatusUpdateFailed, "Service was not stable")),
+					StackEvent(*stackEvent(mockStack.Name, cloudformation.StackStatusUpdateRollbackFailed, "The following resource(s) failed to update: [Service].")),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ValidateDeployable(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_ContinueUpdateRollbackAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		inSkipResources []string
+		createMock      func(ctrl *gomock.Controller) api
+		wantedErr       error
+	}{
+		"wraps the error from continuing the rollback": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: fmt.Errorf("continue update rollback of stack %s: some error", mockStack.Name),
+		},
+		"skips the resources and waits for the rollback": {
+			inSkipResources: []string{"Service"},
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(&cloudformation.ContinueUpdateRollbackInput{
+					StackName:       aws.String(mockStack.Name),
+					ResourcesToSkip: aws.StringSlice([]string{"Service"}),
+				}).Return(nil, nil)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any()).Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ContinueUpdateRollbackAndWait(mockStack.Name, tc.inSkipResources)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	mockChangeSetInput := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetName),
+		StackName:     aws.String(mockStack.Name),
+	}
+	testCases := map[string]struct {
+		createMock      func(ctrl *gomock.Controller) api
+		wantedChangeSet *ChangeSet
+		wantedErr       error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedErr: &errStackUpdateInProgress{
+				name: mockStack.Name,
+			},
+		},
+		"fail if the stack previously failed to be created": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusRollbackComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().DeleteStack(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &errStackRequiresCleanup{
+				name: mockStack.Name,
+			},
+		},
+		"deletes the change set if it's empty": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), mockChangeSetInput, gomock.Any()).Return(errors.New("waiter failed"))
+				m.EXPECT().DescribeChangeSet(mockChangeSetInput).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+					StatusReason:    aws.String(noChangesReason),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &ErrChangeSetEmpty{
+				cs: &changeSet{
+					name:      mockChangeSetName,
+					stackName: mockStack.Name,
+					csType:    updateChangeSetType,
+				},
+			},
+		},
+		"returns the changes to create the stack if it doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeCreate, aws.StringValue(in.ChangeSetType))
+					return nil, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), mockChangeSetInput, gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(mockChangeSetInput).Return(&cloudformation.DescribeChangeSetOutput{
+					Changes: []*cloudformation.Change{
+						{
+							ResourceChange: &cloudformation.ResourceChange{
+								Action:            aws.String(cloudformation.ChangeActionAdd),
+								LogicalResourceId: aws.String("Service"),
+								ResourceType:      aws.String("AWS::ECS::Service"),
+							},
+						},
+					},
+					Parameters: []*cloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerPort"),
+							ParameterValue: aws.String("80"),
+						},
+					},
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedChangeSet: &ChangeSet{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				IsCreate:  true,
+				Resources: []ResourceChange{
+					{
+						Action:    "Add",
+						LogicalID: "Service",
+						Type:      "AWS::ECS::Service",
+					},
+				},
+				Parameters: []ParameterChange{
+					{
+						Key:      "ContainerPort",
+						NewValue: aws.String("80"),
+					},
+				},
+			},
+		},
+		"returns the changes to update the stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+							Parameters: []*cloudformation.Parameter{
+								{
+									ParameterKey:   aws.String("ContainerImage"),
+									ParameterValue: aws.String("nginx:1"),
+								},
+								{
+									ParameterKey:   aws.String("ContainerPort"),
+									ParameterValue: aws.String("80"),
+								},
+								{
+									ParameterKey:   aws.String("LogRetention"),
+									ParameterValue: aws.String("30"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(in.ChangeSetType))
+					return nil, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), mockChangeSetInput, gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(mockChangeSetInput).Return(&cloudformation.DescribeChangeSetOutput{
+					Changes: []*cloudformation.Change{
+						{
+							ResourceChange: &cloudformation.ResourceChange{
+								Action:             aws.String(cloudformation.ChangeActionModify),
+								LogicalResourceId:  aws.String("TaskDefinition"),
+								PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/api:1"),
+								ResourceType:       aws.String("AWS::ECS::TaskDefinition"),
+								Replacement:        aws.String(cloudformation.ReplacementTrue),
+								Details: []*cloudformation.ResourceChangeDetail{
+									{
+										Target: &cloudformation.ResourceTargetDefinition{
+											Attribute: aws.String(cloudformation.ResourceAttributeProperties),
+											Name:      aws.String("ContainerDefinitions"),
+										},
+									},
+									{
+										Target: &cloudformation.ResourceTargetDefinition{
+											Attribute: aws.String(cloudformation.ResourceAttributeProperties),
+											Name:      aws.String("ContainerDefinitions"),
+										},
+									},
+									{
+										Target: &cloudformation.ResourceTargetDefinition{
+											Attribute: aws.String(cloudformation.ResourceAttributeTags),
+										},
+									},
+								},
+							},
+						},
+					},
+					Parameters: []*cloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("nginx:2"),
+						},
+						{
+							ParameterKey:   aws.String("ContainerPort"),
+							ParameterValue: aws.String("80"),
+						},
+					},
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				return m
+			},
+			wantedChangeSet: &ChangeSet{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				Resources: []ResourceChange{
+					{
+						Action:      "Modify",
+						LogicalID:   "TaskDefinition",
+						PhysicalID:  "arn:aws:ecs:us-west-2:1234567890:task-definition/api:1",
+						Type:        "AWS::ECS::TaskDefinition",
+						Replacement: "True",
+						Properties:  []string{"ContainerDefinitions"},
+					},
+				},
+				Parameters: []ParameterChange{
+					{
+						Key:      "ContainerImage",
+						OldValue: aws.String("nginx:1"),
+						NewValue: aws.String("nginx:2"),
+					},
+					{
+						Key:      "LogRetention",
+						OldValue: aws.String("30"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := tc.createMock(ctrl)
+			c := CloudFormation{
+				client: client,
+			}
+			if errEmpty, ok := tc.wantedErr.(*ErrChangeSetEmpty); ok {
+				errEmpty.cs.client = client
+			}
+
+			// WHEN
+			cs, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+			require.Equal(t, tc.wantedChangeSet, cs)
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteChangeSetAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		isCreate   bool
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"waits until the stack is created": {
+			isCreate: true,
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				m.EXPECT().WaitUntilStackCreateCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any()).Return(nil)
+				return m
+			},
+		},
+		"waits until the stack is updated": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilStackUpdateCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("wait until stack %s update is complete: %w", mockStack.Name, errors.New("some error")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ExecuteChangeSetAndWait(&ChangeSet{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				IsCreate:  tc.isCreate,
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		isCreate   bool
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"protects the stack once it's created": {
+			isCreate: true,
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, nil),
+					m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, nil),
+				)
+				return m
+			},
+		},
+		"protects the stack before it's updated": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				gomock.InOrder(
+					m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+						EnableTerminationProtection: aws.Bool(true),
+						StackName:                   aws.String(mockStack.Name),
+					}).Return(nil, nil),
+					m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+						ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					}, nil),
+					m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, nil),
+				)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ExecuteChangeSet(&ChangeSet{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				IsCreate:  tc.isCreate,
+				conf: &stackConfig{
+					TerminationProtection: true,
+				},
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_DisableTerminationProtection(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"succeeds if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+		},
+		"wraps other errors": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(mockStack.Name),
+				}).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("disable termination protection of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.DisableTerminationProtection(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestChangeSet_IsDestructive(t *testing.T) {
+	testCases := map[string]struct {
+		resources []ResourceChange
+		wanted    bool
+	}{
+		"adding and modifying resources in place is not destructive": {
+			resources: []ResourceChange{
+				{Action: "Add", LogicalID: "Queue"},
+				{Action: "Modify", LogicalID: "Service", Replacement: "False"},
+			},
+		},
+		"removing a resource is destructive": {
+			resources: []ResourceChange{
+				{Action: "Remove", LogicalID: "Table"},
+			},
+			wanted: true,
+		},
+		"a conditional replacement is destructive": {
+			resources: []ResourceChange{
+				{Action: "Modify", LogicalID: "Bucket", Replacement: "Conditional"},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cs := &ChangeSet{Resources: tc.resources}
+			require.Equal(t, tc.wanted, cs.IsDestructive())
+		})
+	}
+}
+
 func addCreateDeployCalls(m *mocks.Mockapi) {
 	addDeployCalls(m, cloudformation.ChangeSetTypeCreate)
 }
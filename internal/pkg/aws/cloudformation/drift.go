@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+// Overridden in tests.
+var (
+	driftDetectionPollInterval = 3 * time.Second // Poll for the status of drift detections every 3 seconds.
+	driftDetectionMaxAttempts  = 600             // Wait for at most 30 mins for a drift detection.
+)
+
+// StackDrift represents the result of a drift detection on a stack.
+type StackDrift struct {
+	Status    string          `json:"status"` // Either DRIFTED, IN_SYNC, or NOT_CHECKED.
+	Resources []ResourceDrift `json:"resources"`
+}
+
+// ResourceDrift represents how a resource of a stack differs from its expected configuration in the template.
+type ResourceDrift struct {
+	LogicalID   string               `json:"logicalID"`
+	PhysicalID  string               `json:"physicalID"`
+	Type        string               `json:"type"`
+	Status      string               `json:"status"` // Either IN_SYNC, MODIFIED, DELETED, or NOT_CHECKED.
+	Differences []PropertyDifference `json:"differences,omitempty"`
+}
+
+// Drifted returns true if the resource was modified or deleted outside of CloudFormation.
+func (r ResourceDrift) Drifted() bool {
+	return r.Status == cloudformation.StackResourceDriftStatusModified || r.Status == cloudformation.StackResourceDriftStatusDeleted
+}
+
+// PropertyDifference represents a property of a resource whose actual value differs from the expected value.
+type PropertyDifference struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"` // Either ADD, REMOVE, or NOT_EQUAL.
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// DetectDrift runs a drift detection on the stack, waits for it to complete,
+// and returns how the resources of the stack differ from their expected configuration.
+func (c *CloudFormation) DetectDrift(stackName string) (*StackDrift, error) {
+	out, err := c.client.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: stackName}
+		}
+		return nil, fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	status, err := c.waitForDriftDetection(stackName, aws.StringValue(out.StackDriftDetectionId))
+	if err != nil {
+		return nil, err
+	}
+	resources, err := c.resourceDrifts(stackName)
+	if err != nil {
+		return nil, err
+	}
+	return &StackDrift{
+		Status:    aws.StringValue(status.StackDriftStatus),
+		Resources: resources,
+	}, nil
+}
+
+// NestedStacks returns the IDs of the stacks nested in the stack.
+func (c *CloudFormation) NestedStacks(stackName string) ([]string, error) {
+	out, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	var ids []string
+	for _, resource := range out.StackResources {
+		if aws.StringValue(resource.ResourceType) != nestedStackResourceType || aws.StringValue(resource.PhysicalResourceId) == "" {
+			continue
+		}
+		ids = append(ids, aws.StringValue(resource.PhysicalResourceId))
+	}
+	return ids, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(stackName, detectionID string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	for attempt := 0; attempt < driftDetectionMaxAttempts; attempt++ {
+		out, err := c.client.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe drift detection %s of stack %s: %w", detectionID, stackName, err)
+		}
+		switch aws.StringValue(out.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return out, nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return nil, fmt.Errorf("drift detection %s of stack %s failed: %s", detectionID, stackName, aws.StringValue(out.DetectionStatusReason))
+		}
+		time.Sleep(driftDetectionPollInterval)
+	}
+	return nil, fmt.Errorf("wait until drift detection %s of stack %s is complete: exceeded %d attempts", detectionID, stackName, driftDetectionMaxAttempts)
+}
+
+func (c *CloudFormation) resourceDrifts(stackName string) ([]ResourceDrift, error) {
+	var nextToken *string
+	var drifts []ResourceDrift
+	for {
+		out, err := c.client.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			var diffs []PropertyDifference
+			for _, diff := range drift.PropertyDifferences {
+				diffs = append(diffs, PropertyDifference{
+					Path:     aws.StringValue(diff.PropertyPath),
+					Type:     aws.StringValue(diff.DifferenceType),
+					Expected: aws.StringValue(diff.ExpectedValue),
+					Actual:   aws.StringValue(diff.ActualValue),
+				})
+			}
+			drifts = append(drifts, ResourceDrift{
+				LogicalID:   aws.StringValue(drift.LogicalResourceId),
+				PhysicalID:  aws.StringValue(drift.PhysicalResourceId),
+				Type:        aws.StringValue(drift.ResourceType),
+				Status:      aws.StringValue(drift.StackResourceDriftStatus),
+				Differences: diffs,
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
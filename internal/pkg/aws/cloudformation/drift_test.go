@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	driftDetectionPollInterval = 0
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		createMock  func(ctrl *gomock.Controller) api
+		wantedDrift *StackDrift
+		wantedErr   error
+	}{
+		"returns ErrStackNotFound if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"wraps error if the drift detection failed": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("resource Service failed"),
+				}, nil)
+				return m
+			},
+			wantedErr: errors.New("drift detection 1234 of stack id failed: resource Service failed"),
+		},
+		"wraps error if the resource drifts can't be described": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, mockErr)
+				return m
+			},
+			wantedErr: fmt.Errorf("describe resource drifts of stack id: %w", mockErr),
+		},
+		"waits for the drift detection and returns the differences of the resources": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+					StackDriftDetectionId: aws.String("1234"),
+				}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("Service"),
+							PhysicalResourceId:       aws.String("arn:aws:ecs:us-west-2:1234:service/phonetool-test-Cluster/frontend"),
+							ResourceType:             aws.String("AWS::ECS::Service"),
+							StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+							PropertyDifferences: []*cloudformation.PropertyDifference{
+								{
+									PropertyPath:   aws.String("/DesiredCount"),
+									DifferenceType: aws.String(cloudformation.DifferenceTypeNotEqual),
+									ExpectedValue:  aws.String("1"),
+									ActualValue:    aws.String("3"),
+								},
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+					NextToken: aws.String("next"),
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("LogGroup"),
+							PhysicalResourceId:       aws.String("/copilot/phonetool-test-frontend"),
+							ResourceType:             aws.String("AWS::Logs::LogGroup"),
+							StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusInSync),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedDrift: &StackDrift{
+				Status: "DRIFTED",
+				Resources: []ResourceDrift{
+					{
+						LogicalID:  "Service",
+						PhysicalID: "arn:aws:ecs:us-west-2:1234:service/phonetool-test-Cluster/frontend",
+						Type:       "AWS::ECS::Service",
+						Status:     "MODIFIED",
+						Differences: []PropertyDifference{
+							{
+								Path:     "/DesiredCount",
+								Type:     "NOT_EQUAL",
+								Expected: "1",
+								Actual:   "3",
+							},
+						},
+					},
+					{
+						LogicalID:  "LogGroup",
+						PhysicalID: "/copilot/phonetool-test-frontend",
+						Type:       "AWS::Logs::LogGroup",
+						Status:     "IN_SYNC",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			drift, err := c.DetectDrift(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDrift, drift)
+		})
+	}
+}
+
+func TestCloudFormation_NestedStacks(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(mockStack.Name),
+	}).Return(&cloudformation.DescribeStackResourcesOutput{
+		StackResources: []*cloudformation.StackResource{
+			{
+				ResourceType:       aws.String("AWS::ECS::Service"),
+				PhysicalResourceId: aws.String("frontend"),
+			},
+			{
+				ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+				PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test-frontend-AddonsStack/abcd"),
+			},
+		},
+	}, nil)
+	c := CloudFormation{
+		client: m,
+	}
+
+	// WHEN
+	ids, err := c.NestedStacks(mockStack.Name)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{"arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test-frontend-AddonsStack/abcd"}, ids)
+}
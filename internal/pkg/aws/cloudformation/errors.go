@@ -38,6 +38,24 @@ func (e *ErrStackNotFound) Error() string {
 	return fmt.Sprintf("stack named %s cannot be found", e.name)
 }
 
+// ErrStackRequiresRecovery occurs when a stack was left in a failed state by a previous deployment
+// and can't be deployed to until it's recovered.
+type ErrStackRequiresRecovery struct {
+	Name   string
+	Status string
+	Events []StackEvent // Events of the resources that caused the failure, in chronological order.
+}
+
+func (e *ErrStackRequiresRecovery) Error() string {
+	return fmt.Sprintf("stack %s is in status %s and must be recovered before it can be deployed to", e.Name, e.Status)
+}
+
+// CanContinueRollback returns true if the stack failed to roll back an update,
+// so that it can be recovered by continuing the rollback instead of being deleted.
+func (e *ErrStackRequiresRecovery) CanContinueRollback() bool {
+	return stackStatus(e.Status).requiresRollbackRecovery()
+}
+
 // errChangeSetNotExecutable occurs when the change set cannot be executed.
 type errChangeSetNotExecutable struct {
 	cs    *changeSet
@@ -57,6 +75,15 @@ func (e *errStackUpdateInProgress) Error() string {
 	return fmt.Sprintf("stack %s is currently being updated and cannot be deployed to", e.name)
 }
 
+// errStackRequiresCleanup occurs when we try to review changes to a stack that previously failed to be created.
+type errStackRequiresCleanup struct {
+	name string
+}
+
+func (e *errStackRequiresCleanup) Error() string {
+	return fmt.Sprintf("stack %s previously failed to be created and must be deleted before reviewing its changes", e.name)
+}
+
 // stackDoesNotExist returns true if the underlying error is a stack doesn't exist.
 func stackDoesNotExist(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
@@ -15,10 +15,20 @@ type Stack struct {
 }
 
 type stackConfig struct {
-	Template   string
-	Parameters []*cloudformation.Parameter
-	Tags       []*cloudformation.Tag
-	RoleARN    *string
+	Template            string
+	TemplateURL         string // If set, the template stored at the URL is deployed instead of Template.
+	UsePreviousTemplate bool   // If true, the template of the existing stack is redeployed instead of Template.
+	Parameters          []*cloudformation.Parameter
+	Tags                []*cloudformation.Tag
+	RoleARN             *string
+
+	TerminationProtection bool   // If true, the stack can't be deleted until termination protection is disabled.
+	StackPolicy           string // If set, the body of the policy that restricts the updates to the resources of the stack.
+}
+
+// isProtected returns true if the stack is configured with termination protection or a stack policy.
+func (c *stackConfig) isProtected() bool {
+	return c.TerminationProtection || c.StackPolicy != ""
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -66,6 +76,20 @@ func WithTags(tags map[string]string) StackOption {
 	}
 }
 
+// WithTemplateURL deploys the template stored in the S3 object at url instead of the template body.
+func WithTemplateURL(url string) StackOption {
+	return func(s *Stack) {
+		s.TemplateURL = url
+	}
+}
+
+// WithPreviousTemplate redeploys the template of the existing stack instead of the template body.
+func WithPreviousTemplate() StackOption {
+	return func(s *Stack) {
+		s.UsePreviousTemplate = true
+	}
+}
+
 // WithRoleARN specifies the role that CloudFormation will assume when creating the stack.
 func WithRoleARN(roleARN string) StackOption {
 	return func(s *Stack) {
@@ -73,6 +97,20 @@ func WithRoleARN(roleARN string) StackOption {
 	}
 }
 
+// WithTerminationProtection enables termination protection on the stack once it's deployed.
+func WithTerminationProtection() StackOption {
+	return func(s *Stack) {
+		s.TerminationProtection = true
+	}
+}
+
+// WithStackPolicy sets the policy body that restricts the updates to the resources of the stack once it's deployed.
+func WithStackPolicy(policy string) StackOption {
+	return func(s *Stack) {
+		s.StackPolicy = policy
+	}
+}
+
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
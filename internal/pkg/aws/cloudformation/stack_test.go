@@ -20,11 +20,15 @@ func TestNewStack(t *testing.T) {
 		WithTags(map[string]string{
 			"copilot-application": "phonetool",
 		}),
-		WithRoleARN("arn"))
+		WithRoleARN("arn"),
+		WithTemplateURL("https://bucket.s3.amazonaws.com/hello.stack.yml"),
+		WithPreviousTemplate())
 
 	// THEN
 	require.Equal(t, "hello", s.Name)
 	require.Equal(t, "world", s.Template)
+	require.Equal(t, "https://bucket.s3.amazonaws.com/hello.stack.yml", s.TemplateURL)
+	require.True(t, s.UsePreviousTemplate)
 	require.Equal(t, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String("Port"),
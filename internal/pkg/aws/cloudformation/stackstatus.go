@@ -16,6 +16,12 @@ func (s stackStatus) requiresCleanup() bool {
 	return cloudformation.StackStatusRollbackComplete == string(s) || cloudformation.StackStatusRollbackFailed == string(s)
 }
 
+// requiresRollbackRecovery returns true if the stack failed to roll back an update and must continue its rollback
+// before it can be updated again.
+func (s stackStatus) requiresRollbackRecovery() bool {
+	return cloudformation.StackStatusUpdateRollbackFailed == string(s)
+}
+
 // inProgress returns true if the stack is currently being updated.
 func (s stackStatus) inProgress() bool {
 	return strings.HasSuffix(string(s), "IN_PROGRESS")
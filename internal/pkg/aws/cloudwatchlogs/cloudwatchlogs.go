@@ -31,6 +31,10 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	CreateLogGroup(input *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error)
+	CreateLogStream(input *cloudwatchlogs.CreateLogStreamInput) (*cloudwatchlogs.CreateLogStreamOutput, error)
+	PutLogEvents(input *cloudwatchlogs.PutLogEventsInput) (*cloudwatchlogs.PutLogEventsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -151,6 +155,33 @@ func (c *CloudWatchLogs) TaskLogEvents(logGroupName string, streamLastEventTime
 	}, nil
 }
 
+// LogStreamEvents returns the events of the log stream that follow the token, from the oldest to the latest,
+// and the token to get the next events with. The events are empty if the log stream doesn't exist yet.
+func (c *CloudWatchLogs) LogStreamEvents(logGroupName, logStreamName string, nextToken *string) ([]*Event, *string, error) {
+	resp, err := c.client.GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+		StartFromHead: aws.Bool(true),
+		NextToken:     nextToken,
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, nextToken, nil
+		}
+		return nil, nil, fmt.Errorf("get log events of %s/%s: %w", logGroupName, logStreamName, err)
+	}
+	var events []*Event
+	for _, event := range resp.Events {
+		events = append(events, &Event{
+			LogStreamName: trimLogStreamName(logStreamName),
+			IngestionTime: aws.Int64Value(event.IngestionTime),
+			Message:       aws.StringValue(event.Message),
+			Timestamp:     aws.Int64Value(event.Timestamp),
+		})
+	}
+	return events, resp.NextForwardToken, nil
+}
+
 // LogGroupExists returns if a log group exists.
 func (c *CloudWatchLogs) LogGroupExists(logGroupName string) (bool, error) {
 	_, err := c.client.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
@@ -169,6 +200,77 @@ func (c *CloudWatchLogs) LogGroupExists(logGroupName string) (bool, error) {
 	return false, nil
 }
 
+// LogGroupEvents returns the events of all the log streams of a log group that happened at or after startTime,
+// in milliseconds since epoch, from the oldest to the latest. The events are empty if the log group doesn't exist yet.
+func (c *CloudWatchLogs) LogGroupEvents(logGroupName string, startTime int64) ([]*Event, error) {
+	var events []*Event
+	in := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(logGroupName),
+		StartTime:    aws.Int64(startTime),
+	}
+	for {
+		resp, err := c.client.FilterLogEvents(in)
+		if err != nil {
+			if isResourceNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("filter log events of log group %s: %w", logGroupName, err)
+		}
+		for _, event := range resp.Events {
+			events = append(events, &Event{
+				LogStreamName: trimLogStreamName(aws.StringValue(event.LogStreamName)),
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	return events, nil
+}
+
+// WriteLogStream creates a new log stream in a log group, creating the log group first if it doesn't exist yet,
+// and puts the events in the log stream. The events must be sorted from the oldest to the latest.
+func (c *CloudWatchLogs) WriteLogStream(logGroupName, logStreamName string, events []*Event) error {
+	_, err := c.client.CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
+			return fmt.Errorf("create log group %s: %w", logGroupName, err)
+		}
+	}
+	if _, err := c.client.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	}); err != nil {
+		return fmt.Errorf("create log stream %s/%s: %w", logGroupName, logStreamName, err)
+	}
+	in := &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	}
+	for _, event := range events {
+		in.LogEvents = append(in.LogEvents, &cloudwatchlogs.InputLogEvent{
+			Message:   aws.String(event.Message),
+			Timestamp: aws.Int64(event.Timestamp),
+		})
+	}
+	if _, err := c.client.PutLogEvents(in); err != nil {
+		return fmt.Errorf("put log events in %s/%s: %w", logGroupName, logStreamName, err)
+	}
+	return nil
+}
+
+func isResourceNotFound(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException
+}
+
 func trimLogStreamName(logStreamName string) string {
 	// logStreamName example: copilot/{name}/1cc0685ad01d4d0f8e4e2c00d1775c56
 	return strings.TrimPrefix(logStreamName, logStreamNamePrefix)
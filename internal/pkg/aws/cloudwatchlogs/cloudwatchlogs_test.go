@@ -365,3 +365,216 @@ func TestLogGroupExists(t *testing.T) {
 		})
 	}
 }
+
+func TestLogStreamEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inToken    *string
+		mockClient func(m *mocks.Mockapi)
+		wantEvents []*Event
+		wantToken  *string
+		wantErr    error
+	}{
+		"returns the events that follow the token": {
+			inToken: aws.String("token-1"),
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/api/123"),
+					StartFromHead: aws.Bool(true),
+					NextToken:     aws.String("token-1"),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{Message: aws.String("migrated"), Timestamp: aws.Int64(1), IngestionTime: aws.Int64(2)},
+					},
+					NextForwardToken: aws.String("token-2"),
+				}, nil)
+			},
+			wantEvents: []*Event{
+				{LogStreamName: "api/123", Message: "migrated", Timestamp: 1, IngestionTime: 2},
+			},
+			wantToken: aws.String("token-2"),
+		},
+		"returns no events if the log stream does not exist yet": {
+			inToken: aws.String("token-1"),
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLogEvents(gomock.Any()).Return(nil, awserr.New("ResourceNotFoundException", "some error", nil))
+			},
+			wantToken: aws.String("token-1"),
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get log events of mockLogGroup/copilot/api/123: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			service := CloudWatchLogs{
+				client: mockClient,
+			}
+
+			// WHEN
+			events, token, err := service.LogStreamEvents("mockLogGroup", "copilot/api/123", tc.inToken)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEvents, events)
+			require.Equal(t, tc.wantToken, token)
+		})
+	}
+}
+
+func TestLogGroupEvents(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+		wantEvents []*Event
+		wantErr    error
+	}{
+		"returns the events of all the pages sorted by time": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					StartTime:    aws.Int64(100),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{LogStreamName: aws.String("stream-2"), Message: aws.String("second"), Timestamp: aws.Int64(200), IngestionTime: aws.Int64(201)},
+					},
+					NextToken: aws.String("token-1"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					StartTime:    aws.Int64(100),
+					NextToken:    aws.String("token-1"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{LogStreamName: aws.String("stream-1"), Message: aws.String("first"), Timestamp: aws.Int64(150), IngestionTime: aws.Int64(151)},
+					},
+				}, nil)
+			},
+			wantEvents: []*Event{
+				{LogStreamName: "stream-1", Message: "first", Timestamp: 150, IngestionTime: 151},
+				{LogStreamName: "stream-2", Message: "second", Timestamp: 200, IngestionTime: 201},
+			},
+		},
+		"returns no events if the log group does not exist yet": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, awserr.New("ResourceNotFoundException", "some error", nil))
+			},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("filter log events of log group mockLogGroup: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			service := CloudWatchLogs{
+				client: mockClient,
+			}
+
+			// WHEN
+			events, err := service.LogGroupEvents("mockLogGroup", 100)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEvents, events)
+		})
+	}
+}
+
+func TestWriteLogStream(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+		wantErr    error
+	}{
+		"creates the log group and the log stream, then puts the events": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+						LogGroupName: aws.String("mockLogGroup"),
+					}).Return(&cloudwatchlogs.CreateLogGroupOutput{}, nil),
+					m.EXPECT().CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+						LogGroupName:  aws.String("mockLogGroup"),
+						LogStreamName: aws.String("mockLogStream"),
+					}).Return(&cloudwatchlogs.CreateLogStreamOutput{}, nil),
+					m.EXPECT().PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+						LogGroupName:  aws.String("mockLogGroup"),
+						LogStreamName: aws.String("mockLogStream"),
+						LogEvents: []*cloudwatchlogs.InputLogEvent{
+							{Message: aws.String("hello"), Timestamp: aws.Int64(100)},
+						},
+					}).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil),
+				)
+			},
+		},
+		"reuses an existing log group": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateLogGroup(gomock.Any()).Return(nil, awserr.New("ResourceAlreadyExistsException", "some error", nil))
+				m.EXPECT().CreateLogStream(gomock.Any()).Return(&cloudwatchlogs.CreateLogStreamOutput{}, nil)
+				m.EXPECT().PutLogEvents(gomock.Any()).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil)
+			},
+		},
+		"wraps the error from creating the log group": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateLogGroup(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("create log group mockLogGroup: some error"),
+		},
+		"wraps the error from putting the events": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateLogGroup(gomock.Any()).Return(&cloudwatchlogs.CreateLogGroupOutput{}, nil)
+				m.EXPECT().CreateLogStream(gomock.Any()).Return(&cloudwatchlogs.CreateLogStreamOutput{}, nil)
+				m.EXPECT().PutLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("put log events in mockLogGroup/mockLogStream: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			service := CloudWatchLogs{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := service.WriteLogStream("mockLogGroup", "mockLogStream", []*Event{
+				{Message: "hello", Timestamp: 100},
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
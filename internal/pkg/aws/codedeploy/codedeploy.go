@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+const (
+	appSpecVersion      = "0.0"
+	ecsServiceType      = "AWS::ECS::Service"
+	appSpecRevisionType = "AppSpecContent"
+)
+
+type api interface {
+	CreateDeployment(*codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
+	GetDeployment(*codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+}
+
+// New returns a CodeDeploy configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client: codedeploy.New(s),
+	}
+}
+
+// ECSDeploymentInput holds the fields required to shift the traffic of an ECS service to a new task definition.
+type ECSDeploymentInput struct {
+	Application     string
+	DeploymentGroup string
+	TaskDefinition  string // ARN of the task definition of the replacement tasks.
+	ContainerName   string // Container that the load balancer routes traffic to.
+	ContainerPort   int
+	Hooks           map[string]string // Lambda functions invoked during the deployment, keyed by lifecycle event.
+}
+
+// Deployment represents a CodeDeploy deployment.
+type Deployment struct {
+	ID                   string
+	Status               string
+	ErrorMessage         string // Reason of the failure if the deployment did not succeed.
+	RollbackDeploymentID string // Deployment that rolled back the traffic, if the deployment was rolled back.
+}
+
+// Done returns true if the deployment reached a final status.
+func (d *Deployment) Done() bool {
+	switch d.Status {
+	case codedeploy.DeploymentStatusSucceeded, codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+		return true
+	}
+	return false
+}
+
+// Succeeded returns true if the traffic was shifted to the new tasks.
+func (d *Deployment) Succeeded() bool {
+	return d.Status == codedeploy.DeploymentStatusSucceeded
+}
+
+// CreateECSDeployment starts a blue/green deployment of an ECS service and returns the ID of the deployment.
+func (c *CodeDeploy) CreateECSDeployment(in *ECSDeploymentInput) (string, error) {
+	appSpec, err := in.appSpec()
+	if err != nil {
+		return "", err
+	}
+	out, err := c.client.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(in.Application),
+		DeploymentGroupName: aws.String(in.DeploymentGroup),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(appSpecRevisionType),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create deployment for deployment group %s: %w", in.DeploymentGroup, err)
+	}
+	return aws.StringValue(out.DeploymentId), nil
+}
+
+// Deployment returns the status of a deployment.
+func (c *CodeDeploy) Deployment(id string) (*Deployment, error) {
+	out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %s: %w", id, err)
+	}
+	info := out.DeploymentInfo
+	d := &Deployment{
+		ID:     aws.StringValue(info.DeploymentId),
+		Status: aws.StringValue(info.Status),
+	}
+	if info.ErrorInformation != nil {
+		d.ErrorMessage = aws.StringValue(info.ErrorInformation.Message)
+	}
+	if info.RollbackInfo != nil {
+		d.RollbackDeploymentID = aws.StringValue(info.RollbackInfo.RollbackDeploymentId)
+	}
+	return d, nil
+}
+
+// appSpec returns the JSON application specification of the deployment.
+func (in *ECSDeploymentInput) appSpec() (string, error) {
+	type loadBalancerInfo struct {
+		ContainerName string
+		ContainerPort int
+	}
+	type properties struct {
+		TaskDefinition   string
+		LoadBalancerInfo loadBalancerInfo
+	}
+	type targetService struct {
+		Type       string
+		Properties properties
+	}
+	events := make([]string, 0, len(in.Hooks))
+	for event := range in.Hooks {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+	hooks := make([]map[string]string, 0, len(events))
+	for _, event := range events {
+		hooks = append(hooks, map[string]string{event: in.Hooks[event]})
+	}
+	spec := struct {
+		Version   json.Number                `json:"version"`
+		Resources []map[string]targetService `json:"Resources"`
+		Hooks     []map[string]string        `json:"Hooks,omitempty"`
+	}{
+		Version: json.Number(appSpecVersion),
+		Resources: []map[string]targetService{
+			{
+				"TargetService": {
+					Type: ecsServiceType,
+					Properties: properties{
+						TaskDefinition: in.TaskDefinition,
+						LoadBalancerInfo: loadBalancerInfo{
+							ContainerName: in.ContainerName,
+							ContainerPort: in.ContainerPort,
+						},
+					},
+				},
+			},
+		},
+		Hooks: hooks,
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("marshal application specification: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_CreateECSDeployment(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		in         *ECSDeploymentInput
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"creates a deployment with the application specification of the service": {
+			in: &ECSDeploymentInput{
+				Application:     "phonetool-test-api",
+				DeploymentGroup: "phonetool-test-api-group",
+				TaskDefinition:  "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+				ContainerName:   "api",
+				ContainerPort:   80,
+				Hooks: map[string]string{
+					"BeforeAllowTraffic":    "validate",
+					"AfterAllowTestTraffic": "smoke-test",
+				},
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(&codedeploy.CreateDeploymentInput{
+					ApplicationName:     aws.String("phonetool-test-api"),
+					DeploymentGroupName: aws.String("phonetool-test-api-group"),
+					Revision: &codedeploy.RevisionLocation{
+						RevisionType: aws.String("AppSpecContent"),
+						AppSpecContent: &codedeploy.AppSpecContent{
+							Content: aws.String(`{"version":0.0,"Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2","LoadBalancerInfo":{"ContainerName":"api","ContainerPort":80}}}}],"Hooks":[{"AfterAllowTestTraffic":"smoke-test"},{"BeforeAllowTraffic":"validate"}]}`),
+						},
+					},
+				}).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1234"),
+				}, nil)
+			},
+			wantedID: "d-1234",
+		},
+		"omits the hooks if there are none": {
+			in: &ECSDeploymentInput{
+				Application:     "phonetool-test-api",
+				DeploymentGroup: "phonetool-test-api-group",
+				TaskDefinition:  "api:2",
+				ContainerName:   "api",
+				ContainerPort:   80,
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).DoAndReturn(func(in *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error) {
+					require.Equal(t, `{"version":0.0,"Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"api:2","LoadBalancerInfo":{"ContainerName":"api","ContainerPort":80}}}}]}`,
+						aws.StringValue(in.Revision.AppSpecContent.Content))
+					return &codedeploy.CreateDeploymentOutput{DeploymentId: aws.String("d-1234")}, nil
+				})
+			},
+			wantedID: "d-1234",
+		},
+		"wraps the error from CreateDeployment": {
+			in: &ECSDeploymentInput{
+				DeploymentGroup: "phonetool-test-api-group",
+			},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("create deployment for deployment group phonetool-test-api-group: %w", mockErr),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			c := CodeDeploy{client: m}
+
+			// WHEN
+			id, err := c.CreateECSDeployment(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeDeploy_Deployment(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedDeployment *Deployment
+		wantedErr        error
+	}{
+		"returns the status of an in progress deployment": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+					DeploymentId: aws.String("d-1234"),
+				}).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1234"),
+						Status:       aws.String(codedeploy.DeploymentStatusInProgress),
+					},
+				}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:     "d-1234",
+				Status: codedeploy.DeploymentStatusInProgress,
+			},
+		},
+		"returns the error and rollback of a failed deployment": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1234"),
+						Status:       aws.String(codedeploy.DeploymentStatusFailed),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("The BeforeAllowTraffic hook failed"),
+						},
+						RollbackInfo: &codedeploy.RollbackInfo{
+							RollbackDeploymentId: aws.String("d-5678"),
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:                   "d-1234",
+				Status:               codedeploy.DeploymentStatusFailed,
+				ErrorMessage:         "The BeforeAllowTraffic hook failed",
+				RollbackDeploymentID: "d-5678",
+			},
+		},
+		"wraps the error from GetDeployment": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get deployment d-1234: %w", mockErr),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			c := CodeDeploy{client: m}
+
+			// WHEN
+			d, err := c.Deployment("d-1234")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, d)
+		})
+	}
+}
+
+func TestDeployment_Done(t *testing.T) {
+	testCases := map[string]struct {
+		status          string
+		wantedDone      bool
+		wantedSucceeded bool
+	}{
+		"in progress": {status: codedeploy.DeploymentStatusInProgress},
+		"ready":       {status: codedeploy.DeploymentStatusReady},
+		"succeeded":   {status: codedeploy.DeploymentStatusSucceeded, wantedDone: true, wantedSucceeded: true},
+		"failed":      {status: codedeploy.DeploymentStatusFailed, wantedDone: true},
+		"stopped":     {status: codedeploy.DeploymentStatusStopped, wantedDone: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := &Deployment{Status: tc.status}
+
+			require.Equal(t, tc.wantedDone, d.Done())
+			require.Equal(t, tc.wantedSucceeded, d.Succeeded())
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ec2 provides a client to make API requests to Amazon Elastic Compute Cloud.
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	filterVPCID        = "vpc-id"
+	filterDefaultForAZ = "default-for-az"
+	tagKeyName         = "Name"
+)
+
+type api interface {
+	DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
+	DescribeSubnets(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
+}
+
+// EC2 wraps an AWS EC2 client.
+type EC2 struct {
+	client api
+}
+
+// VPC contains the ID and name of a VPC.
+type VPC struct {
+	ID   string
+	Name string
+}
+
+// String formats the VPC as its ID followed by its name, if it has one.
+func (v VPC) String() string {
+	return formatResource(v.ID, v.Name)
+}
+
+// Subnet contains the ID, name and availability zone of a subnet.
+type Subnet struct {
+	ID               string
+	Name             string
+	AvailabilityZone string
+}
+
+// String formats the subnet as its ID followed by its name, if it has one.
+func (s Subnet) String() string {
+	return formatResource(s.ID, s.Name)
+}
+
+// New returns a EC2 client configured against the input session.
+func New(s *session.Session) *EC2 {
+	return &EC2{
+		client: ec2.New(s),
+	}
+}
+
+// ListVPCs returns the VPCs in the account and region of the session.
+func (c *EC2) ListVPCs() ([]VPC, error) {
+	var vpcs []VPC
+	in := &ec2.DescribeVpcsInput{}
+	for {
+		out, err := c.client.DescribeVpcs(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe VPCs: %w", err)
+		}
+		for _, vpc := range out.Vpcs {
+			vpcs = append(vpcs, VPC{
+				ID:   aws.StringValue(vpc.VpcId),
+				Name: nameTag(vpc.Tags),
+			})
+		}
+		if out.NextToken == nil {
+			return vpcs, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// ListSubnets returns the subnets of a VPC.
+func (c *EC2) ListSubnets(vpcID string) ([]Subnet, error) {
+	var subnets []Subnet
+	in := &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filterVPCID),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}
+	for {
+		out, err := c.client.DescribeSubnets(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe subnets of VPC %s: %w", vpcID, err)
+		}
+		for _, subnet := range out.Subnets {
+			subnets = append(subnets, Subnet{
+				ID:               aws.StringValue(subnet.SubnetId),
+				Name:             nameTag(subnet.Tags),
+				AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
+			})
+		}
+		if out.NextToken == nil {
+			return subnets, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// DefaultSubnets returns the IDs of the default subnets of the default VPC.
+func (c *EC2) DefaultSubnets() ([]string, error) {
+	var subnets []string
+	in := &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filterDefaultForAZ),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+		},
+	}
+	for {
+		out, err := c.client.DescribeSubnets(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe default subnets: %w", err)
+		}
+		for _, subnet := range out.Subnets {
+			subnets = append(subnets, aws.StringValue(subnet.SubnetId))
+		}
+		if out.NextToken == nil {
+			return subnets, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func nameTag(tags []*ec2.Tag) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == tagKeyName {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+func formatResource(id, name string) string {
+	if name == "" {
+		return id
+	}
+	return fmt.Sprintf("%s (%s)", id, name)
+}
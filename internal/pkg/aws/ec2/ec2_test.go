@@ -0,0 +1,223 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ec2
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEC2_ListVPCs(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedVPCs []VPC
+		wantedErr  error
+	}{
+		"fail to describe VPCs": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe VPCs: some error"),
+		},
+		"success with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("vpc-1"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("team"), Value: aws.String("network")},
+								{Key: aws.String("Name"), Value: aws.String("shared")},
+							},
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("vpc-2"),
+						},
+					},
+				}, nil)
+			},
+			wantedVPCs: []VPC{
+				{ID: "vpc-1", Name: "shared"},
+				{ID: "vpc-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+			client := EC2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			vpcs, err := client.ListVPCs()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVPCs, vpcs)
+			}
+		})
+	}
+}
+
+func TestEC2_ListSubnets(t *testing.T) {
+	mockInput := &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{"vpc-1"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedSubnets []Subnet
+		wantedErr     error
+	}{
+		"fail to describe subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe subnets of VPC vpc-1: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(mockInput).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:         aws.String("subnet-1"),
+							AvailabilityZone: aws.String("us-west-2a"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("Name"), Value: aws.String("public")},
+							},
+						},
+						{
+							SubnetId:         aws.String("subnet-2"),
+							AvailabilityZone: aws.String("us-west-2b"),
+						},
+					},
+				}, nil)
+			},
+			wantedSubnets: []Subnet{
+				{ID: "subnet-1", Name: "public", AvailabilityZone: "us-west-2a"},
+				{ID: "subnet-2", AvailabilityZone: "us-west-2b"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+			client := EC2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			subnets, err := client.ListSubnets("vpc-1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSubnets, subnets)
+			}
+		})
+	}
+}
+
+func TestEC2_DefaultSubnets(t *testing.T) {
+	mockInput := &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("default-for-az"),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedSubnets []string
+		wantedErr     error
+	}{
+		"fail to describe subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe default subnets: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(mockInput).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-1")},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters:   mockInput.Filters,
+					NextToken: aws.String("next"),
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-2")},
+					},
+				}, nil)
+			},
+			wantedSubnets: []string{"subnet-1", "subnet-2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+			client := EC2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			subnets, err := client.DefaultSubnets()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSubnets, subnets)
+			}
+		})
+	}
+}
@@ -446,3 +446,68 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestImageDigest(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockTag := "v1"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo %s describe image %s: %w", mockRepoName, mockTag, mockError),
+		},
+		"should return error if no image has the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantError: fmt.Errorf("no image tagged %s found in ecr repo %s", mockTag, mockRepoName),
+		},
+		"should return the digest of the tagged image": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String(mockTag),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: "sha256:abc",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotDigest, gotError := client.ImageDigest(mockRepoName, mockTag)
+
+			require.Equal(t, tc.wantDigest, gotDigest)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	runTaskStartedBy = "copilot"
+
+	awsLogsDriver             = "awslogs"
+	awsLogsGroupOption        = "awslogs-group"
+	awsLogsStreamPrefixOption = "awslogs-stream-prefix"
+)
+
+// RunServiceTask starts a one-off task of the task definition in the cluster and network of the service.
+// The command overrides the one of the container. It returns the ARN of the started task.
+func (e *ECS) RunServiceTask(svc *Service, taskDefinition, container string, command []string) (string, error) {
+	in := &ecs.RunTaskInput{
+		Cluster:                  svc.ClusterArn,
+		TaskDefinition:           aws.String(taskDefinition),
+		NetworkConfiguration:     svc.NetworkConfiguration,
+		PlatformVersion:          svc.PlatformVersion,
+		CapacityProviderStrategy: svc.CapacityProviderStrategy,
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:    aws.String(container),
+					Command: aws.StringSlice(command),
+				},
+			},
+		},
+		StartedBy: aws.String(runTaskStartedBy),
+	}
+	if len(svc.CapacityProviderStrategy) == 0 {
+		in.LaunchType = svc.LaunchType
+	}
+	resp, err := e.client.RunTask(in)
+	if err != nil {
+		return "", fmt.Errorf("run task of task definition %s: %w", taskDefinition, err)
+	}
+	if len(resp.Failures) > 0 {
+		return "", fmt.Errorf("run task of task definition %s: %s", taskDefinition, aws.StringValue(resp.Failures[0].Reason))
+	}
+	if len(resp.Tasks) == 0 {
+		return "", fmt.Errorf("run task of task definition %s: no task started", taskDefinition)
+	}
+	return aws.StringValue(resp.Tasks[0].TaskArn), nil
+}
+
+// RunTaskInput holds the fields needed to run one-off tasks on Fargate.
+type RunTaskInput struct {
+	Cluster        string   // Optional. Name of the cluster, defaults to the default cluster of the account.
+	Count          int      // Number of tasks to start.
+	Subnets        []string // Subnets the tasks are placed in.
+	SecurityGroups []string // Optional. Security groups of the tasks.
+	TaskFamilyName string   // Family of the task definition to run.
+}
+
+// RunTask starts one-off tasks of the latest revision of a task definition family on Fargate.
+// It returns the ARNs of the started tasks.
+func (e *ECS) RunTask(input RunTaskInput) ([]string, error) {
+	in := &ecs.RunTaskInput{
+		Count:          aws.Int64(int64(input.Count)),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		TaskDefinition: aws.String(input.TaskFamilyName),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				Subnets:        aws.StringSlice(input.Subnets),
+				SecurityGroups: aws.StringSlice(input.SecurityGroups),
+			},
+		},
+		StartedBy: aws.String(runTaskStartedBy),
+	}
+	if input.Cluster != "" {
+		in.Cluster = aws.String(input.Cluster)
+	}
+	resp, err := e.client.RunTask(in)
+	if err != nil {
+		return nil, fmt.Errorf("run task of task definition %s: %w", input.TaskFamilyName, err)
+	}
+	if len(resp.Failures) > 0 {
+		return nil, fmt.Errorf("run task of task definition %s: %s", input.TaskFamilyName, aws.StringValue(resp.Failures[0].Reason))
+	}
+	var arns []string
+	for _, task := range resp.Tasks {
+		arns = append(arns, aws.StringValue(task.TaskArn))
+	}
+	return arns, nil
+}
+
+// Task calls ECS API and returns the task in the cluster.
+func (e *ECS) Task(clusterName, taskARN string) (*Task, error) {
+	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterName),
+		Tasks:   aws.StringSlice([]string{taskARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe task %s: %w", taskARN, err)
+	}
+	if len(resp.Tasks) == 0 {
+		return nil, fmt.Errorf("cannot find task %s", taskARN)
+	}
+	t := Task(*resp.Tasks[0])
+	return &t, nil
+}
+
+// RegisterTaskDefinitionWithImage registers a copy of the task definition in which the container runs the image,
+// under the family of the task definition suffixed with "-hooks". It returns the ARN of the registered task definition.
+func (e *ECS) RegisterTaskDefinitionWithImage(taskDefName, container, image string) (string, error) {
+	td, err := e.TaskDefinition(taskDefName)
+	if err != nil {
+		return "", err
+	}
+	var containers []*ecs.ContainerDefinition
+	var found bool
+	for _, c := range td.ContainerDefinitions {
+		copied := *c
+		if aws.StringValue(c.Name) == container {
+			copied.Image = aws.String(image)
+			found = true
+		}
+		containers = append(containers, &copied)
+	}
+	if !found {
+		return "", fmt.Errorf("cannot find container %s in task definition %s", container, taskDefName)
+	}
+	resp, err := e.client.RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+		Family:                  aws.String(aws.StringValue(td.Family) + "-hooks"),
+		ContainerDefinitions:    containers,
+		Cpu:                     td.Cpu,
+		Memory:                  td.Memory,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		TaskRoleArn:             td.TaskRoleArn,
+		NetworkMode:             td.NetworkMode,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		Volumes:                 td.Volumes,
+		PlacementConstraints:    td.PlacementConstraints,
+		ProxyConfiguration:      td.ProxyConfiguration,
+		InferenceAccelerators:   td.InferenceAccelerators,
+		IpcMode:                 td.IpcMode,
+		PidMode:                 td.PidMode,
+	})
+	if err != nil {
+		return "", fmt.Errorf("register copy of task definition %s: %w", taskDefName, err)
+	}
+	return aws.StringValue(resp.TaskDefinition.TaskDefinitionArn), nil
+}
+
+// IsStopped returns true if the task has stopped running.
+func (t *Task) IsStopped() bool {
+	return aws.StringValue(t.LastStatus) == ecs.DesiredStatusStopped
+}
+
+// ExitCode returns the exit code of the container once the task is stopped.
+// It returns an error if the container didn't run to completion.
+func (t *Task) ExitCode(container string) (int, error) {
+	for _, c := range t.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		if c.ExitCode == nil {
+			reason := aws.StringValue(c.Reason)
+			if reason == "" {
+				reason = aws.StringValue(t.StoppedReason)
+			}
+			return 0, fmt.Errorf("container %s did not exit: %s", container, reason)
+		}
+		return int(aws.Int64Value(c.ExitCode)), nil
+	}
+	return 0, fmt.Errorf("cannot find container %s in task %s", container, aws.StringValue(t.TaskArn))
+}
+
+// LogStreamName returns the name of the log stream of the container in the task, given the prefix of the stream.
+func (t *Task) LogStreamName(prefix, container string) (string, error) {
+	taskID, err := t.taskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s", prefix, container, taskID), nil
+}
+
+// AWSLogsConfig returns the log group and the log stream prefix of the container if it sends its logs to CloudWatch Logs
+// with the awslogs driver. It returns false if the container doesn't use the awslogs driver.
+func (t *TaskDefinition) AWSLogsConfig(container string) (group, streamPrefix string, ok bool) {
+	for _, c := range t.ContainerDefinitions {
+		if aws.StringValue(c.Name) != container || c.LogConfiguration == nil {
+			continue
+		}
+		if aws.StringValue(c.LogConfiguration.LogDriver) != awsLogsDriver {
+			return "", "", false
+		}
+		opts := c.LogConfiguration.Options
+		return aws.StringValue(opts[awsLogsGroupOption]), aws.StringValue(opts[awsLogsStreamPrefixOption]), true
+	}
+	return "", "", false
+}
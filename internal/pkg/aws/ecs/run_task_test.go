@@ -0,0 +1,345 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECS_RunServiceTask(t *testing.T) {
+	network := &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        aws.StringSlice([]string{"subnet-1"}),
+			SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+		},
+	}
+	svc := &Service{
+		ClusterArn:           aws.String("cluster"),
+		LaunchType:           aws.String("FARGATE"),
+		PlatformVersion:      aws.String("LATEST"),
+		NetworkConfiguration: network,
+	}
+	wantedInput := &ecs.RunTaskInput{
+		Cluster:              aws.String("cluster"),
+		TaskDefinition:       aws.String("task-def:2"),
+		LaunchType:           aws.String("FARGATE"),
+		PlatformVersion:      aws.String("LATEST"),
+		NetworkConfiguration: network,
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:    aws.String("api"),
+					Command: aws.StringSlice([]string{"./migrate", "up"}),
+				},
+			},
+		},
+		StartedBy: aws.String("copilot"),
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedARN string
+		wantedErr error
+	}{
+		"returns the ARN of the started task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(&ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{{TaskArn: aws.String("task-1")}},
+				}, nil)
+			},
+			wantedARN: "task-1",
+		},
+		"wraps the error from running the task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("run task of task definition task-def:2: some error"),
+		},
+		"returns the reason of a failure": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(&ecs.RunTaskOutput{
+					Failures: []*ecs.Failure{{Reason: aws.String("RESOURCE:MEMORY")}},
+				}, nil)
+			},
+			wantedErr: errors.New("run task of task definition task-def:2: RESOURCE:MEMORY"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			arn, err := service.RunServiceTask(svc, "task-def:2", "api", []string{"./migrate", "up"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestECS_RunTask(t *testing.T) {
+	wantedInput := &ecs.RunTaskInput{
+		Cluster:        aws.String("my-cluster"),
+		Count:          aws.Int64(2),
+		LaunchType:     aws.String("FARGATE"),
+		TaskDefinition: aws.String("copilot-db-migrate"),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String("ENABLED"),
+				Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+			},
+		},
+		StartedBy: aws.String("copilot"),
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedARNs []string
+		wantedErr  error
+	}{
+		"returns the ARNs of the started tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(&ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{{TaskArn: aws.String("task-1")}, {TaskArn: aws.String("task-2")}},
+				}, nil)
+			},
+			wantedARNs: []string{"task-1", "task-2"},
+		},
+		"wraps the error from running the tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("run task of task definition copilot-db-migrate: some error"),
+		},
+		"returns the reason of a failure": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(wantedInput).Return(&ecs.RunTaskOutput{
+					Failures: []*ecs.Failure{{Reason: aws.String("RESOURCE:MEMORY")}},
+				}, nil)
+			},
+			wantedErr: errors.New("run task of task definition copilot-db-migrate: RESOURCE:MEMORY"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			arns, err := service.RunTask(RunTaskInput{
+				Cluster:        "my-cluster",
+				Count:          2,
+				Subnets:        []string{"subnet-1", "subnet-2"},
+				SecurityGroups: []string{"sg-1"},
+				TaskFamilyName: "copilot-db-migrate",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARNs, arns)
+		})
+	}
+}
+
+func TestECS_RegisterTaskDefinitionWithImage(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedARN string
+		wantedErr error
+	}{
+		"registers a copy of the task definition with the image": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						Family: aws.String("phonetool-test-api"),
+						Cpu:    aws.String("256"),
+						Memory: aws.String("512"),
+						ContainerDefinitions: []*ecs.ContainerDefinition{
+							{Name: aws.String("api"), Image: aws.String("repo:old")},
+							{Name: aws.String("nginx"), Image: aws.String("nginx")},
+						},
+					},
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+					Family: aws.String("phonetool-test-api-hooks"),
+					Cpu:    aws.String("256"),
+					Memory: aws.String("512"),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("api"), Image: aws.String("repo@sha256:new")},
+						{Name: aws.String("nginx"), Image: aws.String("nginx")},
+					},
+				}).Return(&ecs.RegisterTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{TaskDefinitionArn: aws.String("phonetool-test-api-hooks:1")},
+				}, nil)
+			},
+			wantedARN: "phonetool-test-api-hooks:1",
+		},
+		"errors if the container is not in the task definition": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						ContainerDefinitions: []*ecs.ContainerDefinition{
+							{Name: aws.String("nginx"), Image: aws.String("nginx")},
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("cannot find container api in task definition task-def"),
+		},
+		"wraps the error from registering the task definition": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						ContainerDefinitions: []*ecs.ContainerDefinition{
+							{Name: aws.String("api"), Image: aws.String("repo:old")},
+						},
+					},
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("register copy of task definition task-def: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			arn, err := service.RegisterTaskDefinitionWithImage("task-def", "api", "repo@sha256:new")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestTask_ExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		in *Task
+
+		wantedCode int
+		wantedErr  error
+	}{
+		"returns the exit code of the container": {
+			in: &Task{
+				Containers: []*ecs.Container{
+					{Name: aws.String("api"), ExitCode: aws.Int64(3)},
+				},
+			},
+			wantedCode: 3,
+		},
+		"returns the reason if the container did not exit": {
+			in: &Task{
+				StoppedReason: aws.String("CannotPullContainerError"),
+				Containers: []*ecs.Container{
+					{Name: aws.String("api")},
+				},
+			},
+			wantedErr: errors.New("container api did not exit: CannotPullContainerError"),
+		},
+		"errors if the container is not in the task": {
+			in:        &Task{TaskArn: aws.String("task-1")},
+			wantedErr: errors.New("cannot find container api in task task-1"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			code, err := tc.in.ExitCode("api")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCode, code)
+		})
+	}
+}
+
+func TestTask_LogStreamName(t *testing.T) {
+	task := &Task{TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d")}
+
+	name, err := task.LogStreamName("copilot", "api")
+
+	require.NoError(t, err)
+	require.Equal(t, "copilot/api/4082490ee6c245e09d2145010aa1ba8d", name)
+}
+
+func TestTaskDefinition_AWSLogsConfig(t *testing.T) {
+	td := &TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name: aws.String("api"),
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String("awslogs"),
+					Options: map[string]*string{
+						"awslogs-group":         aws.String("/copilot/phonetool-test-api"),
+						"awslogs-stream-prefix": aws.String("copilot"),
+					},
+				},
+			},
+			{
+				Name: aws.String("worker"),
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String("awsfirelens"),
+				},
+			},
+		},
+	}
+
+	group, prefix, ok := td.AWSLogsConfig("api")
+	require.True(t, ok)
+	require.Equal(t, "/copilot/phonetool-test-api", group)
+	require.Equal(t, "copilot", prefix)
+
+	_, _, ok = td.AWSLogsConfig("worker")
+	require.False(t, ok)
+}
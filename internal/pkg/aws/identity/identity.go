@@ -7,6 +7,7 @@ package identity
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -29,6 +30,7 @@ func New(s *session.Session) STS {
 
 // Caller holds information about a calling entity.
 type Caller struct {
+	ARN         string
 	RootUserARN string
 	Account     string
 	UserID      string
@@ -43,6 +45,7 @@ func (s STS) Get() (Caller, error) {
 	}
 
 	return Caller{
+		ARN:         aws.StringValue(out.Arn),
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
@@ -41,6 +41,7 @@ func TestIdentity_Get(t *testing.T) {
 				}, nil)
 			},
 			wantIdentity: Caller{
+				ARN:         mockARN,
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
@@ -23,7 +23,12 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(BuildAppInitCommand())
 	cmd.AddCommand(BuildAppListCommand())
 	cmd.AddCommand(BuildAppShowCmd())
+	cmd.AddCommand(BuildAppUpdateCmd())
 	cmd.AddCommand(BuildAppDeleteCommand())
+	cmd.AddCommand(BuildAppMigrateCmd())
+	cmd.AddCommand(BuildAppExportCmd())
+	cmd.AddCommand(BuildAppImportCmd())
+	cmd.AddCommand(BuildAppAuditCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appAuditNamePrompt     = "Which application's audit log would you like to show?"
+	appAuditNameHelpPrompt = "Lists the commands that changed the resources of the application."
+
+	defaultAuditSince = "7d"
+)
+
+type auditAppVars struct {
+	*GlobalOpts
+	since            string
+	shouldOutputJSON bool
+}
+
+type auditAppOpts struct {
+	auditAppVars
+
+	w     io.Writer
+	store applicationGetter
+	log   auditEventsGetter
+	sel   appSelector
+
+	sinceDuration time.Duration // Parsed value of the since flag.
+}
+
+func newAuditAppOpts(vars auditAppVars) (*auditAppOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	return &auditAppOpts{
+		auditAppVars: vars,
+		w:            log.OutputWriter,
+		store:        store,
+		log:          audit.New(sess),
+		sel:          selector.NewSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *auditAppOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	since, err := parseAuditSince(o.since)
+	if err != nil {
+		return err
+	}
+	o.sinceDuration = since
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *auditAppOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appAuditNamePrompt, appAuditNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute lists the events of the audit log of the application, from the oldest to the latest.
+func (o *auditAppOpts) Execute() error {
+	events, err := o.log.Events(o.AppName(), time.Now().Add(-o.sinceDuration))
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Events []*audit.Event `json:"events"`
+		}{Events: events})
+		if err != nil {
+			return fmt.Errorf("marshal audit events: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(events) == 0 {
+		log.Infof("No audit events recorded for application %s in the last %s.\n",
+			color.HighlightUserInput(o.AppName()), o.since)
+		return nil
+	}
+	writeAuditEvents(o.w, events)
+	return nil
+}
+
+// writeAuditEvents writes a table of the audit events.
+func writeAuditEvents(w io.Writer, events []*audit.Event) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", "Time", "Command", "Environment", "Service", "Caller", "Outcome")
+	for _, e := range events {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Time.Format(time.RFC3339), e.Command,
+			auditCell(e.Env), auditCell(e.Service), auditCell(e.Caller.ARN), e.Outcome)
+	}
+	writer.Flush()
+}
+
+func auditCell(value string) string {
+	if value == "" {
+		return emptyCell
+	}
+	return value
+}
+
+// parseAuditSince parses a relative duration like time.ParseDuration, and also accepts a number of days like "7d".
+func parseAuditSince(since string) (time.Duration, error) {
+	var d time.Duration
+	if days := strings.TrimSuffix(since, "d"); days != since {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("parse --%s %s: invalid number of days", sinceFlag, since)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		parsed, err := time.ParseDuration(since)
+		if err != nil {
+			return 0, fmt.Errorf("parse --%s %s: %w", sinceFlag, since, err)
+		}
+		d = parsed
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("--%s must be greater than 0", sinceFlag)
+	}
+	return d, nil
+}
+
+// BuildAppAuditCmd builds the command for showing the audit log of an application.
+func BuildAppAuditCmd() *cobra.Command {
+	vars := auditAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Lists the commands that changed an application.",
+		Long: `Lists the commands that changed an application.
+Each init, deploy and delete command, pipeline update and storage init is recorded with its caller and outcome.`,
+		Example: `
+  Lists the commands that changed the application "my-app" in the last 7 days.
+  /code $ copilot app audit -n my-app
+  Lists the commands of the last 12 hours in JSON format.
+  /code $ copilot app audit --since 12h --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAuditAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.since, sinceFlag, defaultAuditSince, auditSinceFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
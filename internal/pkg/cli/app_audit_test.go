@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuditSince(t *testing.T) {
+	_, invalidDurationErr := time.ParseDuration("week")
+	testCases := map[string]struct {
+		inSince string
+
+		wantedDuration time.Duration
+		wantedErr      error
+	}{
+		"parses a number of days": {
+			inSince:        "7d",
+			wantedDuration: 7 * 24 * time.Hour,
+		},
+		"parses a duration": {
+			inSince:        "1h30m",
+			wantedDuration: 90 * time.Minute,
+		},
+		"errors on an invalid number of days": {
+			inSince:   "xd",
+			wantedErr: errors.New("parse --since xd: invalid number of days"),
+		},
+		"errors on an invalid duration": {
+			inSince:   "week",
+			wantedErr: fmt.Errorf("parse --since week: %w", invalidDurationErr),
+		},
+		"errors if the duration is not positive": {
+			inSince:   "0d",
+			wantedErr: errors.New("--since must be greater than 0"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			d, err := parseAuditSince(tc.inSince)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDuration, d)
+		})
+	}
+}
+
+func TestAuditAppOpts_Execute(t *testing.T) {
+	mockEvents := []*audit.Event{
+		{
+			Time:    time.Date(2020, time.August, 20, 10, 30, 0, 0, time.UTC),
+			Command: "svc deploy",
+			Caller:  audit.Caller{ARN: "arn:aws:iam::1234:user/alice"},
+			App:     "phonetool",
+			Env:     "test",
+			Service: "frontend",
+			Outcome: audit.OutcomeSucceeded,
+		},
+		{
+			Time:    time.Date(2020, time.August, 21, 8, 0, 0, 0, time.UTC),
+			Command: "env delete",
+			Caller:  audit.Caller{ARN: "arn:aws:iam::1234:user/bob"},
+			App:     "phonetool",
+			Env:     "test",
+			Outcome: audit.OutcomeFailed,
+			Error:   "some error",
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockauditEventsGetter)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"writes a table of the events": {
+			setupMocks: func(m *mocks.MockauditEventsGetter) {
+				m.EXPECT().Events("phonetool", gomock.Any()).DoAndReturn(func(_ string, since time.Time) ([]*audit.Event, error) {
+					require.WithinDuration(t, time.Now().Add(-7*24*time.Hour), since, time.Minute)
+					return mockEvents, nil
+				})
+			},
+			wantedContent: `Time                  Command             Environment         Service             Caller                        Outcome
+2020-08-20T10:30:00Z  svc deploy          test                frontend            arn:aws:iam::1234:user/alice  succeeded
+2020-08-21T08:00:00Z  env delete          test                -                   arn:aws:iam::1234:user/bob    failed
+`,
+		},
+		"writes the events in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockauditEventsGetter) {
+				m.EXPECT().Events("phonetool", gomock.Any()).Return(mockEvents[1:], nil)
+			},
+			wantedContent: `{"events":[{"time":"2020-08-21T08:00:00Z","command":"env delete","caller":{"arn":"arn:aws:iam::1234:user/bob","account":"","userId":""},"app":"phonetool","env":"test","outcome":"failed","error":"some error"}]}
+`,
+		},
+		"writes nothing if there are no events": {
+			setupMocks: func(m *mocks.MockauditEventsGetter) {
+				m.EXPECT().Events("phonetool", gomock.Any()).Return(nil, nil)
+			},
+		},
+		"returns the error from getting the events": {
+			setupMocks: func(m *mocks.MockauditEventsGetter) {
+				m.EXPECT().Events("phonetool", gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLog := mocks.NewMockauditEventsGetter(ctrl)
+			tc.setupMocks(mockLog)
+			b := &bytes.Buffer{}
+			opts := &auditAppOpts{
+				auditAppVars: auditAppVars{
+					GlobalOpts:       &GlobalOpts{appName: "phonetool"},
+					since:            "7d",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:             b,
+				log:           mockLog,
+				sinceDuration: 7 * 24 * time.Hour,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -53,6 +54,7 @@ type deleteAppOpts struct {
 	spinner progress
 
 	store                store
+	deployments          deploymentDeleter
 	ws                   wsFileDeleter
 	sessProvider         sessionProvider
 	cfn                  deployer
@@ -60,15 +62,19 @@ type deleteAppOpts struct {
 	executor             func(svcName string) (executor, error)
 	askExecutor          func(envName, envProfile string) (askExecutor, error)
 	deletePipelineRunner func() (deletePipelineRunner, error)
+
+	// Internal state.
+	svcs []*config.Service
+	envs []*config.Environment
 }
 
 func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
@@ -83,6 +89,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		deleteAppVars: vars,
 		spinner:       termprogress.NewSpinner(),
 		store:         store,
+		deployments:   store,
 		ws:            ws,
 		sessProvider:  provider,
 		cfn:           cloudformation.New(defaultSession),
@@ -181,6 +188,10 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
+	if err := o.deleteDeployments(); err != nil {
+		return err
+	}
+
 	if err := o.deleteAppConfigs(); err != nil {
 		return err
 	}
@@ -192,11 +203,22 @@ func (o *deleteAppOpts) Execute() error {
 	return nil
 }
 
+// auditedTarget returns the application deleted by the command.
+func (o *deleteAppOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		stacks: []auditStack{
+			{name: stack.NameForAppStack(o.AppName())},
+		},
+	}
+}
+
 func (o *deleteAppOpts) deleteSvcs() error {
 	svcs, err := o.store.ListServices(o.AppName())
 	if err != nil {
 		return fmt.Errorf("list services for application %s: %w", o.AppName(), err)
 	}
+	o.svcs = svcs
 
 	for _, svc := range svcs {
 		cmd, err := o.executor(svc.Name)
@@ -215,6 +237,7 @@ func (o *deleteAppOpts) deleteEnvs() error {
 	if err != nil {
 		return fmt.Errorf("list environments for application %s: %w", o.AppName(), err)
 	}
+	o.envs = envs
 
 	for _, env := range envs {
 		// Check to see if a profile was passed in for this environment
@@ -281,6 +304,18 @@ func (o *deleteAppOpts) deleteAppResources() error {
 	return nil
 }
 
+// deleteDeployments removes the deployment history of every service in every environment of the application.
+func (o *deleteAppOpts) deleteDeployments() error {
+	for _, env := range o.envs {
+		for _, svc := range o.svcs {
+			if err := o.deployments.DeleteDeployments(o.AppName(), env.Name, svc.Name); err != nil {
+				return fmt.Errorf("delete deployment history of service %s in environment %s: %w", svc.Name, env.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (o *deleteAppOpts) deleteAppConfigs() error {
 	o.spinner.Start(deleteAppConfigStartMsg)
 	if err := o.store.DeleteApplication(o.AppName()); err != nil {
@@ -324,7 +359,7 @@ func BuildAppDeleteCommand() *cobra.Command {
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			return opts.Execute()
+			return executeAudited(cmd, args, opts, opts.Execute)
 		}),
 	}
 
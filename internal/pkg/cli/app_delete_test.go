@@ -132,6 +132,7 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 type deleteAppMocks struct {
 	spinner         *mocks.Mockprogress
 	store           *mocks.Mockstore
+	deployments     *mocks.MockdeploymentDeleter
 	ws              *mocks.MockwsFileDeleter
 	sessProvider    *session.Provider
 	deployer        *mocks.Mockdeployer
@@ -196,6 +197,9 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppResourcesStopMsg)),
 
+					// deleteDeployments
+					mocks.deployments.EXPECT().DeleteDeployments(mockAppName, "staging", "webapp").Return(nil),
+
 					// deleteAppConfigs
 					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
 					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
@@ -237,6 +241,9 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppResourcesStopMsg)),
 
+					// deleteDeployments
+					mocks.deployments.EXPECT().DeleteDeployments(mockAppName, "staging", "webapp").Return(nil),
+
 					// deleteAppConfigs
 					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
 					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
@@ -260,6 +267,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockStore := mocks.NewMockstore(ctrl)
+			mockDeployments := mocks.NewMockdeploymentDeleter(ctrl)
 			mockWorkspace := mocks.NewMockwsFileDeleter(ctrl)
 			mockSession := session.NewProvider()
 			mockDeployer := mocks.NewMockdeployer(ctrl)
@@ -291,6 +299,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			mocks := deleteAppMocks{
 				spinner:         mockSpinner,
 				store:           mockStore,
+				deployments:     mockDeployments,
 				ws:              mockWorkspace,
 				sessProvider:    mockSession,
 				deployer:        mockDeployer,
@@ -309,6 +318,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				},
 				spinner:              mockSpinner,
 				store:                mockStore,
+				deployments:          mockDeployments,
 				ws:                   mockWorkspace,
 				sessProvider:         mockSession,
 				cfn:                  mockDeployer,
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appExportNamePrompt     = "Which application would you like to export?"
+	appExportNameHelpPrompt = "The application's environments and services will be exported along with the portable parameters of the environment stacks."
+)
+
+// portableEnvParams are the parameters of an environment stack that carry over to another account.
+// The other parameters are either recreated on import or specific to the account, like role ARNs.
+var portableEnvParams = []string{stack.EnvParamIncludeLBKey}
+
+type exportAppVars struct {
+	*GlobalOpts
+	outputPath string
+}
+
+type exportAppOpts struct {
+	exportAppVars
+
+	store        store
+	sel          appSelector
+	fs           *afero.Afero
+	w            io.Writer
+	newDescriber func(env *config.Environment) (stackDescriber, error)
+}
+
+func newExportAppOpts(vars exportAppVars) (*exportAppOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &exportAppOpts{
+		exportAppVars: vars,
+		store:         store,
+		sel:           selector.NewSelect(vars.prompt, store),
+		fs:            &afero.Afero{Fs: afero.NewOsFs()},
+		w:             log.OutputWriter,
+		newDescriber: func(env *config.Environment) (stackDescriber, error) {
+			sess, err := session.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *exportAppOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return fmt.Errorf("get application %s: %w", o.AppName(), err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *exportAppOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appExportNamePrompt, appExportNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute writes the application's bundle to the output file, or to stdout if no file is provided.
+func (o *exportAppOpts) Execute() error {
+	bundle, err := o.bundle()
+	if err != nil {
+		return err
+	}
+	data, err := bundle.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal bundle for application %s: %w", o.AppName(), err)
+	}
+	if o.outputPath == "" {
+		_, err := o.w.Write(data)
+		return err
+	}
+	if err := o.fs.WriteFile(o.outputPath, data, 0644); err != nil {
+		return fmt.Errorf("write bundle to %s: %w", o.outputPath, err)
+	}
+	log.Successf("Exported application %s to %s.\n", color.HighlightUserInput(o.AppName()), color.HighlightResource(o.outputPath))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *exportAppOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to recreate the application in another account or region.",
+			color.HighlightCode("copilot app import --input <bundle> --env-profiles <env>=<profile>")),
+	}
+}
+
+func (o *exportAppOpts) bundle() (*config.Bundle, error) {
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.AppName(), err)
+	}
+	svcs, err := o.store.ListServices(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", o.AppName(), err)
+	}
+	bundle := config.NewBundle(app, envs, svcs)
+	for i, env := range envs {
+		describer, err := o.newDescriber(env)
+		if err != nil {
+			return nil, err
+		}
+		params, err := stackParameters(describer, stack.NameForEnv(app.Name, env.Name), portableEnvParams)
+		if err != nil {
+			return nil, fmt.Errorf("get stack parameters of environment %s: %w", env.Name, err)
+		}
+		bundle.Environments[i].StackParameters = params
+	}
+	return bundle, nil
+}
+
+// stackParameters returns the parameters of a deployed stack among keys, or nil if the stack doesn't exist.
+func stackParameters(describer stackDescriber, stackName string, keys []string) (map[string]string, error) {
+	descr, err := describer.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	params := make(map[string]string)
+	for _, p := range descr.Parameters {
+		key := aws.StringValue(p.ParameterKey)
+		for _, k := range keys {
+			if key == k {
+				params[key] = aws.StringValue(p.ParameterValue)
+			}
+		}
+	}
+	return params, nil
+}
+
+// BuildAppExportCmd builds the command for exporting an application to a portable bundle.
+func BuildAppExportCmd() *cobra.Command {
+	vars := exportAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports an application to a portable bundle.",
+		Long: `Exports the configuration of an application, its environments and services,
+along with the portable parameters of the environment stacks, to a bundle that can be imported in another account or region.`,
+		Example: `
+  Exports the application "my-app" to the file my-app.json
+  /code $ copilot app export -n my-app --output my-app.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newExportAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			if opts.outputPath != "" {
+				log.Infoln()
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range opts.RecommendedActions() {
+					log.Infof("- %s\n", followup)
+				}
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputPath, outputFlag, "", exportOutputFlagDescription)
+	return cmd
+}
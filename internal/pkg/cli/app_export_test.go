@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAppOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{
+		App:            "my-app",
+		Name:           "test",
+		Region:         "us-west-2",
+		AccountID:      "1234",
+		ManagerRoleARN: "arn:aws:iam::1234:role/my-app-test-EnvManagerRole",
+	}
+	testCases := map[string]struct {
+		outputPath string
+		setupMocks func(store *mocks.Mockstore, describer *mocks.MockstackDescriber)
+
+		wantedContent string
+		wantedFile    string
+		wantedErr     error
+	}{
+		"errors if failed to list environments": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockstackDescriber) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list environments in application my-app: some error"),
+		},
+		"errors if failed to describe a stack": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockstackDescriber) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				store.EXPECT().ListServices("my-app").Return(nil, nil)
+				describer.EXPECT().Describe("my-app-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get stack parameters of environment test: some error"),
+		},
+		"writes the bundle to stdout with only the portable environment parameters": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockstackDescriber) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1234"}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				store.EXPECT().ListServices("my-app").Return([]*config.Service{
+					{App: "my-app", Name: "api", Type: "Backend Service"},
+					{App: "my-app", Name: "web", Type: "Load Balanced Web Service"},
+				}, nil)
+				describer.EXPECT().Describe("my-app-test").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcfn.Parameter{
+						{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("my-app")},
+						{ParameterKey: aws.String("ToolsAccountPrincipalARN"), ParameterValue: aws.String("arn:aws:iam::1234:root")},
+						{ParameterKey: aws.String("AppDNSDelegationRole"), ParameterValue: aws.String("arn:aws:iam::1234:role/my-app-DNSDelegationRole")},
+						{ParameterKey: aws.String("IncludePublicLoadBalancer"), ParameterValue: aws.String("false")},
+					},
+				}, nil)
+			},
+			wantedContent: `{
+  "version": "1.0",
+  "application": {
+    "name": "my-app"
+  },
+  "environments": [
+    {
+      "name": "test",
+      "region": "us-west-2",
+      "prod": false,
+      "stackParameters": {
+        "IncludePublicLoadBalancer": "false"
+      }
+    }
+  ],
+  "services": [
+    {
+      "name": "api",
+      "type": "Backend Service"
+    },
+    {
+      "name": "web",
+      "type": "Load Balanced Web Service"
+    }
+  ]
+}
+`,
+		},
+		"writes the bundle to a file": {
+			outputPath: "my-app.json",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockstackDescriber) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				store.EXPECT().ListServices("my-app").Return(nil, nil)
+			},
+			wantedFile: `{
+  "version": "1.0",
+  "application": {
+    "name": "my-app"
+  },
+  "environments": null,
+  "services": null
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockDescriber := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(mockStore, mockDescriber)
+			b := &bytes.Buffer{}
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					outputPath: tc.outputPath,
+				},
+				store: mockStore,
+				fs:    fs,
+				w:     b,
+				newDescriber: func(env *config.Environment) (stackDescriber, error) {
+					require.Equal(t, testEnv, env)
+					return mockDescriber, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+			if tc.wantedFile != "" {
+				data, err := fs.ReadFile(tc.outputPath)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFile, string(data))
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtImportSvcStart    = "Adding service %s to application %s."
+	fmtImportSvcFailed   = "Failed to add service %s to application %s.\n"
+	fmtImportSvcComplete = "Added service %s to application %s.\n"
+)
+
+type importAppVars struct {
+	*GlobalOpts
+	inputPath   string
+	envProfiles map[string]string
+}
+
+type importAppOpts struct {
+	importAppVars
+
+	fs       *afero.Afero
+	identity identityService
+	store    store
+	cfn      appDeployer
+	prog     progress
+	initEnv  func(env *config.BundleEnvironment, envProfile string) (askExecutor, error)
+
+	bundle *config.Bundle
+}
+
+func newImportAppOpts(vars importAppVars) (*importAppOpts, error) {
+	sess, err := session.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &importAppOpts{
+		importAppVars: vars,
+		fs:            &afero.Afero{Fs: afero.NewOsFs()},
+		identity:      identity.New(sess),
+		store:         store,
+		cfn:           cloudformation.New(sess),
+		prog:          termprogress.NewSpinner(),
+		initEnv: func(env *config.BundleEnvironment, envProfile string) (askExecutor, error) {
+			envVars, err := bundleEnvVars(vars, env, envProfile)
+			if err != nil {
+				return nil, err
+			}
+			return newInitEnvOpts(envVars)
+		},
+	}, nil
+}
+
+// Validate returns an error if the bundle can't be read or the application already exists.
+func (o *importAppOpts) Validate() error {
+	if o.inputPath == "" {
+		return fmt.Errorf("--%s must be provided", inputFlag)
+	}
+	data, err := o.fs.ReadFile(o.inputPath)
+	if err != nil {
+		return fmt.Errorf("read bundle %s: %w", o.inputPath, err)
+	}
+	bundle, err := config.UnmarshalBundle(data)
+	if err != nil {
+		return fmt.Errorf("read bundle %s: %w", o.inputPath, err)
+	}
+	o.bundle = bundle
+	if o.AppName() == "" {
+		o.appName = bundle.Application.Name
+	}
+	if err := validateAppName(o.AppName()); err != nil {
+		return err
+	}
+	_, err = o.store.GetApplication(o.AppName())
+	if err == nil {
+		return fmt.Errorf("application %s already exists", o.AppName())
+	}
+	var errNoSuchApp *config.ErrNoSuchApplication
+	if !errors.As(err, &errNoSuchApp) {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	for _, env := range bundle.Environments {
+		if _, err := bundleEnvVars(o.importAppVars, env, o.envProfiles[env.Name]); err != nil {
+			return fmt.Errorf("read bundle %s: %w", o.inputPath, err)
+		}
+	}
+	for envName := range o.envProfiles {
+		if !o.bundleHasEnv(envName) {
+			return fmt.Errorf("environment %s is not part of the bundle", envName)
+		}
+	}
+	return nil
+}
+
+// Execute recreates the application, its environments and services from the bundle.
+func (o *importAppOpts) Execute() error {
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	o.prog.Start(fmt.Sprintf(fmtAppInitStart, color.HighlightUserInput(o.AppName())))
+	err = o.cfn.DeployApp(&deploy.CreateAppInput{
+		Name:           o.AppName(),
+		AccountID:      caller.Account,
+		DomainName:     o.bundle.Application.Domain,
+		AdditionalTags: o.bundle.Application.Tags,
+	})
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.AppName())))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtAppInitComplete, color.HighlightUserInput(o.AppName())))
+	app := &config.Application{
+		AccountID: caller.Account,
+		Name:      o.AppName(),
+		Domain:    o.bundle.Application.Domain,
+		Tags:      o.bundle.Application.Tags,
+		Metadata:  o.bundle.Application.Metadata,
+	}
+	if err := o.store.CreateApplication(app); err != nil {
+		return fmt.Errorf("create application %s: %w", o.AppName(), err)
+	}
+
+	for _, env := range o.bundle.Environments {
+		// Environments without a profile are prompted for one by env init.
+		cmd, err := o.initEnv(env, o.envProfiles[env.Name])
+		if err != nil {
+			return err
+		}
+		if err := cmd.Ask(); err != nil {
+			return fmt.Errorf("ask env init for environment %s: %w", env.Name, err)
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("execute env init for environment %s: %w", env.Name, err)
+		}
+	}
+
+	for _, svc := range o.bundle.Services {
+		o.prog.Start(fmt.Sprintf(fmtImportSvcStart, color.HighlightUserInput(svc.Name), color.HighlightUserInput(o.AppName())))
+		if err := o.cfn.AddServiceToApp(app, svc.Name); err != nil {
+			o.prog.Stop(log.Serrorf(fmtImportSvcFailed, color.HighlightUserInput(svc.Name), color.HighlightUserInput(o.AppName())))
+			return fmt.Errorf("add service %s to application %s: %w", svc.Name, o.AppName(), err)
+		}
+		if err := o.store.CreateService(&config.Service{
+			App:      o.AppName(),
+			Name:     svc.Name,
+			Type:     svc.Type,
+			Metadata: svc.Metadata,
+		}); err != nil {
+			o.prog.Stop(log.Serrorf(fmtImportSvcFailed, color.HighlightUserInput(svc.Name), color.HighlightUserInput(o.AppName())))
+			return fmt.Errorf("saving service %s: %w", svc.Name, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtImportSvcComplete, color.HighlightUserInput(svc.Name), color.HighlightUserInput(o.AppName())))
+	}
+	return nil
+}
+
+// auditedTarget returns the application imported by the command and the stacks of its environments.
+func (o *importAppOpts) auditedTarget() auditTarget {
+	target := auditTarget{
+		app: o.AppName(),
+		stacks: []auditStack{
+			{name: stack.NameForAppStack(o.AppName())},
+		},
+	}
+	if o.bundle == nil {
+		return target
+	}
+	for _, env := range o.bundle.Environments {
+		target.stacks = append(target.stacks, auditStack{
+			name: stack.NameForEnv(o.AppName(), env.Name),
+			env:  env.Name,
+		})
+	}
+	return target
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *importAppOpts) RecommendedActions() []string {
+	var actions []string
+	for _, svc := range o.bundle.Services {
+		actions = append(actions, fmt.Sprintf("Run %s to deploy service %s to an environment.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy --app %s --name %s", o.AppName(), svc.Name)), svc.Name))
+	}
+	return actions
+}
+
+// bundleEnvVars returns the env init flags that recreate an exported environment.
+// Only the load balancer stack parameter is carried over, the other parameters of the environment stack
+// are derived by env init from the imported application and the account of the profile.
+func bundleEnvVars(vars importAppVars, env *config.BundleEnvironment, envProfile string) (initEnvVars, error) {
+	publicLB := true
+	if value, ok := env.StackParameters[stack.EnvParamIncludeLBKey]; ok {
+		include, err := strconv.ParseBool(value)
+		if err != nil {
+			return initEnvVars{}, fmt.Errorf("parse stack parameter %s of environment %s: %w", stack.EnvParamIncludeLBKey, env.Name, err)
+		}
+		publicLB = include
+	}
+	return initEnvVars{
+		GlobalOpts:   &GlobalOpts{appName: vars.AppName(), prompt: vars.prompt},
+		EnvName:      env.Name,
+		EnvProfile:   envProfile,
+		IsProduction: env.Prod,
+		metadataVars: metadataVars{
+			description: env.Description,
+			owner:       env.Owner,
+			contact:     env.Contact,
+			labels:      env.Labels,
+		},
+		region:               env.Region,
+		noPublicLoadBalancer: !publicLB,
+	}, nil
+}
+
+func (o *importAppOpts) bundleHasEnv(envName string) bool {
+	for _, env := range o.bundle.Environments {
+		if env.Name == envName {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildAppImportCmd builds the command for importing an application from a bundle.
+func BuildAppImportCmd() *cobra.Command {
+	vars := importAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Recreates an application from an exported bundle.",
+		Long: `Recreates an application, its environments and services from a bundle created with "copilot app export".
+The application is created in the account and region of your default credentials,
+and each environment in the account of the profile it's mapped to, in the region it was exported from.`,
+		Example: `
+  Imports the application in my-app.json and creates the "test" and "prod" environments with the "test" and "prod" profiles.
+  /code $ copilot app import --input my-app.json --env-profiles test=test,prod=prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newImportAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
+				return err
+			}
+			log.Infoln()
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, importAppNameFlagDescription)
+	cmd.Flags().StringVar(&vars.inputPath, inputFlag, "", importInputFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envProfiles, envProfilesFlag, nil, importEnvProfilesFlagDescription)
+	return cmd
+}
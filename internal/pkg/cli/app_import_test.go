@@ -0,0 +1,286 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const testBundle = `{
+  "version": "1.0",
+  "application": {"name": "my-app", "domain": "example.com"},
+  "environments": [{"name": "test", "region": "us-west-2", "prod": false}, {"name": "prod", "region": "us-east-1", "prod": true}],
+  "services": [{"name": "api", "type": "Backend Service", "owner": "payments"}]
+}`
+
+func TestImportAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName     string
+		inBundle      string
+		inEnvProfiles map[string]string
+		mockStore     func(m *mocks.Mockstore)
+
+		wantedAppName string
+		wantedErr     error
+	}{
+		"errors if the bundle is malformed": {
+			inBundle:  `{"version": "0.1"}`,
+			mockStore: func(m *mocks.Mockstore) {},
+			wantedErr: errors.New("read bundle bundle.json: unsupported bundle version 0.1, expected 1.0"),
+		},
+		"errors if the application already exists": {
+			inBundle: testBundle,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedErr: errors.New("application my-app already exists"),
+		},
+		"errors if a profile is mapped to an unknown environment": {
+			inBundle:      testBundle,
+			inEnvProfiles: map[string]string{"staging": "default"},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app"})
+			},
+			wantedErr: errors.New("environment staging is not part of the bundle"),
+		},
+		"errors if the load balancer parameter of an environment is invalid": {
+			inBundle: `{
+  "version": "1.0",
+  "application": {"name": "my-app"},
+  "environments": [{"name": "test", "region": "us-west-2", "stackParameters": {"IncludePublicLoadBalancer": "maybe"}}]
+}`,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app"})
+			},
+			wantedErr: errors.New(`read bundle bundle.json: parse stack parameter IncludePublicLoadBalancer of environment test: strconv.ParseBool: parsing "maybe": invalid syntax`),
+		},
+		"uses the application name from the flag": {
+			inAppName:     "my-app-copy",
+			inBundle:      testBundle,
+			inEnvProfiles: map[string]string{"test": "default"},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app-copy").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app-copy"})
+			},
+			wantedAppName: "my-app-copy",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("bundle.json", []byte(tc.inBundle), 0644))
+
+			opts := &importAppOpts{
+				importAppVars: importAppVars{
+					GlobalOpts:  &GlobalOpts{appName: tc.inAppName},
+					inputPath:   "bundle.json",
+					envProfiles: tc.inEnvProfiles,
+				},
+				fs:    fs,
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.AppName())
+		})
+	}
+}
+
+func TestImportAppOpts_Execute(t *testing.T) {
+	bundle, err := config.UnmarshalBundle([]byte(testBundle))
+	require.NoError(t, err)
+	testApp := &config.Application{Name: "my-app", AccountID: "1234", Domain: "example.com"}
+
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, cfn *mocks.MockappDeployer, envInit *mocks.MockaskExecutor)
+
+		wantedEnvs []string
+		wantedErr  error
+	}{
+		"errors if failed to deploy the application": {
+			setupMocks: func(store *mocks.Mockstore, cfn *mocks.MockappDeployer, envInit *mocks.MockaskExecutor) {
+				cfn.EXPECT().DeployApp(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if failed to create an environment": {
+			setupMocks: func(store *mocks.Mockstore, cfn *mocks.MockappDeployer, envInit *mocks.MockaskExecutor) {
+				cfn.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				store.EXPECT().CreateApplication(testApp).Return(nil)
+				envInit.EXPECT().Ask().Return(nil)
+				envInit.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedEnvs: []string{"test:test-profile"},
+			wantedErr:  fmt.Errorf("execute env init for environment test: some error"),
+		},
+		"recreates the application, environments and services": {
+			setupMocks: func(store *mocks.Mockstore, cfn *mocks.MockappDeployer, envInit *mocks.MockaskExecutor) {
+				cfn.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:       "my-app",
+					AccountID:  "1234",
+					DomainName: "example.com",
+				}).Return(nil)
+				store.EXPECT().CreateApplication(testApp).Return(nil)
+				envInit.EXPECT().Ask().Return(nil).Times(2)
+				envInit.EXPECT().Execute().Return(nil).Times(2)
+				cfn.EXPECT().AddServiceToApp(testApp, "api").Return(nil)
+				store.EXPECT().CreateService(&config.Service{
+					App:      "my-app",
+					Name:     "api",
+					Type:     "Backend Service",
+					Metadata: config.Metadata{Owner: "payments"},
+				}).Return(nil)
+			},
+			wantedEnvs: []string{"test:test-profile", "prod:"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockCfn := mocks.NewMockappDeployer(ctrl)
+			mockEnvInit := mocks.NewMockaskExecutor(ctrl)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
+			mockProg := mocks.NewMockprogress(ctrl)
+			mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+			mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockStore, mockCfn, mockEnvInit)
+			var envs []string
+
+			opts := &importAppOpts{
+				importAppVars: importAppVars{
+					GlobalOpts:  &GlobalOpts{appName: "my-app"},
+					envProfiles: map[string]string{"test": "test-profile"},
+				},
+				identity: mockIdentity,
+				store:    mockStore,
+				cfn:      mockCfn,
+				prog:     mockProg,
+				initEnv: func(env *config.BundleEnvironment, envProfile string) (askExecutor, error) {
+					envs = append(envs, env.Name+":"+envProfile)
+					return mockEnvInit, nil
+				},
+				bundle: bundle,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedEnvs, envs)
+		})
+	}
+}
+
+func TestImportAppOpts_RoundTrip(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	envs := []*config.Environment{
+		{App: "my-app", Name: "test", Region: "us-west-2", AccountID: "1234"},
+		{App: "my-app", Name: "prod", Region: "eu-west-1", AccountID: "1234", Prod: true},
+	}
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1234"}, nil)
+	mockStore.EXPECT().ListEnvironments("my-app").Return(envs, nil)
+	mockStore.EXPECT().ListServices("my-app").Return(nil, nil)
+	mockDescriber := mocks.NewMockstackDescriber(ctrl)
+	mockDescriber.EXPECT().Describe("my-app-test").Return(&cloudformation.StackDescription{
+		Parameters: []*sdkcfn.Parameter{
+			{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("my-app")},
+			{ParameterKey: aws.String("ToolsAccountPrincipalARN"), ParameterValue: aws.String("arn:aws:iam::1234:root")},
+			{ParameterKey: aws.String("IncludePublicLoadBalancer"), ParameterValue: aws.String("false")},
+		},
+	}, nil)
+	mockDescriber.EXPECT().Describe("my-app-prod").Return(&cloudformation.StackDescription{
+		Parameters: []*sdkcfn.Parameter{
+			{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("my-app")},
+			{ParameterKey: aws.String("IncludePublicLoadBalancer"), ParameterValue: aws.String("true")},
+		},
+	}, nil)
+	export := &exportAppOpts{
+		exportAppVars: exportAppVars{
+			GlobalOpts: &GlobalOpts{appName: "my-app"},
+			outputPath: "my-app.json",
+		},
+		store: mockStore,
+		fs:    fs,
+		newDescriber: func(env *config.Environment) (stackDescriber, error) {
+			return mockDescriber, nil
+		},
+	}
+	require.NoError(t, export.Execute())
+	exported, err := fs.ReadFile("my-app.json")
+	require.NoError(t, err)
+	require.NotContains(t, string(exported), "arn:aws:iam", "the bundle must not carry account-specific role ARNs")
+
+	mockStore.EXPECT().GetApplication("my-app-copy").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app-copy"})
+	var initialized []initEnvVars
+	imp := &importAppOpts{
+		importAppVars: importAppVars{
+			GlobalOpts:  &GlobalOpts{appName: "my-app-copy"},
+			inputPath:   "my-app.json",
+			envProfiles: map[string]string{"test": "test-profile", "prod": "prod-profile"},
+		},
+		fs:    fs,
+		store: mockStore,
+		initEnv: func(env *config.BundleEnvironment, envProfile string) (askExecutor, error) {
+			vars, err := bundleEnvVars(importAppVars{GlobalOpts: &GlobalOpts{appName: "my-app-copy"}}, env, envProfile)
+			initialized = append(initialized, vars)
+			return nil, err
+		},
+	}
+
+	// WHEN
+	require.NoError(t, imp.Validate())
+	for _, env := range imp.bundle.Environments {
+		_, err := imp.initEnv(env, imp.envProfiles[env.Name])
+		require.NoError(t, err)
+	}
+
+	// THEN
+	require.Len(t, initialized, 2)
+	require.Equal(t, "test", initialized[0].EnvName)
+	require.Equal(t, "test-profile", initialized[0].EnvProfile)
+	require.Equal(t, "us-west-2", initialized[0].region)
+	require.True(t, initialized[0].noPublicLoadBalancer, "the environment was exported without a public load balancer")
+	require.False(t, initialized[0].IsProduction)
+	require.Equal(t, "prod", initialized[1].EnvName)
+	require.Equal(t, "eu-west-1", initialized[1].region)
+	require.False(t, initialized[1].noPublicLoadBalancer)
+	require.True(t, initialized[1].IsProduction)
+	require.Equal(t, "my-app-copy", initialized[1].AppName())
+}
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -36,6 +38,7 @@ type initAppVars struct {
 	AppName      string
 	DomainName   string
 	ResourceTags map[string]string
+	metadataVars
 }
 
 type initAppOpts struct {
@@ -55,25 +58,25 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
-	ws, err := workspace.New()
-	if err != nil {
-		return nil, fmt.Errorf("new workspace: %w", err)
-	}
-
-	return &initAppOpts{
+	opts := &initAppOpts{
 		initAppVars: vars,
 		identity:    identity.New(sess),
 		store:       store,
 		route53:     route53.New(sess),
-		ws:          ws,
 		cfn:         cloudformation.New(sess),
 		prompt:      prompt.New(),
 		prog:        termprogress.NewSpinner(),
-	}, nil
+	}
+	ws, err := workspace.New(workspace.WithApplication(func() string { return opts.AppName }))
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	opts.ws = ws
+	return opts, nil
 }
 
 // Validate returns an error if the user's input is invalid.
@@ -107,11 +110,14 @@ func (o *initAppOpts) Ask() error {
 			log.Errorf(`Workspace is already registered with application %s instead of %s.
 If you'd like to delete the application locally, you can remove the %s directory.
 If you'd like to delete the application and all of its resources, run %s.
+If you'd like to keep several applications in this workspace, move the files under %s to %s.
 `,
 				summary.Application,
 				o.AppName,
 				workspace.CopilotDirName,
-				color.HighlightCode("copilot app delete"))
+				color.HighlightCode("copilot app delete"),
+				workspace.CopilotDirName,
+				filepath.Join(workspace.CopilotDirName, summary.Application))
 			return fmt.Errorf("workspace already registered with %s", summary.Application)
 		}
 	}
@@ -161,12 +167,19 @@ func (o *initAppOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtAppInitComplete, color.HighlightUserInput(o.AppName)))
 
-	return o.store.CreateApplication(&config.Application{
+	err = o.store.CreateApplication(&config.Application{
 		AccountID: caller.Account,
 		Name:      o.AppName,
 		Domain:    o.DomainName,
 		Tags:      o.ResourceTags,
+		Metadata:  o.metadata(),
 	})
+	var errExists *config.ErrApplicationAlreadyExists
+	if errors.As(err, &errExists) {
+		// Re-running app init on an existing application only updates its stacks, the stored configuration is kept.
+		return nil
+	}
+	return err
 }
 
 func (o *initAppOpts) validateAppName(name string) error {
@@ -198,6 +211,16 @@ func (o *initAppOpts) validateDomain(domainName string) error {
 	return nil
 }
 
+// auditedTarget returns the application created by the command.
+func (o *initAppOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName,
+		stacks: []auditStack{
+			{name: stack.NameForAppStack(o.AppName)},
+		},
+	}
+}
+
 // RecommendedActions returns a list of suggested additional commands users can run after successfully executing this command.
 func (o *initAppOpts) RecommendedActions() []string {
 	return []string{
@@ -265,7 +288,7 @@ An application is a collection of containerized services that operate together.`
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			if err := opts.Execute(); err != nil {
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
 				return err
 			}
 			log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(opts.AppName))
@@ -279,5 +302,6 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.DomainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.ResourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "application")
 	return cmd
 }
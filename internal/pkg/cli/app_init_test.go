@@ -343,6 +343,29 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Serrorf(fmtAppInitFailed, "myapp"))
 			},
 		},
+		"should keep the stored configuration of an existing application": {
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.
+					EXPECT().
+					Get().
+					Return(identity.Caller{
+						Account: "12345",
+					}, nil)
+				mockstore.
+					EXPECT().
+					CreateApplication(gomock.Any()).
+					Return(&config.ErrApplicationAlreadyExists{ApplicationName: "myapp"})
+				mockWorkspace.
+					EXPECT().
+					Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().
+					DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+			},
+		},
 		"should return error from CreateApplication": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
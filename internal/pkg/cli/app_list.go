@@ -43,7 +43,7 @@ func BuildAppListCommand() *cobra.Command {
 			opts := listAppOpts{
 				w: os.Stdout,
 			}
-			ssmStore, err := config.NewStore()
+			ssmStore, err := config.NewConfigStore()
 			if err != nil {
 				return err
 			}
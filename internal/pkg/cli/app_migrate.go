@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appMigrateNamePrompt     = "Which application's configuration would you like to migrate?"
+	appMigrateNameHelpPrompt = "The configuration of the application, its environments and services will be upgraded to the latest version."
+)
+
+type migrateAppVars struct {
+	*GlobalOpts
+	dryRun bool
+}
+
+type migrateAppOpts struct {
+	migrateAppVars
+
+	migrator appMigrator
+	sel      appSelector
+	w        io.Writer
+}
+
+func newMigrateAppOpts(vars migrateAppVars) (*migrateAppOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+
+	return &migrateAppOpts{
+		migrateAppVars: vars,
+		migrator:       store,
+		sel:            selector.NewSelect(vars.prompt, store),
+		w:              log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *migrateAppOpts) Validate() error {
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *migrateAppOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appMigrateNamePrompt, appMigrateNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute applies the pending migrations to the application's configuration, or lists them in dry-run mode.
+func (o *migrateAppOpts) Execute() error {
+	result, err := o.migrator.MigrateApplication(o.AppName(), o.dryRun)
+	if err != nil {
+		return fmt.Errorf("migrate application %s: %w", o.AppName(), err)
+	}
+	if !result.HasChanges() {
+		log.Successf("Application %s is already at the latest version %s.\n", color.HighlightUserInput(o.AppName()), result.FromVersion)
+		return nil
+	}
+	verb := "Migrated"
+	if o.dryRun {
+		verb = "Would migrate"
+	}
+	fmt.Fprintf(o.w, "%s application %s from version %s to %s:\n", verb, o.AppName(), result.FromVersion, result.ToVersion)
+	for _, m := range result.Migrations {
+		fmt.Fprintf(o.w, "  - %s\n", m)
+	}
+	fmt.Fprintf(o.w, "Parameters:\n")
+	for _, p := range result.Parameters {
+		fmt.Fprintf(o.w, "  - %s\n", p)
+	}
+	return nil
+}
+
+// auditedTarget returns the application migrated by the command, or no target in dry-run mode since nothing is changed.
+func (o *migrateAppOpts) auditedTarget() auditTarget {
+	if o.dryRun {
+		return auditTarget{}
+	}
+	return auditTarget{
+		app: o.AppName(),
+	}
+}
+
+// BuildAppMigrateCmd builds the command for migrating the configuration of an application.
+func BuildAppMigrateCmd() *cobra.Command {
+	vars := migrateAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrates the configuration of an application to the latest version.",
+		Long: `Migrates the configuration of an application, its environments and services
+stored in SSM Parameter Store to the latest version supported by copilot.`,
+		Example: `
+  Shows the migrations that would be applied to the application "my-app"
+  /code $ copilot app migrate -n my-app --dry-run`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return executeAudited(cmd, args, opts, opts.Execute)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, appFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, migrateDryRunFlagDescription)
+	return cmd
+}
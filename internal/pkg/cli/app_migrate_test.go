@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName    string
+		mockSelector func(m *mocks.MockappSelector)
+
+		wantedAppName string
+		wantedErr     error
+	}{
+		"with app name set by flag": {
+			inAppName:     "my-app",
+			mockSelector:  func(m *mocks.MockappSelector) {},
+			wantedAppName: "my-app",
+		},
+		"prompts for the app name": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appMigrateNamePrompt, appMigrateNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedAppName: "my-app",
+		},
+		"errors if failed to select app": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appMigrateNamePrompt, appMigrateNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockappSelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &migrateAppOpts{
+				migrateAppVars: migrateAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+				},
+				sel: mockSelector,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.AppName())
+		})
+	}
+}
+
+func TestMigrateAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		dryRun       bool
+		mockMigrator func(m *mocks.MockappMigrator)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"errors if failed to migrate": {
+			mockMigrator: func(m *mocks.MockappMigrator) {
+				m.EXPECT().MigrateApplication("my-app", false).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("migrate application my-app: some error"),
+		},
+		"nothing to migrate": {
+			mockMigrator: func(m *mocks.MockappMigrator) {
+				m.EXPECT().MigrateApplication("my-app", false).Return(&config.MigrationResult{
+					Application: "my-app",
+					FromVersion: "1.0",
+					ToVersion:   "1.0",
+				}, nil)
+			},
+		},
+		"dry run lists the pending migrations": {
+			dryRun: true,
+			mockMigrator: func(m *mocks.MockappMigrator) {
+				m.EXPECT().MigrateApplication("my-app", true).Return(&config.MigrationResult{
+					Application: "my-app",
+					FromVersion: "1.0",
+					ToVersion:   "1.1",
+					Migrations:  []string{"add owner to services"},
+					Parameters:  []string{"/copilot/applications/my-app/components/api", "/copilot/applications/my-app"},
+				}, nil)
+			},
+			wantedContent: `Would migrate application my-app from version 1.0 to 1.1:
+  - add owner to services
+Parameters:
+  - /copilot/applications/my-app/components/api
+  - /copilot/applications/my-app
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMigrator := mocks.NewMockappMigrator(ctrl)
+			tc.mockMigrator(mockMigrator)
+			b := &bytes.Buffer{}
+
+			opts := &migrateAppOpts{
+				migrateAppVars: migrateAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					dryRun: tc.dryRun,
+				},
+				migrator: mockMigrator,
+				w:        b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
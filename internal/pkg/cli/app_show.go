@@ -34,7 +34,7 @@ type showAppOpts struct {
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
@@ -106,13 +106,15 @@ func (o *showAppOpts) description() (*describe.App, error) {
 			AccountID: env.AccountID,
 			Region:    env.Region,
 			Prod:      env.Prod,
+			Metadata:  env.Metadata,
 		})
 	}
 	var trimmedSvcs []*config.Service
 	for _, svc := range svcs {
 		trimmedSvcs = append(trimmedSvcs, &config.Service{
-			Name: svc.Name,
-			Type: svc.Type,
+			Name:     svc.Name,
+			Type:     svc.Type,
+			Metadata: svc.Metadata,
 		})
 	}
 	return &describe.App{
@@ -120,6 +122,7 @@ func (o *showAppOpts) description() (*describe.App, error) {
 		URI:      app.Domain,
 		Envs:     trimmedEnvs,
 		Services: trimmedSvcs,
+		Metadata: app.Metadata,
 	}, nil
 }
 
@@ -243,6 +243,40 @@ Services
 
   Name              Type
   my-svc            lb-web-svc
+`,
+		},
+		"shows the application's metadata": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:   "my-app",
+					Domain: "example.com",
+					Metadata: config.Metadata{
+						Description: "Phone directory",
+						Owner:       "payments",
+						Contact:     "payments@example.com",
+						Labels:      map[string]string{"tier": "1", "cost-center": "42"},
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  URI               example.com
+  Description       Phone directory
+  Owner             payments
+  Contact           payments@example.com
+  Labels            cost-center=42,tier=1
+
+Environments
+
+  Name              AccountID           Region
+
+Services
+
+  Name              Type
 `,
 		},
 		"returns error if fail to get application": {
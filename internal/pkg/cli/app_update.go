@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appUpdateNamePrompt     = "Which application would you like to update?"
+	appUpdateNameHelpPrompt = "The description, owner, contact and labels of the application will be updated."
+)
+
+type updateAppVars struct {
+	*GlobalOpts
+	metadataVars
+	isSet func(flag string) bool // Returns true if a flag was set by the user.
+}
+
+type updateAppOpts struct {
+	updateAppVars
+
+	store store
+	sel   appSelector
+}
+
+func newUpdateAppOpts(vars updateAppVars) (*updateAppOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &updateAppOpts{
+		updateAppVars: vars,
+		store:         store,
+		sel:           selector.NewSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *updateAppOpts) Validate() error {
+	if err := validateMetadataFlagsSet(o.isSet); err != nil {
+		return err
+	}
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return fmt.Errorf("get application %s: %w", o.AppName(), err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *updateAppOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appUpdateNamePrompt, appUpdateNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute updates the metadata of the application.
+func (o *updateAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	o.update(&app.Metadata, o.isSet)
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", o.AppName(), err)
+	}
+	log.Successf("Updated application %s.\n", color.HighlightUserInput(o.AppName()))
+	return nil
+}
+
+// BuildAppUpdateCmd builds the command for updating the metadata of an application.
+func BuildAppUpdateCmd() *cobra.Command {
+	vars := updateAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Updates the description, owner, contact or labels of an application.",
+		Example: `
+  Sets the owner of the application "my-app" and adds the "team=payments" label.
+  /code $ copilot app update -n my-app --owner payments --labels team=payments
+
+  Removes the "team" label from the application "my-app".
+  /code $ copilot app update -n my-app --labels team=`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.isSet = cmd.Flags().Changed
+			opts, err := newUpdateAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, appFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "application")
+	return cmd
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inFlagsSet []string
+		mockStore  func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if no metadata flag is set": {
+			inAppName: "my-app",
+			mockStore: func(m *mocks.Mockstore) {},
+			wantedErr: errors.New("at least one of --description, --owner, --contact or --labels must be provided"),
+		},
+		"errors if the application doesn't exist": {
+			inAppName:  "my-app",
+			inFlagsSet: []string{ownerFlag},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"valid": {
+			inAppName:  "my-app",
+			inFlagsSet: []string{ownerFlag},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &updateAppOpts{
+				updateAppVars: updateAppVars{
+					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
+					isSet:      flagsSet(tc.inFlagsSet...),
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockStore func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if the application was modified concurrently": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().UpdateApplication(gomock.Any()).Return(&config.ErrConcurrentModification{Name: "my-app"})
+			},
+			wantedErr: fmt.Errorf("update application my-app: %w", &config.ErrConcurrentModification{Name: "my-app"}),
+		},
+		"updates the owner and keeps the other fields": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:     "my-app",
+					Domain:   "example.com",
+					Metadata: config.Metadata{Owner: "payments", Description: "Phone directory"},
+				}, nil)
+				m.EXPECT().UpdateApplication(&config.Application{
+					Name:     "my-app",
+					Domain:   "example.com",
+					Metadata: config.Metadata{Owner: "search", Description: "Phone directory"},
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &updateAppOpts{
+				updateAppVars: updateAppVars{
+					GlobalOpts:   &GlobalOpts{appName: "my-app"},
+					metadataVars: metadataVars{owner: "search"},
+					isSet:        flagsSet(ownerFlag),
+				},
+				store: mockStore,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
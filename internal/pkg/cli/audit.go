@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const redactedAuditArg = "REDACTED"
+
+// Flags whose values are secrets and aren't written to the audit log.
+var redactedAuditFlags = map[string]bool{
+	githubAccessTokenFlag: true,
+}
+
+// auditStack is a stack changed by a command.
+type auditStack struct {
+	name string
+	env  string // Name of the environment the stack is deployed to, empty if the stack belongs to the application.
+}
+
+// auditTarget is the application, environment, service and stacks changed by a command.
+type auditTarget struct {
+	app    string
+	env    string
+	svc    string
+	stacks []auditStack
+}
+
+// auditedCommand is implemented by the options of the commands that change the resources of an application,
+// so that each of their executions is recorded in the audit log of the application.
+type auditedCommand interface {
+	auditedTarget() auditTarget
+}
+
+// auditor records the executions of the commands in the audit log of their application.
+type auditor struct {
+	identity          identityService
+	store             environmentGetter
+	sessProvider      sessionProvider
+	log               auditRecorder
+	newStackDescriber func(*awssession.Session) stackDescriber
+}
+
+func newAuditor() (*auditor, error) {
+	provider := session.NewProvider()
+	sess, err := provider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &auditor{
+		identity:     identity.New(sess),
+		store:        store,
+		sessProvider: provider,
+		log:          audit.New(sess),
+		newStackDescriber: func(sess *awssession.Session) stackDescriber {
+			return cloudformation.New(sess)
+		},
+	}, nil
+}
+
+// executeAudited runs execute and records its outcome in the audit log of the application changed by the command.
+// The command doesn't fail if its execution can't be recorded.
+func executeAudited(cmd *cobra.Command, args []string, opts auditedCommand, execute func() error) error {
+	event := newAuditEvent(cmd, args, time.Now())
+	err := execute()
+	target := opts.auditedTarget()
+	if target.app == "" {
+		return err
+	}
+	a, auditErr := newAuditor()
+	if auditErr == nil {
+		auditErr = a.record(event, target, err)
+	}
+	if auditErr != nil {
+		log.Warningf("Failed to record %s in the audit log of application %s: %v\n", event.Command, target.app, auditErr)
+	}
+	return err
+}
+
+// newAuditEvent returns an event for the command started at the given time with its arguments and the flags set by the caller.
+func newAuditEvent(cmd *cobra.Command, args []string, startedAt time.Time) *audit.Event {
+	auditArgs := append([]string{}, args...)
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		value := f.Value.String()
+		if redactedAuditFlags[f.Name] {
+			value = redactedAuditArg
+		}
+		auditArgs = append(auditArgs, fmt.Sprintf("--%s=%s", f.Name, value))
+	})
+	return &audit.Event{
+		Time:    startedAt.UTC(),
+		Command: strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		Args:    auditArgs,
+	}
+}
+
+// record fills in the caller, the target and the outcome of the event, and writes it to the audit log.
+func (a *auditor) record(event *audit.Event, target auditTarget, execErr error) error {
+	caller, err := a.identity.Get()
+	if err != nil {
+		return err
+	}
+	event.Caller = audit.Caller{
+		ARN:     caller.ARN,
+		Account: caller.Account,
+		UserID:  caller.UserID,
+	}
+	event.App = target.app
+	event.Env = target.env
+	event.Service = target.svc
+	for _, stack := range target.stacks {
+		event.Stacks = append(event.Stacks, audit.Stack{
+			Name:        stack.name,
+			ChangeSetID: a.changeSetID(target.app, stack),
+		})
+	}
+	event.Outcome = audit.OutcomeSucceeded
+	if execErr != nil {
+		event.Outcome = audit.OutcomeFailed
+		event.Error = execErr.Error()
+	}
+	return a.log.Record(event)
+}
+
+// changeSetID returns the ID of the last change set executed on the stack with a best effort.
+// If the stack can't be described, for example because it was deleted, this returns an empty string.
+func (a *auditor) changeSetID(appName string, stack auditStack) string {
+	sess, err := a.stackSession(appName, stack.env)
+	if err != nil {
+		return ""
+	}
+	descr, err := a.newStackDescriber(sess).Describe(stack.name)
+	if err != nil {
+		return ""
+	}
+	return aws.StringValue(descr.ChangeSetId)
+}
+
+// stackSession returns a session in the account and region of the environment, or the default session
+// for the stacks of the application.
+func (a *auditor) stackSession(appName, envName string) (*awssession.Session, error) {
+	if envName == "" {
+		return a.sessProvider.Default()
+	}
+	env, err := a.store.GetEnvironment(appName, envName)
+	if err != nil {
+		return nil, err
+	}
+	return a.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+}
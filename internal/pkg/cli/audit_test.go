@@ -0,0 +1,299 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	startedAt := time.Date(2020, time.August, 20, 10, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+	testCases := map[string]struct {
+		inArgs []string
+
+		wantedEvent *audit.Event
+	}{
+		"records the command with its positional arguments and the flags set by the caller": {
+			inArgs: []string{"--name", "frontend", "--env=test", "--resource-tags", "team=payments"},
+			wantedEvent: &audit.Event{
+				Time:    time.Date(2020, time.August, 20, 17, 30, 0, 0, time.UTC),
+				Command: "svc deploy",
+				Args:    []string{"--env=test", "--name=frontend", "--resource-tags=[team=payments]"},
+			},
+		},
+		"redacts the secrets": {
+			inArgs: []string{"--name", "frontend", "--github-access-token", "secret"},
+			wantedEvent: &audit.Event{
+				Time:    time.Date(2020, time.August, 20, 17, 30, 0, 0, time.UTC),
+				Command: "svc deploy",
+				Args:    []string{"--github-access-token=REDACTED", "--name=frontend"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var event *audit.Event
+			root := &cobra.Command{Use: "copilot"}
+			svc := &cobra.Command{Use: "svc"}
+			deploy := &cobra.Command{
+				Use: "deploy",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					event = newAuditEvent(cmd, args, startedAt)
+					return nil
+				},
+			}
+			deploy.Flags().String(nameFlag, "", "")
+			deploy.Flags().String(envFlag, "", "")
+			deploy.Flags().String(imageTagFlag, "", "")
+			deploy.Flags().StringToString(resourceTagsFlag, nil, "")
+			deploy.Flags().String(githubAccessTokenFlag, "", "")
+			svc.AddCommand(deploy)
+			root.AddCommand(svc)
+			root.SetArgs(append([]string{"svc", "deploy"}, tc.inArgs...))
+
+			// WHEN
+			err := root.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvent, event)
+		})
+	}
+}
+
+type auditorMocks struct {
+	identity     *mocks.MockidentityService
+	store        *mocks.MockenvironmentGetter
+	sessProvider *mocks.MocksessionProvider
+	describer    *mocks.MockstackDescriber
+	log          *mocks.MockauditRecorder
+}
+
+func TestAuditor_Record(t *testing.T) {
+	mockSess := &awssession.Session{}
+	mockEnvSess := &awssession.Session{Config: &aws.Config{Region: aws.String("us-east-1")}}
+	mockTarget := auditTarget{
+		app: "phonetool",
+		env: "test",
+		svc: "frontend",
+		stacks: []auditStack{
+			{name: "phonetool-infrastructure-roles"},
+			{name: "phonetool-test-frontend", env: "test"},
+		},
+	}
+	testCases := map[string]struct {
+		inErr      error
+		setupMocks func(m auditorMocks)
+
+		wantedErr error
+	}{
+		"records the caller, the change sets of the stacks and a successful outcome": {
+			setupMocks: func(m auditorMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					ARN:     "arn:aws:iam::1234:user/alice",
+					Account: "1234",
+					UserID:  "AIDA1234",
+				}, nil)
+				m.sessProvider.EXPECT().Default().Return(mockSess, nil)
+				m.describer.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					ChangeSetId: aws.String("arn:aws:cloudformation:us-west-2:1234:changeSet/copilot-1/abc"),
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					ManagerRoleARN: "arn:aws:iam::5678:role/manager",
+					Region:         "us-east-1",
+				}, nil)
+				m.sessProvider.EXPECT().FromRole("arn:aws:iam::5678:role/manager", "us-east-1").Return(mockEnvSess, nil)
+				m.describer.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{
+					ChangeSetId: aws.String("arn:aws:cloudformation:us-east-1:5678:changeSet/copilot-2/def"),
+				}, nil)
+				m.log.EXPECT().Record(&audit.Event{
+					Command: "svc deploy",
+					Caller: audit.Caller{
+						ARN:     "arn:aws:iam::1234:user/alice",
+						Account: "1234",
+						UserID:  "AIDA1234",
+					},
+					App:     "phonetool",
+					Env:     "test",
+					Service: "frontend",
+					Stacks: []audit.Stack{
+						{Name: "phonetool-infrastructure-roles", ChangeSetID: "arn:aws:cloudformation:us-west-2:1234:changeSet/copilot-1/abc"},
+						{Name: "phonetool-test-frontend", ChangeSetID: "arn:aws:cloudformation:us-east-1:5678:changeSet/copilot-2/def"},
+					},
+					Outcome: audit.OutcomeSucceeded,
+				}).Return(nil)
+			},
+		},
+		"records a failed outcome without the change sets of the stacks that can't be described": {
+			inErr: errors.New("some error"),
+			setupMocks: func(m auditorMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::1234:user/alice"}, nil)
+				m.sessProvider.EXPECT().Default().Return(mockSess, nil)
+				m.describer.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, errors.New("stack not found"))
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("environment not found"))
+				m.log.EXPECT().Record(&audit.Event{
+					Command: "svc deploy",
+					Caller:  audit.Caller{ARN: "arn:aws:iam::1234:user/alice"},
+					App:     "phonetool",
+					Env:     "test",
+					Service: "frontend",
+					Stacks: []audit.Stack{
+						{Name: "phonetool-infrastructure-roles"},
+						{Name: "phonetool-test-frontend"},
+					},
+					Outcome: audit.OutcomeFailed,
+					Error:   "some error",
+				}).Return(nil)
+			},
+		},
+		"returns the error from getting the caller": {
+			setupMocks: func(m auditorMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{}, errors.New("get caller identity: some error"))
+				m.log.EXPECT().Record(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("get caller identity: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := auditorMocks{
+				identity:     mocks.NewMockidentityService(ctrl),
+				store:        mocks.NewMockenvironmentGetter(ctrl),
+				sessProvider: mocks.NewMocksessionProvider(ctrl),
+				describer:    mocks.NewMockstackDescriber(ctrl),
+				log:          mocks.NewMockauditRecorder(ctrl),
+			}
+			tc.setupMocks(m)
+			a := &auditor{
+				identity:     m.identity,
+				store:        m.store,
+				sessProvider: m.sessProvider,
+				log:          m.log,
+				newStackDescriber: func(*awssession.Session) stackDescriber {
+					return m.describer
+				},
+			}
+
+			// WHEN
+			err := a.record(&audit.Event{Command: "svc deploy"}, mockTarget, tc.inErr)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditedCommands_auditedTarget(t *testing.T) {
+	globalOpts := &GlobalOpts{appName: "phonetool"}
+	canaryOpts := &svcCanaryOpts{
+		svcCanaryVars: svcCanaryVars{GlobalOpts: globalOpts, svcName: "api", envName: "test"},
+	}
+	svcTarget := auditTarget{
+		app: "phonetool",
+		env: "test",
+		svc: "api",
+		stacks: []auditStack{
+			{name: "phonetool-test-api", env: "test"},
+		},
+	}
+	testCases := map[string]struct {
+		inOpts auditedCommand
+
+		wantedTarget auditTarget
+	}{
+		"svc rollback": {
+			inOpts: &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{GlobalOpts: globalOpts, svcName: "api", envName: "test"},
+			},
+			wantedTarget: svcTarget,
+		},
+		"svc promote": {
+			inOpts:       &svcPromoteOpts{svcCanaryOpts: canaryOpts},
+			wantedTarget: svcTarget,
+		},
+		"svc abort": {
+			inOpts:       &svcAbortOpts{svcCanaryOpts: canaryOpts},
+			wantedTarget: svcTarget,
+		},
+		"lock break": {
+			inOpts: &lockBreakOpts{
+				lockBreakVars: lockBreakVars{GlobalOpts: globalOpts, svcName: "api", envName: "test"},
+			},
+			wantedTarget: auditTarget{app: "phonetool", env: "test", svc: "api"},
+		},
+		"pipeline delete": {
+			inOpts: &deletePipelineOpts{
+				deletePipelineVars: deletePipelineVars{GlobalOpts: globalOpts},
+				PipelineName:       "pipeline-phonetool-api",
+			},
+			wantedTarget: auditTarget{
+				app: "phonetool",
+				stacks: []auditStack{
+					{name: "pipeline-phonetool-api"},
+				},
+			},
+		},
+		"app import": {
+			inOpts: &importAppOpts{
+				importAppVars: importAppVars{GlobalOpts: globalOpts},
+				bundle: &config.Bundle{
+					Environments: []*config.BundleEnvironment{{Name: "test"}, {Name: "prod"}},
+				},
+			},
+			wantedTarget: auditTarget{
+				app: "phonetool",
+				stacks: []auditStack{
+					{name: "phonetool-infrastructure-roles"},
+					{name: "phonetool-test", env: "test"},
+					{name: "phonetool-prod", env: "prod"},
+				},
+			},
+		},
+		"app migrate": {
+			inOpts: &migrateAppOpts{
+				migrateAppVars: migrateAppVars{GlobalOpts: globalOpts},
+			},
+			wantedTarget: auditTarget{app: "phonetool"},
+		},
+		"app migrate in dry-run mode": {
+			inOpts: &migrateAppOpts{
+				migrateAppVars: migrateAppVars{GlobalOpts: globalOpts, dryRun: true},
+			},
+			wantedTarget: auditTarget{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			target := tc.inOpts.auditedTarget()
+
+			// THEN
+			require.Equal(t, tc.wantedTarget, target)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtChangeSetConfirmPrompt = "Deploy these changes to stack %s?"
+	changeSetConfirmHelp      = "The changes are deployed with the CloudFormation change set shown above."
+
+	emptyCell = "-"
+)
+
+// changeSetReviewVars holds the flags shared by the commands that can show their infrastructure changes before deploying them.
+type changeSetReviewVars struct {
+	showDiff bool // Show the changes and ask for confirmation before deploying them.
+	dryRun   bool // Show the changes without deploying them.
+}
+
+// shouldReview returns true if the infrastructure changes need to be shown before they're deployed.
+func (v changeSetReviewVars) shouldReview() bool {
+	return v.showDiff || v.dryRun
+}
+
+// review shows the changes of the change set and returns true if they should be deployed.
+// In dry-run mode, or if the changes are declined, the change set is deleted instead.
+func (v changeSetReviewVars) review(prompt prompter, executor changeSetExecutor, cs *cloudformation.ChangeSet) (bool, error) {
+	renderChangeSet(log.DiagnosticWriter, cs)
+	if v.dryRun {
+		if err := executor.DeleteChangeSet(cs); err != nil {
+			return false, err
+		}
+		log.Infof("Dry run, the changes to stack %s were not deployed.\n", color.HighlightResource(cs.StackName))
+		return false, nil
+	}
+	confirmed, err := prompt.Confirm(fmt.Sprintf(fmtChangeSetConfirmPrompt, color.HighlightResource(cs.StackName)), changeSetConfirmHelp)
+	if err != nil {
+		return false, fmt.Errorf("confirm changes to stack %s: %w", cs.StackName, err)
+	}
+	if !confirmed {
+		if err := executor.DeleteChangeSet(cs); err != nil {
+			return false, err
+		}
+		log.Infof("The changes to stack %s were not deployed.\n", color.HighlightResource(cs.StackName))
+		return false, nil
+	}
+	return true, nil
+}
+
+// renderChangeSet writes a table of the resource changes followed by a table of the parameter changes of the change set.
+func renderChangeSet(w io.Writer, cs *cloudformation.ChangeSet) {
+	action := "update"
+	if cs.IsCreate {
+		action = "create"
+	}
+	fmt.Fprintf(w, "Changes to %s stack %s:\n\n", action, cs.StackName)
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", "Action", "Logical ID", "Type", "Replacement", "Properties")
+	for _, r := range cs.Resources {
+		properties := emptyCell
+		if len(r.Properties) > 0 {
+			properties = strings.Join(r.Properties, ", ")
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", r.Action, r.LogicalID, r.Type, humanReplacement(r.Replacement), properties)
+	}
+	writer.Flush()
+	if len(cs.Parameters) == 0 {
+		return
+	}
+
+	fmt.Fprint(w, "\nParameter changes:\n\n")
+	writer = tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Name", "Old value", "New value")
+	for _, p := range cs.Parameters {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", p.Key, parameterValue(p.OldValue), parameterValue(p.NewValue))
+	}
+	writer.Flush()
+}
+
+// humanReplacement returns whether a modified resource is replaced as "Yes", "No" or "Conditional".
+func humanReplacement(replacement string) string {
+	switch replacement {
+	case "True":
+		return "Yes"
+	case "False":
+		return "No"
+	case "":
+		return emptyCell
+	default:
+		return replacement
+	}
+}
+
+func parameterValue(value *string) string {
+	if value == nil {
+		return emptyCell
+	}
+	if aws.StringValue(value) == "" {
+		return `""`
+	}
+	return aws.StringValue(value)
+}
+
+// addChangeSetReviewFlags adds the flags to review the infrastructure changes of a deployment to the command.
+func addChangeSetReviewFlags(cmd *cobra.Command, vars *changeSetReviewVars) {
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, changeSetDryRunFlagDescription)
+}
@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		in     *cloudformation.ChangeSet
+		wanted string
+	}{
+		"renders the resource and parameter changes of an update": {
+			in: &cloudformation.ChangeSet{
+				StackName: "phonetool-test-api",
+				Resources: []cloudformation.ResourceChange{
+					{
+						Action:      "Modify",
+						LogicalID:   "TaskDefinition",
+						Type:        "AWS::ECS::TaskDefinition",
+						Replacement: "True",
+						Properties:  []string{"ContainerDefinitions", "Cpu"},
+					},
+					{
+						Action:      "Modify",
+						LogicalID:   "Service",
+						Type:        "AWS::ECS::Service",
+						Replacement: "False",
+						Properties:  []string{"TaskDefinition"},
+					},
+					{
+						Action:    "Remove",
+						LogicalID: "AddonsStack",
+						Type:      "AWS::CloudFormation::Stack",
+					},
+				},
+				Parameters: []cloudformation.ParameterChange{
+					{
+						Key:      "ContainerImage",
+						OldValue: aws.String("nginx:1"),
+						NewValue: aws.String("nginx:2"),
+					},
+					{
+						Key:      "AddonsTemplateURL",
+						OldValue: aws.String("https://bucket.s3.amazonaws.com/api.yml"),
+						NewValue: aws.String(""),
+					},
+					{
+						Key:      "LogRetention",
+						OldValue: aws.String("30"),
+					},
+				},
+			},
+			wanted: `Changes to update stack phonetool-test-api:
+
+  Action            Logical ID          Type                        Replacement         Properties
+  Modify            TaskDefinition      AWS::ECS::TaskDefinition    Yes                 ContainerDefinitions, Cpu
+  Modify            Service             AWS::ECS::Service           No                  TaskDefinition
+  Remove            AddonsStack         AWS::CloudFormation::Stack  -                   -
+
+Parameter changes:
+
+  Name               Old value                                New value
+  ContainerImage     nginx:1                                  nginx:2
+  AddonsTemplateURL  https://bucket.s3.amazonaws.com/api.yml  ""
+  LogRetention       30                                       -
+`,
+		},
+		"renders the resources to create without parameters": {
+			in: &cloudformation.ChangeSet{
+				StackName: "pipeline-phonetool",
+				IsCreate:  true,
+				Resources: []cloudformation.ResourceChange{
+					{
+						Action:    "Add",
+						LogicalID: "Pipeline",
+						Type:      "AWS::CodePipeline::Pipeline",
+					},
+				},
+			},
+			wanted: `Changes to create stack pipeline-phonetool:
+
+  Action            Logical ID          Type                         Replacement         Properties
+  Add               Pipeline            AWS::CodePipeline::Pipeline  -                   -
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &bytes.Buffer{}
+
+			// WHEN
+			renderChangeSet(b, tc.in)
+
+			// THEN
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
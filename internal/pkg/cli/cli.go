@@ -12,12 +12,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+const (
+	wsAppNamePrompt     = "Which application in the workspace would you like to use?"
+	wsAppNameHelpPrompt = "The workspace holds several applications, each in its own directory under the copilot directory."
+)
+
 // GlobalOpts holds fields that are used across multiple commands.
 type GlobalOpts struct {
 	appName string
@@ -45,12 +51,31 @@ func (o *GlobalOpts) AppName() string {
 	return o.appName
 }
 
+// BindWorkspaceFlag adds the global flag to select the root of the workspace to the command.
+func BindWorkspaceFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(workspaceFlag, "", workspaceFlagDescription)
+}
+
+// SetWorkspaceRoot points the workspace of all commands to the root set with the global flag, if any,
+// and reloads the application's name from that workspace.
+func SetWorkspaceRoot(cmd *cobra.Command) error {
+	root, err := cmd.Flags().GetString(workspaceFlag)
+	if err != nil || root == "" {
+		return nil
+	}
+	if err := os.Setenv(workspace.EnvVarWorkspace, root); err != nil {
+		return fmt.Errorf("set workspace root to %s: %w", root, err)
+	}
+	bindAppName()
+	return nil
+}
+
 // bindAppName loads the application's name to viper.
 // If there is an error, we swallow the error and leave the default value as empty string.
 func bindAppName() {
 	name, err := loadAppName()
 	if err != nil {
-		return
+		name = ""
 	}
 	viper.SetDefault(appFlag, name)
 }
@@ -70,6 +95,19 @@ func loadAppName() (string, error) {
 	return summary.Application, nil
 }
 
+// newWorkspace returns the workspace scoped to the application of the command.
+// If the workspace holds several applications and the application can't be inferred, the user is prompted for one.
+func newWorkspace(opts *GlobalOpts) (*workspace.Workspace, error) {
+	return workspace.New(workspace.WithApplication(opts.AppName), workspace.WithApplicationSelector(func(apps []string) (string, error) {
+		name, err := opts.prompt.SelectOne(wsAppNamePrompt, wsAppNameHelpPrompt, apps)
+		if err != nil {
+			return "", fmt.Errorf("select application: %w", err)
+		}
+		opts.appName = name
+		return name, nil
+	}))
+}
+
 type errReservedArg struct {
 	val string
 }
@@ -139,3 +177,45 @@ func relPath(fullPath string) (string, error) {
 	}
 	return path, nil
 }
+
+// applyFileUpdate shows how a regenerated file of the workspace changes before writing it, and returns the path of the file
+// relative to the current working directory.
+func applyFileUpdate(ws fileUpdater, update *workspace.FileUpdate, fileDesc string) (string, error) {
+	path, err := relPath(update.Path)
+	if err != nil {
+		return "", err
+	}
+	if update.Diff != "" {
+		log.Infoln(update.Diff)
+	}
+	if err := ws.ApplyUpdate(update); err != nil {
+		return "", err
+	}
+	switch {
+	case update.Created:
+		log.Successf("Wrote the %s at %s\n", fileDesc, color.HighlightResource(path))
+	case update.Diff == "":
+		log.Successf("The %s at %s is already up to date.\n", fileDesc, color.HighlightResource(path))
+	case update.NewPath != "":
+		newPath, err := relPath(update.NewPath)
+		if err != nil {
+			return "", err
+		}
+		log.Warningf("The %s at %s was generated by an earlier version and can't be merged, so it's left unchanged.\n",
+			fileDesc, color.HighlightResource(path))
+		log.Warningf("Wrote the new version at %s, please apply the changes above that you want to keep to your file.\n",
+			color.HighlightResource(newPath))
+	default:
+		backupPath, err := relPath(update.BackupPath)
+		if err != nil {
+			return "", err
+		}
+		log.Successf("Updated the %s at %s, the previous version is backed up at %s.\n",
+			fileDesc, color.HighlightResource(path), color.HighlightResource(backupPath))
+	}
+	if update.Conflicts > 0 {
+		log.Warningf("Resolve the %d conflict(s) marked in the %s between your changes and the new version before using it.\n",
+			update.Conflicts, fileDesc)
+	}
+	return path, nil
+}
@@ -4,21 +4,109 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/cobra"
 )
 
+type deployVars struct {
+	deploySvcVars
+	envNames    []string
+	all         bool
+	concurrency int
+}
+
+// validate returns an error if the flags of a single service deployment are mixed with the flags of --all.
+func (v deployVars) validate() error {
+	if !v.all {
+		if len(v.envNames) > 1 {
+			return fmt.Errorf("cannot specify more than one environment without --%s", allFlag)
+		}
+		return nil
+	}
+	if v.Name != "" {
+		return fmt.Errorf("cannot specify --%s and --%s options at once", allFlag, nameFlag)
+	}
+	if v.Watch {
+		return fmt.Errorf("cannot specify --%s and --%s options at once", allFlag, watchFlag)
+	}
+	if v.shouldReview() {
+		return fmt.Errorf("cannot specify --%s with --%s or --%s", allFlag, diffFlag, dryRunFlag)
+	}
+	return nil
+}
+
+func runDeployAll(cmd *cobra.Command, args []string, vars deployVars) error {
+	opts, err := newDeployAllOpts(deployAllVars{
+		GlobalOpts:   vars.GlobalOpts,
+		envNames:     vars.envNames,
+		imageTag:     vars.ImageTag,
+		resourceTags: vars.ResourceTags,
+		concurrency:  vars.concurrency,
+
+		skipProdConfirmation: vars.SkipProdConfirmation,
+		waitForLock:          vars.WaitForLock,
+	})
+	if err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if err := opts.Ask(); err != nil {
+		return err
+	}
+	if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
+		return err
+	}
+	log.Successf("Deployed all the services of application %s.\n", vars.AppName())
+	return nil
+}
+
 // BuildDeployCmd is the deploy command - which is
-// an alias for app deploy.
+// an alias for svc deploy that can also deploy all the services of the workspace.
 func BuildDeployCmd() *cobra.Command {
-	deployCmd := BuildSvcDeployCmd()
-	deployCmd.Use = "deploy"
-	deployCmd.Short = "Deploy your service."
-	deployCmd.Long = `Command for deploying services to your environments.`
-	deployCmd.Example = `
+	vars := deployVars{
+		deploySvcVars: deploySvcVars{
+			GlobalOpts: NewGlobalOpts(),
+		},
+	}
+	deployCmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy your service.",
+		Long:  `Command for deploying services to your environments.`,
+		Example: `
 	Deploys a service named "frontend" to a "test" environment.
-	/code $ copilot deploy --name frontend --env test`
+	/code $ copilot deploy --name frontend --env test
+	Deploys all the services of the workspace to the "test" and "prod" environments.
+	/code $ copilot deploy --all --env test,prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := vars.validate(); err != nil {
+				return err
+			}
+			if vars.all {
+				return runDeployAll(cmd, args, vars)
+			}
+			if len(vars.envNames) == 1 {
+				vars.EnvName = vars.envNames[0]
+			}
+			return runSvcDeploy(cmd, args, vars.deploySvcVars)
+		}),
+	}
+	deployCmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	deployCmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	deployCmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, deployEnvsFlagDescription)
+	deployCmd.Flags().StringVar(&vars.ImageTag, imageTagFlag, "", imageTagFlagDescription)
+	deployCmd.Flags().StringToStringVar(&vars.ResourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.Watch, watchFlag, false, watchFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.SkipProdConfirmation, yesIMeanProdFlag, false, yesIMeanProdFlagDescription)
+	deployCmd.Flags().DurationVar(&vars.WaitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	addChangeSetReviewFlags(deployCmd, &vars.changeSetReviewVars)
+	deployCmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
+	deployCmd.Flags().IntVar(&vars.concurrency, concurrencyFlag, defaultDeployConcurrency, concurrencyFlagDescription)
 
 	deployCmd.SetUsageTemplate(template.Usage)
 
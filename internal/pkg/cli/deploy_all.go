@@ -0,0 +1,652 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+const (
+	defaultDeployConcurrency = 4
+
+	svcDeployStatusDeployed = "Deployed"
+	svcDeployStatusFailed   = "Failed"
+	svcDeployStatusSkipped  = "Skipped"
+)
+
+var errDeployAllCancelled = errors.New("deploy cancelled - no changes made")
+
+type deployAllVars struct {
+	*GlobalOpts
+	envNames     []string
+	imageTag     string
+	resourceTags map[string]string
+	concurrency  int
+
+	skipProdConfirmation bool
+	waitForLock          time.Duration
+}
+
+type deployAllOpts struct {
+	deployAllVars
+
+	w            io.Writer
+	store        store
+	ws           wsSvcReader
+	cmd          runner
+	builder      imageBuilder
+	sessProvider sessionProvider
+	deployments  deploymentStore
+	locker       deploymentLocker
+	registry     func(region string) (ecrService, error)
+
+	// Build and deploy steps, overridden in tests.
+	buildSvc  func(o *deployAllOpts, svcName string, envs []*config.Environment) error
+	deploySvc func(o *deployAllOpts, svcName string, env *config.Environment) error
+
+	// cached variables
+	targetApp    *config.Application
+	mu           sync.Mutex
+	imageDigests map[string]string  // Digests of the pushed images by service and region.
+	results      []*svcDeployResult // Outcomes of the service deployments.
+}
+
+// svcDeployResult is the outcome of deploying a service to an environment.
+type svcDeployResult struct {
+	svcName string
+	envName string
+	status  string
+	err     error
+}
+
+func newDeployAllOpts(vars deployAllVars) (*deployAllOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := newWorkspace(vars.GlobalOpts)
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	builder, err := docker.NewBuilder()
+	if err != nil {
+		return nil, fmt.Errorf("new image builder: %w", err)
+	}
+	sessProvider := session.NewProvider()
+
+	return &deployAllOpts{
+		deployAllVars: vars,
+		w:             log.OutputWriter,
+		store:         store,
+		ws:            ws,
+		cmd:           command.New(),
+		builder:       builder,
+		sessProvider:  sessProvider,
+		deployments:   store,
+		locker:        store,
+		registry: func(region string) (ecrService, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		buildSvc:  buildAndPushImage,
+		deploySvc: deploySvcToEnv,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *deployAllOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.concurrency < 1 {
+		return fmt.Errorf("--%s must be at least 1", concurrencyFlag)
+	}
+	for _, envName := range o.envNames {
+		if _, err := o.store.GetEnvironment(o.AppName(), envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", envName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the image tag if it can't be defaulted to the git commit.
+func (o *deployAllOpts) Ask() error {
+	if o.imageTag != "" {
+		return nil
+	}
+	tag, err := getVersionTag(o.cmd)
+	if err == nil {
+		o.imageTag = tag
+		return nil
+	}
+	log.Warningln("Failed to default tag, are you in a git repository?")
+	userInputTag, err := o.prompt.Get(inputImageTagPrompt, "", nil /*no validation*/)
+	if err != nil {
+		return fmt.Errorf("prompt for image tag: %w", err)
+	}
+	o.imageTag = userInputTag
+	return nil
+}
+
+// Execute builds the images of all the services in the workspace, then deploys the services to each environment.
+// A service is deployed only once the services it depends on are deployed to the environment.
+func (o *deployAllOpts) Execute() error {
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return err
+	}
+	o.targetApp = app
+	envs, err := o.targetEnvironments()
+	if err != nil {
+		return err
+	}
+	if err := o.confirmProdEnvs(envs); err != nil {
+		return err
+	}
+	svcNames, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if len(svcNames) == 0 {
+		return errNoLocalManifestsFound
+	}
+	deps, err := o.dependencies(svcNames)
+	if err != nil {
+		return err
+	}
+
+	buildErrs := o.buildImages(svcNames, envs)
+	var results []*svcDeployResult
+	for _, env := range envs {
+		results = append(results, o.deployToEnv(svcNames, deps, env, buildErrs)...)
+	}
+	o.results = results
+	writeDeployResults(o.w, results)
+
+	var failed int
+	for _, res := range results {
+		if res.status != svcDeployStatusDeployed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d service deployments did not succeed", failed, len(results))
+	}
+	return nil
+}
+
+// auditedTarget returns the services deployed by the command. Skipped deployments didn't change any stack.
+func (o *deployAllOpts) auditedTarget() auditTarget {
+	target := auditTarget{
+		app: o.AppName(),
+	}
+	if len(o.envNames) == 1 {
+		target.env = o.envNames[0]
+	}
+	for _, res := range o.results {
+		if res.status == svcDeployStatusSkipped {
+			continue
+		}
+		target.stacks = append(target.stacks, auditStack{
+			name: stack.NameForService(o.AppName(), res.envName, res.svcName),
+			env:  res.envName,
+		})
+	}
+	return target
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *deployAllOpts) RecommendedActions() []string {
+	return nil
+}
+
+func (o *deployAllOpts) targetEnvironments() ([]*config.Environment, error) {
+	if len(o.envNames) == 0 {
+		envs, err := o.store.ListEnvironments(o.AppName())
+		if err != nil {
+			return nil, fmt.Errorf("list environments: %w", err)
+		}
+		if len(envs) == 0 {
+			return nil, fmt.Errorf("no environments found in application %s", o.AppName())
+		}
+		return envs, nil
+	}
+	var envs []*config.Environment
+	for _, envName := range o.envNames {
+		env, err := o.store.GetEnvironment(o.AppName(), envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s configuration: %w", envName, err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// dependencies returns the services that each service depends on from their manifests.
+// It returns an error if a service depends on a service that isn't in the workspace or if the dependencies form a cycle.
+func (o *deployAllOpts) dependencies(svcNames []string) (map[string][]string, error) {
+	type dependent interface {
+		Dependencies() []string
+	}
+	local := make(map[string]bool)
+	for _, name := range svcNames {
+		local[name] = true
+	}
+	deps := make(map[string][]string)
+	for _, name := range svcNames {
+		raw, err := o.ws.ReadServiceManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read service %s manifest from workspace: %w", name, err)
+		}
+		mft, err := manifest.UnmarshalService(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal service %s manifest: %w", name, err)
+		}
+		d, ok := mft.(dependent)
+		if !ok {
+			continue
+		}
+		for _, dep := range d.Dependencies() {
+			if !local[dep] {
+				return nil, fmt.Errorf("service %s depends on service %s which is not in the workspace", name, dep)
+			}
+		}
+		deps[name] = d.Dependencies()
+	}
+	if err := validateNoCycle(svcNames, deps); err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
+// validateNoCycle returns an error naming the services involved if the dependencies form a cycle.
+func validateNoCycle(svcNames []string, deps map[string][]string) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					return fmt.Errorf("circular dependency between services: %s", strings.Join(append(path[i:], name), " -> "))
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, name := range svcNames {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildImages builds and pushes the image of each service, at most concurrency at a time.
+// It returns the build error of each service that failed.
+func (o *deployAllOpts) buildImages(svcNames []string, envs []*config.Environment) map[string]error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sem  = make(chan struct{}, o.concurrency)
+		errs = make(map[string]error)
+	)
+	for _, name := range svcNames {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			log.Infof("Building and pushing the image of %s.\n", color.HighlightUserInput(name))
+			if err := o.buildSvc(o, name, envs); err != nil {
+				log.Errorf("Failed to build and push the image of %s: %v\n", name, err)
+				mu.Lock()
+				errs[name] = err
+				mu.Unlock()
+				return
+			}
+			log.Successf("Pushed the image of %s.\n", color.HighlightUserInput(name))
+		}(name)
+	}
+	wg.Wait()
+	return errs
+}
+
+// deployToEnv deploys the services to the environment, at most concurrency at a time.
+// A service is deployed once all of its dependencies are deployed, and skipped if one of them didn't succeed.
+func (o *deployAllOpts) deployToEnv(svcNames []string, deps map[string][]string, env *config.Environment, buildErrs map[string]error) []*svcDeployResult {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sem     = make(chan struct{}, o.concurrency)
+		done    = make(map[string]chan struct{})
+		results = make(map[string]*svcDeployResult)
+	)
+	for _, name := range svcNames {
+		done[name] = make(chan struct{})
+	}
+	for _, name := range svcNames {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer close(done[name])
+			res := &svcDeployResult{
+				svcName: name,
+				envName: env.Name,
+				status:  svcDeployStatusDeployed,
+			}
+			defer func() {
+				mu.Lock()
+				results[name] = res
+				mu.Unlock()
+			}()
+
+			for _, dep := range deps[name] {
+				<-done[dep]
+			}
+			if err, ok := buildErrs[name]; ok {
+				res.status, res.err = svcDeployStatusFailed, fmt.Errorf("build image: %w", err)
+				return
+			}
+			mu.Lock()
+			for _, dep := range deps[name] {
+				if results[dep].status != svcDeployStatusDeployed {
+					res.status, res.err = svcDeployStatusSkipped, fmt.Errorf("dependency %s was not deployed", dep)
+					break
+				}
+			}
+			mu.Unlock()
+			if res.err != nil {
+				return
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if err := o.deploySvc(o, name, env); err != nil {
+				res.status, res.err = svcDeployStatusFailed, err
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	var ordered []*svcDeployResult
+	for _, name := range svcNames {
+		ordered = append(ordered, results[name])
+	}
+	return ordered
+}
+
+// writeDeployResults writes a table with the outcome of each service deployment.
+func writeDeployResults(w io.Writer, results []*svcDeployResult) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", "Service", "Environment", "Status", "Details")
+	for _, res := range results {
+		details := emptyCell
+		if res.err != nil {
+			details = res.err.Error()
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", res.svcName, res.envName, res.status, details)
+	}
+	writer.Flush()
+}
+
+// buildAndPushImage builds the image of the service once and pushes it to the repository of the service in
+// the region of each environment, unless the repository already has its content.
+func buildAndPushImage(o *deployAllOpts, svcName string, envs []*config.Environment) error {
+	path, err := dockerfilePath(o.ws, svcName)
+	if err != nil {
+		return err
+	}
+	repoName := fmt.Sprintf("%s/%s", o.AppName(), svcName)
+	platforms, err := svcPlatforms(o.ws, svcName, envs)
+	if err != nil {
+		return err
+	}
+	if len(platforms) > 0 {
+		return buildAndPushPlatformImage(o, svcName, envs, path, platforms)
+	}
+	var builtURI string
+	for _, region := range envRegions(envs) {
+		registry, err := o.registry(region)
+		if err != nil {
+			return err
+		}
+		uri, err := registry.GetRepository(repoName)
+		if err != nil {
+			return fmt.Errorf("get ECR repository URI in region %s: %w", region, err)
+		}
+		if builtURI == "" {
+			if err := o.builder.Build(uri, o.imageTag, path); err != nil {
+				return fmt.Errorf("build Dockerfile at %s with tag %s: %w", path, o.imageTag, err)
+			}
+			builtURI = uri
+		} else if err := o.builder.Tag(builtURI, o.imageTag, uri); err != nil {
+			return fmt.Errorf("tag image for region %s: %w", region, err)
+		}
+		digest, err := pushedImageDigest(o.builder, registry, uri, repoName, o.imageTag)
+		if err != nil {
+			return err
+		}
+		if digest != "" {
+			log.Infof("Skipped pushing image %s of %s to region %s since its content already exists.\n",
+				color.HighlightUserInput(o.imageTag), color.HighlightUserInput(svcName), region)
+		} else if digest, err = pushImage(o.builder, registry, uri, repoName, o.imageTag); err != nil {
+			return err
+		}
+		o.setImageDigest(svcName, region, digest)
+	}
+	return nil
+}
+
+func (o *deployAllOpts) setImageDigest(svcName, region, digest string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.imageDigests == nil {
+		o.imageDigests = make(map[string]string)
+	}
+	o.imageDigests[svcName+"/"+region] = digest
+}
+
+func (o *deployAllOpts) imageDigest(svcName, region string) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.imageDigests[svcName+"/"+region]
+}
+
+// buildAndPushPlatformImage builds the image of the service once for each of the platforms, and pushes it as a
+// manifest list to the repository of the service in the region of each environment.
+func buildAndPushPlatformImage(o *deployAllOpts, svcName string, envs []*config.Environment, path string, platforms []string) error {
+	repoName := fmt.Sprintf("%s/%s", o.AppName(), svcName)
+	regions := envRegions(envs)
+	var uris []string
+	for _, region := range regions {
+		registry, err := o.registry(region)
+		if err != nil {
+			return err
+		}
+		uri, err := registry.GetRepository(repoName)
+		if err != nil {
+			return fmt.Errorf("get ECR repository URI in region %s: %w", region, err)
+		}
+		auth, err := registry.GetECRAuth()
+		if err != nil {
+			return fmt.Errorf("get ECR auth data: %w", err)
+		}
+		if err := o.builder.Login(uri, auth.Username, auth.Password); err != nil {
+			return err
+		}
+		uris = append(uris, uri)
+	}
+	digests, err := pushPlatformImage(o.builder, uris, o.imageTag, path, platforms)
+	if err != nil {
+		return err
+	}
+	for i, region := range regions {
+		o.setImageDigest(svcName, region, digests[i])
+	}
+	return nil
+}
+
+// svcPlatforms returns the distinct platforms that the tasks of the service run on in the environments, in order.
+// It returns nil if the tasks run on the default platform in every environment.
+func svcPlatforms(ws svcManifestReader, svcName string, envs []*config.Environment) ([]string, error) {
+	var platforms []string
+	var hasPlatform bool
+	for _, env := range envs {
+		platform, err := svcPlatform(ws, svcName, env.Name)
+		if err != nil {
+			return nil, err
+		}
+		if platform != "" {
+			if err := validatePlatform(platform); err != nil {
+				return nil, err
+			}
+			hasPlatform = true
+		} else {
+			platform = manifest.PlatformLinuxAMD64
+		}
+		if !contains(platform, platforms) {
+			platforms = append(platforms, platform)
+		}
+	}
+	if !hasPlatform {
+		return nil, nil
+	}
+	return platforms, nil
+}
+
+// confirmProdEnvs returns an error if a production environment only accepts deployments from a pipeline,
+// or if deploying to the production environments without reviewing the changes is not confirmed.
+func (o *deployAllOpts) confirmProdEnvs(envs []*config.Environment) error {
+	for _, env := range envs {
+		if err := validatePipelineOnly(env); err != nil {
+			return err
+		}
+	}
+	confirmed, err := confirmProd(o.prompt, o.skipProdConfirmation, "deploy every service without reviewing the changes", envs)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errDeployAllCancelled
+	}
+	return nil
+}
+
+// envRegions returns the distinct regions of the environments, in order.
+func envRegions(envs []*config.Environment) []string {
+	var regions []string
+	for _, env := range envs {
+		if !contains(env.Region, regions) {
+			regions = append(regions, env.Region)
+		}
+	}
+	return regions
+}
+
+// deploySvcToEnv deploys the service stack to the environment with the digest of the image that was already pushed.
+func deploySvcToEnv(o *deployAllOpts, svcName string, env *config.Environment) error {
+	svcOpts, err := o.svcDeployOpts(svcName, env)
+	if err != nil {
+		return err
+	}
+	if err := svcOpts.configureClients(); err != nil {
+		return err
+	}
+	addonsURL, err := svcOpts.pushAddonsTemplateToS3Bucket()
+	if err != nil {
+		return err
+	}
+	return svcOpts.withDeploymentLock(func() error {
+		return svcOpts.deploySvc(addonsURL)
+	})
+}
+
+// svcDeployOpts returns the options to deploy the service to the environment with the dependencies of the command.
+func (o *deployAllOpts) svcDeployOpts(svcName string, env *config.Environment) (*deploySvcOpts, error) {
+	svc, err := o.store.GetService(o.AppName(), svcName)
+	if err != nil {
+		return nil, fmt.Errorf("get service configuration: %w", err)
+	}
+	return &deploySvcOpts{
+		deploySvcVars: deploySvcVars{
+			GlobalOpts:   o.GlobalOpts,
+			Name:         svcName,
+			EnvName:      env.Name,
+			ImageTag:     o.imageTag,
+			ResourceTags: o.resourceTags,
+			WaitForLock:  o.waitForLock,
+		},
+		store:             o.store,
+		ws:                o.ws,
+		cmd:               o.cmd,
+		sessProvider:      o.sessProvider,
+		deployments:       o.deployments,
+		locker:            o.locker,
+		spinner:           lineProgress{},
+		targetApp:         o.targetApp,
+		targetEnvironment: env,
+		targetSvc:         svc,
+		imageDigest:       o.imageDigest(svcName, env.Region),
+		newSvcDescriber:   newSvcDeployDescriber,
+	}, nil
+}
+
+// lineProgress reports progress with one line per label instead of a spinner,
+// so that concurrent deployments don't overwrite each other on the terminal.
+type lineProgress struct{}
+
+// Start writes the label.
+func (lineProgress) Start(label string) {
+	log.Infoln(label)
+}
+
+// Stop writes the label if it's not blank.
+func (lineProgress) Stop(label string) {
+	if strings.TrimSpace(label) == "" {
+		return
+	}
+	log.Info(label)
+}
+
+// Events discards the resource events since they can't be displayed for concurrent deployments.
+func (lineProgress) Events([]termprogress.TabRow) {}
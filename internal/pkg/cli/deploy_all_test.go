@@ -0,0 +1,518 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployVars_validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars    deployVars
+		wantedErr error
+	}{
+		"multiple environments without --all": {
+			inVars:    deployVars{envNames: []string{"test", "prod"}},
+			wantedErr: errors.New("cannot specify more than one environment without --all"),
+		},
+		"single service": {
+			inVars: deployVars{
+				deploySvcVars: deploySvcVars{Name: "api"},
+				envNames:      []string{"test"},
+			},
+		},
+		"--all with a service name": {
+			inVars: deployVars{
+				deploySvcVars: deploySvcVars{Name: "api"},
+				all:           true,
+			},
+			wantedErr: errors.New("cannot specify --all and --name options at once"),
+		},
+		"--all with --watch": {
+			inVars: deployVars{
+				deploySvcVars: deploySvcVars{Watch: true},
+				all:           true,
+			},
+			wantedErr: errors.New("cannot specify --all and --watch options at once"),
+		},
+		"--all with --diff": {
+			inVars: deployVars{
+				deploySvcVars: deploySvcVars{changeSetReviewVars: changeSetReviewVars{showDiff: true}},
+				all:           true,
+			},
+			wantedErr: errors.New("cannot specify --all with --diff or --dry-run"),
+		},
+		"--all with multiple environments": {
+			inVars: deployVars{
+				envNames: []string{"test", "prod"},
+				all:      true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.inVars.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeployAllOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName     string
+		inEnvNames    []string
+		inConcurrency int
+		mockStore     func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"no app in workspace": {
+			inConcurrency: 1,
+			mockStore:     func(m *mocks.Mockstore) {},
+			wantedErr:     errNoAppInWorkspace,
+		},
+		"invalid concurrency": {
+			inAppName: "phonetool",
+			mockStore: func(m *mocks.Mockstore) {},
+			wantedErr: errors.New("--concurrency must be at least 1"),
+		},
+		"unknown environment": {
+			inAppName:     "phonetool",
+			inEnvNames:    []string{"test", "prod"},
+			inConcurrency: 1,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment prod configuration: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			opts := &deployAllOpts{
+				deployAllVars: deployAllVars{
+					GlobalOpts:  &GlobalOpts{appName: tc.inAppName},
+					envNames:    tc.inEnvNames,
+					concurrency: tc.inConcurrency,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeployAllOpts_Execute(t *testing.T) {
+	const (
+		dbManifest = `name: db
+type: Backend Service
+image:
+  build: db/Dockerfile`
+		apiManifest = `name: api
+type: Backend Service
+depends_on: [db]
+image:
+  build: api/Dockerfile`
+		webManifest = `name: web
+type: Backend Service
+depends_on: [api]
+image:
+  build: web/Dockerfile`
+	)
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2"}
+	prodEnv := &config.Environment{Name: "prod", Region: "us-east-1"}
+	testCases := map[string]struct {
+		inEnvNames []string
+		manifests  map[string]string
+		buildErrs  map[string]error
+		deployErrs map[string]error // Keyed by service and environment names.
+
+		wantedDeploys []string
+		wantedSummary string
+		wantedErr     error
+	}{
+		"deploys the services in the order of their dependencies": {
+			manifests: map[string]string{"web": webManifest, "api": apiManifest, "db": dbManifest},
+
+			wantedDeploys: []string{"db/test", "api/test", "web/test", "db/prod", "api/prod", "web/prod"},
+			wantedSummary: `Service             Environment         Status              Details
+web                 test                Deployed            -
+api                 test                Deployed            -
+db                  test                Deployed            -
+web                 prod                Deployed            -
+api                 prod                Deployed            -
+db                  prod                Deployed            -
+`,
+		},
+		"skips the services that depend on a failed deployment": {
+			inEnvNames: []string{"test"},
+			manifests:  map[string]string{"web": webManifest, "api": apiManifest, "db": dbManifest},
+			deployErrs: map[string]error{"api/test": errors.New("some error")},
+
+			wantedDeploys: []string{"db/test", "api/test"},
+			wantedSummary: `Service             Environment         Status              Details
+web                 test                Skipped             dependency api was not deployed
+api                 test                Failed              some error
+db                  test                Deployed            -
+`,
+			wantedErr: errors.New("2 of 3 service deployments did not succeed"),
+		},
+		"fails the deployments of a service whose image can't be built": {
+			inEnvNames: []string{"test"},
+			manifests:  map[string]string{"db": dbManifest},
+			buildErrs:  map[string]error{"db": errors.New("some error")},
+
+			wantedSummary: `Service             Environment         Status              Details
+db                  test                Failed              build image: some error
+`,
+			wantedErr: errors.New("1 of 1 service deployments did not succeed"),
+		},
+		"unknown dependency": {
+			manifests: map[string]string{"api": apiManifest},
+
+			wantedErr: errors.New("service api depends on service db which is not in the workspace"),
+		},
+		"circular dependencies": {
+			manifests: map[string]string{
+				"db":  "name: db\ntype: Backend Service\ndepends_on: [web]",
+				"api": apiManifest,
+				"web": webManifest,
+			},
+
+			wantedErr: errors.New("circular dependency between services: web -> api -> db -> web"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			if tc.inEnvNames == nil {
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+			} else {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			}
+			var svcNames []string
+			for _, name := range []string{"web", "api", "db"} {
+				if _, ok := tc.manifests[name]; ok {
+					svcNames = append(svcNames, name)
+				}
+			}
+			mockWs := mocks.NewMockwsSvcReader(ctrl)
+			mockWs.EXPECT().ServiceNames().Return(svcNames, nil)
+			for name, mft := range tc.manifests {
+				mockWs.EXPECT().ReadServiceManifest(name).Return([]byte(mft), nil)
+			}
+
+			var mu sync.Mutex
+			var deploys []string
+			b := &bytes.Buffer{}
+			opts := &deployAllOpts{
+				deployAllVars: deployAllVars{
+					GlobalOpts:  &GlobalOpts{appName: "phonetool"},
+					envNames:    tc.inEnvNames,
+					concurrency: 1,
+				},
+				w:     b,
+				store: mockStore,
+				ws:    mockWs,
+				buildSvc: func(o *deployAllOpts, svcName string, envs []*config.Environment) error {
+					return tc.buildErrs[svcName]
+				},
+				deploySvc: func(o *deployAllOpts, svcName string, env *config.Environment) error {
+					key := fmt.Sprintf("%s/%s", svcName, env.Name)
+					mu.Lock()
+					deploys = append(deploys, key)
+					mu.Unlock()
+					return tc.deployErrs[key]
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDeploys, deploys)
+			require.Equal(t, tc.wantedSummary, b.String())
+		})
+	}
+}
+
+func TestDeployAllOpts_svcDeployOpts(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetService("phonetool", "api").Return(&config.Service{Name: "api"}, nil)
+	mockWs := mocks.NewMockwsSvcReader(ctrl)
+	mockWs.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+hooks:
+  pre_deploy:
+    - local: make lint`), nil)
+	mockCmd := mocks.NewMockrunner(ctrl)
+	mockCmd.EXPECT().Run("sh", []string{"-c", "make lint"}, gomock.Any()).Return(nil)
+	opts := &deployAllOpts{
+		deployAllVars: deployAllVars{
+			GlobalOpts: &GlobalOpts{appName: "phonetool"},
+		},
+		store: mockStore,
+		ws:    mockWs,
+		cmd:   mockCmd,
+	}
+
+	// WHEN
+	svcOpts, err := opts.svcDeployOpts("api", &config.Environment{Name: "test", Region: "us-west-2"})
+	require.NoError(t, err)
+	hooks, err := svcOpts.deployHooks()
+	require.NoError(t, err)
+	err = svcOpts.runPreDeployHooks(hooks.PreDeploy, &mockStackConfig{})
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestBuildAndPushImage(t *testing.T) {
+	const dockerfileManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile`
+	envs := []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "staging", Region: "us-west-2"},
+		{Name: "prod", Region: "us-east-1"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService)
+
+		wantedDigests map[string]string
+		wantedErr     error
+	}{
+		"builds the image once and pushes it to each region": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService) {
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte(dockerfileManifest), nil).Times(4)
+				gomock.InOrder(
+					west.EXPECT().GetRepository("phonetool/api").Return("west/phonetool/api", nil),
+					builder.EXPECT().Build("west/phonetool/api", "v1", "api/Dockerfile").Return(nil),
+					builder.EXPECT().RepoDigest("west/phonetool/api", "v1").Return("", nil),
+					west.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "west"}, nil),
+					builder.EXPECT().Login("west/phonetool/api", "AWS", "west").Return(nil),
+					builder.EXPECT().Push("west/phonetool/api", "v1").Return(nil),
+					builder.EXPECT().RepoDigest("west/phonetool/api", "v1").Return("sha256:abc", nil),
+					east.EXPECT().GetRepository("phonetool/api").Return("east/phonetool/api", nil),
+					builder.EXPECT().Tag("west/phonetool/api", "v1", "east/phonetool/api").Return(nil),
+					builder.EXPECT().RepoDigest("east/phonetool/api", "v1").Return("", nil),
+					east.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "east"}, nil),
+					builder.EXPECT().Login("east/phonetool/api", "AWS", "east").Return(nil),
+					builder.EXPECT().Push("east/phonetool/api", "v1").Return(nil),
+					builder.EXPECT().RepoDigest("east/phonetool/api", "v1").Return("sha256:abc", nil),
+				)
+			},
+			wantedDigests: map[string]string{
+				"api/us-west-2": "sha256:abc",
+				"api/us-east-1": "sha256:abc",
+			},
+		},
+		"skips the push to the regions that already have the content of the image": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService) {
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte(dockerfileManifest), nil).Times(4)
+				gomock.InOrder(
+					west.EXPECT().GetRepository("phonetool/api").Return("west/phonetool/api", nil),
+					builder.EXPECT().Build("west/phonetool/api", "v1", "api/Dockerfile").Return(nil),
+					builder.EXPECT().RepoDigest("west/phonetool/api", "v1").Return("sha256:abc", nil),
+					west.EXPECT().ListImages("phonetool/api").Return([]ecr.Image{{Digest: "sha256:123"}, {Digest: "sha256:abc"}}, nil),
+					east.EXPECT().GetRepository("phonetool/api").Return("east/phonetool/api", nil),
+					builder.EXPECT().Tag("west/phonetool/api", "v1", "east/phonetool/api").Return(nil),
+					builder.EXPECT().RepoDigest("east/phonetool/api", "v1").Return("sha256:abc", nil),
+					east.EXPECT().ListImages("phonetool/api").Return(nil, nil),
+					east.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "east"}, nil),
+					builder.EXPECT().Login("east/phonetool/api", "AWS", "east").Return(nil),
+					builder.EXPECT().Push("east/phonetool/api", "v1").Return(nil),
+					builder.EXPECT().RepoDigest("east/phonetool/api", "v1").Return("sha256:abc", nil),
+				)
+				west.EXPECT().GetECRAuth().Times(0)
+			},
+			wantedDigests: map[string]string{
+				"api/us-west-2": "sha256:abc",
+				"api/us-east-1": "sha256:abc",
+			},
+		},
+		"builds the image for the platforms of the environments and pushes it to each region": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService) {
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte(dockerfileManifest+`
+environments:
+  prod:
+    platform: linux/arm64`), nil).Times(4)
+				gomock.InOrder(
+					west.EXPECT().GetRepository("phonetool/api").Return("west/phonetool/api", nil),
+					west.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "west"}, nil),
+					builder.EXPECT().Login("west/phonetool/api", "AWS", "west").Return(nil),
+					east.EXPECT().GetRepository("phonetool/api").Return("east/phonetool/api", nil),
+					east.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "east"}, nil),
+					builder.EXPECT().Login("east/phonetool/api", "AWS", "east").Return(nil),
+					builder.EXPECT().BuildPlatforms([]string{"west/phonetool/api", "east/phonetool/api"}, "v1", "api/Dockerfile",
+						[]string{"linux/amd64", "linux/arm64"}).Return(nil),
+					builder.EXPECT().IndexDigest("west/phonetool/api", "v1").Return("sha256:abc", nil),
+					builder.EXPECT().IndexDigest("east/phonetool/api", "v1").Return("sha256:abc", nil),
+				)
+				builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigests: map[string]string{
+				"api/us-west-2": "sha256:abc",
+				"api/us-east-1": "sha256:abc",
+			},
+		},
+		"returns the build error": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService) {
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte(dockerfileManifest), nil).Times(4)
+				west.EXPECT().GetRepository("phonetool/api").Return("west/phonetool/api", nil)
+				builder.EXPECT().Build("west/phonetool/api", "v1", "api/Dockerfile").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("build Dockerfile at api/Dockerfile with tag v1: some error"),
+		},
+		"returns the tag error": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, builder *mocks.MockimageBuilder, west, east *mocks.MockecrService) {
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte(dockerfileManifest), nil).Times(4)
+				west.EXPECT().GetRepository("phonetool/api").Return("west/phonetool/api", nil)
+				builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				builder.EXPECT().RepoDigest("west/phonetool/api", "v1").Return("sha256:abc", nil)
+				west.EXPECT().ListImages("phonetool/api").Return([]ecr.Image{{Digest: "sha256:abc"}}, nil)
+				east.EXPECT().GetRepository("phonetool/api").Return("east/phonetool/api", nil)
+				builder.EXPECT().Tag("west/phonetool/api", "v1", "east/phonetool/api").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("tag image for region us-east-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcReader(ctrl)
+			mockBuilder := mocks.NewMockimageBuilder(ctrl)
+			mockWest := mocks.NewMockecrService(ctrl)
+			mockEast := mocks.NewMockecrService(ctrl)
+			tc.setupMocks(mockWs, mockBuilder, mockWest, mockEast)
+			opts := &deployAllOpts{
+				deployAllVars: deployAllVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					imageTag:   "v1",
+				},
+				ws:      mockWs,
+				builder: mockBuilder,
+				registry: func(region string) (ecrService, error) {
+					if region == "us-east-1" {
+						return mockEast, nil
+					}
+					return mockWest, nil
+				},
+			}
+
+			// WHEN
+			err := buildAndPushImage(opts, "api", envs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigests, opts.imageDigests)
+		})
+	}
+}
+
+func TestDeployAllOpts_auditedTarget(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvNames []string
+
+		wantedTarget auditTarget
+	}{
+		"records the stacks of the deployments that were not skipped": {
+			wantedTarget: auditTarget{
+				app: "phonetool",
+				stacks: []auditStack{
+					{name: "phonetool-test-api", env: "test"},
+					{name: "phonetool-prod-api", env: "prod"},
+				},
+			},
+		},
+		"records the environment if there is only one": {
+			inEnvNames: []string{"test"},
+			wantedTarget: auditTarget{
+				app: "phonetool",
+				env: "test",
+				stacks: []auditStack{
+					{name: "phonetool-test-api", env: "test"},
+					{name: "phonetool-prod-api", env: "prod"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &deployAllOpts{
+				deployAllVars: deployAllVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					envNames:   tc.inEnvNames,
+				},
+				results: []*svcDeployResult{
+					{svcName: "api", envName: "test", status: svcDeployStatusDeployed},
+					{svcName: "frontend", envName: "test", status: svcDeployStatusSkipped},
+					{svcName: "api", envName: "prod", status: svcDeployStatusFailed},
+				},
+			}
+
+			// WHEN
+			target := opts.auditedTarget()
+
+			// THEN
+			require.Equal(t, tc.wantedTarget, target)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// Operations holding deployment locks.
+const (
+	svcDeployLockOperation   = "svc deploy"
+	svcDeleteLockOperation   = "svc delete"
+	svcRollbackLockOperation = "svc rollback"
+	svcPromoteLockOperation  = "svc promote"
+	svcAbortLockOperation    = "svc abort"
+	envInitLockOperation     = "env init"
+	envDeleteLockOperation   = "env delete"
+)
+
+// deploymentLockPollInterval is the time to wait between two attempts to acquire a deployment lock held by someone else.
+var deploymentLockPollInterval = 10 * time.Second
+
+// deploymentLockRenewInterval is the time between two renewals of a held deployment lock,
+// so that the lock doesn't expire while a long operation is still running.
+var deploymentLockRenewInterval = config.DeploymentLockTTL / 4
+
+// withDeploymentLock runs fn while holding the deployment lock.
+// If the lock is held by someone else, it waits up to wait for the lock to be released before returning an error.
+func withDeploymentLock(locker deploymentLocker, lock *config.DeploymentLock, wait time.Duration, fn func() error) error {
+	if err := acquireDeploymentLock(locker, lock, wait); err != nil {
+		return err
+	}
+	stopRenewing := renewDeploymentLock(locker, lock)
+	defer func() {
+		stopRenewing()
+		// The lock expires after its TTL if it can't be released.
+		if err := locker.ReleaseDeploymentLock(lock); err != nil {
+			log.Warningf("Failed to release the deployment lock, run %s to release it: %v\n", lockBreakCmd(lock), err)
+		}
+	}()
+	return fn()
+}
+
+func acquireDeploymentLock(locker deploymentLocker, lock *config.DeploymentLock, wait time.Duration) error {
+	deadline := time.Now().Add(wait)
+	waiting := false
+	for {
+		err := locker.AcquireDeploymentLock(lock)
+		var locked *config.ErrDeploymentLocked
+		if !errors.As(err, &locked) {
+			return err
+		}
+		if !time.Now().Add(deploymentLockPollInterval).Before(deadline) {
+			log.Infof("Retry with %s to wait for the lock to be released, or run %s if its holder was interrupted.\n",
+				color.HighlightCode(fmt.Sprintf("--%s", waitForLockFlag)), lockBreakCmd(lock))
+			return err
+		}
+		if !waiting {
+			log.Infof("Waiting for %s to release the deployment lock held by %s.\n",
+				color.HighlightUserInput(locked.Lock.Holder), color.HighlightUserInput(locked.Lock.Operation))
+			waiting = true
+		}
+		time.Sleep(deploymentLockPollInterval)
+	}
+}
+
+// renewDeploymentLock renews the lease of the held lock periodically until the returned function is called.
+func renewDeploymentLock(locker deploymentLocker, lock *config.DeploymentLock) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(deploymentLockRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := locker.AcquireDeploymentLock(lock); err != nil {
+					log.Warningf("Failed to renew the deployment lock: %v\n", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped // Wait for an ongoing renewal so that it doesn't race with the release of the lock.
+	}
+}
+
+// lockBreakCmd returns the command to break the lock.
+func lockBreakCmd(lock *config.DeploymentLock) string {
+	cmd := fmt.Sprintf("copilot lock break -a %s -e %s", lock.App, lock.Env)
+	if lock.Service != "" {
+		cmd = fmt.Sprintf("%s -n %s", cmd, lock.Service)
+	}
+	return color.HighlightCode(cmd)
+}
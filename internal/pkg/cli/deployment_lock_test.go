@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDeploymentLock(t *testing.T) {
+	mockLock := &config.DeploymentLock{
+		App:       "phonetool",
+		Env:       "test",
+		Service:   "api",
+		Operation: svcDeployLockOperation,
+	}
+	lockedErr := &config.ErrDeploymentLocked{
+		Lock: &config.DeploymentLock{
+			App:       "phonetool",
+			Env:       "test",
+			Service:   "api",
+			Operation: svcDeleteLockOperation,
+			Holder:    "arn:aws:iam::1234:user/alice",
+		},
+	}
+	testCases := map[string]struct {
+		inWait     time.Duration
+		inFnErr    error
+		setupMocks func(m *mocks.MockdeploymentLocker)
+
+		wantedCalled bool
+		wantedErr    error
+	}{
+		"runs the function while holding the lock": {
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				gomock.InOrder(
+					m.EXPECT().AcquireDeploymentLock(mockLock).Return(nil),
+					m.EXPECT().ReleaseDeploymentLock(mockLock).Return(nil),
+				)
+			},
+			wantedCalled: true,
+		},
+		"releases the lock if the function fails": {
+			inFnErr: errors.New("some error"),
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				gomock.InOrder(
+					m.EXPECT().AcquireDeploymentLock(mockLock).Return(nil),
+					m.EXPECT().ReleaseDeploymentLock(mockLock).Return(nil),
+				)
+			},
+			wantedCalled: true,
+			wantedErr:    errors.New("some error"),
+		},
+		"does not fail if the lock can't be released": {
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				m.EXPECT().AcquireDeploymentLock(mockLock).Return(nil)
+				m.EXPECT().ReleaseDeploymentLock(mockLock).Return(errors.New("some error"))
+			},
+			wantedCalled: true,
+		},
+		"does not wait for a lock held by someone else by default": {
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				m.EXPECT().AcquireDeploymentLock(mockLock).Return(lockedErr).Times(1)
+				m.EXPECT().ReleaseDeploymentLock(gomock.Any()).Times(0)
+			},
+			wantedErr: lockedErr,
+		},
+		"waits for the lock to be released": {
+			inWait: time.Hour,
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				gomock.InOrder(
+					m.EXPECT().AcquireDeploymentLock(mockLock).Return(lockedErr).Times(2),
+					m.EXPECT().AcquireDeploymentLock(mockLock).Return(nil),
+					m.EXPECT().ReleaseDeploymentLock(mockLock).Return(nil),
+				)
+			},
+			wantedCalled: true,
+		},
+		"does not wait for the lock if it can't be acquired for another reason": {
+			inWait: time.Hour,
+			setupMocks: func(m *mocks.MockdeploymentLocker) {
+				m.EXPECT().AcquireDeploymentLock(mockLock).Return(errors.New("some error")).Times(1)
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentLocker(ctrl)
+			tc.setupMocks(m)
+			defer func(interval time.Duration) {
+				deploymentLockPollInterval = interval
+			}(deploymentLockPollInterval)
+			deploymentLockPollInterval = 0
+			called := false
+
+			// WHEN
+			err := withDeploymentLock(m, mockLock, tc.inWait, func() error {
+				called = true
+				return tc.inFnErr
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedCalled, called)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWithDeploymentLock_RenewsLock(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLock := &config.DeploymentLock{
+		App:       "phonetool",
+		Env:       "test",
+		Service:   "api",
+		Operation: svcDeployLockOperation,
+	}
+	renewed := make(chan struct{})
+	var once sync.Once
+	m := mocks.NewMockdeploymentLocker(ctrl)
+	gomock.InOrder(
+		m.EXPECT().AcquireDeploymentLock(mockLock).Return(nil),
+		m.EXPECT().AcquireDeploymentLock(mockLock).Do(func(_ *config.DeploymentLock) {
+			once.Do(func() { close(renewed) })
+		}).Return(nil).MinTimes(1),
+		m.EXPECT().ReleaseDeploymentLock(mockLock).Return(nil),
+	)
+	defer func(interval time.Duration) {
+		deploymentLockRenewInterval = interval
+	}(deploymentLockRenewInterval)
+	deploymentLockRenewInterval = time.Millisecond
+
+	// WHEN
+	err := withDeploymentLock(m, mockLock, 0, func() error {
+		select {
+		case <-renewed:
+			return nil
+		case <-time.After(5 * time.Second):
+			return errors.New("the lock was not renewed")
+		}
+	})
+
+	// THEN
+	require.NoError(t, err)
+}
@@ -28,6 +28,8 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(BuildEnvListCmd())
 	cmd.AddCommand(BuildEnvDeleteCmd())
 	cmd.AddCommand(BuildEnvShowCmd())
+	cmd.AddCommand(BuildEnvDriftCmd())
+	cmd.AddCommand(BuildEnvUpdateCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -50,12 +52,18 @@ type deleteEnvVars struct {
 	EnvName          string
 	EnvProfile       string
 	SkipConfirmation bool
+
+	SkipProdConfirmation bool
+	WaitForLock          time.Duration
 }
 
 type deleteEnvOpts struct {
 	deleteEnvVars
 	// Interfaces for dependencies.
 	store         environmentStore
+	svcLister     serviceLister
+	deployments   deploymentDeleter
+	locker        deploymentLocker
 	rgClient      resourceGetter
 	deployClient  environmentDeployer
 	profileConfig profileNames
@@ -67,7 +75,7 @@ type deleteEnvOpts struct {
 }
 
 func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to copilot config store: %w", err)
 	}
@@ -79,6 +87,9 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 	return &deleteEnvOpts{
 		deleteEnvVars: vars,
 		store:         store,
+		svcLister:     store,
+		deployments:   store,
+		locker:        store,
 		profileConfig: cfg,
 		prog:          termprogress.NewSpinner(),
 		sel:           selector.NewConfigSelect(vars.prompt, store),
@@ -113,18 +124,16 @@ func (o *deleteEnvOpts) Ask() error {
 		return err
 	}
 
-	if o.SkipConfirmation {
-		return nil
-	}
-	deleteConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, o.EnvName, o.AppName()), "")
-	if err != nil {
-		return fmt.Errorf("confirm to delete environment %s: %w", o.EnvName, err)
-	}
-	if !deleteConfirmed {
-		return errEnvDeleteCancelled
+	if !o.SkipConfirmation {
+		deleteConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, o.EnvName, o.AppName()), "")
+		if err != nil {
+			return fmt.Errorf("confirm to delete environment %s: %w", o.EnvName, err)
+		}
+		if !deleteConfirmed {
+			return errEnvDeleteCancelled
+		}
 	}
-
-	return nil
+	return o.confirmProd()
 }
 
 // Execute deletes the environment from the application by first deleting the stack and then removing the entry from the store.
@@ -135,11 +144,20 @@ func (o *deleteEnvOpts) Execute() error {
 	if err := o.initProfileClients(o); err != nil {
 		return err
 	}
-	if err := o.validateNoRunningServices(); err != nil {
+	var isStackDeleted bool
+	if err := withDeploymentLock(o.locker, &config.DeploymentLock{
+		App:       o.AppName(),
+		Env:       o.EnvName,
+		Operation: envDeleteLockOperation,
+	}, o.WaitForLock, func() error {
+		if err := o.validateNoRunningServices(); err != nil {
+			return err
+		}
+		isStackDeleted = o.deleteStack()
+		return nil
+	}); err != nil {
 		return err
 	}
-
-	isStackDeleted := o.deleteStack()
 	if isStackDeleted { // TODO Add a --force flag that attempts to remove from SSM regardless.
 		// Only remove from SSM if the stack and roles were deleted. Otherwise, the command will error when re-run.
 		o.deleteFromStore()
@@ -147,6 +165,17 @@ func (o *deleteEnvOpts) Execute() error {
 	return nil
 }
 
+// auditedTarget returns the environment deleted by the command.
+func (o *deleteEnvOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.EnvName,
+		stacks: []auditStack{
+			{name: stack.NameForEnv(o.AppName(), o.EnvName), env: o.EnvName},
+		},
+	}
+}
+
 // RecommendedActions is a no-op for this command.
 func (o *deleteEnvOpts) RecommendedActions() []string {
 	return nil
@@ -159,6 +188,22 @@ func (o *deleteEnvOpts) validateEnvName() error {
 	return nil
 }
 
+// confirmProd asks for a second confirmation if the environment is a production environment, even if --yes is set.
+func (o *deleteEnvOpts) confirmProd() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.EnvName, err)
+	}
+	confirmed, err := confirmProd(o.prompt, o.SkipProdConfirmation, "delete all resources", []*config.Environment{env})
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errEnvDeleteCancelled
+	}
+	return nil
+}
+
 func (o *deleteEnvOpts) validateNoRunningServices() error {
 	stacks, err := o.rgClient.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
 		ResourceTypeFilters: []*string{aws.String("cloudformation")},
@@ -245,11 +290,26 @@ func (o *deleteEnvOpts) deleteStack() bool {
 }
 
 func (o *deleteEnvOpts) deleteFromStore() {
+	o.deleteDeployments()
 	if err := o.store.DeleteEnvironment(o.AppName(), o.EnvName); err != nil {
 		log.Infof("Failed to remove environment %s from application %s store: %v\n", o.EnvName, o.AppName(), err)
 	}
 }
 
+// deleteDeployments removes the deployment history of every service in the environment.
+func (o *deleteEnvOpts) deleteDeployments() {
+	svcs, err := o.svcLister.ListServices(o.AppName())
+	if err != nil {
+		log.Infof("Failed to remove the deployment history of environment %s: %v\n", o.EnvName, err)
+		return
+	}
+	for _, svc := range svcs {
+		if err := o.deployments.DeleteDeployments(o.AppName(), o.EnvName, svc.Name); err != nil {
+			log.Infof("Failed to remove the deployment history of service %s in environment %s: %v\n", svc.Name, o.EnvName, err)
+		}
+	}
+}
+
 // BuildEnvDeleteCmd builds the command to delete environment(s).
 func BuildEnvDeleteCmd() *cobra.Command {
 	vars := deleteEnvVars{
@@ -263,7 +323,10 @@ func BuildEnvDeleteCmd() *cobra.Command {
   /code $ copilot env delete --name test --profile default
 
   Delete the "test" environment without prompting.
-  /code $ copilot env delete --name test --profile default --yes`,
+  /code $ copilot env delete --name test --profile default --yes
+
+  Delete the production "prod" environment without prompting.
+  /code $ copilot env delete --name prod --profile default --yes --yes-i-mean-prod`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteEnvOpts(vars)
 			if err != nil {
@@ -275,11 +338,13 @@ func BuildEnvDeleteCmd() *cobra.Command {
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			return opts.Execute()
+			return executeAudited(cmd, args, opts, opts.Execute)
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipProdConfirmation, yesIMeanProdFlag, false, yesIMeanProdFlagDescription)
+	cmd.Flags().DurationVar(&vars.WaitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
 	return cmd
 }
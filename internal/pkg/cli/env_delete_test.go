@@ -90,9 +90,10 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 		testProfile2 = "default2"
 	)
 	testCases := map[string]struct {
-		inEnvName          string
-		inEnvProfile       string
-		inSkipConfirmation bool
+		inEnvName              string
+		inEnvProfile           string
+		inSkipConfirmation     bool
+		inSkipProdConfirmation bool
 
 		mockDependencies func(ctrl *gomock.Controller, o *deleteEnvOpts)
 
@@ -114,8 +115,12 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 					envDeleteProfileHelpPrompt, []string{testProfile1, testProfile2}).Return(testProfile1, nil)
 				mockPrompter.EXPECT().Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, testEnv, testApp), gomock.Any()).Return(true, nil)
 
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+
 				o.sel = mockSelector
 				o.profileConfig = mockCfg
+				o.store = mockStore
 				o.GlobalOpts.prompt = mockPrompter
 			},
 			wantedEnvName:    testEnv,
@@ -132,13 +137,62 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 
 				mockPrompter := mocks.NewMockprompter(ctrl)
 
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+
 				o.sel = mockSelector
 				o.profileConfig = mockCfg
+				o.store = mockStore
 				o.GlobalOpts.prompt = mockPrompter
 			},
 			wantedEnvName:    testEnv,
 			wantedEnvProfile: testProfile1,
 		},
+		"asks to confirm deleting a production environment even if --yes is set": {
+			inSkipConfirmation: true,
+			inEnvName:          testEnv,
+			inEnvProfile:       testProfile1,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				mockPrompter := mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Confirm(fmt.Sprintf(fmtProdConfirmPrompt, "delete all resources", color.HighlightUserInput(testEnv)), prodConfirmHelp).Return(false, nil)
+
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv, Prod: true}, nil)
+
+				o.store = mockStore
+				o.GlobalOpts.prompt = mockPrompter
+			},
+
+			wantedError: errEnvDeleteCancelled,
+		},
+		"skips confirming deleting a production environment with --yes-i-mean-prod": {
+			inSkipConfirmation:     true,
+			inSkipProdConfirmation: true,
+			inEnvName:              testEnv,
+			inEnvProfile:           testProfile1,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv, Prod: true}, nil)
+
+				o.store = mockStore
+				o.GlobalOpts.prompt = mocks.NewMockprompter(ctrl)
+			},
+			wantedEnvName:    testEnv,
+			wantedEnvProfile: testProfile1,
+		},
+		"wraps error from getting the environment": {
+			inSkipConfirmation: true,
+			inEnvName:          testEnv,
+			inEnvProfile:       testProfile1,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+
+				o.store = mockStore
+			},
+
+			wantedError: errors.New("get environment test: some error"),
+		},
 		"wraps error from prompting for confirmation": {
 			inSkipConfirmation: false,
 			inEnvName:          testEnv,
@@ -195,7 +249,8 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 					GlobalOpts: &GlobalOpts{
 						appName: testApp,
 					},
-					SkipConfirmation: tc.inSkipConfirmation,
+					SkipConfirmation:     tc.inSkipConfirmation,
+					SkipProdConfirmation: tc.inSkipProdConfirmation,
 				},
 			}
 			tc.mockDependencies(ctrl, opts)
@@ -228,6 +283,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 		mockDeploy func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer
 		mockStore  func(ctrl *gomock.Controller) *mocks.MockenvironmentStore
 
+		// Services whose deployment history is deleted along with the environment.
+		inSvcs []*config.Service
+
 		wantedError error
 	}{
 		"failed to get resources with tags": {
@@ -324,6 +382,7 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				store.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
 				return store
 			},
+			inSvcs: []*config.Service{{Name: "frontend"}, {Name: "backend"}},
 		},
 	}
 
@@ -332,6 +391,21 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			locker := mocks.NewMockdeploymentLocker(ctrl)
+			locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{
+				App:       testApp,
+				Env:       testEnv,
+				Operation: envDeleteLockOperation,
+			}).Return(nil)
+			locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil)
+			svcLister := mocks.NewMockserviceLister(ctrl)
+			deployments := mocks.NewMockdeploymentDeleter(ctrl)
+			if tc.inSvcs != nil {
+				svcLister.EXPECT().ListServices(testApp).Return(tc.inSvcs, nil)
+				for _, svc := range tc.inSvcs {
+					deployments.EXPECT().DeleteDeployments(testApp, testEnv, svc.Name).Return(nil)
+				}
+			}
 			opts := deleteEnvOpts{
 				deleteEnvVars: deleteEnvVars{
 					EnvName: testEnv,
@@ -340,6 +414,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 					},
 				},
 				store:        tc.mockStore(ctrl),
+				svcLister:    svcLister,
+				deployments:  deployments,
+				locker:       locker,
 				deployClient: tc.mockDeploy(ctrl),
 				rgClient:     tc.mockRG(ctrl),
 				prog:         tc.mockProg(ctrl),
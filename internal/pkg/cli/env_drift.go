@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftAppNamePrompt     = "Which application is the environment in?"
+	envDriftAppNameHelpPrompt = "An application is a collection of related services."
+	envDriftNamePrompt        = "Which environment of %s would you like to detect drift for?"
+	envDriftNameHelpPrompt    = "Drift is detected on the stack of the environment's shared resources (e.g., VPC, cluster, load balancer)."
+
+	fmtEnvDriftStart    = "Detecting drift of environment %s."
+	fmtEnvDriftFailed   = "Failed to detect drift of environment %s."
+	fmtEnvDriftComplete = "Detected drift of environment %s."
+)
+
+type envDriftVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	envName          string
+}
+
+type envDriftOpts struct {
+	envDriftVars
+
+	w                io.Writer
+	store            store
+	sel              configSelector
+	spinner          progress
+	newDriftDetector func(env *config.Environment) (driftDetector, error)
+}
+
+func newEnvDriftOpts(vars envDriftVars) (*envDriftOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &envDriftOpts{
+		envDriftVars:     vars,
+		w:                log.OutputWriter,
+		store:            store,
+		sel:              selector.NewConfigSelect(vars.prompt, store),
+		spinner:          termprogress.NewSpinner(),
+		newDriftDetector: newDriftDetector,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *envDriftOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *envDriftOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.AppName())), envDriftNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment for application %s: %w", o.AppName(), err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute detects drift on the stack of the environment and its nested stacks, and writes the resources that drifted.
+func (o *envDriftOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf(fmtEnvDriftStart, color.HighlightUserInput(o.envName)))
+	drifts, err := detectStackDrifts(detector, stack.NameForEnv(o.AppName(), o.envName))
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtEnvDriftFailed, color.HighlightUserInput(o.envName)))
+		return fmt.Errorf("detect drift of environment %s: %w", o.envName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtEnvDriftComplete, color.HighlightUserInput(o.envName)))
+	return writeStackDrifts(o.w, drifts, o.shouldOutputJSON)
+}
+
+// BuildEnvDriftCmd builds the command for detecting drift on the stack of an environment.
+func BuildEnvDriftCmd() *cobra.Command {
+	vars := envDriftVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed environment outside of Copilot.",
+		Long: `Detects changes made to a deployed environment outside of Copilot.
+Runs CloudFormation drift detection on the stack of the environment,
+and shows the expected and actual values of each property that drifted.`,
+
+		Example: `
+  Shows the resources of the "prod" environment that drifted.
+  /code $ copilot env drift -n prod
+  Outputs the drift of the environment in JSON, for example from a scheduled job.
+  /code $ copilot env drift -n prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
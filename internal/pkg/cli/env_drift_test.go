@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDriftOpts_Execute(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockdriftDetector)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps error if drift can't be detected": {
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test").Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("detect drift of environment test: %w", mockErr),
+		},
+		"writes the status of the environment stack": {
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test").Return(&cloudformation.StackDrift{
+					Status: "DRIFTED",
+					Resources: []cloudformation.ResourceDrift{
+						{
+							LogicalID:  "PublicSubnet1",
+							PhysicalID: "subnet-1234",
+							Type:       "AWS::EC2::Subnet",
+							Status:     "DELETED",
+						},
+					},
+				}, nil)
+				m.EXPECT().NestedStacks("phonetool-test").Return(nil, nil)
+			},
+			wantedContent: `Stack phonetool-test: DRIFTED
+  Resource          Type                Status              Property            Expected            Actual
+  PublicSubnet1     AWS::EC2::Subnet    DELETED             -                   -                   -
+
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+				Name:   "test",
+				Region: "us-west-2",
+			}, nil)
+			mockDetector := mocks.NewMockdriftDetector(ctrl)
+			tc.setupMocks(mockDetector)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any())
+			mockSpinner.EXPECT().Stop(gomock.Any())
+			b := &bytes.Buffer{}
+			opts := &envDriftOpts{
+				envDriftVars: envDriftVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					envName: "test",
+				},
+				w:       b,
+				store:   mockStore,
+				spinner: mockSpinner,
+				newDriftDetector: func(env *config.Environment) (driftDetector, error) {
+					return mockDetector, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -7,17 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/spf13/cobra"
 )
 
@@ -27,8 +33,18 @@ const (
 
 	fmtEnvInitProfilePrompt  = "Which named profile should we use to create %s?"
 	envInitProfileHelpPrompt = "The AWS CLI named profile with the permissions to create an environment."
+
+	envInitImportVPCPrompt     = "Would you like to import an existing VPC?"
+	envInitImportVPCHelpPrompt = `Deploys the environment in a VPC of the environment account instead of creating a new one.
+The VPC needs at least two public subnets for the load balancer and one private subnet.`
+	envInitVPCSelectPrompt            = "Which VPC would you like to import?"
+	envInitPublicSubnetsSelectPrompt  = "Which public subnets would you like to import?"
+	envInitPublicSubnetsHelpPrompt    = "Subnets with a route to an internet gateway. The public load balancer needs at least two of them in different availability zones."
+	envInitPrivateSubnetsSelectPrompt = "Which private subnets would you like to import?"
 )
 
+const minImportedPublicSubnets = 2 // An application load balancer requires subnets in at least two availability zones.
+
 const (
 	fmtDeployEnvStart        = "Proposing infrastructure changes for the %s environment."
 	fmtDeployEnvComplete     = "Environment %s already exists in application %s.\n"
@@ -42,6 +58,11 @@ const (
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n"
+	fmtPreviewEnvFailed      = "Failed to propose infrastructure changes for the %s environment.\n"
+	fmtPreviewEnvComplete    = "Proposed infrastructure changes for the %s environment.\n"
+	fmtUpdateEnvStart        = "Updating the infrastructure for the %s environment."
+	fmtUpdateEnvFailed       = "Failed to update the infrastructure for the %s environment.\n"
+	fmtUpdateEnvComplete     = "Updated the infrastructure for the %s environment.\n"
 )
 
 var (
@@ -53,6 +74,29 @@ type initEnvVars struct {
 	EnvName      string // Name of the environment.
 	EnvProfile   string // AWS profile used to create an environment.
 	IsProduction bool   // Marks the environment as "production" to create it with additional guardrails.
+	PipelineOnly bool   // Only allows deployments to the production environment from a pipeline.
+	metadataVars        // Optional ownership and routing information.
+	changeSetReviewVars
+	WaitForLock time.Duration // How long to wait for another operation to release the deployment lock of the environment.
+	ImportVPC   importVPCVars // Optional existing VPC and subnets to deploy the environment in.
+
+	// Set by app import to recreate an exported environment as it was deployed.
+	region               string // Region to create the environment in instead of the region of the profile.
+	noPublicLoadBalancer bool   // Deploys the environment without a public load balancer.
+}
+
+type importVPCVars struct {
+	ID               string
+	PublicSubnetIDs  []string
+	PrivateSubnetIDs []string
+}
+
+func (v importVPCVars) isSet() bool {
+	return v.ID != "" || len(v.PublicSubnetIDs) != 0 || len(v.PrivateSubnetIDs) != 0
+}
+
+func (v importVPCVars) isComplete() bool {
+	return v.ID != "" && len(v.PublicSubnetIDs) != 0 && len(v.PrivateSubnetIDs) != 0
 }
 
 type initEnvOpts struct {
@@ -60,11 +104,15 @@ type initEnvOpts struct {
 
 	// Interfaces to interact with dependencies.
 	store         store
+	locker        deploymentLocker
 	envDeployer   deployer
+	envPreviewer  envPreviewer
+	envRecoverer  stackRecoverer
 	appDeployer   deployer
 	identity      identityService
 	envIdentity   identityService
 	profileConfig profileNames
+	selVPC        ec2Selector
 	prog          progress
 
 	// initialize profile-specific env clients
@@ -76,13 +124,20 @@ var initEnvProfileClients = func(o *initEnvOpts) error {
 	if err != nil {
 		return fmt.Errorf("create session from profile %s: %w", o.EnvProfile, err)
 	}
+	if o.region != "" {
+		profileSess = profileSess.Copy(&aws.Config{Region: aws.String(o.region)})
+	}
 	o.envIdentity = identity.New(profileSess)
-	o.envDeployer = deploycfn.New(profileSess)
+	envDeployer := deploycfn.New(profileSess)
+	o.envDeployer = envDeployer
+	o.envPreviewer = envDeployer
+	o.envRecoverer = envDeployer
+	o.selVPC = selector.NewEC2Select(o.prompt, ec2.New(profileSess))
 	return nil
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +154,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 	return &initEnvOpts{
 		initEnvVars:        vars,
 		store:              store,
+		locker:             store,
 		appDeployer:        deploycfn.New(defaultSession),
 		identity:           identity.New(defaultSession),
 		profileConfig:      cfg,
@@ -117,15 +173,39 @@ func (o *initEnvOpts) Validate() error {
 	if o.AppName() == "" {
 		return fmt.Errorf("no application found: run %s or %s into your workspace please", color.HighlightCode("app init"), color.HighlightCode("cd"))
 	}
+	if o.PipelineOnly && !o.IsProduction {
+		return fmt.Errorf("--%s can only be specified with --%s", pipelineOnlyFlag, prodEnvFlag)
+	}
+	return o.validateImportVPC()
+}
+
+func (o *initEnvOpts) validateImportVPC() error {
+	if o.ImportVPC.ID == "" {
+		if len(o.ImportVPC.PublicSubnetIDs) != 0 {
+			return fmt.Errorf("--%s can only be specified with --%s", importPublicSubnetsFlag, importVPCIDFlag)
+		}
+		if len(o.ImportVPC.PrivateSubnetIDs) != 0 {
+			return fmt.Errorf("--%s can only be specified with --%s", importPrivateSubnetsFlag, importVPCIDFlag)
+		}
+		return nil
+	}
+	if n := len(o.ImportVPC.PublicSubnetIDs); n != 0 && n < minImportedPublicSubnets {
+		return fmt.Errorf("--%s requires at least %d subnets", importPublicSubnetsFlag, minImportedPublicSubnets)
+	}
 	return nil
 }
 
 // Ask asks for fields that are required but not passed in.
 func (o *initEnvOpts) Ask() error {
+	// Only offer to import a VPC if we're already prompting, so that runs passing all the flags aren't blocked.
+	offerImportVPC := o.EnvName == "" || o.EnvProfile == ""
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
-	return o.askEnvProfile()
+	if err := o.askEnvProfile(); err != nil {
+		return err
+	}
+	return o.askImportVPC(offerImportVPC)
 }
 
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
@@ -135,6 +215,9 @@ func (o *initEnvOpts) Execute() error {
 		// Ensure the app actually exists before we do a deployment.
 		return err
 	}
+	if err := o.loadImportedVPC(); err != nil {
+		return err
+	}
 
 	if err = o.initProfileClients(o); err != nil {
 		return err
@@ -147,8 +230,15 @@ func (o *initEnvOpts) Execute() error {
 	}
 
 	// 1. Start creating the CloudFormation stack for the environment.
-	if err := o.deployEnv(app); err != nil {
+	var deployed bool
+	if err := o.withDeploymentLock(func() error {
+		deployed, err = o.deploy(app)
 		return err
+	}); err != nil {
+		return err
+	}
+	if !deployed {
+		return nil
 	}
 
 	// 2. Get the environment
@@ -157,6 +247,9 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.EnvName, err)
 	}
 	env.Prod = o.IsProduction
+	env.PipelineOnly = o.PipelineOnly
+	env.Metadata = o.metadata()
+	env.ImportVPC = o.storedImportVPC()
 
 	// 3. Add the stack set instance to the app stackset.
 	if err := o.addToStackset(app, env); err != nil {
@@ -165,26 +258,116 @@ func (o *initEnvOpts) Execute() error {
 
 	// 4. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
-		return fmt.Errorf("store environment: %w", err)
+		var errExists *config.ErrEnvironmentAlreadyExists
+		if !errors.As(err, &errExists) {
+			return fmt.Errorf("store environment: %w", err)
+		}
+		// Re-running env init on an existing environment only updates its stack, the stored configuration is kept.
 	}
 	log.Successf("Created environment %s in region %s under application %s.\n",
 		color.HighlightUserInput(env.Name), color.Emphasize(env.Region), color.HighlightUserInput(env.App))
 	return nil
 }
 
-func (o *initEnvOpts) deployEnv(app *config.Application) error {
+func (o *initEnvOpts) deployEnvInput(app *config.Application) (*deploy.CreateEnvironmentInput, error) {
 	caller, err := o.identity.Get()
 	if err != nil {
-		return fmt.Errorf("get identity: %w", err)
+		return nil, fmt.Errorf("get identity: %w", err)
 	}
-	deployEnvInput := &deploy.CreateEnvironmentInput{
+	return &deploy.CreateEnvironmentInput{
 		Name:                     o.EnvName,
 		AppName:                  o.AppName(),
 		Prod:                     o.IsProduction,
-		PublicLoadBalancer:       true, // TODO: configure this based on user input or service Type needs?
+		PublicLoadBalancer:       !o.noPublicLoadBalancer, // TODO: configure this based on user input or service Type needs?
 		ToolsAccountPrincipalARN: caller.RootUserARN,
 		AppDNSName:               app.Domain,
 		AdditionalTags:           app.Tags,
+		ImportVPCConfig:          o.importVPCConfig(),
+	}, nil
+}
+
+// importVPCConfig returns the existing VPC to deploy the environment in, or nil to create a new VPC.
+func (o *initEnvOpts) importVPCConfig() *deploy.ImportVPCConfig {
+	if o.ImportVPC.ID == "" {
+		return nil
+	}
+	return &deploy.ImportVPCConfig{
+		ID:               o.ImportVPC.ID,
+		PublicSubnetIDs:  o.ImportVPC.PublicSubnetIDs,
+		PrivateSubnetIDs: o.ImportVPC.PrivateSubnetIDs,
+	}
+}
+
+// storedImportVPC returns the existing VPC the environment is deployed in as it's stored with the environment,
+// or nil if the environment creates its own VPC.
+func (o *initEnvOpts) storedImportVPC() *config.VPC {
+	if o.ImportVPC.ID == "" {
+		return nil
+	}
+	return &config.VPC{
+		ID:               o.ImportVPC.ID,
+		PublicSubnetIDs:  o.ImportVPC.PublicSubnetIDs,
+		PrivateSubnetIDs: o.ImportVPC.PrivateSubnetIDs,
+	}
+}
+
+// loadImportedVPC reuses the VPC imported when the environment was first created, so that re-running env init
+// doesn't replace the VPC, subnets and the resources in them. The environment can't be moved to another VPC.
+func (o *initEnvOpts) loadImportedVPC() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.EnvName)
+	if err != nil {
+		var errNoSuchEnv *config.ErrNoSuchEnvironment
+		if errors.As(err, &errNoSuchEnv) {
+			return nil
+		}
+		return fmt.Errorf("get environment %s: %w", o.EnvName, err)
+	}
+	if env.ImportVPC == nil {
+		return nil
+	}
+	if !o.ImportVPC.isSet() {
+		o.ImportVPC = importVPCVars{
+			ID:               env.ImportVPC.ID,
+			PublicSubnetIDs:  env.ImportVPC.PublicSubnetIDs,
+			PrivateSubnetIDs: env.ImportVPC.PrivateSubnetIDs,
+		}
+		return nil
+	}
+	if o.ImportVPC.ID != env.ImportVPC.ID {
+		return fmt.Errorf("environment %s is deployed in VPC %s and can't be moved to VPC %s", o.EnvName, env.ImportVPC.ID, o.ImportVPC.ID)
+	}
+	return nil
+}
+
+// deploy creates the stack of the environment, after previewing its changes if needed.
+// It returns false if the changes weren't confirmed.
+func (o *initEnvOpts) deploy(app *config.Application) (bool, error) {
+	if o.shouldReview() {
+		return o.reviewAndDeployEnv(app)
+	}
+	if err := o.deployEnv(app); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// withDeploymentLock runs fn while holding the deployment lock of the environment.
+// Dry runs don't deploy anything, so they don't need the lock.
+func (o *initEnvOpts) withDeploymentLock(fn func() error) error {
+	if o.dryRun {
+		return fn()
+	}
+	return withDeploymentLock(o.locker, &config.DeploymentLock{
+		App:       o.AppName(),
+		Env:       o.EnvName,
+		Operation: envInitLockOperation,
+	}, o.WaitForLock, fn)
+}
+
+func (o *initEnvOpts) deployEnv(app *config.Application) error {
+	deployEnvInput, err := o.deployEnvInput(app)
+	if err != nil {
+		return err
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtDeployEnvStart, color.HighlightUserInput(o.EnvName)))
@@ -197,10 +380,68 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 			return nil
 		}
 		o.prog.Stop(log.Serrorf(fmtDeployEnvFailed, color.HighlightUserInput(o.EnvName)))
-		return err
+		recovered, err := recoverStack(stackRecovery{
+			prompt:    o.prompt,
+			recoverer: o.envRecoverer,
+			spinner:   o.prog,
+		}, err)
+		if !recovered {
+			return err
+		}
+		// The stack left by a previous deployment is deleted, deploy the environment again.
+		return o.deployEnv(app)
+	}
+
+	return o.streamEnvCreation(deployEnvInput)
+}
+
+// reviewAndDeployEnv shows the infrastructure changes to create or update the environment before deploying them.
+// It returns false if the environment shouldn't be added to the application, because it's a dry run or the changes were declined.
+func (o *initEnvOpts) reviewAndDeployEnv(app *config.Application) (bool, error) {
+	deployEnvInput, err := o.deployEnvInput(app)
+	if err != nil {
+		return false, err
 	}
 
-	// Display updates while the deployment is happening.
+	envName := color.HighlightUserInput(o.EnvName)
+	o.prog.Start(fmt.Sprintf(fmtDeployEnvStart, envName))
+	cs, err := o.envPreviewer.PreviewEnvironment(deployEnvInput)
+	if err != nil {
+		var errEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmpty) {
+			// Nothing to update if the stack already exists with the same configuration.
+			o.prog.Stop(log.Ssuccessf(fmtDeployEnvComplete, envName, color.HighlightUserInput(o.AppName())))
+			return !o.dryRun, nil
+		}
+		o.prog.Stop(log.Serrorf(fmtPreviewEnvFailed, envName))
+		return false, fmt.Errorf("preview changes to environment %s: %w", o.EnvName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtPreviewEnvComplete, envName))
+
+	confirmed, err := o.review(o.prompt, o.envPreviewer, cs)
+	if err != nil || !confirmed {
+		return false, err
+	}
+	if cs.IsCreate {
+		if err := o.envPreviewer.ExecuteChangeSet(cs); err != nil {
+			return false, fmt.Errorf("create environment %s: %w", o.EnvName, err)
+		}
+		if err := o.streamEnvCreation(deployEnvInput); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	o.prog.Start(fmt.Sprintf(fmtUpdateEnvStart, envName))
+	if err := o.envPreviewer.ExecuteChangeSetAndWait(cs); err != nil {
+		o.prog.Stop(log.Serrorf(fmtUpdateEnvFailed, envName))
+		return false, fmt.Errorf("update environment %s: %w", o.EnvName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtUpdateEnvComplete, envName))
+	return true, nil
+}
+
+// streamEnvCreation displays updates while the creation of the environment stack is happening.
+func (o *initEnvOpts) streamEnvCreation(deployEnvInput *deploy.CreateEnvironmentInput) error {
 	o.prog.Start(fmt.Sprintf(fmtStreamEnvStart, color.HighlightUserInput(o.EnvName)))
 	stackEvents, responses := o.envDeployer.StreamEnvironmentCreation(deployEnvInput)
 	for stackEvent := range stackEvents {
@@ -212,7 +453,6 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 		return resp.Err
 	}
 	o.prog.Stop(log.Ssuccessf(fmtStreamEnvComplete, color.HighlightUserInput(o.EnvName)))
-
 	return nil
 }
 
@@ -282,6 +522,58 @@ func (o *initEnvOpts) askEnvProfile() error {
 	return nil
 }
 
+// askImportVPC selects the VPC and subnets of the environment account that weren't passed by flags.
+// If no import flag is set, the user is asked whether to import a VPC only if offer is true.
+func (o *initEnvOpts) askImportVPC(offer bool) error {
+	if !o.ImportVPC.isSet() {
+		if !offer {
+			return nil
+		}
+		importVPC, err := o.prompt.Confirm(envInitImportVPCPrompt, envInitImportVPCHelpPrompt, prompt.WithFinalMessage("Import VPC:"))
+		if err != nil {
+			return fmt.Errorf("confirm importing a VPC: %w", err)
+		}
+		if !importVPC {
+			return nil
+		}
+	}
+	if o.ImportVPC.isComplete() {
+		return nil
+	}
+
+	if err := o.initProfileClients(o); err != nil {
+		return err
+	}
+	if o.ImportVPC.ID == "" {
+		vpcID, err := o.selVPC.VPC(envInitVPCSelectPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select VPC: %w", err)
+		}
+		o.ImportVPC.ID = vpcID
+	}
+	if len(o.ImportVPC.PublicSubnetIDs) == 0 {
+		subnets, err := o.selVPC.Subnets(envInitPublicSubnetsSelectPrompt, envInitPublicSubnetsHelpPrompt, o.ImportVPC.ID)
+		if err != nil {
+			return fmt.Errorf("select public subnets: %w", err)
+		}
+		if len(subnets) < minImportedPublicSubnets {
+			return fmt.Errorf("select at least %d public subnets", minImportedPublicSubnets)
+		}
+		o.ImportVPC.PublicSubnetIDs = subnets
+	}
+	if len(o.ImportVPC.PrivateSubnetIDs) == 0 {
+		subnets, err := o.selVPC.Subnets(envInitPrivateSubnetsSelectPrompt, "", o.ImportVPC.ID)
+		if err != nil {
+			return fmt.Errorf("select private subnets: %w", err)
+		}
+		if len(subnets) == 0 {
+			return errors.New("select at least 1 private subnet")
+		}
+		o.ImportVPC.PrivateSubnetIDs = subnets
+	}
+	return nil
+}
+
 func (o *initEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.ResourceEvent) []termprogress.TabRow {
 	matcher := map[termprogress.Text]termprogress.ResourceMatcher{
 		textVPC: func(event deploy.Resource) bool {
@@ -319,7 +611,22 @@ func (o *initEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.Resource
 		textECSCluster:      1,
 		textALB:             4,
 	}
-	return termprogress.HumanizeResourceEvents(envProgressOrder, resourceEvents, matcher, resourceCounts)
+	order := envProgressOrder
+	if o.ImportVPC.ID != "" {
+		order = importedVPCEnvProgressOrder
+	}
+	return termprogress.HumanizeResourceEvents(order, resourceEvents, matcher, resourceCounts)
+}
+
+// auditedTarget returns the environment created by the command.
+func (o *initEnvOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.EnvName,
+		stacks: []auditStack{
+			{name: stack.NameForEnv(o.AppName(), o.EnvName), env: o.EnvName},
+		},
+	}
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -341,7 +648,16 @@ func BuildEnvInitCmd() *cobra.Command {
   /code $ copilot env init --name test --profile default
 
   Creates a prod-iad environment using your "prod-admin" AWS profile.
-  /code $ copilot env init --name prod-iad --profile prod-admin --prod`,
+  /code $ copilot env init --name prod-iad --profile prod-admin --prod
+
+  Creates a prod-pdx environment that only accepts deployments from a pipeline.
+  /code $ copilot env init --name prod-pdx --profile prod-admin --prod --pipeline-only
+
+  Shows the infrastructure changes to the existing test environment and asks for confirmation before updating it.
+  /code $ copilot env init --name test --profile default --diff
+
+  Creates a test environment in an existing VPC instead of creating a new one.
+  /code $ copilot env init --name test --profile default --import-vpc-id vpc-099c32d2 --import-public-subnets subnet-013e8b69,subnet-014661eb --import-private-subnets subnet-055fafef`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -353,11 +669,18 @@ func BuildEnvInitCmd() *cobra.Command {
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			return opts.Execute()
+			return executeAudited(cmd, args, opts, opts.Execute)
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
 	cmd.Flags().BoolVar(&vars.IsProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.PipelineOnly, pipelineOnlyFlag, false, pipelineOnlyFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "environment")
+	addChangeSetReviewFlags(cmd, &vars.changeSetReviewVars)
+	cmd.Flags().DurationVar(&vars.WaitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	cmd.Flags().StringVar(&vars.ImportVPC.ID, importVPCIDFlag, "", importVPCIDFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.ImportVPC.PublicSubnetIDs, importPublicSubnetsFlag, nil, importPublicSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.ImportVPC.PrivateSubnetIDs, importPrivateSubnetsFlag, nil, importPrivateSubnetsFlagDescription)
 	return cmd
 }
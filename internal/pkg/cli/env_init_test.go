@@ -22,8 +22,11 @@ import (
 
 func TestInitEnvOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inEnvName string
-		inAppName string
+		inEnvName      string
+		inAppName      string
+		inProd         bool
+		inPipelineOnly bool
+		inImportVPC    importVPCVars
 
 		wantedErr string
 	}{
@@ -43,6 +46,54 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErr: "no application found: run `app init` or `cd` into your workspace please",
 		},
+		"pipeline only production environment": {
+			inEnvName:      "prod-pdx",
+			inAppName:      "phonetool",
+			inProd:         true,
+			inPipelineOnly: true,
+		},
+		"pipeline only without prod": {
+			inEnvName:      "test-pdx",
+			inAppName:      "phonetool",
+			inPipelineOnly: true,
+
+			wantedErr: "--pipeline-only can only be specified with --prod",
+		},
+		"imported VPC": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+		},
+		"imported VPC without subnets": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inImportVPC: importVPCVars{
+				ID: "vpc-1",
+			},
+		},
+		"imported subnets without VPC": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inImportVPC: importVPCVars{
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+
+			wantedErr: "--import-private-subnets can only be specified with --import-vpc-id",
+		},
+		"imported VPC with a single public subnet": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inImportVPC: importVPCVars{
+				ID:              "vpc-1",
+				PublicSubnetIDs: []string{"subnet-1"},
+			},
+
+			wantedErr: "--import-public-subnets requires at least 2 subnets",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -50,8 +101,11 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			// GIVEN
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					EnvName:    tc.inEnvName,
-					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
+					EnvName:      tc.inEnvName,
+					IsProduction: tc.inProd,
+					PipelineOnly: tc.inPipelineOnly,
+					ImportVPC:    tc.inImportVPC,
+					GlobalOpts:   &GlobalOpts{appName: tc.inAppName},
 				},
 			}
 
@@ -77,13 +131,15 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inputEnv     string
 		inputProfile string
 		inputApp     string
+		inImportVPC  importVPCVars
 
-		setupMocks func(*mocks.Mockprompter, *mocks.MockprofileNames)
+		setupMocks func(*mocks.Mockprompter, *mocks.MockprofileNames, *mocks.Mockec2Selector)
 
-		wantedError error
+		wantedError     error
+		wantedImportVPC importVPCVars
 	}{
 		"with no flags set": {
-			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames) {
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
 				mockPrompter.EXPECT().
 					Get(
 						gomock.Eq(envInitNamePrompt),
@@ -97,10 +153,13 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 						gomock.Eq(envInitProfileHelpPrompt),
 						gomock.Any()).
 					Return(mockProfile, nil)
+				mockPrompter.EXPECT().
+					Confirm(envInitImportVPCPrompt, envInitImportVPCHelpPrompt, gomock.Any()).
+					Return(false, nil)
 			},
 		},
 		"with no existing named profiles": {
-			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames) {
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
 				mockPrompter.EXPECT().
 					Get(
 						gomock.Eq(envInitNamePrompt),
@@ -111,6 +170,77 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			},
 			wantedError: errNamedProfilesNotFound,
 		},
+		"does not offer to import a VPC if all the flags are set": {
+			inputEnv:     mockEnv,
+			inputProfile: mockProfile,
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
+				mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"selects the VPC and subnets to import": {
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
+				mockPrompter.EXPECT().Get(envInitNamePrompt, envInitNameHelpPrompt, gomock.Any()).Return(mockEnv, nil)
+				mockCfg.EXPECT().Names().Return([]string{mockProfile})
+				mockPrompter.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProfile, nil)
+				mockPrompter.EXPECT().
+					Confirm(envInitImportVPCPrompt, envInitImportVPCHelpPrompt, gomock.Any()).
+					Return(true, nil)
+				mockSel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("vpc-1", nil)
+				mockSel.EXPECT().
+					Subnets(envInitPublicSubnetsSelectPrompt, envInitPublicSubnetsHelpPrompt, "vpc-1").
+					Return([]string{"subnet-1", "subnet-2"}, nil)
+				mockSel.EXPECT().
+					Subnets(envInitPrivateSubnetsSelectPrompt, "", "vpc-1").
+					Return([]string{"subnet-3"}, nil)
+			},
+			wantedImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+		},
+		"selects the subnets of the VPC passed by flag": {
+			inputEnv:     mockEnv,
+			inputProfile: mockProfile,
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
+				mockSel.EXPECT().VPC(gomock.Any(), gomock.Any()).Times(0)
+				mockSel.EXPECT().
+					Subnets(envInitPublicSubnetsSelectPrompt, envInitPublicSubnetsHelpPrompt, "vpc-1").
+					Return([]string{"subnet-1", "subnet-2"}, nil)
+			},
+			wantedImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+		},
+		"errors if less than two public subnets are selected": {
+			inputEnv:     mockEnv,
+			inputProfile: mockProfile,
+			inImportVPC: importVPCVars{
+				ID: "vpc-1",
+			},
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
+				mockSel.EXPECT().
+					Subnets(envInitPublicSubnetsSelectPrompt, envInitPublicSubnetsHelpPrompt, "vpc-1").
+					Return([]string{"subnet-1"}, nil)
+			},
+			wantedError: errors.New("select at least 2 public subnets"),
+		},
+		"errors if the VPC cannot be selected": {
+			inputEnv: mockEnv,
+			setupMocks: func(mockPrompter *mocks.Mockprompter, mockCfg *mocks.MockprofileNames, mockSel *mocks.Mockec2Selector) {
+				mockCfg.EXPECT().Names().Return([]string{mockProfile})
+				mockPrompter.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProfile, nil)
+				mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				mockSel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select VPC: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -120,19 +250,25 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 			mockPrompter := mocks.NewMockprompter(ctrl)
 			mockCfg := mocks.NewMockprofileNames(ctrl)
+			mockSel := mocks.NewMockec2Selector(ctrl)
 			// GIVEN
 			addEnv := &initEnvOpts{
 				initEnvVars: initEnvVars{
 					EnvName:    tc.inputEnv,
 					EnvProfile: tc.inputProfile,
+					ImportVPC:  tc.inImportVPC,
 					GlobalOpts: &GlobalOpts{
 						prompt:  mockPrompter,
 						appName: tc.inputApp,
 					},
 				},
 				profileConfig: mockCfg,
+				initProfileClients: func(o *initEnvOpts) error {
+					o.selVPC = mockSel
+					return nil
+				},
 			}
-			tc.setupMocks(mockPrompter, mockCfg)
+			tc.setupMocks(mockPrompter, mockCfg, mockSel)
 
 			// WHEN
 			err := addEnv.Ask()
@@ -141,6 +277,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			if tc.wantedError == nil {
 				require.NoError(t, err)
 				require.Equal(t, mockEnv, addEnv.EnvName, "expected environment names to match")
+				require.Equal(t, tc.wantedImportVPC, addEnv.ImportVPC)
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -150,14 +287,16 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inProd    bool
+		inAppName   string
+		inEnvName   string
+		inProd      bool
+		inImportVPC importVPCVars
 
 		expectstore    func(m *mocks.Mockstore)
 		expectDeployer func(m *mocks.Mockdeployer)
 		expectIdentity func(m *mocks.MockidentityService)
 		expectProgress func(m *mocks.Mockprogress)
+		expectLocker   func(m *mocks.MockdeploymentLocker)
 
 		wantedErrorS string
 	}{
@@ -177,18 +316,40 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{}, errors.New("some identity error"))
 			},
 			wantedErrorS: "get identity: some identity error",
 		},
+		"errors if the environment is locked": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
+			},
+			expectLocker: func(m *mocks.MockdeploymentLocker) {
+				m.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{
+					App:       "phonetool",
+					Env:       "test",
+					Operation: envInitLockOperation,
+				}).Return(errors.New("some lock error"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErrorS: "some lock error",
+		},
 		"errors if environment change set cannot be accepted": {
 			inAppName: "phonetool",
 			inEnvName: "test",
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
@@ -208,6 +369,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
@@ -257,6 +419,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().CreateEnvironment(gomock.Any()).Times(0)
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
@@ -294,6 +457,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().CreateEnvironment(gomock.Any()).Times(0)
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
@@ -340,6 +504,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
 					Name: "phonetool",
 				}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -390,6 +555,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -441,6 +607,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -473,12 +640,169 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"keeps the stored configuration when re-running on an existing environment": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{App: "phonetool", Name: "test"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+				}).Return(&config.ErrEnvironmentAlreadyExists{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtDeployEnvComplete, "test", "phonetool"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"deploys the environment in the imported VPC": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					ImportVPC: &config.VPC{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3"},
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.EXPECT().Stop(gomock.Any()).AnyTimes()
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					ImportVPCConfig: &deploy.ImportVPCConfig{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3"},
+					},
+				}).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"redeploys the environment in the VPC imported when it was created": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+					ImportVPC: &config.VPC{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3"},
+					},
+				}, nil)
+				m.EXPECT().CreateEnvironment(gomock.Any()).Return(&config.ErrEnvironmentAlreadyExists{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.EXPECT().Stop(gomock.Any()).AnyTimes()
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					ImportVPCConfig: &deploy.ImportVPCConfig{
+						ID:               "vpc-1",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3"},
+					},
+				}).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"errors if the environment is moved to another VPC": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-2",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					ImportVPC: &config.VPC{ID: "vpc-1"},
+				}, nil)
+			},
+			wantedErrorS: "environment test is deployed in VPC vpc-1 and can't be moved to VPC vpc-2",
+		},
 		"failed to delegate DNS (app has Domain and env and apps are different)": {
 			inAppName: "phonetool",
 			inEnvName: "test",
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(1)
@@ -498,6 +822,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectstore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"})
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -555,9 +880,16 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockDeployer := mocks.NewMockdeployer(ctrl)
 			mockIdentity := mocks.NewMockidentityService(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
+			mockLocker := mocks.NewMockdeploymentLocker(ctrl)
 			if tc.expectstore != nil {
 				tc.expectstore(mockstore)
 			}
+			if tc.expectLocker != nil {
+				tc.expectLocker(mockLocker)
+			} else {
+				mockLocker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(nil).AnyTimes()
+				mockLocker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil).AnyTimes()
+			}
 			if tc.expectDeployer != nil {
 				tc.expectDeployer(mockDeployer)
 			}
@@ -573,8 +905,10 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					EnvName:      tc.inEnvName,
 					GlobalOpts:   &GlobalOpts{appName: tc.inAppName},
 					IsProduction: tc.inProd,
+					ImportVPC:    tc.inImportVPC,
 				},
 				store:       mockstore,
+				locker:      mockLocker,
 				envDeployer: mockDeployer,
 				appDeployer: mockDeployer,
 				identity:    mockIdentity,
@@ -598,6 +932,161 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestInitEnvOpts_reviewAndDeployEnv(t *testing.T) {
+	mockChangeSet := &cloudformation.ChangeSet{
+		Name:      "ecscli-1234",
+		StackName: "phonetool-test",
+	}
+	testCases := map[string]struct {
+		inDryRun       bool
+		expectDeployer func(m *mocks.Mockdeployer)
+		expectPreview  func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter)
+		expectProgress func(m *mocks.Mockprogress)
+
+		wantedDeployed bool
+		wantedErr      error
+	}{
+		"continues with the existing environment if there are no changes": {
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				m.EXPECT().PreviewEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+				}).Return(nil, &cloudformation.ErrChangeSetEmpty{})
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtDeployEnvComplete, "test", "phonetool"))
+			},
+			wantedDeployed: true,
+		},
+		"returns an error if the changes can't be previewed": {
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				m.EXPECT().PreviewEnvironment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtPreviewEnvFailed, "test"))
+			},
+			wantedErr: errors.New("preview changes to environment test: some error"),
+		},
+		"stops after showing the changes in dry-run mode": {
+			inDryRun: true,
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				m.EXPECT().PreviewEnvironment(gomock.Any()).Return(mockChangeSet, nil)
+				m.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtPreviewEnvComplete, "test"))
+			},
+		},
+		"streams the creation of a new environment once the changes are confirmed": {
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				cs := &cloudformation.ChangeSet{
+					Name:      mockChangeSet.Name,
+					StackName: mockChangeSet.StackName,
+					IsCreate:  true,
+				}
+				m.EXPECT().PreviewEnvironment(gomock.Any()).Return(cs, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().ExecuteChangeSet(cs).Return(nil)
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				events := make(chan []deploy.ResourceEvent, 1)
+				responses := make(chan deploy.CreateEnvironmentResponse, 1)
+				m.EXPECT().StreamEnvironmentCreation(gomock.Any()).Return(events, responses)
+				responses <- deploy.CreateEnvironmentResponse{}
+				close(events)
+				close(responses)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtPreviewEnvComplete, "test"))
+				m.EXPECT().Start(fmt.Sprintf(fmtStreamEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtStreamEnvComplete, "test"))
+			},
+			wantedDeployed: true,
+		},
+		"updates an existing environment once the changes are confirmed": {
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				m.EXPECT().PreviewEnvironment(gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().ExecuteChangeSetAndWait(mockChangeSet).Return(nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtPreviewEnvComplete, "test"))
+				m.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtUpdateEnvComplete, "test"))
+			},
+			wantedDeployed: true,
+		},
+		"returns an error if the update fails": {
+			expectPreview: func(m *mocks.MockenvPreviewer, prompt *mocks.Mockprompter) {
+				m.EXPECT().PreviewEnvironment(gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().ExecuteChangeSetAndWait(mockChangeSet).Return(errors.New("some error"))
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtPreviewEnvComplete, "test"))
+				m.EXPECT().Start(fmt.Sprintf(fmtUpdateEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtUpdateEnvFailed, "test"))
+			},
+			wantedErr: errors.New("update environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDeployer := mocks.NewMockdeployer(ctrl)
+			mockPreviewer := mocks.NewMockenvPreviewer(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockIdentity.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			if tc.expectDeployer != nil {
+				tc.expectDeployer(mockDeployer)
+			}
+			tc.expectPreview(mockPreviewer, mockPrompt)
+			tc.expectProgress(mockProgress)
+
+			opts := &initEnvOpts{
+				initEnvVars: initEnvVars{
+					EnvName:    "test",
+					GlobalOpts: &GlobalOpts{appName: "phonetool", prompt: mockPrompt},
+					changeSetReviewVars: changeSetReviewVars{
+						showDiff: !tc.inDryRun,
+						dryRun:   tc.inDryRun,
+					},
+				},
+				envDeployer:  mockDeployer,
+				envPreviewer: mockPreviewer,
+				identity:     mockIdentity,
+				prog:         mockProgress,
+			}
+
+			// WHEN
+			deployed, err := opts.reviewAndDeployEnv(&config.Application{Name: "phonetool"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDeployed, deployed)
+		})
+	}
+}
+
 func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 	testCases := map[string]struct {
 		app            *config.Application
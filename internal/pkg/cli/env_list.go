@@ -35,7 +35,7 @@ type listEnvOpts struct {
 }
 
 func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, err
 	}
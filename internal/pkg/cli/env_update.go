@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envUpdateAppNamePrompt     = "Which application is the environment in?"
+	envUpdateAppNameHelpPrompt = "An application is a collection of related services."
+	envUpdateNamePrompt        = "Which environment of %s would you like to update?"
+	envUpdateHelpPrompt        = "The description, owner, contact and labels of the environment will be updated."
+)
+
+type updateEnvVars struct {
+	*GlobalOpts
+	metadataVars
+	envName string
+	isSet   func(flag string) bool // Returns true if a flag was set by the user.
+}
+
+type updateEnvOpts struct {
+	updateEnvVars
+
+	store store
+	sel   appEnvSelector
+}
+
+func newUpdateEnvOpts(vars updateEnvVars) (*updateEnvOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+
+	return &updateEnvOpts{
+		updateEnvVars: vars,
+		store:         store,
+		sel:           selector.NewSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *updateEnvOpts) Validate() error {
+	if err := validateMetadataFlagsSet(o.isSet); err != nil {
+		return err
+	}
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *updateEnvOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(envUpdateAppNamePrompt, envUpdateAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(fmt.Sprintf(envUpdateNamePrompt, color.HighlightUserInput(o.AppName())), envUpdateHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment for application %s: %w", o.AppName(), err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute updates the metadata of the environment.
+func (o *updateEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	o.update(&env.Metadata, o.isSet)
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update environment %s: %w", o.envName, err)
+	}
+	log.Successf("Updated environment %s in application %s.\n", color.HighlightUserInput(o.envName), color.HighlightUserInput(o.AppName()))
+	return nil
+}
+
+// BuildEnvUpdateCmd builds the command for updating the metadata of an environment.
+func BuildEnvUpdateCmd() *cobra.Command {
+	vars := updateEnvVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Updates the description, owner, contact or labels of an environment.",
+		Example: `
+  Sets the description of the "test" environment.
+  /code $ copilot env update -n test --description "Integration tests run here."`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.isSet = cmd.Flags().Changed
+			opts, err := newUpdateEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "environment")
+	return cmd
+}
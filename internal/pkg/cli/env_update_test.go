@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEnvOpts_Ask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSel := mocks.NewMockappEnvSelector(ctrl)
+	mockSel.EXPECT().Application(envUpdateAppNamePrompt, envUpdateAppNameHelpPrompt).Return("my-app", nil)
+	mockSel.EXPECT().Environment(gomock.Any(), envUpdateHelpPrompt, "my-app").Return("test", nil)
+
+	opts := &updateEnvOpts{
+		updateEnvVars: updateEnvVars{
+			GlobalOpts: &GlobalOpts{},
+		},
+		sel: mockSel,
+	}
+
+	require.NoError(t, opts.Ask())
+	require.Equal(t, "my-app", opts.AppName())
+	require.Equal(t, "test", opts.envName)
+}
+
+func TestUpdateEnvOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockStore func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if failed to get the environment": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get environment test: some error"),
+		},
+		"adds a label to the environment": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					App:      "my-app",
+					Name:     "test",
+					Metadata: config.Metadata{Labels: map[string]string{"team": "payments"}},
+				}, nil)
+				m.EXPECT().UpdateEnvironment(&config.Environment{
+					App:      "my-app",
+					Name:     "test",
+					Metadata: config.Metadata{Labels: map[string]string{"team": "payments", "stage": "beta"}},
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &updateEnvOpts{
+				updateEnvVars: updateEnvVars{
+					GlobalOpts:   &GlobalOpts{appName: "my-app"},
+					envName:      "test",
+					metadataVars: metadataVars{labels: map[string]string{"stage": "beta"}},
+					isSet:        flagsSet(labelsFlag),
+				},
+				store: mockStore,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
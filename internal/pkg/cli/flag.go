@@ -23,6 +23,9 @@ const (
 	yesFlag     = "yes"
 	jsonFlag    = "json"
 
+	// Global flags.
+	workspaceFlag = "workspace"
+
 	// Command specific flags.
 	dockerFileFlag        = "dockerfile"
 	imageTagFlag          = "tag"
@@ -45,6 +48,28 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	dryRunFlag            = "dry-run"
+	outputFlag            = "output"
+	inputFlag             = "input"
+	descriptionFlag       = "description"
+	ownerFlag             = "owner"
+	contactFlag           = "contact"
+	labelsFlag            = "labels"
+	labelFlag             = "label"
+	forceFlag             = "force"
+	watchFlag             = "watch"
+	diffFlag              = "diff"
+	toRevisionFlag        = "to"
+	allFlag               = "all"
+	concurrencyFlag       = "concurrency"
+	platformFlag          = "platform"
+	yesIMeanProdFlag      = "yes-i-mean-prod"
+	pipelineOnlyFlag      = "pipeline-only"
+	waitForLockFlag       = "wait-for-lock"
+
+	importVPCIDFlag          = "import-vpc-id"
+	importPublicSubnetsFlag  = "import-public-subnets"
+	importPrivateSubnetsFlag = "import-private-subnets"
 
 	storageTypeFlag         = "storage-type"
 	storagePartitionKeyFlag = "partition-key"
@@ -87,10 +112,15 @@ var (
 %s`, strings.Join(template.QuoteSliceFunc(manifest.ServiceTypes), ", "))
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
+	platformFlagDescription = fmt.Sprintf(`Optional. Platform to build the image for and run the tasks on. Must be one of:
+%s`, strings.Join(template.QuoteSliceFunc(manifest.Platforms), ", "))
 )
 
 const (
-	appFlagDescription      = "Name of the application."
+	appFlagDescription       = "Name of the application."
+	workspaceFlagDescription = `Path to the root of the workspace.
+Overrides the COPILOT_WORKSPACE environment variable.
+By default, the workspace is searched from the current directory up to the root of the git repository.`
 	envFlagDescription      = "Name of the environment."
 	svcFlagDescription      = "Name of the service."
 	pipelineFlagDescription = "Name of the pipeline."
@@ -108,6 +138,8 @@ Allows you to categorize resources.`
 	followFlagDescription         = "Optional. Specifies if the logs should be streamed."
 	sinceFlagDescription          = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
+	auditSinceFlagDescription = `Optional. Only return events newer than a relative duration like 12h or 7d.
+Defaults to 7d.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
@@ -125,6 +157,44 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	envProfilesFlagDescription       = "Optional. Environments and the profile to use to delete the environment."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	migrateDryRunFlagDescription     = "Optional. Show the migrations to apply without updating the configuration."
+	exportOutputFlagDescription      = "Optional. Writes the bundle to a file instead of stdout."
+	importInputFlagDescription       = "Path to a bundle created with app export."
+	importAppNameFlagDescription     = "Optional. Name of the application to create. Defaults to the name in the bundle."
+	importEnvProfilesFlagDescription = "Optional. Environments and the profile to use to create the environment."
+	descriptionFlagDescription       = "Optional. A short description of the %s."
+	ownerFlagDescription             = "Optional. The team or individual owning the %s."
+	contactFlagDescription           = "Optional. How to reach the owner of the %s (ex: email address, chat channel)."
+	labelsFlagDescription            = `Optional. Labels with a key and value separated with commas.
+Allows you to group and filter by %s. An empty value removes the label on update.`
+	labelFilterFlagDescription  = "Optional. Only show services with the labels, specified by key=value separated with commas."
+	svcInitForceFlagDescription = `Optional. Regenerate the manifest if it already exists.
+Your changes to the manifest are kept and the previous version is backed up.
+Manifests written by earlier versions are left unchanged, the new version is written next to them.`
+	pipelineInitForceFlagDescription = `Optional. Regenerate the pipeline manifest and buildspec if they already exist.
+Your changes to the files are kept and the previous versions are backed up.
+Files written by earlier versions are left unchanged, the new versions are written next to them.`
+	watchFlagDescription = `Optional. Keep redeploying the service when its source code or manifest changes,
+and stream its logs in between.`
+	diffFlagDescription            = "Optional. Show the infrastructure changes and ask for confirmation before deploying them."
+	changeSetDryRunFlagDescription = "Optional. Show the infrastructure changes without deploying them."
+	toRevisionFlagDescription      = "Revision of the service to redeploy, as listed by svc history."
+	deployAllFlagDescription       = `Optional. Deploy all the services of the workspace.
+Deploys to every environment of the application unless --env is specified.`
+	deployEnvsFlagDescription   = "Name of the environment. With --all, one or more environments separated with commas."
+	concurrencyFlagDescription  = "Optional. Maximum number of images built and services deployed at the same time with --all."
+	yesIMeanProdFlagDescription = `Optional. Skips the confirmation of changes that delete or replace resources
+in environments created with --prod.`
+	pipelineOnlyFlagDescription = "Optional. Only allow deployments to the production environment from a pipeline."
+	waitForLockFlagDescription  = `Optional. How long to wait for another operation to release the deployment lock,
+like 30s or 10m. Defaults to failing right away if the lock is held.`
+	lockSvcFlagDescription = `Optional. Name of the service whose lock to break.
+Defaults to the lock of the environment itself.`
+	importVPCIDFlagDescription = `Optional. ID of an existing VPC to deploy the environment in.
+Defaults to creating a new VPC.`
+	importPublicSubnetsFlagDescription = `Optional. IDs of at least two public subnets of the imported VPC,
+separated with commas. Used by the public load balancer.`
+	importPrivateSubnetsFlagDescription = "Optional. IDs of the private subnets of the imported VPC, separated with commas."
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageServiceFlagDescription      = "Name of the service to associate with storage."
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -72,11 +73,12 @@ type initOpts struct {
 }
 
 func newInitOpts(vars initVars) (*initOpts, error) {
-	ws, err := workspace.New()
+	var initAppCmd *initAppOpts
+	ws, err := workspace.New(workspace.WithApplication(func() string { return initAppCmd.AppName }))
 	if err != nil {
 		return nil, err
 	}
-	ssm, err := config.NewStore()
+	ssm, err := config.NewConfigStore()
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +96,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		return nil, err
 	}
 
-	initAppCmd := &initAppOpts{
+	initAppCmd = &initAppOpts{
 		initAppVars: initAppVars{
 			AppName: vars.appName,
 		},
@@ -130,6 +132,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 			IsProduction: false,
 		},
 		store:         ssm,
+		locker:        ssm,
 		appDeployer:   deployer,
 		profileConfig: cfg,
 		prog:          spin,
@@ -138,6 +141,10 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		initProfileClients: initEnvProfileClients,
 	}
 
+	builder, err := docker.NewBuilder()
+	if err != nil {
+		return nil, fmt.Errorf("new image builder: %w", err)
+	}
 	deploySvcCmd := &deploySvcOpts{
 		deploySvcVars: deploySvcVars{
 			EnvName:    defaultEnvironmentName,
@@ -149,9 +156,10 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		ws:           ws,
 		sel:          selector.NewWorkspaceSelect(prompt, ssm, ws),
 		spinner:      spin,
-		docker:       docker.New(),
+		builder:      builder,
 		cmd:          command.New(),
 		sessProvider: sessProvider,
+		locker:       ssm,
 	}
 
 	return &initOpts{
@@ -204,6 +212,25 @@ containerized services that operate together.`))
 	return o.deploySvc()
 }
 
+// auditedTarget returns the application and service created by the command, and the test environment
+// if the service was deployed to it.
+func (o *initOpts) auditedTarget() auditTarget {
+	target := auditTarget{
+		app: *o.appName,
+		svc: *o.svcName,
+		stacks: []auditStack{
+			{name: stack.NameForAppStack(*o.appName)},
+		},
+	}
+	if o.ShouldDeploy {
+		target.env = defaultEnvironmentName
+		target.stacks = append(target.stacks,
+			auditStack{name: stack.NameForEnv(*o.appName, defaultEnvironmentName), env: defaultEnvironmentName},
+			auditStack{name: stack.NameForService(*o.appName, defaultEnvironmentName, *o.svcName), env: defaultEnvironmentName})
+	}
+	return target
+}
+
 func (o *initOpts) loadApp() error {
 	if err := o.initAppCmd.Ask(); err != nil {
 		return fmt.Errorf("ask app init: %w", err)
@@ -277,7 +304,7 @@ func BuildInitCmd() *cobra.Command {
 				return err
 			}
 			opts.promptForShouldDeploy = !cmd.Flags().Changed(deployFlag)
-			if err := opts.Run(); err != nil {
+			if err := executeAudited(cmd, args, opts, opts.Run); err != nil {
 				return err
 			}
 			if !opts.ShouldDeploy {
@@ -6,14 +6,20 @@ package cli
 import (
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/audit"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -42,6 +48,7 @@ type serviceStore interface {
 	serviceCreator
 	serviceGetter
 	serviceLister
+	serviceUpdater
 	serviceDeleter
 }
 
@@ -57,6 +64,10 @@ type serviceLister interface {
 	ListServices(appName string) ([]*config.Service, error)
 }
 
+type serviceUpdater interface {
+	UpdateService(svc *config.Service) error
+}
+
 type serviceDeleter interface {
 	DeleteService(appName, svcName string) error
 }
@@ -65,6 +76,7 @@ type applicationStore interface {
 	applicationCreator
 	applicationGetter
 	applicationLister
+	applicationUpdater
 	applicationDeleter
 }
 
@@ -80,6 +92,10 @@ type applicationLister interface {
 	ListApplications() ([]*config.Application, error)
 }
 
+type applicationUpdater interface {
+	UpdateApplication(app *config.Application) error
+}
+
 type applicationDeleter interface {
 	DeleteApplication(name string) error
 }
@@ -88,6 +104,7 @@ type environmentStore interface {
 	environmentCreator
 	environmentGetter
 	environmentLister
+	environmentUpdater
 	environmentDeleter
 }
 
@@ -103,6 +120,10 @@ type environmentLister interface {
 	ListEnvironments(appName string) ([]*config.Environment, error)
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentDeleter interface {
 	DeleteEnvironment(appName, environmentName string) error
 }
@@ -113,6 +134,59 @@ type store interface {
 	serviceStore
 }
 
+type deploymentStore interface {
+	deploymentCreator
+	deploymentGetter
+	deploymentLister
+}
+
+type deploymentCreator interface {
+	CreateDeployment(d *config.Deployment) error
+}
+
+type deploymentGetter interface {
+	GetDeployment(appName, envName, svcName string, revision int) (*config.Deployment, error)
+}
+
+type deploymentLister interface {
+	ListDeployments(appName, envName, svcName string) ([]*config.Deployment, error)
+}
+
+type deploymentDeleter interface {
+	DeleteDeployments(appName, envName, svcName string) error
+}
+
+type deploymentLocker interface {
+	AcquireDeploymentLock(lock *config.DeploymentLock) error
+	ReleaseDeploymentLock(lock *config.DeploymentLock) error
+}
+
+type deploymentLockBreaker interface {
+	GetDeploymentLock(appName, envName, svcName string) (*config.DeploymentLock, error)
+	BreakDeploymentLock(appName, envName, svcName string) error
+}
+
+type auditRecorder interface {
+	Record(event *audit.Event) error
+}
+
+type auditEventsGetter interface {
+	Events(appName string, since time.Time) ([]*audit.Event, error)
+}
+
+type driftDetector interface {
+	DetectDrift(stackName string) (*cloudformation.StackDrift, error)
+	NestedStacks(stackName string) ([]string, error)
+}
+
+type stackDescriber interface {
+	Describe(stackName string) (*cloudformation.StackDescription, error)
+}
+
+type appMigrator interface {
+	MigrateApplication(appName string, dryRun bool) (*config.MigrationResult, error)
+}
+
 // Secretsmanager interface.
 
 type secretsManager interface {
@@ -131,6 +205,8 @@ type secretDeleter interface {
 type ecrService interface {
 	GetRepository(name string) (string, error)
 	GetECRAuth() (ecr.Auth, error)
+	ImageDigest(repoName, tag string) (string, error)
+	ListImages(repoName string) ([]ecr.Image, error)
 }
 
 type cwlogService interface {
@@ -147,10 +223,14 @@ type stackSerializer interface {
 	SerializedParameters() (string, error)
 }
 
-type dockerService interface {
+type imageBuilder interface {
 	Build(uri, tag, path string) error
 	Login(uri, username, password string) error
 	Push(uri, tag string) error
+	Tag(uri, tag, targetURI string) error
+	RepoDigest(uri, tag string) (string, error)
+	BuildPlatforms(uris []string, tag, path string, platforms []string) error
+	IndexDigest(uri, tag string) (string, error)
 }
 
 type runner interface {
@@ -173,6 +253,11 @@ type profileNames interface {
 	Names() []string
 }
 
+type vpcSubnetLister interface {
+	ListVPCs() ([]ec2.VPC, error)
+	ListSubnets(vpcID string) ([]ec2.Subnet, error)
+}
+
 type sessionProvider interface {
 	defaultSessionProvider
 	regionalSessionProvider
@@ -189,10 +274,17 @@ type wsFileDeleter interface {
 
 type svcManifestReader interface {
 	ReadServiceManifest(svcName string) ([]byte, error)
+	ServiceManifestPath(svcName string) (string, error)
+}
+
+type fileUpdater interface {
+	ApplyUpdate(update *workspace.FileUpdate) error
 }
 
 type svcManifestWriter interface {
 	WriteServiceManifest(marshaler encoding.BinaryMarshaler, svcName string) (string, error)
+	UpdateServiceManifest(marshaler encoding.BinaryMarshaler, svcName string) (*workspace.FileUpdate, error)
+	fileUpdater
 }
 
 type wsPipelineManifestReader interface {
@@ -200,8 +292,12 @@ type wsPipelineManifestReader interface {
 }
 
 type wsPipelineWriter interface {
+	AppDirRelPath() (string, error)
 	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
 	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	UpdatePipelineBuildspec(marshaler encoding.BinaryMarshaler) (*workspace.FileUpdate, error)
+	UpdatePipelineManifest(marshaler encoding.BinaryMarshaler) (*workspace.FileUpdate, error)
+	fileUpdater
 }
 
 type wsServiceLister interface {
@@ -216,6 +312,7 @@ type wsSvcReader interface {
 type wsPipelineReader interface {
 	wsServiceLister
 	wsPipelineManifestReader
+	AppDirRelPath() (string, error)
 }
 
 type wsAppManager interface {
@@ -244,8 +341,55 @@ type environmentDeployer interface {
 	GetEnvironment(appName, envName string) (*config.Environment, error)
 }
 
+type changeSetExecutor interface {
+	ExecuteChangeSet(cs *cloudformation.ChangeSet) error
+	ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error
+	DeleteChangeSet(cs *cloudformation.ChangeSet) error
+}
+
+type svcPreviewer interface {
+	PreviewService(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation.ChangeSet, error)
+	changeSetExecutor
+}
+
+type svcRollbacker interface {
+	RollbackService(in deploy.RollbackServiceInput, opts ...cloudformation.StackOption) error
+}
+
+type svcStackParametersGetter interface {
+	ServiceStackParameters(stackName string) (map[string]string, error)
+}
+
+type svcParametersUpdater interface {
+	UpdateServiceParameters(in deploy.UpdateServiceParametersInput, opts ...cloudformation.StackOption) error
+}
+
+type canaryReleaser interface {
+	svcStackParametersGetter
+	svcStackOutputsGetter
+	svcParametersUpdater
+}
+
+type envPreviewer interface {
+	PreviewEnvironment(env *deploy.CreateEnvironmentInput) (*cloudformation.ChangeSet, error)
+	changeSetExecutor
+}
+
+type pipelinePreviewer interface {
+	PreviewPipeline(in *deploy.CreatePipelineInput) (*cloudformation.ChangeSet, error)
+	changeSetExecutor
+}
+
 type svcDeleter interface {
-	DeleteService(in deploy.DeleteServiceInput) error
+	StreamServiceDeletion(in deploy.DeleteServiceInput) (<-chan []deploy.ResourceEvent, <-chan error)
+}
+
+type svcDeploymentStreamer interface {
+	StreamServiceDeployment(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error)
+}
+
+type stackRecoverer interface {
+	RecoverStack(failed *cloudformation.ErrStackRequiresRecovery, skipResources []string) error
 }
 
 type svcRemoverFromApp interface {
@@ -298,6 +442,48 @@ type serviceArnGetter interface {
 	GetServiceArn() (*ecs.ServiceArn, error)
 }
 
+type ecsServiceDescriber interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+}
+
+type hookTaskRunner interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	RegisterTaskDefinitionWithImage(taskDefName, container, image string) (string, error)
+	RunServiceTask(svc *ecs.Service, taskDefinition, container string, command []string) (string, error)
+	Task(clusterName, taskARN string) (*ecs.Task, error)
+}
+
+type logStreamReader interface {
+	LogStreamEvents(logGroupName, logStreamName string, nextToken *string) ([]*cloudwatchlogs.Event, *string, error)
+}
+
+type svcStackOutputsGetter interface {
+	ServiceStackOutputs(stackName string) (map[string]string, error)
+}
+
+type taskDeployer interface {
+	DeployTask(input *deploy.CreateTaskResourcesInput) error
+	TaskRepositoryURI(taskName string) (string, error)
+}
+
+type taskRunner interface {
+	RunTask(input ecs.RunTaskInput) ([]string, error)
+}
+
+type defaultSubnetsGetter interface {
+	DefaultSubnets() ([]string, error)
+}
+
+type codeDeployDeployer interface {
+	CreateECSDeployment(in *codedeploy.ECSDeploymentInput) (string, error)
+	Deployment(id string) (*codedeploy.Deployment, error)
+}
+
+type fileWatcher interface {
+	Wait() ([]string, error)
+}
+
 type statusDescriber interface {
 	Describe() (*describe.ServiceStatusDesc, error)
 }
@@ -351,3 +537,8 @@ type wsSelector interface {
 	appEnvSelector
 	Service(prompt, help string) (string, error)
 }
+
+type ec2Selector interface {
+	VPC(prompt, help string) (string, error)
+	Subnets(prompt, help, vpcID string) ([]string, error)
+}
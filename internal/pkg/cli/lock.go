@@ -0,0 +1,30 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildLockCmd is the top level command for deployment locks.
+func BuildLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "lock",
+		Short: `Commands for deployment locks.
+Deployment locks prevent concurrent deployments of the same service or environment.`,
+		Long: `Commands for deployment locks.
+Deployment locks prevent concurrent deployments of the same service or environment.
+They're acquired by the commands that deploy or delete services and environments, and expire after an hour.`,
+	}
+
+	cmd.AddCommand(BuildLockBreakCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Operational,
+	}
+	return cmd
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	lockBreakEnvNamePrompt     = "Which environment is the deployment lock in?"
+	lockBreakEnvNameHelpPrompt = "Each environment has a deployment lock for itself and one for each of its services."
+	fmtLockBreakConfirmPrompt  = "Are you sure you want to break the deployment lock of %s held by %s for %s since %s?"
+	lockBreakConfirmHelp       = "If the operation holding the lock is still running, another operation can deploy at the same time."
+)
+
+type lockBreakVars struct {
+	*GlobalOpts
+	envName          string
+	svcName          string
+	skipConfirmation bool
+}
+
+type lockBreakOpts struct {
+	lockBreakVars
+
+	store   store
+	breaker deploymentLockBreaker
+	sel     configSelector
+}
+
+func newLockBreakOpts(vars lockBreakVars) (*lockBreakOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &lockBreakOpts{
+		lockBreakVars: vars,
+		store:         store,
+		breaker:       store,
+		sel:           selector.NewConfigSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *lockBreakOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for the environment of the lock if it's not passed in.
+func (o *lockBreakOpts) Ask() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(lockBreakEnvNamePrompt, lockBreakEnvNameHelpPrompt, o.AppName())
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = env
+	return nil
+}
+
+// Execute releases the deployment lock of the service in the environment, or of the environment itself,
+// after confirming who holds it.
+func (o *lockBreakOpts) Execute() error {
+	target := o.lockTarget()
+	lock, err := o.breaker.GetDeploymentLock(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		var notLocked *config.ErrNoSuchDeploymentLock
+		if errors.As(err, &notLocked) {
+			log.Infof("The deployment lock of %s isn't held by anyone.\n", target)
+			return nil
+		}
+		return err
+	}
+	if !o.skipConfirmation && !lock.Expired() {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtLockBreakConfirmPrompt, target,
+			color.HighlightUserInput(lock.Holder), color.HighlightUserInput(lock.Operation), humanize.Time(lock.AcquiredAt)), lockBreakConfirmHelp)
+		if err != nil {
+			return fmt.Errorf("confirm breaking the deployment lock: %w", err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+	if err := o.breaker.BreakDeploymentLock(o.AppName(), o.envName, o.svcName); err != nil {
+		return err
+	}
+	log.Successf("Broke the deployment lock of %s.\n", target)
+	return nil
+}
+
+// lockTarget returns the name of the service or environment guarded by the lock.
+func (o *lockBreakOpts) lockTarget() string {
+	if o.svcName == "" {
+		return fmt.Sprintf("environment %s", color.HighlightUserInput(o.envName))
+	}
+	return fmt.Sprintf("service %s in environment %s", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+}
+
+// auditedTarget returns the service or environment whose lock is released by the command.
+func (o *lockBreakOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.envName,
+		svc: o.svcName,
+	}
+}
+
+// BuildLockBreakCmd builds the command for releasing a deployment lock held by someone else.
+func BuildLockBreakCmd() *cobra.Command {
+	vars := lockBreakVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "break",
+		Short: "Releases a deployment lock held by another operation.",
+		Long: `Releases the deployment lock of a service in an environment, or of the environment itself,
+regardless of who holds it. Use it when the operation holding the lock was interrupted.`,
+
+		Example: `
+  Breaks the deployment lock of the "frontend" service in the "test" environment.
+  /code $ copilot lock break -e test -n frontend
+  Breaks the deployment lock of the "test" environment itself.
+  /code $ copilot lock break -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newLockBreakOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return executeAudited(cmd, args, opts, opts.Execute)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", lockSvcFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockBreakOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvName  string
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"does not ask for the environment if it's passed in": {
+			inEnvName: "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedEnvName: "test",
+		},
+		"asks for the environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(lockBreakEnvNamePrompt, lockBreakEnvNameHelpPrompt, "phonetool").Return("prod", nil)
+			},
+			wantedEnvName: "prod",
+		},
+		"wraps the error of the selector": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := &lockBreakOpts{
+				lockBreakVars: lockBreakVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					envName:    tc.inEnvName,
+				},
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+		})
+	}
+}
+
+func TestLockBreakOpts_Execute(t *testing.T) {
+	heldLock := &config.DeploymentLock{
+		App:        "phonetool",
+		Env:        "test",
+		Service:    "api",
+		ID:         "abcd",
+		Operation:  svcDeployLockOperation,
+		Holder:     "arn:aws:iam::1234:user/alice",
+		AcquiredAt: time.Now().Add(-time.Minute),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	expiredLock := &config.DeploymentLock{
+		App:       "phonetool",
+		Env:       "test",
+		Service:   "api",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+		setupMocks         func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"does nothing if the lock isn't held": {
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(nil, &config.ErrNoSuchDeploymentLock{
+					ServiceName:     "api",
+					EnvironmentName: "test",
+				})
+				breaker.EXPECT().BreakDeploymentLock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"does not break the lock if declined": {
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(heldLock, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), lockBreakConfirmHelp).Return(false, nil)
+				breaker.EXPECT().BreakDeploymentLock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"breaks the lock once confirmed": {
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(heldLock, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), lockBreakConfirmHelp).Return(true, nil)
+				breaker.EXPECT().BreakDeploymentLock("phonetool", "test", "api").Return(nil)
+			},
+		},
+		"breaks the lock without confirmation with --yes": {
+			inSkipConfirmation: true,
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(heldLock, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				breaker.EXPECT().BreakDeploymentLock("phonetool", "test", "api").Return(nil)
+			},
+		},
+		"breaks an expired lock without confirmation": {
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(expiredLock, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				breaker.EXPECT().BreakDeploymentLock("phonetool", "test", "api").Return(nil)
+			},
+		},
+		"returns the error of breaking the lock": {
+			inSkipConfirmation: true,
+			setupMocks: func(breaker *mocks.MockdeploymentLockBreaker, prompt *mocks.Mockprompter) {
+				breaker.EXPECT().GetDeploymentLock("phonetool", "test", "api").Return(heldLock, nil)
+				breaker.EXPECT().BreakDeploymentLock("phonetool", "test", "api").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			breaker := mocks.NewMockdeploymentLockBreaker(ctrl)
+			prompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(breaker, prompt)
+			opts := &lockBreakOpts{
+				lockBreakVars: lockBreakVars{
+					GlobalOpts:       &GlobalOpts{appName: "phonetool", prompt: prompt},
+					envName:          "test",
+					svcName:          "api",
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				breaker: breaker,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
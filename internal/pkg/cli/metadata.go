@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// metadataVars holds the flags shared by the commands that set the metadata of an application, environment or service.
+type metadataVars struct {
+	description string
+	owner       string
+	contact     string
+	labels      map[string]string
+}
+
+// metadata returns the metadata provided by flags. Labels with an empty value are left out.
+func (v metadataVars) metadata() config.Metadata {
+	m := config.Metadata{
+		Description: v.description,
+		Owner:       v.owner,
+		Contact:     v.contact,
+	}
+	for k, val := range v.labels {
+		if val == "" {
+			continue
+		}
+		if m.Labels == nil {
+			m.Labels = make(map[string]string)
+		}
+		m.Labels[k] = val
+	}
+	return m
+}
+
+// update overwrites the fields of m whose flag is set. Labels are merged with the existing ones,
+// and a label with an empty value is removed.
+func (v metadataVars) update(m *config.Metadata, isSet func(flag string) bool) {
+	if isSet(descriptionFlag) {
+		m.Description = v.description
+	}
+	if isSet(ownerFlag) {
+		m.Owner = v.owner
+	}
+	if isSet(contactFlag) {
+		m.Contact = v.contact
+	}
+	if !isSet(labelsFlag) {
+		return
+	}
+	for k, val := range v.labels {
+		if val == "" {
+			delete(m.Labels, k)
+			continue
+		}
+		if m.Labels == nil {
+			m.Labels = make(map[string]string)
+		}
+		m.Labels[k] = val
+	}
+	if len(m.Labels) == 0 {
+		m.Labels = nil
+	}
+}
+
+// validateMetadataFlagsSet returns an error if none of the metadata flags are set.
+func validateMetadataFlagsSet(isSet func(flag string) bool) error {
+	for _, flag := range []string{descriptionFlag, ownerFlag, contactFlag, labelsFlag} {
+		if isSet(flag) {
+			return nil
+		}
+	}
+	return fmt.Errorf("at least one of --%s, --%s, --%s or --%s must be provided", descriptionFlag, ownerFlag, contactFlag, labelsFlag)
+}
+
+// addMetadataFlags adds the metadata flags of a resource, such as an "application", to the command.
+func addMetadataFlags(cmd *cobra.Command, vars *metadataVars, resource string) {
+	cmd.Flags().StringVar(&vars.description, descriptionFlag, "", fmt.Sprintf(descriptionFlagDescription, resource))
+	cmd.Flags().StringVar(&vars.owner, ownerFlag, "", fmt.Sprintf(ownerFlagDescription, resource))
+	cmd.Flags().StringVar(&vars.contact, contactFlag, "", fmt.Sprintf(contactFlagDescription, resource))
+	cmd.Flags().StringToStringVar(&vars.labels, labelsFlag, nil, fmt.Sprintf(labelsFlagDescription, resource+"s"))
+}
@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func flagsSet(flags ...string) func(string) bool {
+	return func(flag string) bool {
+		for _, f := range flags {
+			if f == flag {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestMetadataVars_Metadata(t *testing.T) {
+	vars := metadataVars{
+		description: "Phone directory",
+		owner:       "payments",
+		labels:      map[string]string{"tier": "1", "team": ""},
+	}
+
+	require.Equal(t, config.Metadata{
+		Description: "Phone directory",
+		Owner:       "payments",
+		Labels:      map[string]string{"tier": "1"},
+	}, vars.metadata())
+}
+
+func TestMetadataVars_Update(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     metadataVars
+		inFlagsSet []string
+		inMetadata config.Metadata
+
+		wanted config.Metadata
+	}{
+		"only overwrites the flags that are set": {
+			inVars:     metadataVars{owner: "search", contact: "ignored"},
+			inFlagsSet: []string{ownerFlag},
+			inMetadata: config.Metadata{Owner: "payments", Contact: "payments@example.com"},
+			wanted:     config.Metadata{Owner: "search", Contact: "payments@example.com"},
+		},
+		"clears a field set to an empty value": {
+			inVars:     metadataVars{},
+			inFlagsSet: []string{descriptionFlag},
+			inMetadata: config.Metadata{Description: "Phone directory", Owner: "payments"},
+			wanted:     config.Metadata{Owner: "payments"},
+		},
+		"merges labels and removes labels with an empty value": {
+			inVars:     metadataVars{labels: map[string]string{"tier": "2", "team": "", "env": "prod"}},
+			inFlagsSet: []string{labelsFlag},
+			inMetadata: config.Metadata{Labels: map[string]string{"tier": "1", "team": "payments"}},
+			wanted:     config.Metadata{Labels: map[string]string{"tier": "2", "env": "prod"}},
+		},
+		"removing the last label leaves no labels": {
+			inVars:     metadataVars{labels: map[string]string{"team": ""}},
+			inFlagsSet: []string{labelsFlag},
+			inMetadata: config.Metadata{Labels: map[string]string{"team": "payments"}},
+			wanted:     config.Metadata{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.inMetadata
+
+			tc.inVars.update(&m, flagsSet(tc.inFlagsSet...))
+
+			require.Equal(t, tc.wanted, m)
+		})
+	}
+}
+
+func TestValidateMetadataFlagsSet(t *testing.T) {
+	require.EqualError(t, validateMetadataFlagsSet(flagsSet()), "at least one of --description, --owner, --contact or --labels must be provided")
+	require.NoError(t, validateMetadataFlagsSet(flagsSet(contactFlag)))
+}
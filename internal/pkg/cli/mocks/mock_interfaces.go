@@ -7,12 +7,17 @@ package mocks
 import (
 	encoding "encoding"
 	session "github.com/aws/aws-sdk-go/aws/session"
+	audit "github.com/aws/copilot-cli/internal/pkg/audit"
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -21,6 +26,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
+	time "time"
 )
 
 // MockactionCommand is a mock of actionCommand interface
@@ -169,6 +175,20 @@ func (mr *MockserviceStoreMockRecorder) ListServices(appName interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockserviceStore)(nil).ListServices), appName)
 }
 
+// UpdateService mocks base method
+func (m *MockserviceStore) UpdateService(svc *config.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateService indicates an expected call of UpdateService
+func (mr *MockserviceStoreMockRecorder) UpdateService(svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockserviceStore)(nil).UpdateService), svc)
+}
+
 // DeleteService mocks base method
 func (m *MockserviceStore) DeleteService(appName, svcName string) error {
 	m.ctrl.T.Helper()
@@ -296,6 +316,43 @@ func (mr *MockserviceListerMockRecorder) ListServices(appName interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockserviceLister)(nil).ListServices), appName)
 }
 
+// MockserviceUpdater is a mock of serviceUpdater interface
+type MockserviceUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceUpdaterMockRecorder
+}
+
+// MockserviceUpdaterMockRecorder is the mock recorder for MockserviceUpdater
+type MockserviceUpdaterMockRecorder struct {
+	mock *MockserviceUpdater
+}
+
+// NewMockserviceUpdater creates a new mock instance
+func NewMockserviceUpdater(ctrl *gomock.Controller) *MockserviceUpdater {
+	mock := &MockserviceUpdater{ctrl: ctrl}
+	mock.recorder = &MockserviceUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceUpdater) EXPECT() *MockserviceUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateService mocks base method
+func (m *MockserviceUpdater) UpdateService(svc *config.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateService indicates an expected call of UpdateService
+func (mr *MockserviceUpdaterMockRecorder) UpdateService(svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockserviceUpdater)(nil).UpdateService), svc)
+}
+
 // MockserviceDeleter is a mock of serviceDeleter interface
 type MockserviceDeleter struct {
 	ctrl     *gomock.Controller
@@ -400,6 +457,20 @@ func (mr *MockapplicationStoreMockRecorder) ListApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockapplicationStore)(nil).ListApplications))
 }
 
+// UpdateApplication mocks base method
+func (m *MockapplicationStore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockapplicationStoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationStore)(nil).UpdateApplication), app)
+}
+
 // DeleteApplication mocks base method
 func (m *MockapplicationStore) DeleteApplication(name string) error {
 	m.ctrl.T.Helper()
@@ -527,6 +598,43 @@ func (mr *MockapplicationListerMockRecorder) ListApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockapplicationLister)(nil).ListApplications))
 }
 
+// MockapplicationUpdater is a mock of applicationUpdater interface
+type MockapplicationUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockapplicationUpdaterMockRecorder
+}
+
+// MockapplicationUpdaterMockRecorder is the mock recorder for MockapplicationUpdater
+type MockapplicationUpdaterMockRecorder struct {
+	mock *MockapplicationUpdater
+}
+
+// NewMockapplicationUpdater creates a new mock instance
+func NewMockapplicationUpdater(ctrl *gomock.Controller) *MockapplicationUpdater {
+	mock := &MockapplicationUpdater{ctrl: ctrl}
+	mock.recorder = &MockapplicationUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockapplicationUpdater) EXPECT() *MockapplicationUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateApplication mocks base method
+func (m *MockapplicationUpdater) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockapplicationUpdaterMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockapplicationUpdater)(nil).UpdateApplication), app)
+}
+
 // MockapplicationDeleter is a mock of applicationDeleter interface
 type MockapplicationDeleter struct {
 	ctrl     *gomock.Controller
@@ -631,6 +739,20 @@ func (mr *MockenvironmentStoreMockRecorder) ListEnvironments(appName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentStore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // DeleteEnvironment mocks base method
 func (m *MockenvironmentStore) DeleteEnvironment(appName, environmentName string) error {
 	m.ctrl.T.Helper()
@@ -758,6 +880,43 @@ func (mr *MockenvironmentListerMockRecorder) ListEnvironments(appName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentLister)(nil).ListEnvironments), appName)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentDeleter is a mock of environmentDeleter interface
 type MockenvironmentDeleter struct {
 	ctrl     *gomock.Controller
@@ -862,6 +1021,20 @@ func (mr *MockstoreMockRecorder) ListApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*Mockstore)(nil).ListApplications))
 }
 
+// UpdateApplication mocks base method
+func (m *Mockstore) UpdateApplication(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication
+func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
+}
+
 // DeleteApplication mocks base method
 func (m *Mockstore) DeleteApplication(name string) error {
 	m.ctrl.T.Helper()
@@ -920,6 +1093,20 @@ func (mr *MockstoreMockRecorder) ListEnvironments(appName interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*Mockstore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // DeleteEnvironment mocks base method
 func (m *Mockstore) DeleteEnvironment(appName, environmentName string) error {
 	m.ctrl.T.Helper()
@@ -978,6 +1165,20 @@ func (mr *MockstoreMockRecorder) ListServices(appName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*Mockstore)(nil).ListServices), appName)
 }
 
+// UpdateService mocks base method
+func (m *Mockstore) UpdateService(svc *config.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateService indicates an expected call of UpdateService
+func (mr *MockstoreMockRecorder) UpdateService(svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*Mockstore)(nil).UpdateService), svc)
+}
+
 // DeleteService mocks base method
 func (m *Mockstore) DeleteService(appName, svcName string) error {
 	m.ctrl.T.Helper()
@@ -992,1267 +1193,3164 @@ func (mr *MockstoreMockRecorder) DeleteService(appName, svcName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*Mockstore)(nil).DeleteService), appName, svcName)
 }
 
-// MocksecretsManager is a mock of secretsManager interface
-type MocksecretsManager struct {
+// MockdeploymentStore is a mock of deploymentStore interface
+type MockdeploymentStore struct {
 	ctrl     *gomock.Controller
-	recorder *MocksecretsManagerMockRecorder
+	recorder *MockdeploymentStoreMockRecorder
 }
 
-// MocksecretsManagerMockRecorder is the mock recorder for MocksecretsManager
-type MocksecretsManagerMockRecorder struct {
-	mock *MocksecretsManager
+// MockdeploymentStoreMockRecorder is the mock recorder for MockdeploymentStore
+type MockdeploymentStoreMockRecorder struct {
+	mock *MockdeploymentStore
 }
 
-// NewMocksecretsManager creates a new mock instance
-func NewMocksecretsManager(ctrl *gomock.Controller) *MocksecretsManager {
-	mock := &MocksecretsManager{ctrl: ctrl}
-	mock.recorder = &MocksecretsManagerMockRecorder{mock}
+// NewMockdeploymentStore creates a new mock instance
+func NewMockdeploymentStore(ctrl *gomock.Controller) *MockdeploymentStore {
+	mock := &MockdeploymentStore{ctrl: ctrl}
+	mock.recorder = &MockdeploymentStoreMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksecretsManager) EXPECT() *MocksecretsManagerMockRecorder {
+func (m *MockdeploymentStore) EXPECT() *MockdeploymentStoreMockRecorder {
 	return m.recorder
 }
 
-// CreateSecret mocks base method
-func (m *MocksecretsManager) CreateSecret(secretName, secretString string) (string, error) {
+// CreateDeployment mocks base method
+func (m *MockdeploymentStore) CreateDeployment(d *config.Deployment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreateDeployment", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeployment indicates an expected call of CreateDeployment
+func (mr *MockdeploymentStoreMockRecorder) CreateDeployment(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployment", reflect.TypeOf((*MockdeploymentStore)(nil).CreateDeployment), d)
+}
+
+// GetDeployment mocks base method
+func (m *MockdeploymentStore) GetDeployment(appName, envName, svcName string, revision int) (*config.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployment", appName, envName, svcName, revision)
+	ret0, _ := ret[0].(*config.Deployment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSecret indicates an expected call of CreateSecret
-func (mr *MocksecretsManagerMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
+// GetDeployment indicates an expected call of GetDeployment
+func (mr *MockdeploymentStoreMockRecorder) GetDeployment(appName, envName, svcName, revision interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MocksecretsManager)(nil).CreateSecret), secretName, secretString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockdeploymentStore)(nil).GetDeployment), appName, envName, svcName, revision)
 }
 
-// DeleteSecret mocks base method
-func (m *MocksecretsManager) DeleteSecret(secretName string) error {
+// ListDeployments mocks base method
+func (m *MockdeploymentStore) ListDeployments(appName, envName, svcName string) ([]*config.Deployment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListDeployments", appName, envName, svcName)
+	ret0, _ := ret[0].([]*config.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSecret indicates an expected call of DeleteSecret
-func (mr *MocksecretsManagerMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+// ListDeployments indicates an expected call of ListDeployments
+func (mr *MockdeploymentStoreMockRecorder) ListDeployments(appName, envName, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretsManager)(nil).DeleteSecret), secretName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockdeploymentStore)(nil).ListDeployments), appName, envName, svcName)
 }
 
-// MocksecretCreator is a mock of secretCreator interface
-type MocksecretCreator struct {
+// MockdeploymentCreator is a mock of deploymentCreator interface
+type MockdeploymentCreator struct {
 	ctrl     *gomock.Controller
-	recorder *MocksecretCreatorMockRecorder
+	recorder *MockdeploymentCreatorMockRecorder
 }
 
-// MocksecretCreatorMockRecorder is the mock recorder for MocksecretCreator
-type MocksecretCreatorMockRecorder struct {
-	mock *MocksecretCreator
+// MockdeploymentCreatorMockRecorder is the mock recorder for MockdeploymentCreator
+type MockdeploymentCreatorMockRecorder struct {
+	mock *MockdeploymentCreator
 }
 
-// NewMocksecretCreator creates a new mock instance
-func NewMocksecretCreator(ctrl *gomock.Controller) *MocksecretCreator {
-	mock := &MocksecretCreator{ctrl: ctrl}
-	mock.recorder = &MocksecretCreatorMockRecorder{mock}
+// NewMockdeploymentCreator creates a new mock instance
+func NewMockdeploymentCreator(ctrl *gomock.Controller) *MockdeploymentCreator {
+	mock := &MockdeploymentCreator{ctrl: ctrl}
+	mock.recorder = &MockdeploymentCreatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksecretCreator) EXPECT() *MocksecretCreatorMockRecorder {
+func (m *MockdeploymentCreator) EXPECT() *MockdeploymentCreatorMockRecorder {
 	return m.recorder
 }
 
-// CreateSecret mocks base method
-func (m *MocksecretCreator) CreateSecret(secretName, secretString string) (string, error) {
+// CreateDeployment mocks base method
+func (m *MockdeploymentCreator) CreateDeployment(d *config.Deployment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateDeployment", d)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateSecret indicates an expected call of CreateSecret
-func (mr *MocksecretCreatorMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
+// CreateDeployment indicates an expected call of CreateDeployment
+func (mr *MockdeploymentCreatorMockRecorder) CreateDeployment(d interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MocksecretCreator)(nil).CreateSecret), secretName, secretString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployment", reflect.TypeOf((*MockdeploymentCreator)(nil).CreateDeployment), d)
 }
 
-// MocksecretDeleter is a mock of secretDeleter interface
-type MocksecretDeleter struct {
+// MockdeploymentGetter is a mock of deploymentGetter interface
+type MockdeploymentGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MocksecretDeleterMockRecorder
+	recorder *MockdeploymentGetterMockRecorder
 }
 
-// MocksecretDeleterMockRecorder is the mock recorder for MocksecretDeleter
-type MocksecretDeleterMockRecorder struct {
-	mock *MocksecretDeleter
+// MockdeploymentGetterMockRecorder is the mock recorder for MockdeploymentGetter
+type MockdeploymentGetterMockRecorder struct {
+	mock *MockdeploymentGetter
 }
 
-// NewMocksecretDeleter creates a new mock instance
-func NewMocksecretDeleter(ctrl *gomock.Controller) *MocksecretDeleter {
-	mock := &MocksecretDeleter{ctrl: ctrl}
-	mock.recorder = &MocksecretDeleterMockRecorder{mock}
+// NewMockdeploymentGetter creates a new mock instance
+func NewMockdeploymentGetter(ctrl *gomock.Controller) *MockdeploymentGetter {
+	mock := &MockdeploymentGetter{ctrl: ctrl}
+	mock.recorder = &MockdeploymentGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksecretDeleter) EXPECT() *MocksecretDeleterMockRecorder {
+func (m *MockdeploymentGetter) EXPECT() *MockdeploymentGetterMockRecorder {
 	return m.recorder
 }
 
-// DeleteSecret mocks base method
-func (m *MocksecretDeleter) DeleteSecret(secretName string) error {
+// GetDeployment mocks base method
+func (m *MockdeploymentGetter) GetDeployment(appName, envName, svcName string, revision int) (*config.Deployment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetDeployment", appName, envName, svcName, revision)
+	ret0, _ := ret[0].(*config.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSecret indicates an expected call of DeleteSecret
-func (mr *MocksecretDeleterMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+// GetDeployment indicates an expected call of GetDeployment
+func (mr *MockdeploymentGetterMockRecorder) GetDeployment(appName, envName, svcName, revision interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretDeleter)(nil).DeleteSecret), secretName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockdeploymentGetter)(nil).GetDeployment), appName, envName, svcName, revision)
 }
 
-// MockecrService is a mock of ecrService interface
-type MockecrService struct {
+// MockdeploymentLister is a mock of deploymentLister interface
+type MockdeploymentLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockecrServiceMockRecorder
+	recorder *MockdeploymentListerMockRecorder
 }
 
-// MockecrServiceMockRecorder is the mock recorder for MockecrService
-type MockecrServiceMockRecorder struct {
-	mock *MockecrService
+// MockdeploymentListerMockRecorder is the mock recorder for MockdeploymentLister
+type MockdeploymentListerMockRecorder struct {
+	mock *MockdeploymentLister
 }
 
-// NewMockecrService creates a new mock instance
-func NewMockecrService(ctrl *gomock.Controller) *MockecrService {
-	mock := &MockecrService{ctrl: ctrl}
-	mock.recorder = &MockecrServiceMockRecorder{mock}
+// NewMockdeploymentLister creates a new mock instance
+func NewMockdeploymentLister(ctrl *gomock.Controller) *MockdeploymentLister {
+	mock := &MockdeploymentLister{ctrl: ctrl}
+	mock.recorder = &MockdeploymentListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockecrService) EXPECT() *MockecrServiceMockRecorder {
+func (m *MockdeploymentLister) EXPECT() *MockdeploymentListerMockRecorder {
 	return m.recorder
 }
 
-// GetRepository mocks base method
-func (m *MockecrService) GetRepository(name string) (string, error) {
+// ListDeployments mocks base method
+func (m *MockdeploymentLister) ListDeployments(appName, envName, svcName string) ([]*config.Deployment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRepository", name)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListDeployments", appName, envName, svcName)
+	ret0, _ := ret[0].([]*config.Deployment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRepository indicates an expected call of GetRepository
-func (mr *MockecrServiceMockRecorder) GetRepository(name interface{}) *gomock.Call {
+// ListDeployments indicates an expected call of ListDeployments
+func (mr *MockdeploymentListerMockRecorder) ListDeployments(appName, envName, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockecrService)(nil).GetRepository), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockdeploymentLister)(nil).ListDeployments), appName, envName, svcName)
 }
 
-// GetECRAuth mocks base method
-func (m *MockecrService) GetECRAuth() (ecr.Auth, error) {
+// MockdeploymentDeleter is a mock of deploymentDeleter interface
+type MockdeploymentDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentDeleterMockRecorder
+}
+
+// MockdeploymentDeleterMockRecorder is the mock recorder for MockdeploymentDeleter
+type MockdeploymentDeleterMockRecorder struct {
+	mock *MockdeploymentDeleter
+}
+
+// NewMockdeploymentDeleter creates a new mock instance
+func NewMockdeploymentDeleter(ctrl *gomock.Controller) *MockdeploymentDeleter {
+	mock := &MockdeploymentDeleter{ctrl: ctrl}
+	mock.recorder = &MockdeploymentDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeploymentDeleter) EXPECT() *MockdeploymentDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteDeployments mocks base method
+func (m *MockdeploymentDeleter) DeleteDeployments(appName, envName, svcName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetECRAuth")
-	ret0, _ := ret[0].(ecr.Auth)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteDeployments", appName, envName, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetECRAuth indicates an expected call of GetECRAuth
-func (mr *MockecrServiceMockRecorder) GetECRAuth() *gomock.Call {
+// DeleteDeployments indicates an expected call of DeleteDeployments
+func (mr *MockdeploymentDeleterMockRecorder) DeleteDeployments(appName, envName, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetECRAuth", reflect.TypeOf((*MockecrService)(nil).GetECRAuth))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployments", reflect.TypeOf((*MockdeploymentDeleter)(nil).DeleteDeployments), appName, envName, svcName)
 }
 
-// MockcwlogService is a mock of cwlogService interface
-type MockcwlogService struct {
+// MockdeploymentLocker is a mock of deploymentLocker interface
+type MockdeploymentLocker struct {
 	ctrl     *gomock.Controller
-	recorder *MockcwlogServiceMockRecorder
+	recorder *MockdeploymentLockerMockRecorder
 }
 
-// MockcwlogServiceMockRecorder is the mock recorder for MockcwlogService
-type MockcwlogServiceMockRecorder struct {
-	mock *MockcwlogService
+// MockdeploymentLockerMockRecorder is the mock recorder for MockdeploymentLocker
+type MockdeploymentLockerMockRecorder struct {
+	mock *MockdeploymentLocker
 }
 
-// NewMockcwlogService creates a new mock instance
-func NewMockcwlogService(ctrl *gomock.Controller) *MockcwlogService {
-	mock := &MockcwlogService{ctrl: ctrl}
-	mock.recorder = &MockcwlogServiceMockRecorder{mock}
+// NewMockdeploymentLocker creates a new mock instance
+func NewMockdeploymentLocker(ctrl *gomock.Controller) *MockdeploymentLocker {
+	mock := &MockdeploymentLocker{ctrl: ctrl}
+	mock.recorder = &MockdeploymentLockerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockcwlogService) EXPECT() *MockcwlogServiceMockRecorder {
+func (m *MockdeploymentLocker) EXPECT() *MockdeploymentLockerMockRecorder {
 	return m.recorder
 }
 
-// TaskLogEvents mocks base method
-func (m *MockcwlogService) TaskLogEvents(logGroupName string, streamLastEventTime map[string]int64, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+// AcquireDeploymentLock mocks base method
+func (m *MockdeploymentLocker) AcquireDeploymentLock(lock *config.DeploymentLock) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{logGroupName, streamLastEventTime}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "TaskLogEvents", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AcquireDeploymentLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// TaskLogEvents indicates an expected call of TaskLogEvents
-func (mr *MockcwlogServiceMockRecorder) TaskLogEvents(logGroupName, streamLastEventTime interface{}, opts ...interface{}) *gomock.Call {
+// AcquireDeploymentLock indicates an expected call of AcquireDeploymentLock
+func (mr *MockdeploymentLockerMockRecorder) AcquireDeploymentLock(lock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{logGroupName, streamLastEventTime}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskLogEvents", reflect.TypeOf((*MockcwlogService)(nil).TaskLogEvents), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireDeploymentLock", reflect.TypeOf((*MockdeploymentLocker)(nil).AcquireDeploymentLock), lock)
 }
 
-// LogGroupExists mocks base method
-func (m *MockcwlogService) LogGroupExists(logGroupName string) (bool, error) {
+// ReleaseDeploymentLock mocks base method
+func (m *MockdeploymentLocker) ReleaseDeploymentLock(lock *config.DeploymentLock) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogGroupExists", logGroupName)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReleaseDeploymentLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LogGroupExists indicates an expected call of LogGroupExists
-func (mr *MockcwlogServiceMockRecorder) LogGroupExists(logGroupName interface{}) *gomock.Call {
+// ReleaseDeploymentLock indicates an expected call of ReleaseDeploymentLock
+func (mr *MockdeploymentLockerMockRecorder) ReleaseDeploymentLock(lock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockcwlogService)(nil).LogGroupExists), logGroupName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDeploymentLock", reflect.TypeOf((*MockdeploymentLocker)(nil).ReleaseDeploymentLock), lock)
 }
 
-// Mocktemplater is a mock of templater interface
-type Mocktemplater struct {
+// MockdeploymentLockBreaker is a mock of deploymentLockBreaker interface
+type MockdeploymentLockBreaker struct {
 	ctrl     *gomock.Controller
-	recorder *MocktemplaterMockRecorder
+	recorder *MockdeploymentLockBreakerMockRecorder
 }
 
-// MocktemplaterMockRecorder is the mock recorder for Mocktemplater
-type MocktemplaterMockRecorder struct {
-	mock *Mocktemplater
+// MockdeploymentLockBreakerMockRecorder is the mock recorder for MockdeploymentLockBreaker
+type MockdeploymentLockBreakerMockRecorder struct {
+	mock *MockdeploymentLockBreaker
 }
 
-// NewMocktemplater creates a new mock instance
-func NewMocktemplater(ctrl *gomock.Controller) *Mocktemplater {
-	mock := &Mocktemplater{ctrl: ctrl}
-	mock.recorder = &MocktemplaterMockRecorder{mock}
+// NewMockdeploymentLockBreaker creates a new mock instance
+func NewMockdeploymentLockBreaker(ctrl *gomock.Controller) *MockdeploymentLockBreaker {
+	mock := &MockdeploymentLockBreaker{ctrl: ctrl}
+	mock.recorder = &MockdeploymentLockBreakerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mocktemplater) EXPECT() *MocktemplaterMockRecorder {
+func (m *MockdeploymentLockBreaker) EXPECT() *MockdeploymentLockBreakerMockRecorder {
 	return m.recorder
 }
 
-// Template mocks base method
-func (m *Mocktemplater) Template() (string, error) {
+// GetDeploymentLock mocks base method
+func (m *MockdeploymentLockBreaker) GetDeploymentLock(appName, envName, svcName string) (*config.DeploymentLock, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetDeploymentLock", appName, envName, svcName)
+	ret0, _ := ret[0].(*config.DeploymentLock)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Template indicates an expected call of Template
-func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
+// GetDeploymentLock indicates an expected call of GetDeploymentLock
+func (mr *MockdeploymentLockBreakerMockRecorder) GetDeploymentLock(appName, envName, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentLock", reflect.TypeOf((*MockdeploymentLockBreaker)(nil).GetDeploymentLock), appName, envName, svcName)
 }
 
-// MockstackSerializer is a mock of stackSerializer interface
-type MockstackSerializer struct {
+// BreakDeploymentLock mocks base method
+func (m *MockdeploymentLockBreaker) BreakDeploymentLock(appName, envName, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakDeploymentLock", appName, envName, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BreakDeploymentLock indicates an expected call of BreakDeploymentLock
+func (mr *MockdeploymentLockBreakerMockRecorder) BreakDeploymentLock(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakDeploymentLock", reflect.TypeOf((*MockdeploymentLockBreaker)(nil).BreakDeploymentLock), appName, envName, svcName)
+}
+
+// MockauditRecorder is a mock of auditRecorder interface
+type MockauditRecorder struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackSerializerMockRecorder
+	recorder *MockauditRecorderMockRecorder
 }
 
-// MockstackSerializerMockRecorder is the mock recorder for MockstackSerializer
-type MockstackSerializerMockRecorder struct {
-	mock *MockstackSerializer
+// MockauditRecorderMockRecorder is the mock recorder for MockauditRecorder
+type MockauditRecorderMockRecorder struct {
+	mock *MockauditRecorder
 }
 
-// NewMockstackSerializer creates a new mock instance
-func NewMockstackSerializer(ctrl *gomock.Controller) *MockstackSerializer {
-	mock := &MockstackSerializer{ctrl: ctrl}
-	mock.recorder = &MockstackSerializerMockRecorder{mock}
+// NewMockauditRecorder creates a new mock instance
+func NewMockauditRecorder(ctrl *gomock.Controller) *MockauditRecorder {
+	mock := &MockauditRecorder{ctrl: ctrl}
+	mock.recorder = &MockauditRecorderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockstackSerializer) EXPECT() *MockstackSerializerMockRecorder {
+func (m *MockauditRecorder) EXPECT() *MockauditRecorderMockRecorder {
 	return m.recorder
 }
 
-// Template mocks base method
-func (m *MockstackSerializer) Template() (string, error) {
+// Record mocks base method
+func (m *MockauditRecorder) Record(event *audit.Event) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template")
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Record", event)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Template indicates an expected call of Template
-func (mr *MockstackSerializerMockRecorder) Template() *gomock.Call {
+// Record indicates an expected call of Record
+func (mr *MockauditRecorderMockRecorder) Record(event interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackSerializer)(nil).Template))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockauditRecorder)(nil).Record), event)
 }
 
-// SerializedParameters mocks base method
-func (m *MockstackSerializer) SerializedParameters() (string, error) {
+// MockauditEventsGetter is a mock of auditEventsGetter interface
+type MockauditEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditEventsGetterMockRecorder
+}
+
+// MockauditEventsGetterMockRecorder is the mock recorder for MockauditEventsGetter
+type MockauditEventsGetterMockRecorder struct {
+	mock *MockauditEventsGetter
+}
+
+// NewMockauditEventsGetter creates a new mock instance
+func NewMockauditEventsGetter(ctrl *gomock.Controller) *MockauditEventsGetter {
+	mock := &MockauditEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockauditEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockauditEventsGetter) EXPECT() *MockauditEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// Events mocks base method
+func (m *MockauditEventsGetter) Events(appName string, since time.Time) ([]*audit.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SerializedParameters")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Events", appName, since)
+	ret0, _ := ret[0].([]*audit.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SerializedParameters indicates an expected call of SerializedParameters
-func (mr *MockstackSerializerMockRecorder) SerializedParameters() *gomock.Call {
+// Events indicates an expected call of Events
+func (mr *MockauditEventsGetterMockRecorder) Events(appName, since interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackSerializer)(nil).SerializedParameters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockauditEventsGetter)(nil).Events), appName, since)
 }
 
-// MockdockerService is a mock of dockerService interface
-type MockdockerService struct {
+// MockdriftDetector is a mock of driftDetector interface
+type MockdriftDetector struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerServiceMockRecorder
+	recorder *MockdriftDetectorMockRecorder
 }
 
-// MockdockerServiceMockRecorder is the mock recorder for MockdockerService
-type MockdockerServiceMockRecorder struct {
-	mock *MockdockerService
+// MockdriftDetectorMockRecorder is the mock recorder for MockdriftDetector
+type MockdriftDetectorMockRecorder struct {
+	mock *MockdriftDetector
 }
 
-// NewMockdockerService creates a new mock instance
-func NewMockdockerService(ctrl *gomock.Controller) *MockdockerService {
-	mock := &MockdockerService{ctrl: ctrl}
-	mock.recorder = &MockdockerServiceMockRecorder{mock}
+// NewMockdriftDetector creates a new mock instance
+func NewMockdriftDetector(ctrl *gomock.Controller) *MockdriftDetector {
+	mock := &MockdriftDetector{ctrl: ctrl}
+	mock.recorder = &MockdriftDetectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdockerService) EXPECT() *MockdockerServiceMockRecorder {
+func (m *MockdriftDetector) EXPECT() *MockdriftDetectorMockRecorder {
 	return m.recorder
 }
 
-// Build mocks base method
-func (m *MockdockerService) Build(uri, tag, path string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Build", uri, tag, path)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Build indicates an expected call of Build
-func (mr *MockdockerServiceMockRecorder) Build(uri, tag, path interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockdockerService)(nil).Build), uri, tag, path)
-}
-
-// Login mocks base method
-func (m *MockdockerService) Login(uri, username, password string) error {
+// DetectDrift mocks base method
+func (m *MockdriftDetector) DetectDrift(stackName string) (*cloudformation.StackDrift, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", uri, username, password)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DetectDrift", stackName)
+	ret0, _ := ret[0].(*cloudformation.StackDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Login indicates an expected call of Login
-func (mr *MockdockerServiceMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
+// DetectDrift indicates an expected call of DetectDrift
+func (mr *MockdriftDetectorMockRecorder) DetectDrift(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockdockerService)(nil).Login), uri, username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockdriftDetector)(nil).DetectDrift), stackName)
 }
 
-// Push mocks base method
-func (m *MockdockerService) Push(uri, tag string) error {
+// NestedStacks mocks base method
+func (m *MockdriftDetector) NestedStacks(stackName string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Push", uri, tag)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "NestedStacks", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Push indicates an expected call of Push
-func (mr *MockdockerServiceMockRecorder) Push(uri, tag interface{}) *gomock.Call {
+// NestedStacks indicates an expected call of NestedStacks
+func (mr *MockdriftDetectorMockRecorder) NestedStacks(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockdockerService)(nil).Push), uri, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NestedStacks", reflect.TypeOf((*MockdriftDetector)(nil).NestedStacks), stackName)
 }
 
-// Mockrunner is a mock of runner interface
-type Mockrunner struct {
+// MockstackDescriber is a mock of stackDescriber interface
+type MockstackDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunnerMockRecorder
+	recorder *MockstackDescriberMockRecorder
 }
 
-// MockrunnerMockRecorder is the mock recorder for Mockrunner
-type MockrunnerMockRecorder struct {
-	mock *Mockrunner
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
 }
 
-// NewMockrunner creates a new mock instance
-func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
-	mock := &Mockrunner{ctrl: ctrl}
-	mock.recorder = &MockrunnerMockRecorder{mock}
+// NewMockstackDescriber creates a new mock instance
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method
-func (m *Mockrunner) Run(name string, args []string, options ...command.Option) error {
+// Describe mocks base method
+func (m *MockstackDescriber) Describe(stackName string) (*cloudformation.StackDescription, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{name, args}
-	for _, a := range options {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Run", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Describe", stackName)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Run indicates an expected call of Run
-func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+// Describe indicates an expected call of Describe
+func (mr *MockstackDescriberMockRecorder) Describe(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{name, args}, options...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), stackName)
 }
 
-// MockdefaultSessionProvider is a mock of defaultSessionProvider interface
-type MockdefaultSessionProvider struct {
+// MockappMigrator is a mock of appMigrator interface
+type MockappMigrator struct {
 	ctrl     *gomock.Controller
-	recorder *MockdefaultSessionProviderMockRecorder
+	recorder *MockappMigratorMockRecorder
 }
 
-// MockdefaultSessionProviderMockRecorder is the mock recorder for MockdefaultSessionProvider
-type MockdefaultSessionProviderMockRecorder struct {
-	mock *MockdefaultSessionProvider
+// MockappMigratorMockRecorder is the mock recorder for MockappMigrator
+type MockappMigratorMockRecorder struct {
+	mock *MockappMigrator
 }
 
-// NewMockdefaultSessionProvider creates a new mock instance
-func NewMockdefaultSessionProvider(ctrl *gomock.Controller) *MockdefaultSessionProvider {
-	mock := &MockdefaultSessionProvider{ctrl: ctrl}
-	mock.recorder = &MockdefaultSessionProviderMockRecorder{mock}
+// NewMockappMigrator creates a new mock instance
+func NewMockappMigrator(ctrl *gomock.Controller) *MockappMigrator {
+	mock := &MockappMigrator{ctrl: ctrl}
+	mock.recorder = &MockappMigratorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdefaultSessionProvider) EXPECT() *MockdefaultSessionProviderMockRecorder {
+func (m *MockappMigrator) EXPECT() *MockappMigratorMockRecorder {
 	return m.recorder
 }
 
-// Default mocks base method
-func (m *MockdefaultSessionProvider) Default() (*session.Session, error) {
+// MigrateApplication mocks base method
+func (m *MockappMigrator) MigrateApplication(appName string, dryRun bool) (*config.MigrationResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Default")
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "MigrateApplication", appName, dryRun)
+	ret0, _ := ret[0].(*config.MigrationResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Default indicates an expected call of Default
-func (mr *MockdefaultSessionProviderMockRecorder) Default() *gomock.Call {
+// MigrateApplication indicates an expected call of MigrateApplication
+func (mr *MockappMigratorMockRecorder) MigrateApplication(appName, dryRun interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MockdefaultSessionProvider)(nil).Default))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateApplication", reflect.TypeOf((*MockappMigrator)(nil).MigrateApplication), appName, dryRun)
 }
 
-// MockregionalSessionProvider is a mock of regionalSessionProvider interface
-type MockregionalSessionProvider struct {
+// MocksecretsManager is a mock of secretsManager interface
+type MocksecretsManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockregionalSessionProviderMockRecorder
+	recorder *MocksecretsManagerMockRecorder
 }
 
-// MockregionalSessionProviderMockRecorder is the mock recorder for MockregionalSessionProvider
-type MockregionalSessionProviderMockRecorder struct {
-	mock *MockregionalSessionProvider
+// MocksecretsManagerMockRecorder is the mock recorder for MocksecretsManager
+type MocksecretsManagerMockRecorder struct {
+	mock *MocksecretsManager
 }
 
-// NewMockregionalSessionProvider creates a new mock instance
-func NewMockregionalSessionProvider(ctrl *gomock.Controller) *MockregionalSessionProvider {
-	mock := &MockregionalSessionProvider{ctrl: ctrl}
-	mock.recorder = &MockregionalSessionProviderMockRecorder{mock}
+// NewMocksecretsManager creates a new mock instance
+func NewMocksecretsManager(ctrl *gomock.Controller) *MocksecretsManager {
+	mock := &MocksecretsManager{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockregionalSessionProvider) EXPECT() *MockregionalSessionProviderMockRecorder {
+func (m *MocksecretsManager) EXPECT() *MocksecretsManagerMockRecorder {
 	return m.recorder
 }
 
-// DefaultWithRegion mocks base method
-func (m *MockregionalSessionProvider) DefaultWithRegion(region string) (*session.Session, error) {
+// CreateSecret mocks base method
+func (m *MocksecretsManager) CreateSecret(secretName, secretString string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultWithRegion", region)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DefaultWithRegion indicates an expected call of DefaultWithRegion
-func (mr *MockregionalSessionProviderMockRecorder) DefaultWithRegion(region interface{}) *gomock.Call {
+// CreateSecret indicates an expected call of CreateSecret
+func (mr *MocksecretsManagerMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultWithRegion", reflect.TypeOf((*MockregionalSessionProvider)(nil).DefaultWithRegion), region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MocksecretsManager)(nil).CreateSecret), secretName, secretString)
 }
 
-// MocksessionFromRoleProvider is a mock of sessionFromRoleProvider interface
-type MocksessionFromRoleProvider struct {
+// DeleteSecret mocks base method
+func (m *MocksecretsManager) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MocksecretsManagerMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretsManager)(nil).DeleteSecret), secretName)
+}
+
+// MocksecretCreator is a mock of secretCreator interface
+type MocksecretCreator struct {
 	ctrl     *gomock.Controller
-	recorder *MocksessionFromRoleProviderMockRecorder
+	recorder *MocksecretCreatorMockRecorder
 }
 
-// MocksessionFromRoleProviderMockRecorder is the mock recorder for MocksessionFromRoleProvider
-type MocksessionFromRoleProviderMockRecorder struct {
-	mock *MocksessionFromRoleProvider
+// MocksecretCreatorMockRecorder is the mock recorder for MocksecretCreator
+type MocksecretCreatorMockRecorder struct {
+	mock *MocksecretCreator
 }
 
-// NewMocksessionFromRoleProvider creates a new mock instance
-func NewMocksessionFromRoleProvider(ctrl *gomock.Controller) *MocksessionFromRoleProvider {
-	mock := &MocksessionFromRoleProvider{ctrl: ctrl}
-	mock.recorder = &MocksessionFromRoleProviderMockRecorder{mock}
+// NewMocksecretCreator creates a new mock instance
+func NewMocksecretCreator(ctrl *gomock.Controller) *MocksecretCreator {
+	mock := &MocksecretCreator{ctrl: ctrl}
+	mock.recorder = &MocksecretCreatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksessionFromRoleProvider) EXPECT() *MocksessionFromRoleProviderMockRecorder {
+func (m *MocksecretCreator) EXPECT() *MocksecretCreatorMockRecorder {
 	return m.recorder
 }
 
-// FromRole mocks base method
-func (m *MocksessionFromRoleProvider) FromRole(roleARN, region string) (*session.Session, error) {
+// CreateSecret mocks base method
+func (m *MocksecretCreator) CreateSecret(secretName, secretString string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FromRole", roleARN, region)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "CreateSecret", secretName, secretString)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FromRole indicates an expected call of FromRole
-func (mr *MocksessionFromRoleProviderMockRecorder) FromRole(roleARN, region interface{}) *gomock.Call {
+// CreateSecret indicates an expected call of CreateSecret
+func (mr *MocksecretCreatorMockRecorder) CreateSecret(secretName, secretString interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromRole", reflect.TypeOf((*MocksessionFromRoleProvider)(nil).FromRole), roleARN, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MocksecretCreator)(nil).CreateSecret), secretName, secretString)
 }
 
-// MockprofileNames is a mock of profileNames interface
-type MockprofileNames struct {
+// MocksecretDeleter is a mock of secretDeleter interface
+type MocksecretDeleter struct {
 	ctrl     *gomock.Controller
-	recorder *MockprofileNamesMockRecorder
+	recorder *MocksecretDeleterMockRecorder
 }
 
-// MockprofileNamesMockRecorder is the mock recorder for MockprofileNames
-type MockprofileNamesMockRecorder struct {
-	mock *MockprofileNames
+// MocksecretDeleterMockRecorder is the mock recorder for MocksecretDeleter
+type MocksecretDeleterMockRecorder struct {
+	mock *MocksecretDeleter
 }
 
-// NewMockprofileNames creates a new mock instance
-func NewMockprofileNames(ctrl *gomock.Controller) *MockprofileNames {
-	mock := &MockprofileNames{ctrl: ctrl}
-	mock.recorder = &MockprofileNamesMockRecorder{mock}
+// NewMocksecretDeleter creates a new mock instance
+func NewMocksecretDeleter(ctrl *gomock.Controller) *MocksecretDeleter {
+	mock := &MocksecretDeleter{ctrl: ctrl}
+	mock.recorder = &MocksecretDeleterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockprofileNames) EXPECT() *MockprofileNamesMockRecorder {
+func (m *MocksecretDeleter) EXPECT() *MocksecretDeleterMockRecorder {
 	return m.recorder
 }
 
-// Names mocks base method
-func (m *MockprofileNames) Names() []string {
+// DeleteSecret mocks base method
+func (m *MocksecretDeleter) DeleteSecret(secretName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Names")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Names indicates an expected call of Names
-func (mr *MockprofileNamesMockRecorder) Names() *gomock.Call {
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MocksecretDeleterMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Names", reflect.TypeOf((*MockprofileNames)(nil).Names))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretDeleter)(nil).DeleteSecret), secretName)
 }
 
-// MocksessionProvider is a mock of sessionProvider interface
-type MocksessionProvider struct {
+// MockecrService is a mock of ecrService interface
+type MockecrService struct {
 	ctrl     *gomock.Controller
-	recorder *MocksessionProviderMockRecorder
+	recorder *MockecrServiceMockRecorder
 }
 
-// MocksessionProviderMockRecorder is the mock recorder for MocksessionProvider
-type MocksessionProviderMockRecorder struct {
-	mock *MocksessionProvider
+// MockecrServiceMockRecorder is the mock recorder for MockecrService
+type MockecrServiceMockRecorder struct {
+	mock *MockecrService
 }
 
-// NewMocksessionProvider creates a new mock instance
-func NewMocksessionProvider(ctrl *gomock.Controller) *MocksessionProvider {
-	mock := &MocksessionProvider{ctrl: ctrl}
-	mock.recorder = &MocksessionProviderMockRecorder{mock}
+// NewMockecrService creates a new mock instance
+func NewMockecrService(ctrl *gomock.Controller) *MockecrService {
+	mock := &MockecrService{ctrl: ctrl}
+	mock.recorder = &MockecrServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksessionProvider) EXPECT() *MocksessionProviderMockRecorder {
+func (m *MockecrService) EXPECT() *MockecrServiceMockRecorder {
 	return m.recorder
 }
 
-// Default mocks base method
-func (m *MocksessionProvider) Default() (*session.Session, error) {
+// GetRepository mocks base method
+func (m *MockecrService) GetRepository(name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Default")
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "GetRepository", name)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Default indicates an expected call of Default
-func (mr *MocksessionProviderMockRecorder) Default() *gomock.Call {
+// GetRepository indicates an expected call of GetRepository
+func (mr *MockecrServiceMockRecorder) GetRepository(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MocksessionProvider)(nil).Default))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockecrService)(nil).GetRepository), name)
 }
 
-// DefaultWithRegion mocks base method
-func (m *MocksessionProvider) DefaultWithRegion(region string) (*session.Session, error) {
+// GetECRAuth mocks base method
+func (m *MockecrService) GetECRAuth() (ecr.Auth, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultWithRegion", region)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "GetECRAuth")
+	ret0, _ := ret[0].(ecr.Auth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DefaultWithRegion indicates an expected call of DefaultWithRegion
-func (mr *MocksessionProviderMockRecorder) DefaultWithRegion(region interface{}) *gomock.Call {
+// GetECRAuth indicates an expected call of GetECRAuth
+func (mr *MockecrServiceMockRecorder) GetECRAuth() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultWithRegion", reflect.TypeOf((*MocksessionProvider)(nil).DefaultWithRegion), region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetECRAuth", reflect.TypeOf((*MockecrService)(nil).GetECRAuth))
 }
 
-// FromRole mocks base method
-func (m *MocksessionProvider) FromRole(roleARN, region string) (*session.Session, error) {
+// ImageDigest mocks base method
+func (m *MockecrService) ImageDigest(repoName, tag string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FromRole", roleARN, region)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "ImageDigest", repoName, tag)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FromRole indicates an expected call of FromRole
-func (mr *MocksessionProviderMockRecorder) FromRole(roleARN, region interface{}) *gomock.Call {
+// ImageDigest indicates an expected call of ImageDigest
+func (mr *MockecrServiceMockRecorder) ImageDigest(repoName, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromRole", reflect.TypeOf((*MocksessionProvider)(nil).FromRole), roleARN, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockecrService)(nil).ImageDigest), repoName, tag)
 }
 
-// Mockdescriber is a mock of describer interface
-type Mockdescriber struct {
+// ListImages mocks base method
+func (m *MockecrService) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages
+func (mr *MockecrServiceMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockecrService)(nil).ListImages), repoName)
+}
+
+// MockcwlogService is a mock of cwlogService interface
+type MockcwlogService struct {
 	ctrl     *gomock.Controller
-	recorder *MockdescriberMockRecorder
+	recorder *MockcwlogServiceMockRecorder
 }
 
-// MockdescriberMockRecorder is the mock recorder for Mockdescriber
-type MockdescriberMockRecorder struct {
-	mock *Mockdescriber
+// MockcwlogServiceMockRecorder is the mock recorder for MockcwlogService
+type MockcwlogServiceMockRecorder struct {
+	mock *MockcwlogService
 }
 
-// NewMockdescriber creates a new mock instance
-func NewMockdescriber(ctrl *gomock.Controller) *Mockdescriber {
-	mock := &Mockdescriber{ctrl: ctrl}
-	mock.recorder = &MockdescriberMockRecorder{mock}
+// NewMockcwlogService creates a new mock instance
+func NewMockcwlogService(ctrl *gomock.Controller) *MockcwlogService {
+	mock := &MockcwlogService{ctrl: ctrl}
+	mock.recorder = &MockcwlogServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockdescriber) EXPECT() *MockdescriberMockRecorder {
+func (m *MockcwlogService) EXPECT() *MockcwlogServiceMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method
-func (m *Mockdescriber) Describe() (describe.HumanJSONStringer, error) {
+// TaskLogEvents mocks base method
+func (m *MockcwlogService) TaskLogEvents(logGroupName string, streamLastEventTime map[string]int64, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	varargs := []interface{}{logGroupName, streamLastEventTime}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TaskLogEvents", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe
-func (mr *MockdescriberMockRecorder) Describe() *gomock.Call {
+// TaskLogEvents indicates an expected call of TaskLogEvents
+func (mr *MockcwlogServiceMockRecorder) TaskLogEvents(logGroupName, streamLastEventTime interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockdescriber)(nil).Describe))
+	varargs := append([]interface{}{logGroupName, streamLastEventTime}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskLogEvents", reflect.TypeOf((*MockcwlogService)(nil).TaskLogEvents), varargs...)
 }
 
-// MockwsFileDeleter is a mock of wsFileDeleter interface
-type MockwsFileDeleter struct {
+// LogGroupExists mocks base method
+func (m *MockcwlogService) LogGroupExists(logGroupName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupExists", logGroupName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupExists indicates an expected call of LogGroupExists
+func (mr *MockcwlogServiceMockRecorder) LogGroupExists(logGroupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockcwlogService)(nil).LogGroupExists), logGroupName)
+}
+
+// Mocktemplater is a mock of templater interface
+type Mocktemplater struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsFileDeleterMockRecorder
+	recorder *MocktemplaterMockRecorder
 }
 
-// MockwsFileDeleterMockRecorder is the mock recorder for MockwsFileDeleter
-type MockwsFileDeleterMockRecorder struct {
-	mock *MockwsFileDeleter
+// MocktemplaterMockRecorder is the mock recorder for Mocktemplater
+type MocktemplaterMockRecorder struct {
+	mock *Mocktemplater
 }
 
-// NewMockwsFileDeleter creates a new mock instance
-func NewMockwsFileDeleter(ctrl *gomock.Controller) *MockwsFileDeleter {
-	mock := &MockwsFileDeleter{ctrl: ctrl}
-	mock.recorder = &MockwsFileDeleterMockRecorder{mock}
+// NewMocktemplater creates a new mock instance
+func NewMocktemplater(ctrl *gomock.Controller) *Mocktemplater {
+	mock := &Mocktemplater{ctrl: ctrl}
+	mock.recorder = &MocktemplaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsFileDeleter) EXPECT() *MockwsFileDeleterMockRecorder {
+func (m *Mocktemplater) EXPECT() *MocktemplaterMockRecorder {
 	return m.recorder
 }
 
-// DeleteWorkspaceFile mocks base method
-func (m *MockwsFileDeleter) DeleteWorkspaceFile() error {
+// Template mocks base method
+func (m *Mocktemplater) Template() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorkspaceFile")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWorkspaceFile indicates an expected call of DeleteWorkspaceFile
-func (mr *MockwsFileDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
+// Template indicates an expected call of Template
+func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsFileDeleter)(nil).DeleteWorkspaceFile))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
 }
 
-// MocksvcManifestReader is a mock of svcManifestReader interface
-type MocksvcManifestReader struct {
+// MockstackSerializer is a mock of stackSerializer interface
+type MockstackSerializer struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcManifestReaderMockRecorder
+	recorder *MockstackSerializerMockRecorder
 }
 
-// MocksvcManifestReaderMockRecorder is the mock recorder for MocksvcManifestReader
-type MocksvcManifestReaderMockRecorder struct {
-	mock *MocksvcManifestReader
+// MockstackSerializerMockRecorder is the mock recorder for MockstackSerializer
+type MockstackSerializerMockRecorder struct {
+	mock *MockstackSerializer
 }
 
-// NewMocksvcManifestReader creates a new mock instance
-func NewMocksvcManifestReader(ctrl *gomock.Controller) *MocksvcManifestReader {
-	mock := &MocksvcManifestReader{ctrl: ctrl}
-	mock.recorder = &MocksvcManifestReaderMockRecorder{mock}
+// NewMockstackSerializer creates a new mock instance
+func NewMockstackSerializer(ctrl *gomock.Controller) *MockstackSerializer {
+	mock := &MockstackSerializer{ctrl: ctrl}
+	mock.recorder = &MockstackSerializerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcManifestReader) EXPECT() *MocksvcManifestReaderMockRecorder {
+func (m *MockstackSerializer) EXPECT() *MockstackSerializerMockRecorder {
 	return m.recorder
 }
 
-// ReadServiceManifest mocks base method
-func (m *MocksvcManifestReader) ReadServiceManifest(svcName string) ([]byte, error) {
+// Template mocks base method
+func (m *MockstackSerializer) Template() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadServiceManifest indicates an expected call of ReadServiceManifest
-func (mr *MocksvcManifestReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+// Template indicates an expected call of Template
+func (mr *MockstackSerializerMockRecorder) Template() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MocksvcManifestReader)(nil).ReadServiceManifest), svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackSerializer)(nil).Template))
 }
 
-// MocksvcManifestWriter is a mock of svcManifestWriter interface
-type MocksvcManifestWriter struct {
+// SerializedParameters mocks base method
+func (m *MockstackSerializer) SerializedParameters() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializedParameters")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializedParameters indicates an expected call of SerializedParameters
+func (mr *MockstackSerializerMockRecorder) SerializedParameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackSerializer)(nil).SerializedParameters))
+}
+
+// MockimageBuilder is a mock of imageBuilder interface
+type MockimageBuilder struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcManifestWriterMockRecorder
+	recorder *MockimageBuilderMockRecorder
 }
 
-// MocksvcManifestWriterMockRecorder is the mock recorder for MocksvcManifestWriter
-type MocksvcManifestWriterMockRecorder struct {
-	mock *MocksvcManifestWriter
+// MockimageBuilderMockRecorder is the mock recorder for MockimageBuilder
+type MockimageBuilderMockRecorder struct {
+	mock *MockimageBuilder
 }
 
-// NewMocksvcManifestWriter creates a new mock instance
-func NewMocksvcManifestWriter(ctrl *gomock.Controller) *MocksvcManifestWriter {
-	mock := &MocksvcManifestWriter{ctrl: ctrl}
-	mock.recorder = &MocksvcManifestWriterMockRecorder{mock}
+// NewMockimageBuilder creates a new mock instance
+func NewMockimageBuilder(ctrl *gomock.Controller) *MockimageBuilder {
+	mock := &MockimageBuilder{ctrl: ctrl}
+	mock.recorder = &MockimageBuilderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcManifestWriter) EXPECT() *MocksvcManifestWriterMockRecorder {
+func (m *MockimageBuilder) EXPECT() *MockimageBuilderMockRecorder {
 	return m.recorder
 }
 
-// WriteServiceManifest mocks base method
-func (m *MocksvcManifestWriter) WriteServiceManifest(marshaler encoding.BinaryMarshaler, svcName string) (string, error) {
+// Build mocks base method
+func (m *MockimageBuilder) Build(uri, tag, path string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteServiceManifest", marshaler, svcName)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Build", uri, tag, path)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// WriteServiceManifest indicates an expected call of WriteServiceManifest
-func (mr *MocksvcManifestWriterMockRecorder) WriteServiceManifest(marshaler, svcName interface{}) *gomock.Call {
+// Build indicates an expected call of Build
+func (mr *MockimageBuilderMockRecorder) Build(uri, tag, path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteServiceManifest", reflect.TypeOf((*MocksvcManifestWriter)(nil).WriteServiceManifest), marshaler, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockimageBuilder)(nil).Build), uri, tag, path)
 }
 
-// MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface
-type MockwsPipelineManifestReader struct {
-	ctrl     *gomock.Controller
-	recorder *MockwsPipelineManifestReaderMockRecorder
-}
-
-// MockwsPipelineManifestReaderMockRecorder is the mock recorder for MockwsPipelineManifestReader
-type MockwsPipelineManifestReaderMockRecorder struct {
-	mock *MockwsPipelineManifestReader
-}
-
-// NewMockwsPipelineManifestReader creates a new mock instance
-func NewMockwsPipelineManifestReader(ctrl *gomock.Controller) *MockwsPipelineManifestReader {
-	mock := &MockwsPipelineManifestReader{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineManifestReaderMockRecorder{mock}
-	return mock
+// Login mocks base method
+func (m *MockimageBuilder) Login(uri, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", uri, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMockRecorder {
-	return m.recorder
+// Login indicates an expected call of Login
+func (mr *MockimageBuilderMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockimageBuilder)(nil).Login), uri, username, password)
 }
 
-// ReadPipelineManifest mocks base method
-func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+// Push mocks base method
+func (m *MockimageBuilder) Push(uri, tag string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Push", uri, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+// Push indicates an expected call of Push
+func (mr *MockimageBuilderMockRecorder) Push(uri, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockimageBuilder)(nil).Push), uri, tag)
 }
 
-// MockwsPipelineWriter is a mock of wsPipelineWriter interface
-type MockwsPipelineWriter struct {
-	ctrl     *gomock.Controller
-	recorder *MockwsPipelineWriterMockRecorder
+// Tag mocks base method
+func (m *MockimageBuilder) Tag(uri, tag, targetURI string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tag", uri, tag, targetURI)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockwsPipelineWriterMockRecorder is the mock recorder for MockwsPipelineWriter
-type MockwsPipelineWriterMockRecorder struct {
-	mock *MockwsPipelineWriter
+// Tag indicates an expected call of Tag
+func (mr *MockimageBuilderMockRecorder) Tag(uri, tag, targetURI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockimageBuilder)(nil).Tag), uri, tag, targetURI)
 }
 
-// NewMockwsPipelineWriter creates a new mock instance
-func NewMockwsPipelineWriter(ctrl *gomock.Controller) *MockwsPipelineWriter {
-	mock := &MockwsPipelineWriter{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineWriterMockRecorder{mock}
-	return mock
+// RepoDigest mocks base method
+func (m *MockimageBuilder) RepoDigest(uri, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepoDigest", uri, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
-	return m.recorder
+// RepoDigest indicates an expected call of RepoDigest
+func (mr *MockimageBuilderMockRecorder) RepoDigest(uri, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepoDigest", reflect.TypeOf((*MockimageBuilder)(nil).RepoDigest), uri, tag)
 }
 
-// WritePipelineBuildspec mocks base method
-func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+// BuildPlatforms mocks base method
+func (m *MockimageBuilder) BuildPlatforms(uris []string, tag, path string, platforms []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "BuildPlatforms", uris, tag, path, platforms)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+// BuildPlatforms indicates an expected call of BuildPlatforms
+func (mr *MockimageBuilderMockRecorder) BuildPlatforms(uris, tag, path, platforms interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildPlatforms", reflect.TypeOf((*MockimageBuilder)(nil).BuildPlatforms), uris, tag, path, platforms)
 }
 
-// WritePipelineManifest mocks base method
-func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+// IndexDigest mocks base method
+func (m *MockimageBuilder) IndexDigest(uri, tag string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret := m.ctrl.Call(m, "IndexDigest", uri, tag)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WritePipelineManifest indicates an expected call of WritePipelineManifest
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+// IndexDigest indicates an expected call of IndexDigest
+func (mr *MockimageBuilderMockRecorder) IndexDigest(uri, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexDigest", reflect.TypeOf((*MockimageBuilder)(nil).IndexDigest), uri, tag)
 }
 
-// MockwsServiceLister is a mock of wsServiceLister interface
-type MockwsServiceLister struct {
+// Mockrunner is a mock of runner interface
+type Mockrunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsServiceListerMockRecorder
+	recorder *MockrunnerMockRecorder
 }
 
-// MockwsServiceListerMockRecorder is the mock recorder for MockwsServiceLister
-type MockwsServiceListerMockRecorder struct {
-	mock *MockwsServiceLister
+// MockrunnerMockRecorder is the mock recorder for Mockrunner
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
 }
 
-// NewMockwsServiceLister creates a new mock instance
-func NewMockwsServiceLister(ctrl *gomock.Controller) *MockwsServiceLister {
-	mock := &MockwsServiceLister{ctrl: ctrl}
-	mock.recorder = &MockwsServiceListerMockRecorder{mock}
+// NewMockrunner creates a new mock instance
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsServiceLister) EXPECT() *MockwsServiceListerMockRecorder {
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
 	return m.recorder
 }
 
-// ServiceNames mocks base method
-func (m *MockwsServiceLister) ServiceNames() ([]string, error) {
+// Run mocks base method
+func (m *Mockrunner) Run(name string, args []string, options ...command.Option) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceNames")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ServiceNames indicates an expected call of ServiceNames
-func (mr *MockwsServiceListerMockRecorder) ServiceNames() *gomock.Call {
+// Run indicates an expected call of Run
+func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsServiceLister)(nil).ServiceNames))
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
 }
 
-// MockwsSvcReader is a mock of wsSvcReader interface
-type MockwsSvcReader struct {
+// MockdefaultSessionProvider is a mock of defaultSessionProvider interface
+type MockdefaultSessionProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsSvcReaderMockRecorder
+	recorder *MockdefaultSessionProviderMockRecorder
 }
 
-// MockwsSvcReaderMockRecorder is the mock recorder for MockwsSvcReader
-type MockwsSvcReaderMockRecorder struct {
-	mock *MockwsSvcReader
+// MockdefaultSessionProviderMockRecorder is the mock recorder for MockdefaultSessionProvider
+type MockdefaultSessionProviderMockRecorder struct {
+	mock *MockdefaultSessionProvider
 }
 
-// NewMockwsSvcReader creates a new mock instance
-func NewMockwsSvcReader(ctrl *gomock.Controller) *MockwsSvcReader {
-	mock := &MockwsSvcReader{ctrl: ctrl}
-	mock.recorder = &MockwsSvcReaderMockRecorder{mock}
+// NewMockdefaultSessionProvider creates a new mock instance
+func NewMockdefaultSessionProvider(ctrl *gomock.Controller) *MockdefaultSessionProvider {
+	mock := &MockdefaultSessionProvider{ctrl: ctrl}
+	mock.recorder = &MockdefaultSessionProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsSvcReader) EXPECT() *MockwsSvcReaderMockRecorder {
+func (m *MockdefaultSessionProvider) EXPECT() *MockdefaultSessionProviderMockRecorder {
 	return m.recorder
 }
 
-// ServiceNames mocks base method
-func (m *MockwsSvcReader) ServiceNames() ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceNames")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ServiceNames indicates an expected call of ServiceNames
-func (mr *MockwsSvcReaderMockRecorder) ServiceNames() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsSvcReader)(nil).ServiceNames))
-}
-
-// ReadServiceManifest mocks base method
-func (m *MockwsSvcReader) ReadServiceManifest(svcName string) ([]byte, error) {
+// Default mocks base method
+func (m *MockdefaultSessionProvider) Default() (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Default")
+	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadServiceManifest indicates an expected call of ReadServiceManifest
-func (mr *MockwsSvcReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+// Default indicates an expected call of Default
+func (mr *MockdefaultSessionProviderMockRecorder) Default() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceManifest), svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MockdefaultSessionProvider)(nil).Default))
 }
 
-// MockwsPipelineReader is a mock of wsPipelineReader interface
-type MockwsPipelineReader struct {
+// MockregionalSessionProvider is a mock of regionalSessionProvider interface
+type MockregionalSessionProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineReaderMockRecorder
+	recorder *MockregionalSessionProviderMockRecorder
 }
 
-// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader
-type MockwsPipelineReaderMockRecorder struct {
-	mock *MockwsPipelineReader
+// MockregionalSessionProviderMockRecorder is the mock recorder for MockregionalSessionProvider
+type MockregionalSessionProviderMockRecorder struct {
+	mock *MockregionalSessionProvider
 }
 
-// NewMockwsPipelineReader creates a new mock instance
-func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
-	mock := &MockwsPipelineReader{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
+// NewMockregionalSessionProvider creates a new mock instance
+func NewMockregionalSessionProvider(ctrl *gomock.Controller) *MockregionalSessionProvider {
+	mock := &MockregionalSessionProvider{ctrl: ctrl}
+	mock.recorder = &MockregionalSessionProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
+func (m *MockregionalSessionProvider) EXPECT() *MockregionalSessionProviderMockRecorder {
 	return m.recorder
 }
 
-// ServiceNames mocks base method
-func (m *MockwsPipelineReader) ServiceNames() ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceNames")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ServiceNames indicates an expected call of ServiceNames
-func (mr *MockwsPipelineReaderMockRecorder) ServiceNames() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineReader)(nil).ServiceNames))
-}
-
-// ReadPipelineManifest mocks base method
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+// DefaultWithRegion mocks base method
+func (m *MockregionalSessionProvider) DefaultWithRegion(region string) (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "DefaultWithRegion", region)
+	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+// DefaultWithRegion indicates an expected call of DefaultWithRegion
+func (mr *MockregionalSessionProviderMockRecorder) DefaultWithRegion(region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultWithRegion", reflect.TypeOf((*MockregionalSessionProvider)(nil).DefaultWithRegion), region)
 }
 
-// MockwsAppManager is a mock of wsAppManager interface
-type MockwsAppManager struct {
+// MocksessionFromRoleProvider is a mock of sessionFromRoleProvider interface
+type MocksessionFromRoleProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsAppManagerMockRecorder
+	recorder *MocksessionFromRoleProviderMockRecorder
 }
 
-// MockwsAppManagerMockRecorder is the mock recorder for MockwsAppManager
-type MockwsAppManagerMockRecorder struct {
-	mock *MockwsAppManager
+// MocksessionFromRoleProviderMockRecorder is the mock recorder for MocksessionFromRoleProvider
+type MocksessionFromRoleProviderMockRecorder struct {
+	mock *MocksessionFromRoleProvider
 }
 
-// NewMockwsAppManager creates a new mock instance
-func NewMockwsAppManager(ctrl *gomock.Controller) *MockwsAppManager {
-	mock := &MockwsAppManager{ctrl: ctrl}
-	mock.recorder = &MockwsAppManagerMockRecorder{mock}
+// NewMocksessionFromRoleProvider creates a new mock instance
+func NewMocksessionFromRoleProvider(ctrl *gomock.Controller) *MocksessionFromRoleProvider {
+	mock := &MocksessionFromRoleProvider{ctrl: ctrl}
+	mock.recorder = &MocksessionFromRoleProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsAppManager) EXPECT() *MockwsAppManagerMockRecorder {
+func (m *MocksessionFromRoleProvider) EXPECT() *MocksessionFromRoleProviderMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method
-func (m *MockwsAppManager) Create(appName string) error {
+// FromRole mocks base method
+func (m *MocksessionFromRoleProvider) FromRole(roleARN, region string) (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", appName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FromRole", roleARN, region)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Create indicates an expected call of Create
-func (mr *MockwsAppManagerMockRecorder) Create(appName interface{}) *gomock.Call {
+// FromRole indicates an expected call of FromRole
+func (mr *MocksessionFromRoleProviderMockRecorder) FromRole(roleARN, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockwsAppManager)(nil).Create), appName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromRole", reflect.TypeOf((*MocksessionFromRoleProvider)(nil).FromRole), roleARN, region)
 }
 
-// Summary mocks base method
-func (m *MockwsAppManager) Summary() (*workspace.Summary, error) {
+// MockprofileNames is a mock of profileNames interface
+type MockprofileNames struct {
+	ctrl     *gomock.Controller
+	recorder *MockprofileNamesMockRecorder
+}
+
+// MockprofileNamesMockRecorder is the mock recorder for MockprofileNames
+type MockprofileNamesMockRecorder struct {
+	mock *MockprofileNames
+}
+
+// NewMockprofileNames creates a new mock instance
+func NewMockprofileNames(ctrl *gomock.Controller) *MockprofileNames {
+	mock := &MockprofileNames{ctrl: ctrl}
+	mock.recorder = &MockprofileNamesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockprofileNames) EXPECT() *MockprofileNamesMockRecorder {
+	return m.recorder
+}
+
+// Names mocks base method
+func (m *MockprofileNames) Names() []string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Summary")
-	ret0, _ := ret[0].(*workspace.Summary)
+	ret := m.ctrl.Call(m, "Names")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Names indicates an expected call of Names
+func (mr *MockprofileNamesMockRecorder) Names() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Names", reflect.TypeOf((*MockprofileNames)(nil).Names))
+}
+
+// MockvpcSubnetLister is a mock of vpcSubnetLister interface
+type MockvpcSubnetLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcSubnetListerMockRecorder
+}
+
+// MockvpcSubnetListerMockRecorder is the mock recorder for MockvpcSubnetLister
+type MockvpcSubnetListerMockRecorder struct {
+	mock *MockvpcSubnetLister
+}
+
+// NewMockvpcSubnetLister creates a new mock instance
+func NewMockvpcSubnetLister(ctrl *gomock.Controller) *MockvpcSubnetLister {
+	mock := &MockvpcSubnetLister{ctrl: ctrl}
+	mock.recorder = &MockvpcSubnetListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockvpcSubnetLister) EXPECT() *MockvpcSubnetListerMockRecorder {
+	return m.recorder
+}
+
+// ListVPCs mocks base method
+func (m *MockvpcSubnetLister) ListVPCs() ([]ec2.VPC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCs")
+	ret0, _ := ret[0].([]ec2.VPC)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Summary indicates an expected call of Summary
-func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
+// ListVPCs indicates an expected call of ListVPCs
+func (mr *MockvpcSubnetListerMockRecorder) ListVPCs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockvpcSubnetLister)(nil).ListVPCs))
 }
 
-// MockwsAddonManager is a mock of wsAddonManager interface
-type MockwsAddonManager struct {
+// ListSubnets mocks base method
+func (m *MockvpcSubnetLister) ListSubnets(vpcID string) ([]ec2.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnets", vpcID)
+	ret0, _ := ret[0].([]ec2.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnets indicates an expected call of ListSubnets
+func (mr *MockvpcSubnetListerMockRecorder) ListSubnets(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnets", reflect.TypeOf((*MockvpcSubnetLister)(nil).ListSubnets), vpcID)
+}
+
+// MocksessionProvider is a mock of sessionProvider interface
+type MocksessionProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsAddonManagerMockRecorder
+	recorder *MocksessionProviderMockRecorder
 }
 
-// MockwsAddonManagerMockRecorder is the mock recorder for MockwsAddonManager
-type MockwsAddonManagerMockRecorder struct {
-	mock *MockwsAddonManager
+// MocksessionProviderMockRecorder is the mock recorder for MocksessionProvider
+type MocksessionProviderMockRecorder struct {
+	mock *MocksessionProvider
 }
 
-// NewMockwsAddonManager creates a new mock instance
-func NewMockwsAddonManager(ctrl *gomock.Controller) *MockwsAddonManager {
-	mock := &MockwsAddonManager{ctrl: ctrl}
-	mock.recorder = &MockwsAddonManagerMockRecorder{mock}
+// NewMocksessionProvider creates a new mock instance
+func NewMocksessionProvider(ctrl *gomock.Controller) *MocksessionProvider {
+	mock := &MocksessionProvider{ctrl: ctrl}
+	mock.recorder = &MocksessionProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsAddonManager) EXPECT() *MockwsAddonManagerMockRecorder {
+func (m *MocksessionProvider) EXPECT() *MocksessionProviderMockRecorder {
 	return m.recorder
 }
 
-// WriteAddon mocks base method
-func (m *MockwsAddonManager) WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error) {
+// Default mocks base method
+func (m *MocksessionProvider) Default() (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteAddon", f, svc, name)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Default")
+	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WriteAddon indicates an expected call of WriteAddon
-func (mr *MockwsAddonManagerMockRecorder) WriteAddon(f, svc, name interface{}) *gomock.Call {
+// Default indicates an expected call of Default
+func (mr *MocksessionProviderMockRecorder) Default() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteAddon), f, svc, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MocksessionProvider)(nil).Default))
 }
 
-// ServiceNames mocks base method
-func (m *MockwsAddonManager) ServiceNames() ([]string, error) {
+// DefaultWithRegion mocks base method
+func (m *MocksessionProvider) DefaultWithRegion(region string) (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceNames")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "DefaultWithRegion", region)
+	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ServiceNames indicates an expected call of ServiceNames
-func (mr *MockwsAddonManagerMockRecorder) ServiceNames() *gomock.Call {
+// DefaultWithRegion indicates an expected call of DefaultWithRegion
+func (mr *MocksessionProviderMockRecorder) DefaultWithRegion(region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultWithRegion", reflect.TypeOf((*MocksessionProvider)(nil).DefaultWithRegion), region)
+}
+
+// FromRole mocks base method
+func (m *MocksessionProvider) FromRole(roleARN, region string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromRole", roleARN, region)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FromRole indicates an expected call of FromRole
+func (mr *MocksessionProviderMockRecorder) FromRole(roleARN, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromRole", reflect.TypeOf((*MocksessionProvider)(nil).FromRole), roleARN, region)
+}
+
+// Mockdescriber is a mock of describer interface
+type Mockdescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdescriberMockRecorder
+}
+
+// MockdescriberMockRecorder is the mock recorder for Mockdescriber
+type MockdescriberMockRecorder struct {
+	mock *Mockdescriber
+}
+
+// NewMockdescriber creates a new mock instance
+func NewMockdescriber(ctrl *gomock.Controller) *Mockdescriber {
+	mock := &Mockdescriber{ctrl: ctrl}
+	mock.recorder = &MockdescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockdescriber) EXPECT() *MockdescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *Mockdescriber) Describe() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockdescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockdescriber)(nil).Describe))
+}
+
+// MockwsFileDeleter is a mock of wsFileDeleter interface
+type MockwsFileDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsFileDeleterMockRecorder
+}
+
+// MockwsFileDeleterMockRecorder is the mock recorder for MockwsFileDeleter
+type MockwsFileDeleterMockRecorder struct {
+	mock *MockwsFileDeleter
+}
+
+// NewMockwsFileDeleter creates a new mock instance
+func NewMockwsFileDeleter(ctrl *gomock.Controller) *MockwsFileDeleter {
+	mock := &MockwsFileDeleter{ctrl: ctrl}
+	mock.recorder = &MockwsFileDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsFileDeleter) EXPECT() *MockwsFileDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkspaceFile mocks base method
+func (m *MockwsFileDeleter) DeleteWorkspaceFile() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceFile")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceFile indicates an expected call of DeleteWorkspaceFile
+func (mr *MockwsFileDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsFileDeleter)(nil).DeleteWorkspaceFile))
+}
+
+// MocksvcManifestReader is a mock of svcManifestReader interface
+type MocksvcManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcManifestReaderMockRecorder
+}
+
+// MocksvcManifestReaderMockRecorder is the mock recorder for MocksvcManifestReader
+type MocksvcManifestReaderMockRecorder struct {
+	mock *MocksvcManifestReader
+}
+
+// NewMocksvcManifestReader creates a new mock instance
+func NewMocksvcManifestReader(ctrl *gomock.Controller) *MocksvcManifestReader {
+	mock := &MocksvcManifestReader{ctrl: ctrl}
+	mock.recorder = &MocksvcManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcManifestReader) EXPECT() *MocksvcManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadServiceManifest mocks base method
+func (m *MocksvcManifestReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MocksvcManifestReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MocksvcManifestReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceManifestPath mocks base method
+func (m *MocksvcManifestReader) ServiceManifestPath(svcName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceManifestPath", svcName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceManifestPath indicates an expected call of ServiceManifestPath
+func (mr *MocksvcManifestReaderMockRecorder) ServiceManifestPath(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceManifestPath", reflect.TypeOf((*MocksvcManifestReader)(nil).ServiceManifestPath), svcName)
+}
+
+// MockfileUpdater is a mock of fileUpdater interface
+type MockfileUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockfileUpdaterMockRecorder
+}
+
+// MockfileUpdaterMockRecorder is the mock recorder for MockfileUpdater
+type MockfileUpdaterMockRecorder struct {
+	mock *MockfileUpdater
+}
+
+// NewMockfileUpdater creates a new mock instance
+func NewMockfileUpdater(ctrl *gomock.Controller) *MockfileUpdater {
+	mock := &MockfileUpdater{ctrl: ctrl}
+	mock.recorder = &MockfileUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockfileUpdater) EXPECT() *MockfileUpdaterMockRecorder {
+	return m.recorder
+}
+
+// ApplyUpdate mocks base method
+func (m *MockfileUpdater) ApplyUpdate(update *workspace.FileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUpdate", update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyUpdate indicates an expected call of ApplyUpdate
+func (mr *MockfileUpdaterMockRecorder) ApplyUpdate(update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUpdate", reflect.TypeOf((*MockfileUpdater)(nil).ApplyUpdate), update)
+}
+
+// MocksvcManifestWriter is a mock of svcManifestWriter interface
+type MocksvcManifestWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcManifestWriterMockRecorder
+}
+
+// MocksvcManifestWriterMockRecorder is the mock recorder for MocksvcManifestWriter
+type MocksvcManifestWriterMockRecorder struct {
+	mock *MocksvcManifestWriter
+}
+
+// NewMocksvcManifestWriter creates a new mock instance
+func NewMocksvcManifestWriter(ctrl *gomock.Controller) *MocksvcManifestWriter {
+	mock := &MocksvcManifestWriter{ctrl: ctrl}
+	mock.recorder = &MocksvcManifestWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcManifestWriter) EXPECT() *MocksvcManifestWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteServiceManifest mocks base method
+func (m *MocksvcManifestWriter) WriteServiceManifest(marshaler encoding.BinaryMarshaler, svcName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteServiceManifest", marshaler, svcName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteServiceManifest indicates an expected call of WriteServiceManifest
+func (mr *MocksvcManifestWriterMockRecorder) WriteServiceManifest(marshaler, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteServiceManifest", reflect.TypeOf((*MocksvcManifestWriter)(nil).WriteServiceManifest), marshaler, svcName)
+}
+
+// UpdateServiceManifest mocks base method
+func (m *MocksvcManifestWriter) UpdateServiceManifest(marshaler encoding.BinaryMarshaler, svcName string) (*workspace.FileUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceManifest", marshaler, svcName)
+	ret0, _ := ret[0].(*workspace.FileUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceManifest indicates an expected call of UpdateServiceManifest
+func (mr *MocksvcManifestWriterMockRecorder) UpdateServiceManifest(marshaler, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceManifest", reflect.TypeOf((*MocksvcManifestWriter)(nil).UpdateServiceManifest), marshaler, svcName)
+}
+
+// ApplyUpdate mocks base method
+func (m *MocksvcManifestWriter) ApplyUpdate(update *workspace.FileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUpdate", update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyUpdate indicates an expected call of ApplyUpdate
+func (mr *MocksvcManifestWriterMockRecorder) ApplyUpdate(update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUpdate", reflect.TypeOf((*MocksvcManifestWriter)(nil).ApplyUpdate), update)
+}
+
+// MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface
+type MockwsPipelineManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineManifestReaderMockRecorder
+}
+
+// MockwsPipelineManifestReaderMockRecorder is the mock recorder for MockwsPipelineManifestReader
+type MockwsPipelineManifestReaderMockRecorder struct {
+	mock *MockwsPipelineManifestReader
+}
+
+// NewMockwsPipelineManifestReader creates a new mock instance
+func NewMockwsPipelineManifestReader(ctrl *gomock.Controller) *MockwsPipelineManifestReader {
+	mock := &MockwsPipelineManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadPipelineManifest mocks base method
+func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+}
+
+// MockwsPipelineWriter is a mock of wsPipelineWriter interface
+type MockwsPipelineWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineWriterMockRecorder
+}
+
+// MockwsPipelineWriterMockRecorder is the mock recorder for MockwsPipelineWriter
+type MockwsPipelineWriterMockRecorder struct {
+	mock *MockwsPipelineWriter
+}
+
+// NewMockwsPipelineWriter creates a new mock instance
+func NewMockwsPipelineWriter(ctrl *gomock.Controller) *MockwsPipelineWriter {
+	mock := &MockwsPipelineWriter{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
+	return m.recorder
+}
+
+// AppDirRelPath mocks base method
+func (m *MockwsPipelineWriter) AppDirRelPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppDirRelPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppDirRelPath indicates an expected call of AppDirRelPath
+func (mr *MockwsPipelineWriterMockRecorder) AppDirRelPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppDirRelPath", reflect.TypeOf((*MockwsPipelineWriter)(nil).AppDirRelPath))
+}
+
+// WritePipelineBuildspec mocks base method
+func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+}
+
+// WritePipelineManifest mocks base method
+func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineManifest indicates an expected call of WritePipelineManifest
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+}
+
+// UpdatePipelineBuildspec mocks base method
+func (m *MockwsPipelineWriter) UpdatePipelineBuildspec(marshaler encoding.BinaryMarshaler) (*workspace.FileUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePipelineBuildspec", marshaler)
+	ret0, _ := ret[0].(*workspace.FileUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePipelineBuildspec indicates an expected call of UpdatePipelineBuildspec
+func (mr *MockwsPipelineWriterMockRecorder) UpdatePipelineBuildspec(marshaler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).UpdatePipelineBuildspec), marshaler)
+}
+
+// UpdatePipelineManifest mocks base method
+func (m *MockwsPipelineWriter) UpdatePipelineManifest(marshaler encoding.BinaryMarshaler) (*workspace.FileUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePipelineManifest", marshaler)
+	ret0, _ := ret[0].(*workspace.FileUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePipelineManifest indicates an expected call of UpdatePipelineManifest
+func (mr *MockwsPipelineWriterMockRecorder) UpdatePipelineManifest(marshaler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).UpdatePipelineManifest), marshaler)
+}
+
+// ApplyUpdate mocks base method
+func (m *MockwsPipelineWriter) ApplyUpdate(update *workspace.FileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUpdate", update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyUpdate indicates an expected call of ApplyUpdate
+func (mr *MockwsPipelineWriterMockRecorder) ApplyUpdate(update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUpdate", reflect.TypeOf((*MockwsPipelineWriter)(nil).ApplyUpdate), update)
+}
+
+// MockwsServiceLister is a mock of wsServiceLister interface
+type MockwsServiceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsServiceListerMockRecorder
+}
+
+// MockwsServiceListerMockRecorder is the mock recorder for MockwsServiceLister
+type MockwsServiceListerMockRecorder struct {
+	mock *MockwsServiceLister
+}
+
+// NewMockwsServiceLister creates a new mock instance
+func NewMockwsServiceLister(ctrl *gomock.Controller) *MockwsServiceLister {
+	mock := &MockwsServiceLister{ctrl: ctrl}
+	mock.recorder = &MockwsServiceListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsServiceLister) EXPECT() *MockwsServiceListerMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsServiceLister) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsServiceListerMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsServiceLister)(nil).ServiceNames))
+}
+
+// MockwsSvcReader is a mock of wsSvcReader interface
+type MockwsSvcReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSvcReaderMockRecorder
+}
+
+// MockwsSvcReaderMockRecorder is the mock recorder for MockwsSvcReader
+type MockwsSvcReaderMockRecorder struct {
+	mock *MockwsSvcReader
+}
+
+// NewMockwsSvcReader creates a new mock instance
+func NewMockwsSvcReader(ctrl *gomock.Controller) *MockwsSvcReader {
+	mock := &MockwsSvcReader{ctrl: ctrl}
+	mock.recorder = &MockwsSvcReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsSvcReader) EXPECT() *MockwsSvcReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsSvcReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsSvcReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsSvcReader)(nil).ServiceNames))
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsSvcReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsSvcReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceManifestPath mocks base method
+func (m *MockwsSvcReader) ServiceManifestPath(svcName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceManifestPath", svcName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceManifestPath indicates an expected call of ServiceManifestPath
+func (mr *MockwsSvcReaderMockRecorder) ServiceManifestPath(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceManifestPath", reflect.TypeOf((*MockwsSvcReader)(nil).ServiceManifestPath), svcName)
+}
+
+// MockwsPipelineReader is a mock of wsPipelineReader interface
+type MockwsPipelineReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineReaderMockRecorder
+}
+
+// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader
+type MockwsPipelineReaderMockRecorder struct {
+	mock *MockwsPipelineReader
+}
+
+// NewMockwsPipelineReader creates a new mock instance
+func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
+	mock := &MockwsPipelineReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsPipelineReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsPipelineReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineReader)(nil).ServiceNames))
+}
+
+// ReadPipelineManifest mocks base method
+func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+}
+
+// AppDirRelPath mocks base method
+func (m *MockwsPipelineReader) AppDirRelPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppDirRelPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppDirRelPath indicates an expected call of AppDirRelPath
+func (mr *MockwsPipelineReaderMockRecorder) AppDirRelPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppDirRelPath", reflect.TypeOf((*MockwsPipelineReader)(nil).AppDirRelPath))
+}
+
+// MockwsAppManager is a mock of wsAppManager interface
+type MockwsAppManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppManagerMockRecorder
+}
+
+// MockwsAppManagerMockRecorder is the mock recorder for MockwsAppManager
+type MockwsAppManagerMockRecorder struct {
+	mock *MockwsAppManager
+}
+
+// NewMockwsAppManager creates a new mock instance
+func NewMockwsAppManager(ctrl *gomock.Controller) *MockwsAppManager {
+	mock := &MockwsAppManager{ctrl: ctrl}
+	mock.recorder = &MockwsAppManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAppManager) EXPECT() *MockwsAppManagerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockwsAppManager) Create(appName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", appName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockwsAppManagerMockRecorder) Create(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockwsAppManager)(nil).Create), appName)
+}
+
+// Summary mocks base method
+func (m *MockwsAppManager) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary
+func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
+}
+
+// MockwsAddonManager is a mock of wsAddonManager interface
+type MockwsAddonManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAddonManagerMockRecorder
+}
+
+// MockwsAddonManagerMockRecorder is the mock recorder for MockwsAddonManager
+type MockwsAddonManagerMockRecorder struct {
+	mock *MockwsAddonManager
+}
+
+// NewMockwsAddonManager creates a new mock instance
+func NewMockwsAddonManager(ctrl *gomock.Controller) *MockwsAddonManager {
+	mock := &MockwsAddonManager{ctrl: ctrl}
+	mock.recorder = &MockwsAddonManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAddonManager) EXPECT() *MockwsAddonManagerMockRecorder {
+	return m.recorder
+}
+
+// WriteAddon mocks base method
+func (m *MockwsAddonManager) WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAddon", f, svc, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAddon indicates an expected call of WriteAddon
+func (mr *MockwsAddonManagerMockRecorder) WriteAddon(f, svc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteAddon), f, svc, name)
+}
+
+// ServiceNames mocks base method
+func (m *MockwsAddonManager) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsAddonManagerMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsAddonManager)(nil).ServiceNames))
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsAddonManager) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsAddonManagerMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAddonManager)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceManifestPath mocks base method
+func (m *MockwsAddonManager) ServiceManifestPath(svcName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceManifestPath", svcName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceManifestPath indicates an expected call of ServiceManifestPath
+func (mr *MockwsAddonManagerMockRecorder) ServiceManifestPath(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceManifestPath", reflect.TypeOf((*MockwsAddonManager)(nil).ServiceManifestPath), svcName)
+}
+
+// MockartifactUploader is a mock of artifactUploader interface
+type MockartifactUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactUploaderMockRecorder
+}
+
+// MockartifactUploaderMockRecorder is the mock recorder for MockartifactUploader
+type MockartifactUploaderMockRecorder struct {
+	mock *MockartifactUploader
+}
+
+// NewMockartifactUploader creates a new mock instance
+func NewMockartifactUploader(ctrl *gomock.Controller) *MockartifactUploader {
+	mock := &MockartifactUploader{ctrl: ctrl}
+	mock.recorder = &MockartifactUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockartifactUploader) EXPECT() *MockartifactUploaderMockRecorder {
+	return m.recorder
+}
+
+// PutArtifact mocks base method
+func (m *MockartifactUploader) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifact", bucket, fileName, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifact indicates an expected call of PutArtifact
+func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, fileName, data)
+}
+
+// MockbucketEmptier is a mock of bucketEmptier interface
+type MockbucketEmptier struct {
+	ctrl     *gomock.Controller
+	recorder *MockbucketEmptierMockRecorder
+}
+
+// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier
+type MockbucketEmptierMockRecorder struct {
+	mock *MockbucketEmptier
+}
+
+// NewMockbucketEmptier creates a new mock instance
+func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
+	mock := &MockbucketEmptier{ctrl: ctrl}
+	mock.recorder = &MockbucketEmptierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
+	return m.recorder
+}
+
+// EmptyBucket mocks base method
+func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmptyBucket indicates an expected call of EmptyBucket
+func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
+}
+
+// MockenvironmentDeployer is a mock of environmentDeployer interface
+type MockenvironmentDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentDeployerMockRecorder
+}
+
+// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer
+type MockenvironmentDeployerMockRecorder struct {
+	mock *MockenvironmentDeployer
+}
+
+// NewMockenvironmentDeployer creates a new mock instance
+func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
+	mock := &MockenvironmentDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironment mocks base method
+func (m *MockenvironmentDeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployEnvironment), env)
+}
+
+// StreamEnvironmentCreation mocks base method
+func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
+	return ret0, ret1
+}
+
+// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
+func (mr *MockenvironmentDeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*MockenvironmentDeployer)(nil).StreamEnvironmentCreation), env)
+}
+
+// DeleteEnvironment mocks base method
+func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName)
+}
+
+// GetEnvironment mocks base method
+func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
+}
+
+// MockchangeSetExecutor is a mock of changeSetExecutor interface
+type MockchangeSetExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockchangeSetExecutorMockRecorder
+}
+
+// MockchangeSetExecutorMockRecorder is the mock recorder for MockchangeSetExecutor
+type MockchangeSetExecutorMockRecorder struct {
+	mock *MockchangeSetExecutor
+}
+
+// NewMockchangeSetExecutor creates a new mock instance
+func NewMockchangeSetExecutor(ctrl *gomock.Controller) *MockchangeSetExecutor {
+	mock := &MockchangeSetExecutor{ctrl: ctrl}
+	mock.recorder = &MockchangeSetExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockchangeSetExecutor) EXPECT() *MockchangeSetExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockchangeSetExecutor) ExecuteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockchangeSetExecutorMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockchangeSetExecutor)(nil).ExecuteChangeSet), cs)
+}
+
+// ExecuteChangeSetAndWait mocks base method
+func (m *MockchangeSetExecutor) ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSetAndWait", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSetAndWait indicates an expected call of ExecuteChangeSetAndWait
+func (mr *MockchangeSetExecutorMockRecorder) ExecuteChangeSetAndWait(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSetAndWait", reflect.TypeOf((*MockchangeSetExecutor)(nil).ExecuteChangeSetAndWait), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockchangeSetExecutor) DeleteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockchangeSetExecutorMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockchangeSetExecutor)(nil).DeleteChangeSet), cs)
+}
+
+// MocksvcPreviewer is a mock of svcPreviewer interface
+type MocksvcPreviewer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcPreviewerMockRecorder
+}
+
+// MocksvcPreviewerMockRecorder is the mock recorder for MocksvcPreviewer
+type MocksvcPreviewerMockRecorder struct {
+	mock *MocksvcPreviewer
+}
+
+// NewMocksvcPreviewer creates a new mock instance
+func NewMocksvcPreviewer(ctrl *gomock.Controller) *MocksvcPreviewer {
+	mock := &MocksvcPreviewer{ctrl: ctrl}
+	mock.recorder = &MocksvcPreviewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcPreviewer) EXPECT() *MocksvcPreviewerMockRecorder {
+	return m.recorder
+}
+
+// PreviewService mocks base method
+func (m *MocksvcPreviewer) PreviewService(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewService", varargs...)
+	ret0, _ := ret[0].(*cloudformation.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewService indicates an expected call of PreviewService
+func (mr *MocksvcPreviewerMockRecorder) PreviewService(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewService", reflect.TypeOf((*MocksvcPreviewer)(nil).PreviewService), varargs...)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MocksvcPreviewer) ExecuteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MocksvcPreviewerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MocksvcPreviewer)(nil).ExecuteChangeSet), cs)
+}
+
+// ExecuteChangeSetAndWait mocks base method
+func (m *MocksvcPreviewer) ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSetAndWait", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSetAndWait indicates an expected call of ExecuteChangeSetAndWait
+func (mr *MocksvcPreviewerMockRecorder) ExecuteChangeSetAndWait(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSetAndWait", reflect.TypeOf((*MocksvcPreviewer)(nil).ExecuteChangeSetAndWait), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MocksvcPreviewer) DeleteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MocksvcPreviewerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MocksvcPreviewer)(nil).DeleteChangeSet), cs)
+}
+
+// MocksvcRollbacker is a mock of svcRollbacker interface
+type MocksvcRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRollbackerMockRecorder
+}
+
+// MocksvcRollbackerMockRecorder is the mock recorder for MocksvcRollbacker
+type MocksvcRollbackerMockRecorder struct {
+	mock *MocksvcRollbacker
+}
+
+// NewMocksvcRollbacker creates a new mock instance
+func NewMocksvcRollbacker(ctrl *gomock.Controller) *MocksvcRollbacker {
+	mock := &MocksvcRollbacker{ctrl: ctrl}
+	mock.recorder = &MocksvcRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRollbacker) EXPECT() *MocksvcRollbackerMockRecorder {
+	return m.recorder
+}
+
+// RollbackService mocks base method
+func (m *MocksvcRollbacker) RollbackService(in deploy.RollbackServiceInput, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackService indicates an expected call of RollbackService
+func (mr *MocksvcRollbackerMockRecorder) RollbackService(in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackService", reflect.TypeOf((*MocksvcRollbacker)(nil).RollbackService), varargs...)
+}
+
+// MocksvcStackParametersGetter is a mock of svcStackParametersGetter interface
+type MocksvcStackParametersGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcStackParametersGetterMockRecorder
+}
+
+// MocksvcStackParametersGetterMockRecorder is the mock recorder for MocksvcStackParametersGetter
+type MocksvcStackParametersGetterMockRecorder struct {
+	mock *MocksvcStackParametersGetter
+}
+
+// NewMocksvcStackParametersGetter creates a new mock instance
+func NewMocksvcStackParametersGetter(ctrl *gomock.Controller) *MocksvcStackParametersGetter {
+	mock := &MocksvcStackParametersGetter{ctrl: ctrl}
+	mock.recorder = &MocksvcStackParametersGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcStackParametersGetter) EXPECT() *MocksvcStackParametersGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceStackParameters mocks base method
+func (m *MocksvcStackParametersGetter) ServiceStackParameters(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackParameters", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackParameters indicates an expected call of ServiceStackParameters
+func (mr *MocksvcStackParametersGetterMockRecorder) ServiceStackParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackParameters", reflect.TypeOf((*MocksvcStackParametersGetter)(nil).ServiceStackParameters), stackName)
+}
+
+// MocksvcParametersUpdater is a mock of svcParametersUpdater interface
+type MocksvcParametersUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcParametersUpdaterMockRecorder
+}
+
+// MocksvcParametersUpdaterMockRecorder is the mock recorder for MocksvcParametersUpdater
+type MocksvcParametersUpdaterMockRecorder struct {
+	mock *MocksvcParametersUpdater
+}
+
+// NewMocksvcParametersUpdater creates a new mock instance
+func NewMocksvcParametersUpdater(ctrl *gomock.Controller) *MocksvcParametersUpdater {
+	mock := &MocksvcParametersUpdater{ctrl: ctrl}
+	mock.recorder = &MocksvcParametersUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcParametersUpdater) EXPECT() *MocksvcParametersUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateServiceParameters mocks base method
+func (m *MocksvcParametersUpdater) UpdateServiceParameters(in deploy.UpdateServiceParametersInput, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceParameters", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceParameters indicates an expected call of UpdateServiceParameters
+func (mr *MocksvcParametersUpdaterMockRecorder) UpdateServiceParameters(in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceParameters", reflect.TypeOf((*MocksvcParametersUpdater)(nil).UpdateServiceParameters), varargs...)
+}
+
+// MockcanaryReleaser is a mock of canaryReleaser interface
+type MockcanaryReleaser struct {
+	ctrl     *gomock.Controller
+	recorder *MockcanaryReleaserMockRecorder
+}
+
+// MockcanaryReleaserMockRecorder is the mock recorder for MockcanaryReleaser
+type MockcanaryReleaserMockRecorder struct {
+	mock *MockcanaryReleaser
+}
+
+// NewMockcanaryReleaser creates a new mock instance
+func NewMockcanaryReleaser(ctrl *gomock.Controller) *MockcanaryReleaser {
+	mock := &MockcanaryReleaser{ctrl: ctrl}
+	mock.recorder = &MockcanaryReleaserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcanaryReleaser) EXPECT() *MockcanaryReleaserMockRecorder {
+	return m.recorder
+}
+
+// ServiceStackParameters mocks base method
+func (m *MockcanaryReleaser) ServiceStackParameters(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackParameters", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackParameters indicates an expected call of ServiceStackParameters
+func (mr *MockcanaryReleaserMockRecorder) ServiceStackParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackParameters", reflect.TypeOf((*MockcanaryReleaser)(nil).ServiceStackParameters), stackName)
+}
+
+// ServiceStackOutputs mocks base method
+func (m *MockcanaryReleaser) ServiceStackOutputs(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackOutputs", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackOutputs indicates an expected call of ServiceStackOutputs
+func (mr *MockcanaryReleaserMockRecorder) ServiceStackOutputs(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackOutputs", reflect.TypeOf((*MockcanaryReleaser)(nil).ServiceStackOutputs), stackName)
+}
+
+// UpdateServiceParameters mocks base method
+func (m *MockcanaryReleaser) UpdateServiceParameters(in deploy.UpdateServiceParametersInput, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceParameters", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceParameters indicates an expected call of UpdateServiceParameters
+func (mr *MockcanaryReleaserMockRecorder) UpdateServiceParameters(in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceParameters", reflect.TypeOf((*MockcanaryReleaser)(nil).UpdateServiceParameters), varargs...)
+}
+
+// MockenvPreviewer is a mock of envPreviewer interface
+type MockenvPreviewer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvPreviewerMockRecorder
+}
+
+// MockenvPreviewerMockRecorder is the mock recorder for MockenvPreviewer
+type MockenvPreviewerMockRecorder struct {
+	mock *MockenvPreviewer
+}
+
+// NewMockenvPreviewer creates a new mock instance
+func NewMockenvPreviewer(ctrl *gomock.Controller) *MockenvPreviewer {
+	mock := &MockenvPreviewer{ctrl: ctrl}
+	mock.recorder = &MockenvPreviewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvPreviewer) EXPECT() *MockenvPreviewerMockRecorder {
+	return m.recorder
+}
+
+// PreviewEnvironment mocks base method
+func (m *MockenvPreviewer) PreviewEnvironment(env *deploy.CreateEnvironmentInput) (*cloudformation.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewEnvironment", env)
+	ret0, _ := ret[0].(*cloudformation.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewEnvironment indicates an expected call of PreviewEnvironment
+func (mr *MockenvPreviewerMockRecorder) PreviewEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewEnvironment", reflect.TypeOf((*MockenvPreviewer)(nil).PreviewEnvironment), env)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockenvPreviewer) ExecuteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockenvPreviewerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockenvPreviewer)(nil).ExecuteChangeSet), cs)
+}
+
+// ExecuteChangeSetAndWait mocks base method
+func (m *MockenvPreviewer) ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSetAndWait", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSetAndWait indicates an expected call of ExecuteChangeSetAndWait
+func (mr *MockenvPreviewerMockRecorder) ExecuteChangeSetAndWait(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSetAndWait", reflect.TypeOf((*MockenvPreviewer)(nil).ExecuteChangeSetAndWait), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockenvPreviewer) DeleteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockenvPreviewerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockenvPreviewer)(nil).DeleteChangeSet), cs)
+}
+
+// MockpipelinePreviewer is a mock of pipelinePreviewer interface
+type MockpipelinePreviewer struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelinePreviewerMockRecorder
+}
+
+// MockpipelinePreviewerMockRecorder is the mock recorder for MockpipelinePreviewer
+type MockpipelinePreviewerMockRecorder struct {
+	mock *MockpipelinePreviewer
+}
+
+// NewMockpipelinePreviewer creates a new mock instance
+func NewMockpipelinePreviewer(ctrl *gomock.Controller) *MockpipelinePreviewer {
+	mock := &MockpipelinePreviewer{ctrl: ctrl}
+	mock.recorder = &MockpipelinePreviewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelinePreviewer) EXPECT() *MockpipelinePreviewerMockRecorder {
+	return m.recorder
+}
+
+// PreviewPipeline mocks base method
+func (m *MockpipelinePreviewer) PreviewPipeline(in *deploy.CreatePipelineInput) (*cloudformation.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewPipeline", in)
+	ret0, _ := ret[0].(*cloudformation.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewPipeline indicates an expected call of PreviewPipeline
+func (mr *MockpipelinePreviewerMockRecorder) PreviewPipeline(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewPipeline", reflect.TypeOf((*MockpipelinePreviewer)(nil).PreviewPipeline), in)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockpipelinePreviewer) ExecuteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockpipelinePreviewerMockRecorder) ExecuteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockpipelinePreviewer)(nil).ExecuteChangeSet), cs)
+}
+
+// ExecuteChangeSetAndWait mocks base method
+func (m *MockpipelinePreviewer) ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSetAndWait", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSetAndWait indicates an expected call of ExecuteChangeSetAndWait
+func (mr *MockpipelinePreviewerMockRecorder) ExecuteChangeSetAndWait(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSetAndWait", reflect.TypeOf((*MockpipelinePreviewer)(nil).ExecuteChangeSetAndWait), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockpipelinePreviewer) DeleteChangeSet(cs *cloudformation.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockpipelinePreviewerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockpipelinePreviewer)(nil).DeleteChangeSet), cs)
+}
+
+// MocksvcDeleter is a mock of svcDeleter interface
+type MocksvcDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDeleterMockRecorder
+}
+
+// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
+type MocksvcDeleterMockRecorder struct {
+	mock *MocksvcDeleter
+}
+
+// NewMocksvcDeleter creates a new mock instance
+func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
+	mock := &MocksvcDeleter{ctrl: ctrl}
+	mock.recorder = &MocksvcDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
+	return m.recorder
+}
+
+// StreamServiceDeletion mocks base method
+func (m *MocksvcDeleter) StreamServiceDeletion(in deploy.DeleteServiceInput) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamServiceDeletion", in)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamServiceDeletion indicates an expected call of StreamServiceDeletion
+func (mr *MocksvcDeleterMockRecorder) StreamServiceDeletion(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamServiceDeletion", reflect.TypeOf((*MocksvcDeleter)(nil).StreamServiceDeletion), in)
+}
+
+// MocksvcDeploymentStreamer is a mock of svcDeploymentStreamer interface
+type MocksvcDeploymentStreamer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDeploymentStreamerMockRecorder
+}
+
+// MocksvcDeploymentStreamerMockRecorder is the mock recorder for MocksvcDeploymentStreamer
+type MocksvcDeploymentStreamerMockRecorder struct {
+	mock *MocksvcDeploymentStreamer
+}
+
+// NewMocksvcDeploymentStreamer creates a new mock instance
+func NewMocksvcDeploymentStreamer(ctrl *gomock.Controller) *MocksvcDeploymentStreamer {
+	mock := &MocksvcDeploymentStreamer{ctrl: ctrl}
+	mock.recorder = &MocksvcDeploymentStreamerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDeploymentStreamer) EXPECT() *MocksvcDeploymentStreamerMockRecorder {
+	return m.recorder
+}
+
+// StreamServiceDeployment mocks base method
+func (m *MocksvcDeploymentStreamer) StreamServiceDeployment(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamServiceDeployment", varargs...)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamServiceDeployment indicates an expected call of StreamServiceDeployment
+func (mr *MocksvcDeploymentStreamerMockRecorder) StreamServiceDeployment(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamServiceDeployment", reflect.TypeOf((*MocksvcDeploymentStreamer)(nil).StreamServiceDeployment), varargs...)
+}
+
+// MockstackRecoverer is a mock of stackRecoverer interface
+type MockstackRecoverer struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackRecovererMockRecorder
+}
+
+// MockstackRecovererMockRecorder is the mock recorder for MockstackRecoverer
+type MockstackRecovererMockRecorder struct {
+	mock *MockstackRecoverer
+}
+
+// NewMockstackRecoverer creates a new mock instance
+func NewMockstackRecoverer(ctrl *gomock.Controller) *MockstackRecoverer {
+	mock := &MockstackRecoverer{ctrl: ctrl}
+	mock.recorder = &MockstackRecovererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackRecoverer) EXPECT() *MockstackRecovererMockRecorder {
+	return m.recorder
+}
+
+// RecoverStack mocks base method
+func (m *MockstackRecoverer) RecoverStack(failed *cloudformation.ErrStackRequiresRecovery, skipResources []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverStack", failed, skipResources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecoverStack indicates an expected call of RecoverStack
+func (mr *MockstackRecovererMockRecorder) RecoverStack(failed, skipResources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverStack", reflect.TypeOf((*MockstackRecoverer)(nil).RecoverStack), failed, skipResources)
+}
+
+// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
+type MocksvcRemoverFromApp struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRemoverFromAppMockRecorder
+}
+
+// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
+type MocksvcRemoverFromAppMockRecorder struct {
+	mock *MocksvcRemoverFromApp
+}
+
+// NewMocksvcRemoverFromApp creates a new mock instance
+func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
+	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+	return m.recorder
+}
+
+// RemoveServiceFromApp mocks base method
+func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
+func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+}
+
+// MockimageRemover is a mock of imageRemover interface
+type MockimageRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRemoverMockRecorder
+}
+
+// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover
+type MockimageRemoverMockRecorder struct {
+	mock *MockimageRemover
+}
+
+// NewMockimageRemover creates a new mock instance
+func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
+	mock := &MockimageRemover{ctrl: ctrl}
+	mock.recorder = &MockimageRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
+	return m.recorder
+}
+
+// ClearRepository mocks base method
+func (m *MockimageRemover) ClearRepository(repoName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRepository", repoName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRepository indicates an expected call of ClearRepository
+func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
+}
+
+// MockpipelineDeployer is a mock of pipelineDeployer interface
+type MockpipelineDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineDeployerMockRecorder
+}
+
+// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer
+type MockpipelineDeployerMockRecorder struct {
+	mock *MockpipelineDeployer
+}
+
+// NewMockpipelineDeployer creates a new mock instance
+func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
+	mock := &MockpipelineDeployer{ctrl: ctrl}
+	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
+	return m.recorder
+}
+
+// CreatePipeline mocks base method
+func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipeline", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePipeline indicates an expected call of CreatePipeline
+func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), env)
+}
+
+// UpdatePipeline mocks base method
+func (m *MockpipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePipeline", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePipeline indicates an expected call of UpdatePipeline
+func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), env)
+}
+
+// PipelineExists mocks base method
+func (m *MockpipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineExists", env)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineExists indicates an expected call of PipelineExists
+func (mr *MockpipelineDeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), env)
+}
+
+// DeletePipeline mocks base method
+func (m *MockpipelineDeployer) DeletePipeline(pipelineName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipeline indicates an expected call of DeletePipeline
+func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipelineName)
+}
+
+// AddPipelineResourcesToApp mocks base method
+func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
+func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
+}
+
+// GetAppResourcesByRegion mocks base method
+func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
+func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// GetRegionalAppResources mocks base method
+func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
+func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
+}
+
+// MockappDeployer is a mock of appDeployer interface
+type MockappDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockappDeployerMockRecorder
+}
+
+// MockappDeployerMockRecorder is the mock recorder for MockappDeployer
+type MockappDeployerMockRecorder struct {
+	mock *MockappDeployer
+}
+
+// NewMockappDeployer creates a new mock instance
+func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
+	mock := &MockappDeployer{ctrl: ctrl}
+	mock.recorder = &MockappDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployApp mocks base method
+func (m *MockappDeployer) DeployApp(in *deploy.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp
+func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
+}
+
+// AddServiceToApp mocks base method
+func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceToApp indicates an expected call of AddServiceToApp
+func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), app, svcName)
+}
+
+// AddEnvToApp mocks base method
+func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEnvToApp indicates an expected call of AddEnvToApp
+func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), app, env)
+}
+
+// DelegateDNSPermissions mocks base method
+func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
+func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsAddonManager)(nil).ServiceNames))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
 }
 
-// ReadServiceManifest mocks base method
-func (m *MockwsAddonManager) ReadServiceManifest(svcName string) ([]byte, error) {
+// DeleteApp mocks base method
+func (m *MockappDeployer) DeleteApp(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReadServiceManifest indicates an expected call of ReadServiceManifest
-func (mr *MockwsAddonManagerMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+// DeleteApp indicates an expected call of DeleteApp
+func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAddonManager)(nil).ReadServiceManifest), svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
 }
 
-// MockartifactUploader is a mock of artifactUploader interface
-type MockartifactUploader struct {
+// MockappResourcesGetter is a mock of appResourcesGetter interface
+type MockappResourcesGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockartifactUploaderMockRecorder
+	recorder *MockappResourcesGetterMockRecorder
 }
 
-// MockartifactUploaderMockRecorder is the mock recorder for MockartifactUploader
-type MockartifactUploaderMockRecorder struct {
-	mock *MockartifactUploader
+// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter
+type MockappResourcesGetterMockRecorder struct {
+	mock *MockappResourcesGetter
 }
 
-// NewMockartifactUploader creates a new mock instance
-func NewMockartifactUploader(ctrl *gomock.Controller) *MockartifactUploader {
-	mock := &MockartifactUploader{ctrl: ctrl}
-	mock.recorder = &MockartifactUploaderMockRecorder{mock}
+// NewMockappResourcesGetter creates a new mock instance
+func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
+	mock := &MockappResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockartifactUploader) EXPECT() *MockartifactUploaderMockRecorder {
+func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
 	return m.recorder
 }
 
-// PutArtifact mocks base method
-func (m *MockartifactUploader) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+// GetAppResourcesByRegion mocks base method
+func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutArtifact", bucket, fileName, data)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PutArtifact indicates an expected call of PutArtifact
-func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, fileName, data interface{}) *gomock.Call {
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
+func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, fileName, data)
-}
-
-// MockbucketEmptier is a mock of bucketEmptier interface
-type MockbucketEmptier struct {
-	ctrl     *gomock.Controller
-	recorder *MockbucketEmptierMockRecorder
-}
-
-// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier
-type MockbucketEmptierMockRecorder struct {
-	mock *MockbucketEmptier
-}
-
-// NewMockbucketEmptier creates a new mock instance
-func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
-	mock := &MockbucketEmptier{ctrl: ctrl}
-	mock.recorder = &MockbucketEmptierMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
 }
 
-// EmptyBucket mocks base method
-func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
+// GetRegionalAppResources mocks base method
+func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EmptyBucket indicates an expected call of EmptyBucket
-func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
+func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
 }
 
-// MockenvironmentDeployer is a mock of environmentDeployer interface
-type MockenvironmentDeployer struct {
+// Mockdeployer is a mock of deployer interface
+type Mockdeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvironmentDeployerMockRecorder
+	recorder *MockdeployerMockRecorder
 }
 
-// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer
-type MockenvironmentDeployerMockRecorder struct {
-	mock *MockenvironmentDeployer
+// MockdeployerMockRecorder is the mock recorder for Mockdeployer
+type MockdeployerMockRecorder struct {
+	mock *Mockdeployer
 }
 
-// NewMockenvironmentDeployer creates a new mock instance
-func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
-	mock := &MockenvironmentDeployer{ctrl: ctrl}
-	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
+// NewMockdeployer creates a new mock instance
+func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
+	mock := &Mockdeployer{ctrl: ctrl}
+	mock.recorder = &MockdeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
+func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
 	return m.recorder
 }
 
 // DeployEnvironment mocks base method
-func (m *MockenvironmentDeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeployEnvironment", env)
 	ret0, _ := ret[0].(error)
@@ -2260,13 +4358,13 @@ func (m *MockenvironmentDeployer) DeployEnvironment(env *deploy.CreateEnvironmen
 }
 
 // DeployEnvironment indicates an expected call of DeployEnvironment
-func (mr *MockenvironmentDeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployEnvironment), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
 }
 
 // StreamEnvironmentCreation mocks base method
-func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+func (m *Mockdeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
 	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
@@ -2275,13 +4373,13 @@ func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEn
 }
 
 // StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
-func (mr *MockenvironmentDeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*MockenvironmentDeployer)(nil).StreamEnvironmentCreation), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*Mockdeployer)(nil).StreamEnvironmentCreation), env)
 }
 
 // DeleteEnvironment mocks base method
-func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName string) error {
+func (m *Mockdeployer) DeleteEnvironment(appName, envName string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName)
 	ret0, _ := ret[0].(error)
@@ -2289,13 +4387,13 @@ func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName string) err
 }
 
 // DeleteEnvironment indicates an expected call of DeleteEnvironment
-func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName)
 }
 
 // GetEnvironment mocks base method
-func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
 	ret0, _ := ret[0].(*config.Environment)
@@ -2304,147 +4402,83 @@ func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*conf
 }
 
 // GetEnvironment indicates an expected call of GetEnvironment
-func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
-}
-
-// MocksvcDeleter is a mock of svcDeleter interface
-type MocksvcDeleter struct {
-	ctrl     *gomock.Controller
-	recorder *MocksvcDeleterMockRecorder
-}
-
-// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
-type MocksvcDeleterMockRecorder struct {
-	mock *MocksvcDeleter
-}
-
-// NewMocksvcDeleter creates a new mock instance
-func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
-	mock := &MocksvcDeleter{ctrl: ctrl}
-	mock.recorder = &MocksvcDeleterMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
 }
 
-// DeleteService mocks base method
-func (m *MocksvcDeleter) DeleteService(in deploy.DeleteServiceInput) error {
+// DeployApp mocks base method
+func (m *Mockdeployer) DeployApp(in *deploy.CreateAppInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteService", in)
+	ret := m.ctrl.Call(m, "DeployApp", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteService indicates an expected call of DeleteService
-func (mr *MocksvcDeleterMockRecorder) DeleteService(in interface{}) *gomock.Call {
+// DeployApp indicates an expected call of DeployApp
+func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcDeleter)(nil).DeleteService), in)
-}
-
-// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
-type MocksvcRemoverFromApp struct {
-	ctrl     *gomock.Controller
-	recorder *MocksvcRemoverFromAppMockRecorder
-}
-
-// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
-type MocksvcRemoverFromAppMockRecorder struct {
-	mock *MocksvcRemoverFromApp
-}
-
-// NewMocksvcRemoverFromApp creates a new mock instance
-func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
-	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
 }
 
-// RemoveServiceFromApp mocks base method
-func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+// AddServiceToApp mocks base method
+func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
+	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
-func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+// AddServiceToApp indicates an expected call of AddServiceToApp
+func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
-}
-
-// MockimageRemover is a mock of imageRemover interface
-type MockimageRemover struct {
-	ctrl     *gomock.Controller
-	recorder *MockimageRemoverMockRecorder
-}
-
-// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover
-type MockimageRemoverMockRecorder struct {
-	mock *MockimageRemover
-}
-
-// NewMockimageRemover creates a new mock instance
-func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
-	mock := &MockimageRemover{ctrl: ctrl}
-	mock.recorder = &MockimageRemoverMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), app, svcName)
 }
 
-// ClearRepository mocks base method
-func (m *MockimageRemover) ClearRepository(repoName string) error {
+// AddEnvToApp mocks base method
+func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClearRepository", repoName)
+	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ClearRepository indicates an expected call of ClearRepository
-func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
+// AddEnvToApp indicates an expected call of AddEnvToApp
+func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), app, env)
 }
 
-// MockpipelineDeployer is a mock of pipelineDeployer interface
-type MockpipelineDeployer struct {
-	ctrl     *gomock.Controller
-	recorder *MockpipelineDeployerMockRecorder
+// DelegateDNSPermissions mocks base method
+func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer
-type MockpipelineDeployerMockRecorder struct {
-	mock *MockpipelineDeployer
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
+func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
 }
 
-// NewMockpipelineDeployer creates a new mock instance
-func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
-	mock := &MockpipelineDeployer{ctrl: ctrl}
-	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
-	return mock
+// DeleteApp mocks base method
+func (m *Mockdeployer) DeleteApp(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
-	return m.recorder
+// DeleteApp indicates an expected call of DeleteApp
+func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
 }
 
 // CreatePipeline mocks base method
-func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
+func (m *Mockdeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePipeline", env)
 	ret0, _ := ret[0].(error)
@@ -2452,13 +4486,13 @@ func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) e
 }
 
 // CreatePipeline indicates an expected call of CreatePipeline
-func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), env)
 }
 
 // UpdatePipeline mocks base method
-func (m *MockpipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
+func (m *Mockdeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePipeline", env)
 	ret0, _ := ret[0].(error)
@@ -2466,13 +4500,13 @@ func (m *MockpipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) e
 }
 
 // UpdatePipeline indicates an expected call of UpdatePipeline
-func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), env)
 }
 
 // PipelineExists mocks base method
-func (m *MockpipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+func (m *Mockdeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PipelineExists", env)
 	ret0, _ := ret[0].(bool)
@@ -2481,13 +4515,13 @@ func (m *MockpipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (
 }
 
 // PipelineExists indicates an expected call of PipelineExists
-func (mr *MockpipelineDeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), env)
 }
 
 // DeletePipeline mocks base method
-func (m *MockpipelineDeployer) DeletePipeline(pipelineName string) error {
+func (m *Mockdeployer) DeletePipeline(pipelineName string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
 	ret0, _ := ret[0].(error)
@@ -2495,13 +4529,13 @@ func (m *MockpipelineDeployer) DeletePipeline(pipelineName string) error {
 }
 
 // DeletePipeline indicates an expected call of DeletePipeline
-func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipelineName)
 }
 
 // AddPipelineResourcesToApp mocks base method
-func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
 	ret0, _ := ret[0].(error)
@@ -2509,13 +4543,13 @@ func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application
 }
 
 // AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
-func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
 }
 
 // GetAppResourcesByRegion mocks base method
-func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
 	ret0, _ := ret[0].(*stack.AppRegionalResources)
@@ -2524,13 +4558,13 @@ func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application,
 }
 
 // GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
 }
 
 // GetRegionalAppResources mocks base method
-func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
 	ret0, _ := ret[0].([]*stack.AppRegionalResources)
@@ -2539,536 +4573,570 @@ func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application)
 }
 
 // GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
 }
 
-// MockappDeployer is a mock of appDeployer interface
-type MockappDeployer struct {
+// MockdomainValidator is a mock of domainValidator interface
+type MockdomainValidator struct {
 	ctrl     *gomock.Controller
-	recorder *MockappDeployerMockRecorder
+	recorder *MockdomainValidatorMockRecorder
 }
 
-// MockappDeployerMockRecorder is the mock recorder for MockappDeployer
-type MockappDeployerMockRecorder struct {
-	mock *MockappDeployer
+// MockdomainValidatorMockRecorder is the mock recorder for MockdomainValidator
+type MockdomainValidatorMockRecorder struct {
+	mock *MockdomainValidator
 }
 
-// NewMockappDeployer creates a new mock instance
-func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
-	mock := &MockappDeployer{ctrl: ctrl}
-	mock.recorder = &MockappDeployerMockRecorder{mock}
+// NewMockdomainValidator creates a new mock instance
+func NewMockdomainValidator(ctrl *gomock.Controller) *MockdomainValidator {
+	mock := &MockdomainValidator{ctrl: ctrl}
+	mock.recorder = &MockdomainValidatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+func (m *MockdomainValidator) EXPECT() *MockdomainValidatorMockRecorder {
 	return m.recorder
 }
 
-// DeployApp mocks base method
-func (m *MockappDeployer) DeployApp(in *deploy.CreateAppInput) error {
+// DomainExists mocks base method
+func (m *MockdomainValidator) DomainExists(domainName string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DomainExists", domainName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeployApp indicates an expected call of DeployApp
-func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+// DomainExists indicates an expected call of DomainExists
+func (mr *MockdomainValidatorMockRecorder) DomainExists(domainName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainExists", reflect.TypeOf((*MockdomainValidator)(nil).DomainExists), domainName)
 }
 
-// AddServiceToApp mocks base method
-func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockdockerfileParser is a mock of dockerfileParser interface
+type MockdockerfileParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileParserMockRecorder
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp
-func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), app, svcName)
+// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser
+type MockdockerfileParserMockRecorder struct {
+	mock *MockdockerfileParser
 }
 
-// AddEnvToApp mocks base method
-func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockdockerfileParser creates a new mock instance
+func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
+	mock := &MockdockerfileParser{ctrl: ctrl}
+	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+	return mock
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), app, env)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+	return m.recorder
 }
 
-// DelegateDNSPermissions mocks base method
-func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+// GetExposedPorts mocks base method
+func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetExposedPorts")
+	ret0, _ := ret[0].([]uint16)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
-func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+// GetExposedPorts indicates an expected call of GetExposedPorts
+func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
 }
 
-// DeleteApp mocks base method
-func (m *MockappDeployer) DeleteApp(name string) error {
+// GetHealthCheck mocks base method
+func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetHealthCheck")
+	ret0, _ := ret[0].(*dockerfile.HealthCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteApp indicates an expected call of DeleteApp
-func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+// GetHealthCheck indicates an expected call of GetHealthCheck
+func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
 }
 
-// MockappResourcesGetter is a mock of appResourcesGetter interface
-type MockappResourcesGetter struct {
+// MockserviceArnGetter is a mock of serviceArnGetter interface
+type MockserviceArnGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockappResourcesGetterMockRecorder
+	recorder *MockserviceArnGetterMockRecorder
 }
 
-// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter
-type MockappResourcesGetterMockRecorder struct {
-	mock *MockappResourcesGetter
+// MockserviceArnGetterMockRecorder is the mock recorder for MockserviceArnGetter
+type MockserviceArnGetterMockRecorder struct {
+	mock *MockserviceArnGetter
 }
 
-// NewMockappResourcesGetter creates a new mock instance
-func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
-	mock := &MockappResourcesGetter{ctrl: ctrl}
-	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
+// NewMockserviceArnGetter creates a new mock instance
+func NewMockserviceArnGetter(ctrl *gomock.Controller) *MockserviceArnGetter {
+	mock := &MockserviceArnGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceArnGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
+func (m *MockserviceArnGetter) EXPECT() *MockserviceArnGetterMockRecorder {
 	return m.recorder
 }
 
-// GetAppResourcesByRegion mocks base method
-func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// GetServiceArn mocks base method
+func (m *MockserviceArnGetter) GetServiceArn() (*ecs.ServiceArn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "GetServiceArn")
+	ret0, _ := ret[0].(*ecs.ServiceArn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// GetServiceArn indicates an expected call of GetServiceArn
+func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
 }
 
-// GetRegionalAppResources mocks base method
-func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// MockecsServiceDescriber is a mock of ecsServiceDescriber interface
+type MockecsServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceDescriberMockRecorder
+}
+
+// MockecsServiceDescriberMockRecorder is the mock recorder for MockecsServiceDescriber
+type MockecsServiceDescriberMockRecorder struct {
+	mock *MockecsServiceDescriber
+}
+
+// NewMockecsServiceDescriber creates a new mock instance
+func NewMockecsServiceDescriber(ctrl *gomock.Controller) *MockecsServiceDescriber {
+	mock := &MockecsServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceDescriber) EXPECT() *MockecsServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockecsServiceDescriber) Service(clusterName, serviceName string) (*ecs.Service, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// Service indicates an expected call of Service
+func (mr *MockecsServiceDescriberMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceDescriber)(nil).Service), clusterName, serviceName)
 }
 
-// Mockdeployer is a mock of deployer interface
-type Mockdeployer struct {
+// MockhookTaskRunner is a mock of hookTaskRunner interface
+type MockhookTaskRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeployerMockRecorder
+	recorder *MockhookTaskRunnerMockRecorder
 }
 
-// MockdeployerMockRecorder is the mock recorder for Mockdeployer
-type MockdeployerMockRecorder struct {
-	mock *Mockdeployer
+// MockhookTaskRunnerMockRecorder is the mock recorder for MockhookTaskRunner
+type MockhookTaskRunnerMockRecorder struct {
+	mock *MockhookTaskRunner
 }
 
-// NewMockdeployer creates a new mock instance
-func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
-	mock := &Mockdeployer{ctrl: ctrl}
-	mock.recorder = &MockdeployerMockRecorder{mock}
+// NewMockhookTaskRunner creates a new mock instance
+func NewMockhookTaskRunner(ctrl *gomock.Controller) *MockhookTaskRunner {
+	mock := &MockhookTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockhookTaskRunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+func (m *MockhookTaskRunner) EXPECT() *MockhookTaskRunnerMockRecorder {
 	return m.recorder
 }
 
-// DeployEnvironment mocks base method
-func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployEnvironment", env)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeployEnvironment indicates an expected call of DeployEnvironment
-func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
-}
-
-// StreamEnvironmentCreation mocks base method
-func (m *Mockdeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+// Service mocks base method
+func (m *MockhookTaskRunner) Service(clusterName, serviceName string) (*ecs.Service, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
-	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
-	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
-func (mr *MockdeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+// Service indicates an expected call of Service
+func (mr *MockhookTaskRunnerMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*Mockdeployer)(nil).StreamEnvironmentCreation), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockhookTaskRunner)(nil).Service), clusterName, serviceName)
 }
 
-// DeleteEnvironment mocks base method
-func (m *Mockdeployer) DeleteEnvironment(appName, envName string) error {
+// TaskDefinition mocks base method
+func (m *MockhookTaskRunner) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteEnvironment indicates an expected call of DeleteEnvironment
-func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MockhookTaskRunnerMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockhookTaskRunner)(nil).TaskDefinition), taskDefName)
 }
 
-// GetEnvironment mocks base method
-func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+// RegisterTaskDefinitionWithImage mocks base method
+func (m *MockhookTaskRunner) RegisterTaskDefinitionWithImage(taskDefName, container, image string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
-	ret0, _ := ret[0].(*config.Environment)
+	ret := m.ctrl.Call(m, "RegisterTaskDefinitionWithImage", taskDefName, container, image)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEnvironment indicates an expected call of GetEnvironment
-func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+// RegisterTaskDefinitionWithImage indicates an expected call of RegisterTaskDefinitionWithImage
+func (mr *MockhookTaskRunnerMockRecorder) RegisterTaskDefinitionWithImage(taskDefName, container, image interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskDefinitionWithImage", reflect.TypeOf((*MockhookTaskRunner)(nil).RegisterTaskDefinitionWithImage), taskDefName, container, image)
 }
 
-// DeployApp mocks base method
-func (m *Mockdeployer) DeployApp(in *deploy.CreateAppInput) error {
+// RunServiceTask mocks base method
+func (m *MockhookTaskRunner) RunServiceTask(svc *ecs.Service, taskDefinition, container string, command []string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RunServiceTask", svc, taskDefinition, container, command)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeployApp indicates an expected call of DeployApp
-func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+// RunServiceTask indicates an expected call of RunServiceTask
+func (mr *MockhookTaskRunnerMockRecorder) RunServiceTask(svc, taskDefinition, container, command interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunServiceTask", reflect.TypeOf((*MockhookTaskRunner)(nil).RunServiceTask), svc, taskDefinition, container, command)
 }
 
-// AddServiceToApp mocks base method
-func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string) error {
+// Task mocks base method
+func (m *MockhookTaskRunner) Task(clusterName, taskARN string) (*ecs.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Task", clusterName, taskARN)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp
-func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
+// Task indicates an expected call of Task
+func (mr *MockhookTaskRunnerMockRecorder) Task(clusterName, taskARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), app, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockhookTaskRunner)(nil).Task), clusterName, taskARN)
 }
 
-// AddEnvToApp mocks base method
-func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocklogStreamReader is a mock of logStreamReader interface
+type MocklogStreamReader struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogStreamReaderMockRecorder
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), app, env)
+// MocklogStreamReaderMockRecorder is the mock recorder for MocklogStreamReader
+type MocklogStreamReaderMockRecorder struct {
+	mock *MocklogStreamReader
 }
 
-// DelegateDNSPermissions mocks base method
-func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMocklogStreamReader creates a new mock instance
+func NewMocklogStreamReader(ctrl *gomock.Controller) *MocklogStreamReader {
+	mock := &MocklogStreamReader{ctrl: ctrl}
+	mock.recorder = &MocklogStreamReaderMockRecorder{mock}
+	return mock
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
-func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklogStreamReader) EXPECT() *MocklogStreamReaderMockRecorder {
+	return m.recorder
 }
 
-// DeleteApp mocks base method
-func (m *Mockdeployer) DeleteApp(name string) error {
+// LogStreamEvents mocks base method
+func (m *MocklogStreamReader) LogStreamEvents(logGroupName, logStreamName string, nextToken *string) ([]*cloudwatchlogs.Event, *string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LogStreamEvents", logGroupName, logStreamName, nextToken)
+	ret0, _ := ret[0].([]*cloudwatchlogs.Event)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// DeleteApp indicates an expected call of DeleteApp
-func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+// LogStreamEvents indicates an expected call of LogStreamEvents
+func (mr *MocklogStreamReaderMockRecorder) LogStreamEvents(logGroupName, logStreamName, nextToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamEvents", reflect.TypeOf((*MocklogStreamReader)(nil).LogStreamEvents), logGroupName, logStreamName, nextToken)
 }
 
-// CreatePipeline mocks base method
-func (m *Mockdeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocksvcStackOutputsGetter is a mock of svcStackOutputsGetter interface
+type MocksvcStackOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcStackOutputsGetterMockRecorder
 }
 
-// CreatePipeline indicates an expected call of CreatePipeline
-func (mr *MockdeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), env)
+// MocksvcStackOutputsGetterMockRecorder is the mock recorder for MocksvcStackOutputsGetter
+type MocksvcStackOutputsGetterMockRecorder struct {
+	mock *MocksvcStackOutputsGetter
 }
 
-// UpdatePipeline mocks base method
-func (m *Mockdeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMocksvcStackOutputsGetter creates a new mock instance
+func NewMocksvcStackOutputsGetter(ctrl *gomock.Controller) *MocksvcStackOutputsGetter {
+	mock := &MocksvcStackOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MocksvcStackOutputsGetterMockRecorder{mock}
+	return mock
 }
 
-// UpdatePipeline indicates an expected call of UpdatePipeline
-func (mr *MockdeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), env)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcStackOutputsGetter) EXPECT() *MocksvcStackOutputsGetterMockRecorder {
+	return m.recorder
 }
 
-// PipelineExists mocks base method
-func (m *Mockdeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+// ServiceStackOutputs mocks base method
+func (m *MocksvcStackOutputsGetter) ServiceStackOutputs(stackName string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PipelineExists", env)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ServiceStackOutputs", stackName)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PipelineExists indicates an expected call of PipelineExists
-func (mr *MockdeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+// ServiceStackOutputs indicates an expected call of ServiceStackOutputs
+func (mr *MocksvcStackOutputsGetterMockRecorder) ServiceStackOutputs(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackOutputs", reflect.TypeOf((*MocksvcStackOutputsGetter)(nil).ServiceStackOutputs), stackName)
 }
 
-// DeletePipeline mocks base method
-func (m *Mockdeployer) DeletePipeline(pipelineName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocktaskDeployer is a mock of taskDeployer interface
+type MocktaskDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDeployerMockRecorder
 }
 
-// DeletePipeline indicates an expected call of DeletePipeline
-func (mr *MockdeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipelineName)
+// MocktaskDeployerMockRecorder is the mock recorder for MocktaskDeployer
+type MocktaskDeployerMockRecorder struct {
+	mock *MocktaskDeployer
 }
 
-// AddPipelineResourcesToApp mocks base method
-func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+// NewMocktaskDeployer creates a new mock instance
+func NewMocktaskDeployer(ctrl *gomock.Controller) *MocktaskDeployer {
+	mock := &MocktaskDeployer{ctrl: ctrl}
+	mock.recorder = &MocktaskDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployTask mocks base method
+func (m *MocktaskDeployer) DeployTask(input *deploy.CreateTaskResourcesInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
+	ret := m.ctrl.Call(m, "DeployTask", input)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
-func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+// DeployTask indicates an expected call of DeployTask
+func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), input)
 }
 
-// GetAppResourcesByRegion mocks base method
-func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// TaskRepositoryURI mocks base method
+func (m *MocktaskDeployer) TaskRepositoryURI(taskName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "TaskRepositoryURI", taskName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// TaskRepositoryURI indicates an expected call of TaskRepositoryURI
+func (mr *MocktaskDeployerMockRecorder) TaskRepositoryURI(taskName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskRepositoryURI", reflect.TypeOf((*MocktaskDeployer)(nil).TaskRepositoryURI), taskName)
 }
 
-// GetRegionalAppResources mocks base method
-func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// MocktaskRunner is a mock of taskRunner interface
+type MocktaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskRunnerMockRecorder
+}
+
+// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner
+type MocktaskRunnerMockRecorder struct {
+	mock *MocktaskRunner
+}
+
+// NewMocktaskRunner creates a new mock instance
+func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
+	mock := &MocktaskRunner{ctrl: ctrl}
+	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// RunTask mocks base method
+func (m *MocktaskRunner) RunTask(input ecs.RunTaskInput) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "RunTask", input)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// RunTask indicates an expected call of RunTask
+func (mr *MocktaskRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MocktaskRunner)(nil).RunTask), input)
 }
 
-// MockdomainValidator is a mock of domainValidator interface
-type MockdomainValidator struct {
+// MockdefaultSubnetsGetter is a mock of defaultSubnetsGetter interface
+type MockdefaultSubnetsGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockdomainValidatorMockRecorder
+	recorder *MockdefaultSubnetsGetterMockRecorder
 }
 
-// MockdomainValidatorMockRecorder is the mock recorder for MockdomainValidator
-type MockdomainValidatorMockRecorder struct {
-	mock *MockdomainValidator
+// MockdefaultSubnetsGetterMockRecorder is the mock recorder for MockdefaultSubnetsGetter
+type MockdefaultSubnetsGetterMockRecorder struct {
+	mock *MockdefaultSubnetsGetter
 }
 
-// NewMockdomainValidator creates a new mock instance
-func NewMockdomainValidator(ctrl *gomock.Controller) *MockdomainValidator {
-	mock := &MockdomainValidator{ctrl: ctrl}
-	mock.recorder = &MockdomainValidatorMockRecorder{mock}
+// NewMockdefaultSubnetsGetter creates a new mock instance
+func NewMockdefaultSubnetsGetter(ctrl *gomock.Controller) *MockdefaultSubnetsGetter {
+	mock := &MockdefaultSubnetsGetter{ctrl: ctrl}
+	mock.recorder = &MockdefaultSubnetsGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdomainValidator) EXPECT() *MockdomainValidatorMockRecorder {
+func (m *MockdefaultSubnetsGetter) EXPECT() *MockdefaultSubnetsGetterMockRecorder {
 	return m.recorder
 }
 
-// DomainExists mocks base method
-func (m *MockdomainValidator) DomainExists(domainName string) (bool, error) {
+// DefaultSubnets mocks base method
+func (m *MockdefaultSubnetsGetter) DefaultSubnets() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DomainExists", domainName)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "DefaultSubnets")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DomainExists indicates an expected call of DomainExists
-func (mr *MockdomainValidatorMockRecorder) DomainExists(domainName interface{}) *gomock.Call {
+// DefaultSubnets indicates an expected call of DefaultSubnets
+func (mr *MockdefaultSubnetsGetterMockRecorder) DefaultSubnets() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainExists", reflect.TypeOf((*MockdomainValidator)(nil).DomainExists), domainName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultSubnets", reflect.TypeOf((*MockdefaultSubnetsGetter)(nil).DefaultSubnets))
 }
 
-// MockdockerfileParser is a mock of dockerfileParser interface
-type MockdockerfileParser struct {
+// MockcodeDeployDeployer is a mock of codeDeployDeployer interface
+type MockcodeDeployDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileParserMockRecorder
+	recorder *MockcodeDeployDeployerMockRecorder
 }
 
-// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser
-type MockdockerfileParserMockRecorder struct {
-	mock *MockdockerfileParser
+// MockcodeDeployDeployerMockRecorder is the mock recorder for MockcodeDeployDeployer
+type MockcodeDeployDeployerMockRecorder struct {
+	mock *MockcodeDeployDeployer
 }
 
-// NewMockdockerfileParser creates a new mock instance
-func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
-	mock := &MockdockerfileParser{ctrl: ctrl}
-	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+// NewMockcodeDeployDeployer creates a new mock instance
+func NewMockcodeDeployDeployer(ctrl *gomock.Controller) *MockcodeDeployDeployer {
+	mock := &MockcodeDeployDeployer{ctrl: ctrl}
+	mock.recorder = &MockcodeDeployDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+func (m *MockcodeDeployDeployer) EXPECT() *MockcodeDeployDeployerMockRecorder {
 	return m.recorder
 }
 
-// GetExposedPorts mocks base method
-func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
+// CreateECSDeployment mocks base method
+func (m *MockcodeDeployDeployer) CreateECSDeployment(in *codedeploy.ECSDeploymentInput) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExposedPorts")
-	ret0, _ := ret[0].([]uint16)
+	ret := m.ctrl.Call(m, "CreateECSDeployment", in)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExposedPorts indicates an expected call of GetExposedPorts
-func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
+// CreateECSDeployment indicates an expected call of CreateECSDeployment
+func (mr *MockcodeDeployDeployerMockRecorder) CreateECSDeployment(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSDeployment", reflect.TypeOf((*MockcodeDeployDeployer)(nil).CreateECSDeployment), in)
 }
 
-// GetHealthCheck mocks base method
-func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
+// Deployment mocks base method
+func (m *MockcodeDeployDeployer) Deployment(id string) (*codedeploy.Deployment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHealthCheck")
-	ret0, _ := ret[0].(*dockerfile.HealthCheck)
+	ret := m.ctrl.Call(m, "Deployment", id)
+	ret0, _ := ret[0].(*codedeploy.Deployment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHealthCheck indicates an expected call of GetHealthCheck
-func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
+// Deployment indicates an expected call of Deployment
+func (mr *MockcodeDeployDeployerMockRecorder) Deployment(id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployment", reflect.TypeOf((*MockcodeDeployDeployer)(nil).Deployment), id)
 }
 
-// MockserviceArnGetter is a mock of serviceArnGetter interface
-type MockserviceArnGetter struct {
+// MockfileWatcher is a mock of fileWatcher interface
+type MockfileWatcher struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceArnGetterMockRecorder
+	recorder *MockfileWatcherMockRecorder
 }
 
-// MockserviceArnGetterMockRecorder is the mock recorder for MockserviceArnGetter
-type MockserviceArnGetterMockRecorder struct {
-	mock *MockserviceArnGetter
+// MockfileWatcherMockRecorder is the mock recorder for MockfileWatcher
+type MockfileWatcherMockRecorder struct {
+	mock *MockfileWatcher
 }
 
-// NewMockserviceArnGetter creates a new mock instance
-func NewMockserviceArnGetter(ctrl *gomock.Controller) *MockserviceArnGetter {
-	mock := &MockserviceArnGetter{ctrl: ctrl}
-	mock.recorder = &MockserviceArnGetterMockRecorder{mock}
+// NewMockfileWatcher creates a new mock instance
+func NewMockfileWatcher(ctrl *gomock.Controller) *MockfileWatcher {
+	mock := &MockfileWatcher{ctrl: ctrl}
+	mock.recorder = &MockfileWatcherMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockserviceArnGetter) EXPECT() *MockserviceArnGetterMockRecorder {
+func (m *MockfileWatcher) EXPECT() *MockfileWatcherMockRecorder {
 	return m.recorder
 }
 
-// GetServiceArn mocks base method
-func (m *MockserviceArnGetter) GetServiceArn() (*ecs.ServiceArn, error) {
+// Wait mocks base method
+func (m *MockfileWatcher) Wait() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetServiceArn")
-	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret := m.ctrl.Call(m, "Wait")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetServiceArn indicates an expected call of GetServiceArn
-func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
+// Wait indicates an expected call of Wait
+func (mr *MockfileWatcherMockRecorder) Wait() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockfileWatcher)(nil).Wait))
 }
 
 // MockstatusDescriber is a mock of statusDescriber interface
@@ -3642,3 +5710,56 @@ func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
 }
+
+// Mockec2Selector is a mock of ec2Selector interface
+type Mockec2Selector struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2SelectorMockRecorder
+}
+
+// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector
+type Mockec2SelectorMockRecorder struct {
+	mock *Mockec2Selector
+}
+
+// NewMockec2Selector creates a new mock instance
+func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
+	mock := &Mockec2Selector{ctrl: ctrl}
+	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+	return m.recorder
+}
+
+// VPC mocks base method
+func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPC indicates an expected call of VPC
+func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+}
+
+// Subnets mocks base method
+func (m *Mockec2Selector) Subnets(prompt, help, vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subnets", prompt, help, vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subnets indicates an expected call of Subnets
+func (mr *Mockec2SelectorMockRecorder) Subnets(prompt, help, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*Mockec2Selector)(nil).Subnets), prompt, help, vpcID)
+}
@@ -53,7 +53,7 @@ type deletePipelineOpts struct {
 }
 
 func newDeletePipelineOpts(vars deletePipelineVars) (*deletePipelineOpts, error) {
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
@@ -189,6 +189,16 @@ func (o *deletePipelineOpts) RecommendedActions() []string {
 	return nil
 }
 
+// auditedTarget returns the pipeline deleted by the command.
+func (o *deletePipelineOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		stacks: []auditStack{
+			{name: o.PipelineName},
+		},
+	}
+}
+
 // Run validates user input, asks for any missing flags, and then executes the command.
 func (o *deletePipelineOpts) Run() error {
 	if err := o.Validate(); err != nil {
@@ -220,9 +230,10 @@ func BuildPipelineDeleteCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return opts.Run()
+			return executeAudited(cmd, args, opts, opts.Run)
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.DeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
 	return cmd
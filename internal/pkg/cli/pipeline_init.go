@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"os"
@@ -61,6 +62,7 @@ type initPipelineVars struct {
 	GitHubURL         string
 	GitHubAccessToken string
 	GitBranch         string
+	Force             bool // Regenerate the manifest and buildspec if they already exist.
 	*GlobalOpts
 }
 
@@ -97,7 +99,7 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		fs:               &afero.Afero{Fs: afero.NewOsFs()},
 	}
 
-	ssmStore, err := config.NewStore()
+	ssmStore, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store client: %w", err)
 	}
@@ -112,7 +114,7 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 	}
 	opts.envs = envs
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
@@ -259,6 +261,19 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 		return fmt.Errorf("generate a pipeline manifest: %w", err)
 	}
 
+	if o.Force {
+		err = o.updatePipelineManifest(manifest)
+	} else {
+		err = o.writePipelineManifest(manifest)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infoln("The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.")
+	return nil
+}
+
+func (o *initPipelineOpts) writePipelineManifest(manifest encoding.BinaryMarshaler) error {
 	var manifestExists bool
 	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
 	if err != nil {
@@ -280,27 +295,57 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 		manifestMsgFmt = "Pipeline manifest file for %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.GitHubRepo), color.HighlightResource(manifestPath))
-	log.Infoln("The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.")
 	return nil
 }
 
+func (o *initPipelineOpts) updatePipelineManifest(manifest encoding.BinaryMarshaler) error {
+	update, err := o.workspace.UpdatePipelineManifest(manifest)
+	if err != nil {
+		return fmt.Errorf("update pipeline manifest in workspace: %w", err)
+	}
+	_, err = applyFileUpdate(o.workspace, update, fmt.Sprintf("pipeline manifest for %s", color.HighlightUserInput(o.GitHubRepo)))
+	return err
+}
+
 func (o *initPipelineOpts) createBuildspec() error {
 	artifactBuckets, err := o.artifactBuckets()
 	if err != nil {
 		return err
 	}
+	appDir, err := o.workspace.AppDirRelPath()
+	if err != nil {
+		return fmt.Errorf("get application directory: %w", err)
+	}
 	content, err := o.parser.Parse(buildspecTemplatePath, struct {
 		BinaryS3BucketPath string
 		Version            string
 		ArtifactBuckets    []artifactBucket
+		AppName            string
+		AppDir             string // Directory of the application's manifests, relative to the root of the repository.
 	}{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
 		ArtifactBuckets:    artifactBuckets,
+		AppName:            o.AppName(),
+		AppDir:             appDir,
 	})
 	if err != nil {
 		return err
 	}
+	if o.Force {
+		err = o.updateBuildspec(content)
+	} else {
+		err = o.writeBuildspec(content)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infoln("The buildspec contains the commands to build and push your container images to your ECR repositories.")
+
+	return nil
+}
+
+func (o *initPipelineOpts) writeBuildspec(content encoding.BinaryMarshaler) error {
 	buildspecPath, err := o.workspace.WritePipelineBuildspec(content)
 	var buildspecExists bool
 	if err != nil {
@@ -320,11 +365,18 @@ func (o *initPipelineOpts) createBuildspec() error {
 		return err
 	}
 	log.Successf(buildspecMsgFmt, color.HighlightResource(buildspecPath))
-	log.Infoln("The buildspec contains the commands to build and push your container images to your ECR repositories.")
-
 	return nil
 }
 
+func (o *initPipelineOpts) updateBuildspec(content encoding.BinaryMarshaler) error {
+	update, err := o.workspace.UpdatePipelineBuildspec(content)
+	if err != nil {
+		return fmt.Errorf("update buildspec in workspace: %w", err)
+	}
+	_, err = applyFileUpdate(o.workspace, update, "buildspec for the pipeline's build stage")
+	return err
+}
+
 func (o *initPipelineOpts) artifactBuckets() ([]artifactBucket, error) {
 	app, err := o.store.GetApplication(o.AppName())
 	if err != nil {
@@ -511,7 +563,10 @@ func BuildPipelineInitCmd() *cobra.Command {
   /code  --github-url https://github.com/gitHubUserName/myFrontendApp.git \
   /code  --github-access-token file://myGitHubToken \
   /code  --environments "stage,prod" \
-  /code  --deploy`,
+  /code  --deploy
+
+  Regenerate the pipeline manifest and buildspec after upgrading copilot, keeping your edits.
+  /code $ copilot pipeline init --github-url https://github.com/gitHubUserName/myFrontendApp.git --environments "stage,prod" --force`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -534,10 +589,12 @@ func BuildPipelineInitCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitHubURL, githubURLFlag, githubURLFlagShort, "", githubURLFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitHubAccessToken, githubAccessTokenFlag, githubAccessTokenFlagShort, "", githubAccessTokenFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.Environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.Force, forceFlag, false, pipelineInitForceFlagDescription)
 
 	return cmd
 }
@@ -302,6 +302,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inGitHubRepo   string
 		inGitBranch    string
 		inAppName      string
+		inForce        bool
 
 		mockSecretsManager          func(m *mocks.MocksecretsManager)
 		mockWsWriter                func(m *mocks.MockwsPipelineWriter)
@@ -348,6 +349,79 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"regenerates manifest and buildspec if forced": {
+			inEnvironments: []string{"test"},
+			inGitHubToken:  "hunter2",
+			inGitHubRepo:   "goose",
+			inGitBranch:    "dev",
+			inAppName:      "badgoose",
+			inForce:        true,
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", &secretsmanager.ErrSecretAlreadyExists{})
+			},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any()).Times(0)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
+				m.EXPECT().UpdatePipelineManifest(gomock.Any()).Return(&workspace.FileUpdate{Path: "/pipeline.yml"}, nil)
+				m.EXPECT().UpdatePipelineBuildspec(gomock.Any()).Return(&workspace.FileUpdate{
+					Path:       "/buildspec.yml",
+					BackupPath: "/buildspec.yml.bak",
+					Diff:       "--- /buildspec.yml\n+++ /buildspec.yml\n",
+					Conflicts:  1,
+				}, nil)
+				m.EXPECT().ApplyUpdate(gomock.Any()).Return(nil).Times(2)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+		},
+		"returns an error if the buildspec can't be regenerated": {
+			inEnvironments: []string{"test"},
+			inGitHubToken:  "hunter2",
+			inGitHubRepo:   "goose",
+			inGitBranch:    "dev",
+			inAppName:      "badgoose",
+			inForce:        true,
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
+			},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().UpdatePipelineManifest(gomock.Any()).Return(&workspace.FileUpdate{Path: "/pipeline.yml", Created: true}, nil)
+				m.EXPECT().ApplyUpdate(&workspace.FileUpdate{Path: "/pipeline.yml", Created: true}).Return(nil)
+				m.EXPECT().UpdatePipelineBuildspec(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{}, nil)
+			},
+			expectedError: fmt.Errorf("update buildspec in workspace: some error"),
+		},
 		"does not return an error if secret already exists": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
@@ -572,6 +646,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 			tc.mockSecretsManager(mockSecretsManager)
 			tc.mockWsWriter(mockWriter)
+			mockWriter.EXPECT().AppDirRelPath().Return("copilot", nil).AnyTimes()
 			tc.mockParser(mockParser)
 			tc.mockRegionalResourcesGetter(mockRegionalResourcesGetter)
 			tc.mockStoreSvc(mockstore)
@@ -583,6 +658,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					GitHubRepo:        tc.inGitHubRepo,
 					GitHubAccessToken: tc.inGitHubToken,
 					GitBranch:         tc.inGitBranch,
+					Force:             tc.inForce,
 					GlobalOpts:        &GlobalOpts{appName: tc.inAppName},
 				},
 
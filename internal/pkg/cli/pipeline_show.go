@@ -49,12 +49,12 @@ type showPipelineOpts struct {
 }
 
 func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store client: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"path"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
@@ -36,6 +37,11 @@ const (
 	fmtPipelineUpdateProposalFailed   = "Failed to accept changes for pipeline: %s."
 	fmtPipelineUpdateProposalComplete = "Successfully updated pipeline: %s"
 
+	fmtPipelineUpdateExecuteStart    = "Updating the pipeline: %s"
+	fmtPipelineUpdatePreviewFailed   = "Failed to propose infrastructure changes for the pipeline: %s."
+	fmtPipelineUpdatePreviewComplete = "Proposed infrastructure changes for the pipeline: %s"
+	fmtPipelineUpdatePreviewEmpty    = "No infrastructure changes to deploy for the pipeline: %s"
+
 	fmtPipelineUpdateExistPrompt = "Are you sure you want to update an existing pipeline: %s?"
 )
 
@@ -43,21 +49,24 @@ type updatePipelineVars struct {
 	PipelineName     string
 	SkipConfirmation bool
 	*GlobalOpts
+	changeSetReviewVars
 }
 
 type updatePipelineOpts struct {
 	updatePipelineVars
 
-	pipelineDeployer pipelineDeployer
-	app              *config.Application
-	prog             progress
-	region           string
-	envStore         environmentStore
-	ws               wsPipelineReader
+	pipelineDeployer  pipelineDeployer
+	pipelinePreviewer pipelinePreviewer
+	recoverer         stackRecoverer
+	app               *config.Application
+	prog              progress
+	region            string
+	envStore          environmentStore
+	ws                wsPipelineReader
 }
 
 func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store client: %w", err)
 	}
@@ -72,14 +81,17 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 		return nil, fmt.Errorf("default session: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
 
+	pipelineDeployer := deploycfn.New(defaultSession)
 	return &updatePipelineOpts{
 		app:                app,
-		pipelineDeployer:   deploycfn.New(defaultSession),
+		pipelineDeployer:   pipelineDeployer,
+		pipelinePreviewer:  pipelineDeployer,
+		recoverer:          pipelineDeployer,
 		region:             aws.StringValue(defaultSession.Config.Region),
 		updatePipelineVars: vars,
 		envStore:           store,
@@ -153,6 +165,9 @@ func (o *updatePipelineOpts) shouldUpdate() (bool, error) {
 }
 
 func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) error {
+	if o.shouldReview() {
+		return o.reviewAndDeployPipeline(in)
+	}
 	exist, err := o.pipelineDeployer.PipelineExists(in)
 	if err != nil {
 		return fmt.Errorf("check if pipeline exists: %w", err)
@@ -163,7 +178,10 @@ func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 			var alreadyExists *cloudformation.ErrStackAlreadyExists
 			if !errors.As(err, &alreadyExists) {
 				o.prog.Stop(log.Serrorf(fmtPipelineUpdateFailed, color.HighlightUserInput(o.PipelineName)))
-				return fmt.Errorf("create pipeline: %w", err)
+				if recovered, err := o.recoverStack(err); !recovered {
+					return fmt.Errorf("create pipeline: %w", err)
+				}
+				return o.deployPipeline(in)
 			}
 		}
 		o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateComplete, color.HighlightUserInput(o.PipelineName)))
@@ -181,12 +199,68 @@ func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 	o.prog.Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, color.HighlightUserInput(o.PipelineName)))
 	if err := o.pipelineDeployer.UpdatePipeline(in); err != nil {
 		o.prog.Stop(log.Serrorf(fmtPipelineUpdateProposalFailed, color.HighlightUserInput(o.PipelineName)))
-		return fmt.Errorf("update pipeline: %w", err)
+		if recovered, err := o.recoverStack(err); !recovered {
+			return fmt.Errorf("update pipeline: %w", err)
+		}
+		// A stack that failed to be created is deleted by the recovery, so the pipeline may need to be created instead.
+		return o.deployPipeline(in)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateProposalComplete, color.HighlightUserInput(o.PipelineName)))
 	return nil
 }
 
+// recoverStack offers to recover the stack of the pipeline if a previous deployment left it in a failed state.
+func (o *updatePipelineOpts) recoverStack(err error) (bool, error) {
+	return recoverStack(stackRecovery{
+		prompt:    o.prompt,
+		recoverer: o.recoverer,
+		spinner:   o.prog,
+	}, err)
+}
+
+// reviewAndDeployPipeline shows the infrastructure changes to create or update the pipeline before deploying them.
+func (o *updatePipelineOpts) reviewAndDeployPipeline(in *deploy.CreatePipelineInput) error {
+	pipelineName := color.HighlightUserInput(o.PipelineName)
+	o.prog.Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName))
+	cs, err := o.pipelinePreviewer.PreviewPipeline(in)
+	if err != nil {
+		var errEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmpty) {
+			o.prog.Stop(log.Ssuccessf(fmtPipelineUpdatePreviewEmpty, pipelineName))
+			return nil
+		}
+		o.prog.Stop(log.Serrorf(fmtPipelineUpdatePreviewFailed, pipelineName))
+		return fmt.Errorf("preview changes to pipeline %s: %w", o.PipelineName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdatePreviewComplete, pipelineName))
+
+	confirmed, err := o.review(o.prompt, o.pipelinePreviewer, cs)
+	if err != nil || !confirmed {
+		return err
+	}
+	start, failed, complete := fmtPipelineUpdateExecuteStart, fmtPipelineUpdateProposalFailed, fmtPipelineUpdateProposalComplete
+	if cs.IsCreate {
+		start, failed, complete = fmtPipelineUpdateStart, fmtPipelineUpdateFailed, fmtPipelineUpdateComplete
+	}
+	o.prog.Start(fmt.Sprintf(start, pipelineName))
+	if err := o.pipelinePreviewer.ExecuteChangeSetAndWait(cs); err != nil {
+		o.prog.Stop(log.Serrorf(failed, pipelineName))
+		return fmt.Errorf("deploy pipeline: %w", err)
+	}
+	o.prog.Stop(log.Ssuccessf(complete, pipelineName))
+	return nil
+}
+
+// auditedTarget returns the pipeline updated by the command.
+func (o *updatePipelineOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		stacks: []auditStack{
+			{name: o.PipelineName},
+		},
+	}
+}
+
 // Execute create a new pipeline or update the current pipeline if it already exists.
 func (o *updatePipelineOpts) Execute() error {
 	// bootstrap pipeline resources
@@ -225,6 +299,12 @@ func (o *updatePipelineOpts) Execute() error {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
+	// the buildspec is stored in the application directory, which is nested in multi-application workspaces
+	appDir, err := o.ws.AppDirRelPath()
+	if err != nil {
+		return fmt.Errorf("get application directory: %w", err)
+	}
+
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:         o.AppName(),
 		Name:            pipeline.Name,
@@ -232,6 +312,7 @@ func (o *updatePipelineOpts) Execute() error {
 		Stages:          stages,
 		ArtifactBuckets: artifactBuckets,
 		AdditionalTags:  o.app.Tags,
+		BuildspecPath:   path.Join(appDir, workspace.BuildspecFileName),
 	}
 
 	if err := o.deployPipeline(deployPipelineInput); err != nil {
@@ -252,7 +333,10 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the services in your workspace, using the environments associated with the application.`,
 		Example: `
   Deploys an updated pipeline for the services in your workspace.
-  /code $ copilot pipeline update`,
+  /code $ copilot pipeline update
+
+  Shows the infrastructure changes to the pipeline without deploying them.
+  /code $ copilot pipeline update --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newUpdatePipelineOpts(vars)
 			if err != nil {
@@ -261,10 +345,12 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
-			return opts.Execute()
+			return executeAudited(cmd, args, opts, opts.Execute)
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	addChangeSetReviewFlags(cmd, &vars.changeSetReviewVars)
 
 	return cmd
 }
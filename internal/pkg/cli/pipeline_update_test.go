@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -277,6 +278,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
@@ -306,6 +308,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -336,6 +339,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -363,6 +367,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -473,6 +478,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, errors.New("some error")),
@@ -499,6 +505,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
@@ -528,6 +535,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().AppDirRelPath().Return("copilot", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -591,3 +599,126 @@ stages:
 		})
 	}
 }
+
+func TestUpdatePipelineOpts_reviewAndDeployPipeline(t *testing.T) {
+	const pipelineName = "pipepiper"
+	mockChangeSet := &cloudformation.ChangeSet{
+		Name:      "ecscli-1234",
+		StackName: "pipeline-badgoose-pipepiper",
+	}
+	testCases := map[string]struct {
+		inDryRun  bool
+		callMocks func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"does nothing if the pipeline is up to date": {
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(nil, &cloudformation.ErrChangeSetEmpty{}),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdatePreviewEmpty, pipelineName)),
+				)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"returns an error if the changes can't be previewed": {
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(nil, errors.New("some error")),
+					prog.EXPECT().Stop(log.Serrorf(fmtPipelineUpdatePreviewFailed, pipelineName)),
+				)
+			},
+			wantedErr: errors.New("preview changes to pipeline pipepiper: some error"),
+		},
+		"discards the changes in dry-run mode": {
+			inDryRun: true,
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(mockChangeSet, nil),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdatePreviewComplete, pipelineName)),
+					previewer.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil),
+				)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				previewer.EXPECT().ExecuteChangeSetAndWait(gomock.Any()).Times(0)
+			},
+		},
+		"discards the changes if they are declined": {
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(mockChangeSet, nil),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdatePreviewComplete, pipelineName)),
+					prompt.EXPECT().Confirm(fmt.Sprintf(fmtChangeSetConfirmPrompt, mockChangeSet.StackName), changeSetConfirmHelp).Return(false, nil),
+					previewer.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil),
+				)
+				previewer.EXPECT().ExecuteChangeSetAndWait(gomock.Any()).Times(0)
+			},
+		},
+		"deploys the changes once they are confirmed": {
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(mockChangeSet, nil),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdatePreviewComplete, pipelineName)),
+					prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateExecuteStart, pipelineName)),
+					previewer.EXPECT().ExecuteChangeSetAndWait(mockChangeSet).Return(nil),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateProposalComplete, pipelineName)),
+				)
+			},
+		},
+		"returns an error if the changes fail to deploy": {
+			callMocks: func(previewer *mocks.MockpipelinePreviewer, prompt *mocks.Mockprompter, prog *mocks.Mockprogress) {
+				gomock.InOrder(
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)),
+					previewer.EXPECT().PreviewPipeline(gomock.Any()).Return(mockChangeSet, nil),
+					prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdatePreviewComplete, pipelineName)),
+					prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateExecuteStart, pipelineName)),
+					previewer.EXPECT().ExecuteChangeSetAndWait(mockChangeSet).Return(errors.New("some error")),
+					prog.EXPECT().Stop(log.Serrorf(fmtPipelineUpdateProposalFailed, pipelineName)),
+				)
+			},
+			wantedErr: errors.New("deploy pipeline: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPreviewer := mocks.NewMockpipelinePreviewer(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			tc.callMocks(mockPreviewer, mockPrompt, mockProgress)
+
+			opts := &updatePipelineOpts{
+				updatePipelineVars: updatePipelineVars{
+					PipelineName: pipelineName,
+					GlobalOpts: &GlobalOpts{
+						prompt: mockPrompt,
+					},
+					changeSetReviewVars: changeSetReviewVars{
+						showDiff: !tc.inDryRun,
+						dryRun:   tc.inDryRun,
+					},
+				},
+				pipelinePreviewer: mockPreviewer,
+				prog:              mockProgress,
+			}
+
+			// WHEN
+			err := opts.deployPipeline(&deploy.CreatePipelineInput{Name: pipelineName})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtProdConfirmPrompt = "Are you sure you want to %s in production environment %s?"
+	prodConfirmHelp      = "The environment was created with --prod. Use --yes-i-mean-prod to skip this confirmation."
+
+	// pipelineBuildEnvVar is set by CodeBuild in the build projects of pipelines.
+	pipelineBuildEnvVar = "CODEBUILD_BUILD_ID"
+)
+
+// prodEnvNames returns the names of the production environments in envs.
+func prodEnvNames(envs []*config.Environment) []string {
+	var names []string
+	for _, env := range envs {
+		if env.Prod {
+			names = append(names, env.Name)
+		}
+	}
+	return names
+}
+
+// confirmProd asks for confirmation before the action is taken in the production environments of envs.
+// It returns true if none of the environments are production environments or if the confirmation is skipped.
+func confirmProd(prompt prompter, skipConfirmation bool, action string, envs []*config.Environment) (bool, error) {
+	names := prodEnvNames(envs)
+	if len(names) == 0 || skipConfirmation {
+		return true, nil
+	}
+	envNames := color.HighlightUserInput(strings.Join(names, ", "))
+	confirmed, err := prompt.Confirm(fmt.Sprintf(fmtProdConfirmPrompt, action, envNames), prodConfirmHelp)
+	if err != nil {
+		return false, fmt.Errorf("confirm to %s in production environment %s: %w", action, strings.Join(names, ", "), err)
+	}
+	return confirmed, nil
+}
+
+// confirmProdChangeSet asks for confirmation before deploying a change set that deletes or replaces resources
+// in a production environment. If the changes are declined, the change set is deleted.
+func confirmProdChangeSet(prompt prompter, executor changeSetExecutor, cs *cloudformation.ChangeSet, env *config.Environment, skipConfirmation bool) (bool, error) {
+	if !env.Prod || skipConfirmation || !cs.IsDestructive() {
+		return true, nil
+	}
+	renderChangeSet(log.DiagnosticWriter, cs)
+	confirmed, err := confirmProd(prompt, false, "delete or replace resources", []*config.Environment{env})
+	if err != nil {
+		return false, err
+	}
+	if !confirmed {
+		if err := executor.DeleteChangeSet(cs); err != nil {
+			return false, err
+		}
+		log.Infof("The changes to stack %s were not deployed.\n", color.HighlightResource(cs.StackName))
+		return false, nil
+	}
+	return true, nil
+}
+
+// validatePipelineOnly returns an error if the environment only accepts deployments from a pipeline
+// and the command is not running in one.
+func validatePipelineOnly(env *config.Environment) error {
+	if !env.PipelineOnly || os.Getenv(pipelineBuildEnvVar) != "" {
+		return nil
+	}
+	return fmt.Errorf("environment %s only accepts deployments from a pipeline", env.Name)
+}
+
+// svcStackOptions returns the options of the service stacks deployed to the environment.
+func svcStackOptions(env *config.Environment) []cloudformation.StackOption {
+	opts := []cloudformation.StackOption{cloudformation.WithRoleARN(env.ExecutionRoleARN)}
+	if env.Prod {
+		opts = append(opts, deploycfn.ProdStackOptions()...)
+	}
+	return opts
+}
@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmProd(t *testing.T) {
+	testEnv := &config.Environment{Name: "test"}
+	prodEnv := &config.Environment{Name: "prod", Prod: true}
+	testCases := map[string]struct {
+		inEnvs             []*config.Environment
+		inSkipConfirmation bool
+		mockPrompt         func(m *mocks.Mockprompter)
+
+		wantedConfirmed bool
+		wantedErr       error
+	}{
+		"does not prompt without production environments": {
+			inEnvs:     []*config.Environment{testEnv},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+
+			wantedConfirmed: true,
+		},
+		"does not prompt if the confirmation is skipped": {
+			inEnvs:             []*config.Environment{testEnv, prodEnv},
+			inSkipConfirmation: true,
+			mockPrompt:         func(m *mocks.Mockprompter) {},
+
+			wantedConfirmed: true,
+		},
+		"returns the confirmation for the production environments": {
+			inEnvs: []*config.Environment{testEnv, prodEnv},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtProdConfirmPrompt, "delete service api", color.HighlightUserInput("prod")), prodConfirmHelp).Return(false, nil)
+			},
+
+			wantedConfirmed: false,
+		},
+		"wraps the prompt error": {
+			inEnvs: []*config.Environment{prodEnv},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("confirm to delete service api in production environment prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prompt := mocks.NewMockprompter(ctrl)
+			tc.mockPrompt(prompt)
+
+			// WHEN
+			confirmed, err := confirmProd(prompt, tc.inSkipConfirmation, "delete service api", tc.inEnvs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConfirmed, confirmed)
+		})
+	}
+}
+
+func TestConfirmProdChangeSet(t *testing.T) {
+	destructiveChangeSet := &cloudformation.ChangeSet{
+		StackName: "phonetool-prod-api",
+		Resources: []cloudformation.ResourceChange{
+			{Action: "Modify", LogicalID: "LogGroup", Type: "AWS::Logs::LogGroup", Replacement: "True"},
+		},
+	}
+	safeChangeSet := &cloudformation.ChangeSet{
+		StackName: "phonetool-prod-api",
+		Resources: []cloudformation.ResourceChange{
+			{Action: "Modify", LogicalID: "TaskDefinition", Type: "AWS::ECS::TaskDefinition", Replacement: "False"},
+		},
+	}
+	testCases := map[string]struct {
+		inChangeSet        *cloudformation.ChangeSet
+		inEnv              *config.Environment
+		inSkipConfirmation bool
+		mockDeps           func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor)
+
+		wantedConfirmed bool
+		wantedErr       error
+	}{
+		"does not prompt outside of production environments": {
+			inChangeSet: destructiveChangeSet,
+			inEnv:       &config.Environment{Name: "test"},
+			mockDeps:    func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {},
+
+			wantedConfirmed: true,
+		},
+		"does not prompt for changes that keep the resources": {
+			inChangeSet: safeChangeSet,
+			inEnv:       &config.Environment{Name: "prod", Prod: true},
+			mockDeps:    func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {},
+
+			wantedConfirmed: true,
+		},
+		"does not prompt if the confirmation is skipped": {
+			inChangeSet:        destructiveChangeSet,
+			inEnv:              &config.Environment{Name: "prod", Prod: true},
+			inSkipConfirmation: true,
+			mockDeps:           func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {},
+
+			wantedConfirmed: true,
+		},
+		"deploys confirmed changes": {
+			inChangeSet: destructiveChangeSet,
+			inEnv:       &config.Environment{Name: "prod", Prod: true},
+			mockDeps: func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtProdConfirmPrompt, "delete or replace resources", color.HighlightUserInput("prod")), prodConfirmHelp).Return(true, nil)
+			},
+
+			wantedConfirmed: true,
+		},
+		"deletes the change set if the changes are declined": {
+			inChangeSet: destructiveChangeSet,
+			inEnv:       &config.Environment{Name: "prod", Prod: true},
+			mockDeps: func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+				executor.EXPECT().DeleteChangeSet(destructiveChangeSet).Return(nil)
+			},
+
+			wantedConfirmed: false,
+		},
+		"returns the error from deleting the change set": {
+			inChangeSet: destructiveChangeSet,
+			inEnv:       &config.Environment{Name: "prod", Prod: true},
+			mockDeps: func(prompt *mocks.Mockprompter, executor *mocks.MockchangeSetExecutor) {
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+				executor.EXPECT().DeleteChangeSet(destructiveChangeSet).Return(errors.New("some error"))
+			},
+
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prompt := mocks.NewMockprompter(ctrl)
+			executor := mocks.NewMockchangeSetExecutor(ctrl)
+			tc.mockDeps(prompt, executor)
+
+			// WHEN
+			confirmed, err := confirmProdChangeSet(prompt, executor, tc.inChangeSet, tc.inEnv, tc.inSkipConfirmation)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConfirmed, confirmed)
+		})
+	}
+}
+
+func TestValidatePipelineOnly(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv     *config.Environment
+		inBuildID string
+
+		wantedErr error
+	}{
+		"allows environments without the restriction": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+		},
+		"allows deployments from a pipeline": {
+			inEnv:     &config.Environment{Name: "prod", Prod: true, PipelineOnly: true},
+			inBuildID: "phonetool-build:1234",
+		},
+		"rejects deployments outside of a pipeline": {
+			inEnv: &config.Environment{Name: "prod", Prod: true, PipelineOnly: true},
+
+			wantedErr: errors.New("environment prod only accepts deployments from a pipeline"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			prev, ok := os.LookupEnv(pipelineBuildEnvVar)
+			defer func() {
+				if ok {
+					os.Setenv(pipelineBuildEnvVar, prev)
+				} else {
+					os.Unsetenv(pipelineBuildEnvVar)
+				}
+			}()
+			os.Setenv(pipelineBuildEnvVar, tc.inBuildID)
+
+			// WHEN
+			err := validatePipelineOnly(tc.inEnv)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcStackOptions(t *testing.T) {
+	require.Len(t, svcStackOptions(&config.Environment{Name: "test"}), 1)
+	require.Len(t, svcStackOptions(&config.Environment{Name: "prod", Prod: true}), 3)
+}
@@ -3,7 +3,15 @@
 
 package cli
 
-import termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
 
 // progress is the interface to inform the user that a long operation is taking place.
 type progress interface {
@@ -18,6 +26,9 @@ type progress interface {
 // envProgressOrder is the order in which we want to progress text to appear on the terminal.
 var envProgressOrder = []termprogress.Text{textVPC, textInternetGateway, textPublicSubnets, textPrivateSubnets, textRouteTables, textECSCluster, textALB}
 
+// importedVPCEnvProgressOrder is the order of the progress text of an environment deployed in an existing VPC.
+var importedVPCEnvProgressOrder = []termprogress.Text{textECSCluster, textALB}
+
 // Row descriptions displayed while deploying an environment.
 const (
 	textVPC             termprogress.Text = "- Virtual private cloud on 2 availability zones to hold your services"
@@ -28,3 +39,131 @@ const (
 	textECSCluster      termprogress.Text = "- ECS Cluster to hold your services "
 	textALB             termprogress.Text = "- Application load balancer to distribute traffic "
 )
+
+// svcProgressOrder is the order in which we want the progress text of a service to appear on the terminal.
+var svcProgressOrder = []termprogress.Text{textAddonsStack, textTaskDefinition, textTargetGroup, textListenerRule, textECSService}
+
+// Row descriptions displayed while deploying or deleting a service.
+const (
+	textAddonsStack    termprogress.Text = "- Addons stack with the additional resources of your service"
+	textTaskDefinition termprogress.Text = "- Task definition with the container settings of your service"
+	textTargetGroup    termprogress.Text = "- Target group to register your tasks with the load balancer"
+	textListenerRule   termprogress.Text = "- Listener rule to route requests to your service"
+	textECSService     termprogress.Text = "- ECS service to run and maintain your tasks"
+
+	fmtTextAddonsResource = "  - %s"
+	fmtECSServiceTasks    = "  - %d/%d running tasks, %d pending\t"
+)
+
+// svcResourceMatchers matches the resources of the service stack to the text describing them.
+var svcResourceMatchers = map[termprogress.Text]termprogress.ResourceMatcher{
+	textAddonsStack: func(r deploy.Resource) bool {
+		return r.NestedStack == "" && r.Type == "AWS::CloudFormation::Stack" && r.LogicalName == "AddonsStack"
+	},
+	textTaskDefinition: func(r deploy.Resource) bool {
+		return r.NestedStack == "" && r.Type == "AWS::ECS::TaskDefinition"
+	},
+	textTargetGroup: func(r deploy.Resource) bool {
+		return r.NestedStack == "" && r.Type == "AWS::ElasticLoadBalancingV2::TargetGroup"
+	},
+	textListenerRule: func(r deploy.Resource) bool {
+		return r.NestedStack == "" && r.Type == "AWS::ElasticLoadBalancingV2::ListenerRule"
+	},
+	textECSService: func(r deploy.Resource) bool {
+		return r.NestedStack == "" && r.Type == "AWS::ECS::Service"
+	},
+}
+
+// humanizeServiceEvents groups the events of a service stack under human-friendly texts.
+// Only the resources that changed are displayed, and the resources of the addons stack are listed under it.
+// If ecsSvc is not nil, the task counts of the ECS service are displayed until the service becomes stable.
+func humanizeServiceEvents(events []deploy.ResourceEvent, ecsSvc ecsServiceDescriber) []termprogress.TabRow {
+	var orderedTexts []termprogress.Text
+	matcher := make(map[termprogress.Text]termprogress.ResourceMatcher)
+	wantedCount := make(map[termprogress.Text]int)
+	add := func(text termprogress.Text, matches termprogress.ResourceMatcher) {
+		orderedTexts = append(orderedTexts, text)
+		matcher[text] = matches
+		wantedCount[text] = 1
+	}
+	for _, text := range svcProgressOrder {
+		matches := svcResourceMatchers[text]
+		if _, ok := lastMatchingEvent(events, matches); !ok {
+			continue
+		}
+		add(text, matches)
+		if text != textAddonsStack {
+			continue
+		}
+		for _, name := range addonsResources(events) {
+			name := name
+			add(termprogress.Text(fmt.Sprintf(fmtTextAddonsResource, name)), func(r deploy.Resource) bool {
+				return r.NestedStack != "" && r.LogicalName == name
+			})
+		}
+	}
+	rows := termprogress.HumanizeResourceEvents(orderedTexts, events, matcher, wantedCount)
+
+	if ecsSvc == nil {
+		return rows
+	}
+	// The ECS service is the last text, so its task counts can be appended to the rows while it is stabilizing.
+	ev, ok := lastMatchingEvent(events, svcResourceMatchers[textECSService])
+	if !ok || ev.PhysicalID == "" || !strings.HasSuffix(ev.Status, "_IN_PROGRESS") || strings.HasPrefix(ev.Status, "DELETE") {
+		return rows
+	}
+	if counts, ok := ecsServiceTasks(ecsSvc, ev.PhysicalID); ok {
+		rows = append(rows, counts)
+	}
+	return rows
+}
+
+// lastMatchingEvent returns the most recent event of the resources that match.
+func lastMatchingEvent(events []deploy.ResourceEvent, matches termprogress.ResourceMatcher) (deploy.ResourceEvent, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if matches(events[i].Resource) {
+			return events[i], true
+		}
+	}
+	return deploy.ResourceEvent{}, false
+}
+
+// addonsResources returns the logical names of the resources in nested stacks, in the order of their first event.
+func addonsResources(events []deploy.ResourceEvent) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, ev := range events {
+		if ev.NestedStack == "" || seen[ev.LogicalName] {
+			continue
+		}
+		seen[ev.LogicalName] = true
+		names = append(names, ev.LogicalName)
+	}
+	return names
+}
+
+// ecsServiceTasks returns a row with the task counts of the primary deployment of the ECS service.
+// Failing to describe the service is ignored since the counts are only informative.
+func ecsServiceTasks(ecsSvc ecsServiceDescriber, serviceARN string) (termprogress.TabRow, bool) {
+	arn := ecs.ServiceArn(serviceARN)
+	clusterName, err := arn.ClusterName()
+	if err != nil {
+		return "", false
+	}
+	serviceName, err := arn.ServiceName()
+	if err != nil {
+		return "", false
+	}
+	svc, err := ecsSvc.Service(clusterName, serviceName)
+	if err != nil {
+		return "", false
+	}
+	for _, d := range svc.Deployments {
+		if aws.StringValue(d.Status) != "PRIMARY" {
+			continue
+		}
+		return termprogress.TabRow(fmt.Sprintf(fmtECSServiceTasks,
+			aws.Int64Value(d.RunningCount), aws.Int64Value(d.DesiredCount), aws.Int64Value(d.PendingCount))), true
+	}
+	return "", false
+}
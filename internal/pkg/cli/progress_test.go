@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanizeServiceEvents(t *testing.T) {
+	const serviceARN = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api-Service"
+	taskDefComplete := deploy.ResourceEvent{
+		Resource: deploy.Resource{
+			LogicalName: "TaskDefinition",
+			Type:        "AWS::ECS::TaskDefinition",
+		},
+		Status: "UPDATE_COMPLETE",
+	}
+	serviceInProgress := deploy.ResourceEvent{
+		Resource: deploy.Resource{
+			LogicalName: "Service",
+			Type:        "AWS::ECS::Service",
+			PhysicalID:  serviceARN,
+		},
+		Status: "UPDATE_IN_PROGRESS",
+	}
+	testCases := map[string]struct {
+		inEvents   []deploy.ResourceEvent
+		mockECSSvc func(m *mocks.MockecsServiceDescriber)
+		inNoECSSvc bool
+
+		wantedRows []termprogress.TabRow
+	}{
+		"only displays the resources with events": {
+			inEvents: []deploy.ResourceEvent{taskDefComplete},
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textTaskDefinition, termprogress.StatusComplete)),
+			},
+		},
+		"lists the resources of the addons stack under it": {
+			inEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "AddonsStack",
+						Type:        "AWS::CloudFormation::Stack",
+					},
+					Status: "CREATE_IN_PROGRESS",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "MyTable",
+						Type:        "AWS::DynamoDB::Table",
+						NestedStack: "AddonsStack",
+					},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Invalid key schema",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "MyTableAccessPolicy",
+						Type:        "AWS::IAM::ManagedPolicy",
+						NestedStack: "AddonsStack",
+					},
+					Status: "CREATE_COMPLETE",
+				},
+			},
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textAddonsStack, termprogress.StatusInProgress)),
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", "  - MyTable", termprogress.StatusFailed)),
+				termprogress.TabRow("  Invalid key schema\t"),
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", "  - MyTableAccessPolicy", termprogress.StatusComplete)),
+			},
+		},
+		"displays the task counts of the primary deployment while the ECS service is in progress": {
+			inEvents: []deploy.ResourceEvent{taskDefComplete, serviceInProgress},
+			mockECSSvc: func(m *mocks.MockecsServiceDescriber) {
+				m.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(&ecs.Service{
+					Deployments: []*sdkecs.Deployment{
+						{
+							Status:       aws.String("ACTIVE"),
+							RunningCount: aws.Int64(2),
+							DesiredCount: aws.Int64(2),
+						},
+						{
+							Status:       aws.String("PRIMARY"),
+							RunningCount: aws.Int64(1),
+							DesiredCount: aws.Int64(2),
+							PendingCount: aws.Int64(1),
+						},
+					},
+				}, nil)
+			},
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textTaskDefinition, termprogress.StatusComplete)),
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textECSService, termprogress.StatusInProgress)),
+				termprogress.TabRow("  - 1/2 running tasks, 1 pending\t"),
+			},
+		},
+		"ignores the task counts if the ECS service can't be described": {
+			inEvents: []deploy.ResourceEvent{serviceInProgress},
+			mockECSSvc: func(m *mocks.MockecsServiceDescriber) {
+				m.EXPECT().Service(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textECSService, termprogress.StatusInProgress)),
+			},
+		},
+		"does not display the task counts once the ECS service is stable": {
+			inEvents: []deploy.ResourceEvent{
+				serviceInProgress,
+				{
+					Resource: serviceInProgress.Resource,
+					Status:   "UPDATE_COMPLETE",
+				},
+			},
+			mockECSSvc: func(m *mocks.MockecsServiceDescriber) {
+				m.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textECSService, termprogress.StatusComplete)),
+			},
+		},
+		"does not display the task counts without an ECS client": {
+			inEvents:   []deploy.ResourceEvent{serviceInProgress},
+			inNoECSSvc: true,
+
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textECSService, termprogress.StatusInProgress)),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var ecsSvc ecsServiceDescriber
+			if !tc.inNoECSSvc {
+				m := mocks.NewMockecsServiceDescriber(ctrl)
+				if tc.mockECSSvc != nil {
+					tc.mockECSSvc(m)
+				}
+				ecsSvc = m
+			}
+
+			// WHEN
+			rows := humanizeServiceEvents(tc.inEvents, ecsSvc)
+
+			// THEN
+			require.Equal(t, tc.wantedRows, rows)
+		})
+	}
+}
@@ -1,13 +1,15 @@
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
 
-// Package selector provides functionality for users to select an application, environment, or service name.
+// Package selector provides functionality for users to select an application, environment, or service name,
+// or the VPC and subnets to import in an environment.
 package selector
 
 import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -15,9 +17,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
-// Prompter wraps the method for users to select an option from a list of options.
+// Prompter wraps the methods for users to select one or multiple options from a list of options.
 type Prompter interface {
 	SelectOne(message, help string, options []string, promptOpts ...prompt.Option) (string, error)
+	MultiSelect(message, help string, options []string, promptOpts ...prompt.Option) ([]string, error)
 }
 
 type appEnvLister interface {
@@ -33,6 +36,11 @@ type wsSvcLister interface {
 	ServiceNames() ([]string, error)
 }
 
+type vpcSubnetLister interface {
+	ListVPCs() ([]ec2.VPC, error)
+	ListSubnets(vpcID string) ([]ec2.Subnet, error)
+}
+
 // Select prompts users to select the name of an application or environment.
 type Select struct {
 	prompt Prompter
@@ -51,8 +59,14 @@ type WorkspaceSelect struct {
 	svcLister wsSvcLister
 }
 
+// EC2Select is a VPC and subnets selector.
+type EC2Select struct {
+	prompt Prompter
+	ec2Svc vpcSubnetLister
+}
+
 // NewSelect returns a selector that chooses applications or environments.
-func NewSelect(prompt Prompter, store *config.Store) *Select {
+func NewSelect(prompt Prompter, store config.ConfigStore) *Select {
 	return &Select{
 		prompt: prompt,
 		lister: store,
@@ -60,7 +74,7 @@ func NewSelect(prompt Prompter, store *config.Store) *Select {
 }
 
 // NewConfigSelect returns a new selector that chooses applications, environments, or services from the config store.
-func NewConfigSelect(prompt Prompter, store *config.Store) *ConfigSelect {
+func NewConfigSelect(prompt Prompter, store config.ConfigStore) *ConfigSelect {
 	return &ConfigSelect{
 		Select:    NewSelect(prompt, store),
 		svcLister: store,
@@ -69,13 +83,21 @@ func NewConfigSelect(prompt Prompter, store *config.Store) *ConfigSelect {
 
 // NewWorkspaceSelect returns a new selector that chooses applications and environments from the config store, but
 // services from the local workspace.
-func NewWorkspaceSelect(prompt Prompter, store *config.Store, ws *workspace.Workspace) *WorkspaceSelect {
+func NewWorkspaceSelect(prompt Prompter, store config.ConfigStore, ws *workspace.Workspace) *WorkspaceSelect {
 	return &WorkspaceSelect{
 		Select:    NewSelect(prompt, store),
 		svcLister: ws,
 	}
 }
 
+// NewEC2Select returns a new selector that chooses the VPC and subnets in the account and region of the EC2 client.
+func NewEC2Select(prompt Prompter, ec2Client vpcSubnetLister) *EC2Select {
+	return &EC2Select{
+		prompt: prompt,
+		ec2Svc: ec2Client,
+	}
+}
+
 // Service fetches all services in the workspace and then prompts the user to select one.
 func (s *WorkspaceSelect) Service(prompt, help string) (string, error) {
 	serviceNames, err := s.retrieveWorkspaceServices()
@@ -187,6 +209,54 @@ func (s *Select) Application(prompt, help string) (string, error) {
 	return app, nil
 }
 
+// VPC fetches all the VPCs in an account/region and prompts the user to select one.
+func (s *EC2Select) VPC(prompt, help string) (string, error) {
+	vpcs, err := s.ec2Svc.ListVPCs()
+	if err != nil {
+		return "", fmt.Errorf("list VPCs: %w", err)
+	}
+	if len(vpcs) == 0 {
+		return "", errors.New("no VPCs found")
+	}
+	options := make([]string, len(vpcs))
+	ids := make(map[string]string, len(vpcs))
+	for ind, vpc := range vpcs {
+		options[ind] = vpc.String()
+		ids[vpc.String()] = vpc.ID
+	}
+	vpc, err := s.prompt.SelectOne(prompt, help, options)
+	if err != nil {
+		return "", fmt.Errorf("select VPC: %w", err)
+	}
+	return ids[vpc], nil
+}
+
+// Subnets fetches all the subnets of a VPC and prompts the user to select one or more of them.
+func (s *EC2Select) Subnets(prompt, help, vpcID string) ([]string, error) {
+	subnets, err := s.ec2Svc.ListSubnets(vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("list subnets: %w", err)
+	}
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("no subnets found in VPC %s", vpcID)
+	}
+	options := make([]string, len(subnets))
+	ids := make(map[string]string, len(subnets))
+	for ind, subnet := range subnets {
+		options[ind] = fmt.Sprintf("%s in %s", subnet, subnet.AvailabilityZone)
+		ids[options[ind]] = subnet.ID
+	}
+	selected, err := s.prompt.MultiSelect(prompt, help, options)
+	if err != nil {
+		return nil, fmt.Errorf("select subnets: %w", err)
+	}
+	subnetIDs := make([]string, len(selected))
+	for ind, subnet := range selected {
+		subnetIDs[ind] = ids[subnet]
+	}
+	return subnetIDs, nil
+}
+
 func (s *Select) retrieveApps() ([]string, error) {
 	apps, err := s.lister.ListApplications()
 	if err != nil {
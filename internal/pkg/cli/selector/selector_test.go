@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
@@ -595,3 +596,190 @@ func TestSelect_EnvironmentWithNone(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2Select_VPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVPCLister := mocks.NewMockvpcSubnetLister(ctrl)
+	mockPrompt := mocks.NewMockprompter(ctrl)
+	defer ctrl.Finish()
+
+	testCases := map[string]struct {
+		mocking func()
+		wantErr error
+		want    string
+	}{
+		"with error listing VPCs": {
+			mocking: func() {
+				mockVPCLister.
+					EXPECT().
+					ListVPCs().
+					Return(nil, fmt.Errorf("some error")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("list VPCs: some error"),
+		},
+		"with no VPCs": {
+			mocking: func() {
+				mockVPCLister.
+					EXPECT().
+					ListVPCs().
+					Return([]ec2.VPC{}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			wantErr: fmt.Errorf("no VPCs found"),
+		},
+		"with error selecting VPC": {
+			mocking: func() {
+				mockVPCLister.
+					EXPECT().
+					ListVPCs().
+					Return([]ec2.VPC{{ID: "vpc-1"}}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"vpc-1"})).
+					Return("", fmt.Errorf("error selecting")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("select VPC: error selecting"),
+		},
+		"with multiple VPCs": {
+			mocking: func() {
+				mockVPCLister.
+					EXPECT().
+					ListVPCs().
+					Return([]ec2.VPC{
+						{ID: "vpc-1", Name: "shared"},
+						{ID: "vpc-2"},
+					}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					SelectOne(
+						gomock.Eq("Select a VPC"),
+						gomock.Eq("Help text"),
+						gomock.Eq([]string{"vpc-1 (shared)", "vpc-2"})).
+					Return("vpc-1 (shared)", nil).
+					Times(1)
+			},
+			want: "vpc-1",
+		},
+	}
+
+	sel := EC2Select{
+		prompt: mockPrompt,
+		ec2Svc: mockVPCLister,
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mocking()
+
+			got, err := sel.VPC("Select a VPC", "Help text")
+			if tc.wantErr != nil {
+				require.EqualError(t, tc.wantErr, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEC2Select_Subnets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSubnetLister := mocks.NewMockvpcSubnetLister(ctrl)
+	mockPrompt := mocks.NewMockprompter(ctrl)
+	defer ctrl.Finish()
+
+	testCases := map[string]struct {
+		mocking func()
+		wantErr error
+		want    []string
+	}{
+		"with error listing subnets": {
+			mocking: func() {
+				mockSubnetLister.
+					EXPECT().
+					ListSubnets("vpc-1").
+					Return(nil, fmt.Errorf("some error")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("list subnets: some error"),
+		},
+		"with no subnets": {
+			mocking: func() {
+				mockSubnetLister.
+					EXPECT().
+					ListSubnets("vpc-1").
+					Return([]ec2.Subnet{}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					MultiSelect(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			wantErr: fmt.Errorf("no subnets found in VPC vpc-1"),
+		},
+		"with error selecting subnets": {
+			mocking: func() {
+				mockSubnetLister.
+					EXPECT().
+					ListSubnets("vpc-1").
+					Return([]ec2.Subnet{{ID: "subnet-1", AvailabilityZone: "us-west-2a"}}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					MultiSelect(gomock.Any(), gomock.Any(), gomock.Eq([]string{"subnet-1 in us-west-2a"})).
+					Return(nil, fmt.Errorf("error selecting")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("select subnets: error selecting"),
+		},
+		"with multiple subnets": {
+			mocking: func() {
+				mockSubnetLister.
+					EXPECT().
+					ListSubnets("vpc-1").
+					Return([]ec2.Subnet{
+						{ID: "subnet-1", Name: "public-a", AvailabilityZone: "us-west-2a"},
+						{ID: "subnet-2", AvailabilityZone: "us-west-2b"},
+						{ID: "subnet-3", AvailabilityZone: "us-west-2c"},
+					}, nil).
+					Times(1)
+				mockPrompt.
+					EXPECT().
+					MultiSelect(
+						gomock.Eq("Select subnets"),
+						gomock.Eq("Help text"),
+						gomock.Eq([]string{"subnet-1 (public-a) in us-west-2a", "subnet-2 in us-west-2b", "subnet-3 in us-west-2c"})).
+					Return([]string{"subnet-1 (public-a) in us-west-2a", "subnet-3 in us-west-2c"}, nil).
+					Times(1)
+			},
+			want: []string{"subnet-1", "subnet-3"},
+		},
+	}
+
+	sel := EC2Select{
+		prompt: mockPrompt,
+		ec2Svc: mockSubnetLister,
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.mocking()
+
+			got, err := sel.Subnets("Select subnets", "Help text", "vpc-1")
+			if tc.wantErr != nil {
+				require.EqualError(t, tc.wantErr, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
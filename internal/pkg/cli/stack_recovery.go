@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtStackRecoveryDeletePrompt   = "Stack %s failed to be created. Delete it and create it again?"
+	stackRecoveryDeleteHelpPrompt  = "A stack that failed to be created can't be updated, it must be deleted before it's deployed again."
+	fmtStackRecoveryRollbackPrompt = "Stack %s failed to roll back an update. Continue the rollback?"
+	stackRecoveryRollbackHelp      = "A stack that failed to roll back an update can't be updated until its rollback is complete."
+	stackRecoverySkipPrompt        = "Which resources that failed to roll back should be skipped?"
+	stackRecoverySkipHelpPrompt    = `Skipped resources are marked as rolled back without being changed, so they may no longer match the template.
+Skip the resources that can't be rolled back, for example because they were modified or deleted outside of CloudFormation.`
+
+	fmtStackRecoveryStart    = "Recovering stack %s."
+	fmtStackRecoveryFailed   = "Failed to recover stack %s.\n"
+	fmtStackRecoveryComplete = "Recovered stack %s.\n"
+)
+
+// stackRecovery holds the dependencies to recover a stack left in a failed state by a previous deployment.
+type stackRecovery struct {
+	prompt    prompter
+	recoverer stackRecoverer
+	spinner   progress
+}
+
+// recoverStack offers to recover the stack of a deployment that failed with a ErrStackRequiresRecovery,
+// after showing the events of the resources that caused the failure.
+// It returns true if the stack is recovered and the deployment can be retried, otherwise the error is returned as is.
+// Stacks aren't recovered in the builds of pipelines since they can't be confirmed.
+func recoverStack(r stackRecovery, err error) (bool, error) {
+	var failed *cloudformation.ErrStackRequiresRecovery
+	if !errors.As(err, &failed) {
+		return false, err
+	}
+	logFailureEvents(failed)
+	if os.Getenv(pipelineBuildEnvVar) != "" {
+		return false, err
+	}
+	stackName := color.HighlightResource(failed.Name)
+	var skipResources []string
+	if failed.CanContinueRollback() {
+		confirmed, promptErr := r.prompt.Confirm(fmt.Sprintf(fmtStackRecoveryRollbackPrompt, stackName), stackRecoveryRollbackHelp)
+		if promptErr != nil {
+			return false, fmt.Errorf("confirm rollback of stack %s: %w", failed.Name, promptErr)
+		}
+		if !confirmed {
+			return false, err
+		}
+		skipResources, promptErr = askResourcesToSkip(r.prompt, failed)
+		if promptErr != nil {
+			return false, promptErr
+		}
+	} else {
+		confirmed, promptErr := r.prompt.Confirm(fmt.Sprintf(fmtStackRecoveryDeletePrompt, stackName), stackRecoveryDeleteHelpPrompt)
+		if promptErr != nil {
+			return false, fmt.Errorf("confirm deletion of stack %s: %w", failed.Name, promptErr)
+		}
+		if !confirmed {
+			return false, err
+		}
+	}
+	r.spinner.Start(fmt.Sprintf(fmtStackRecoveryStart, stackName))
+	if err := r.recoverer.RecoverStack(failed, skipResources); err != nil {
+		r.spinner.Stop(log.Serrorf(fmtStackRecoveryFailed, stackName))
+		return false, fmt.Errorf("recover stack %s: %w", failed.Name, err)
+	}
+	r.spinner.Stop(log.Ssuccessf(fmtStackRecoveryComplete, stackName))
+	return true, nil
+}
+
+// askResourcesToSkip asks which of the resources that failed to roll back should be skipped by the rollback.
+func askResourcesToSkip(prompt prompter, failed *cloudformation.ErrStackRequiresRecovery) ([]string, error) {
+	var candidates []string
+	seen := make(map[string]bool)
+	for _, event := range failed.Events {
+		logicalID := aws.StringValue(event.LogicalResourceId)
+		if logicalID == failed.Name || seen[logicalID] {
+			continue
+		}
+		seen[logicalID] = true
+		candidates = append(candidates, logicalID)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	resources, err := prompt.MultiSelect(stackRecoverySkipPrompt, stackRecoverySkipHelpPrompt, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("select resources to skip: %w", err)
+	}
+	return resources, nil
+}
+
+// logFailureEvents shows the events of the resources that caused the failure of the stack.
+func logFailureEvents(failed *cloudformation.ErrStackRequiresRecovery) {
+	log.Errorf("Stack %s is in status %s.\n", color.HighlightResource(failed.Name), failed.Status)
+	if len(failed.Events) == 0 {
+		return
+	}
+	log.Infoln("The previous deployment failed because of the following resources:")
+	for _, event := range failed.Events {
+		// CFN error messages end with a '.' and only the first sentence is useful, the rest is error codes.
+		reason := strings.Split(aws.StringValue(event.ResourceStatusReason), ".")[0]
+		log.Infof("- %s %s: %s\n", color.HighlightResource(aws.StringValue(event.LogicalResourceId)),
+			aws.StringValue(event.ResourceStatus), reason)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverStack(t *testing.T) {
+	failedCreation := &cloudformation.ErrStackRequiresRecovery{
+		Name:   "phonetool-test-api",
+		Status: "ROLLBACK_COMPLETE",
+		Events: []cloudformation.StackEvent{
+			{
+				LogicalResourceId:    aws.String("Service"),
+				ResourceStatus:       aws.String("CREATE_FAILED"),
+				ResourceStatusReason: aws.String("Resource limit exceeded. Status Code: 400"),
+			},
+		},
+	}
+	failedRollback := &cloudformation.ErrStackRequiresRecovery{
+		Name:   "phonetool-test-api",
+		Status: "UPDATE_ROLLBACK_FAILED",
+		Events: []cloudformation.StackEvent{
+			{
+				LogicalResourceId: aws.String("Service"),
+				ResourceStatus:    aws.String("UPDATE_FAILED"),
+			},
+			{
+				LogicalResourceId: aws.String("Service"),
+				ResourceStatus:    aws.String("UPDATE_FAILED"),
+			},
+			{
+				LogicalResourceId: aws.String("phonetool-test-api"),
+				ResourceStatus:    aws.String("UPDATE_ROLLBACK_FAILED"),
+			},
+		},
+	}
+	type recoverStackMocks struct {
+		prompt    *mocks.Mockprompter
+		recoverer *mocks.MockstackRecoverer
+		spinner   *mocks.Mockprogress
+	}
+	testCases := map[string]struct {
+		inErr           error
+		inPipelineBuild bool
+		setupMocks      func(m recoverStackMocks)
+
+		wantedRecovered bool
+		wantedErr       error
+	}{
+		"returns other errors as is": {
+			inErr: errors.New("some error"),
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"does not recover the stack in the build of a pipeline": {
+			inErr:           failedCreation,
+			inPipelineBuild: true,
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.recoverer.EXPECT().RecoverStack(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: failedCreation,
+		},
+		"does not delete the stack if declined": {
+			inErr: failedCreation,
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), stackRecoveryDeleteHelpPrompt).Return(false, nil)
+				m.recoverer.EXPECT().RecoverStack(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: failedCreation,
+		},
+		"deletes a stack that failed to be created": {
+			inErr: failedCreation,
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), stackRecoveryDeleteHelpPrompt).Return(true, nil)
+				m.prompt.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().RecoverStack(failedCreation, nil).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedRecovered: true,
+		},
+		"continues the rollback without the resources to skip": {
+			inErr: failedRollback,
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), stackRecoveryRollbackHelp).Return(true, nil)
+				m.prompt.EXPECT().MultiSelect(stackRecoverySkipPrompt, stackRecoverySkipHelpPrompt, []string{"Service"}).Return([]string{"Service"}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().RecoverStack(failedRollback, []string{"Service"}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedRecovered: true,
+		},
+		"wraps the error from recovering the stack": {
+			inErr: failedCreation,
+			setupMocks: func(m recoverStackMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().RecoverStack(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("recover stack phonetool-test-api: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := recoverStackMocks{
+				prompt:    mocks.NewMockprompter(ctrl),
+				recoverer: mocks.NewMockstackRecoverer(ctrl),
+				spinner:   mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			if tc.inPipelineBuild {
+				os.Setenv(pipelineBuildEnvVar, "build-id")
+				defer os.Unsetenv(pipelineBuildEnvVar)
+			}
+
+			// WHEN
+			recovered, err := recoverStack(stackRecovery{
+				prompt:    m.prompt,
+				recoverer: m.recoverer,
+				spinner:   m.spinner,
+			}, tc.inErr)
+
+			// THEN
+			require.Equal(t, tc.wantedRecovered, recovered)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
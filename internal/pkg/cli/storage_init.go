@@ -138,12 +138,12 @@ type initStorageOpts struct {
 }
 
 func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store client: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
@@ -606,6 +606,14 @@ func (o *initStorageOpts) newS3Addon() (*addon.S3, error) {
 	return addon.NewS3(props), nil
 }
 
+// auditedTarget returns the service the storage is added to by the command.
+func (o *initStorageOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		svc: o.storageSvc,
+	}
+}
+
 func (o *initStorageOpts) RecommendedActions() []string {
 
 	newVar := template.ToSnakeCaseFunc(template.EnvVarNameFunc(o.storageName))
@@ -645,7 +653,7 @@ func BuildStorageInitCmd() *cobra.Command {
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			if err := opts.Execute(); err != nil {
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
 				return err
 			}
 			log.Infoln("Recommended follow-up actions:")
@@ -655,6 +663,7 @@ func BuildStorageInitCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, svcTypeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageSvc, svcFlag, svcFlagShort, "", storageServiceFlagDescription)
@@ -666,6 +675,7 @@ func BuildStorageInitCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.noSort, storageNoSortFlag, false, storageNoSortFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
+	requiredFlags.AddFlag(cmd.Flags().Lookup(appFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(svcFlag))
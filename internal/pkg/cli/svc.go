@@ -27,8 +27,14 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(BuildSvcListCmd())
 	cmd.AddCommand(BuildSvcPackageCmd())
 	cmd.AddCommand(BuildSvcDeployCmd())
+	cmd.AddCommand(BuildSvcHistoryCmd())
+	cmd.AddCommand(BuildSvcDriftCmd())
+	cmd.AddCommand(BuildSvcRollbackCmd())
+	cmd.AddCommand(BuildSvcPromoteCmd())
+	cmd.AddCommand(BuildSvcAbortCmd())
 	cmd.AddCommand(BuildSvcDeleteCmd())
 	cmd.AddCommand(BuildSvcShowCmd())
+	cmd.AddCommand(BuildSvcUpdateCmd())
 	cmd.AddCommand(BuildSvcStatusCmd())
 	cmd.AddCommand(BuildSvcLogsCmd())
 
@@ -0,0 +1,105 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcAbortAction = "abort"
+
+	fmtSvcAbortStart    = "Sending all the traffic of %s in %s back to the stable version."
+	fmtSvcAbortComplete = "Sent all the traffic of %s in %s back to the stable version.\n"
+)
+
+type svcAbortOpts struct {
+	*svcCanaryOpts
+}
+
+func newSvcAbortOpts(vars svcCanaryVars) (*svcAbortOpts, error) {
+	opts, err := newSvcCanaryOpts(vars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcAbortOpts{
+		svcCanaryOpts: opts,
+	}, nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcAbortOpts) Ask() error {
+	return o.ask(svcAbortAction)
+}
+
+// Execute sends all the traffic of the service back to its stable tasks and removes the canary.
+func (o *svcAbortOpts) Execute() error {
+	return o.withDeploymentLock(svcAbortLockOperation, o.abort)
+}
+
+func (o *svcAbortOpts) abort() error {
+	c, err := o.canary()
+	if err != nil {
+		return err
+	}
+	// Aborts are allowed outside of pipelines since they only restore the stable version.
+	if !c.isReleased() {
+		return fmt.Errorf("service %s has no canary to abort in environment %s", o.svcName, o.envName)
+	}
+	return o.shiftTraffic(c.aborted(), fmtSvcAbortStart, fmtSvcAbortComplete)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcAbortOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to release a new canary once it's fixed.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// BuildSvcAbortCmd builds the command for sending all the traffic of a service back to its stable version.
+func BuildSvcAbortCmd() *cobra.Command {
+	vars := svcCanaryVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "abort",
+		Short: "Sends all the traffic of a service back to its stable version.",
+		Long: `Sends all the traffic of a service deployed with canary releases back to its stable version.
+The tasks of the canary are stopped.`,
+
+		Example: `
+  Aborts the canary of the "frontend" service in the "prod" environment.
+  /code $ copilot svc abort -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcAbortOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().DurationVar(&vars.waitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	return cmd
+}
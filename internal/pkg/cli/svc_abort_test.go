@@ -0,0 +1,103 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcAbortOpts_Execute(t *testing.T) {
+	const (
+		stableImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"
+		canaryImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v2"
+	)
+	mockOutputs := map[string]string{
+		"CanarySteps": "10,50",
+	}
+	testCases := map[string]struct {
+		inEnv      *config.Environment
+		setupMocks func(m svcCanaryMocks)
+
+		wantedErr error
+	}{
+		"errors if there is no canary to abort": {
+			inEnv: &config.Environment{App: "phonetool", Name: "test"},
+			setupMocks: func(m svcCanaryMocks) {
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(map[string]string{
+					"ContainerImage":       stableImage,
+					"CanaryContainerImage": "",
+					"StableWeight":         "100",
+					"CanaryWeight":         "0",
+				}, nil)
+			},
+			wantedErr: errors.New("service frontend has no canary to abort in environment test"),
+		},
+		"errors if the canary weight is not a number": {
+			inEnv: &config.Environment{App: "phonetool", Name: "test"},
+			setupMocks: func(m svcCanaryMocks) {
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(map[string]string{
+					"CanaryWeight": "ten",
+				}, nil)
+			},
+			wantedErr: errors.New(`convert canary weight ten to an integer: strconv.Atoi: parsing "ten": invalid syntax`),
+		},
+		"sends all the traffic back to the stable version even outside of a pipeline": {
+			inEnv: &config.Environment{App: "phonetool", Name: "test", PipelineOnly: true},
+			setupMocks: func(m svcCanaryMocks) {
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(map[string]string{
+					"ContainerImage":       stableImage,
+					"CanaryContainerImage": canaryImage,
+					"StableWeight":         "50",
+					"CanaryWeight":         "50",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.releaser.EXPECT().UpdateServiceParameters(deploy.UpdateServiceParametersInput{
+					Name:    "frontend",
+					EnvName: "test",
+					AppName: "phonetool",
+					Parameters: map[string]string{
+						"ContainerImage":       stableImage,
+						"CanaryContainerImage": "",
+						"StableWeight":         "100",
+						"CanaryWeight":         "0",
+					},
+				}, gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newSvcCanaryMocks(ctrl)
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(tc.inEnv, nil)
+			tc.setupMocks(m)
+			opts := &svcAbortOpts{
+				svcCanaryOpts: newSvcCanaryTestOpts(m),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
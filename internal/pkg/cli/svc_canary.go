@@ -0,0 +1,239 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+const (
+	fmtSvcCanaryNamePrompt     = "Which service would you like to %s the canary of?"
+	svcCanaryNameHelpPrompt    = "Only load balanced web services deployed with canary releases have a canary."
+	svcCanaryEnvNamePrompt     = "Which environment is the canary released in?"
+	svcCanaryEnvNameHelpPrompt = "Each environment has its own canary and traffic split."
+
+	fmtSvcCanaryShiftFailed = "Failed to shift the traffic of %s in %s.\n"
+)
+
+type svcCanaryVars struct {
+	*GlobalOpts
+	svcName     string
+	envName     string
+	waitForLock time.Duration
+}
+
+// svcCanaryOpts holds the dependencies shared by the commands that shift the traffic of a canary release.
+type svcCanaryOpts struct {
+	svcCanaryVars
+
+	store       store
+	locker      deploymentLocker
+	sel         configSelector
+	spinner     progress
+	releaser    canaryReleaser
+	newReleaser func(env *config.Environment) (canaryReleaser, error)
+
+	// cached variables
+	targetEnv *config.Environment
+}
+
+func newSvcCanaryOpts(vars svcCanaryVars) (*svcCanaryOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &svcCanaryOpts{
+		svcCanaryVars: vars,
+		store:         store,
+		locker:        store,
+		sel:           selector.NewConfigSelect(vars.prompt, store),
+		spinner:       termprogress.NewSpinner(),
+		newReleaser: func(env *config.Environment) (canaryReleaser, error) {
+			sess, err := session.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcCanaryOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ask asks for the service and the environment of the canary if they're not passed in.
+func (o *svcCanaryOpts) ask(action string) error {
+	if o.svcName == "" {
+		svc, err := o.sel.Service(fmt.Sprintf(fmtSvcCanaryNamePrompt, action), svcCanaryNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.svcName = svc
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcCanaryEnvNamePrompt, svcCanaryEnvNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// withDeploymentLock runs fn while holding the deployment lock of the service in the environment,
+// so that the traffic split read from the stack of the service isn't changed by someone else before it's shifted.
+func (o *svcCanaryOpts) withDeploymentLock(operation string, fn func() error) error {
+	return withDeploymentLock(o.locker, &config.DeploymentLock{
+		App:       o.AppName(),
+		Env:       o.envName,
+		Service:   o.svcName,
+		Operation: operation,
+	}, o.waitForLock, fn)
+}
+
+// canary returns the canary release of the service in the environment, as deployed in its stack.
+func (o *svcCanaryOpts) canary() (*canaryRelease, error) {
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	o.targetEnv = env
+	releaser, err := o.newReleaser(env)
+	if err != nil {
+		return nil, err
+	}
+	o.releaser = releaser
+	stackName := stack.NameForService(o.AppName(), o.envName, o.svcName)
+	outputs, err := o.releaser.ServiceStackOutputs(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of stack %s: %w", stackName, err)
+	}
+	rawSteps, ok := outputs[stack.LBWebServiceCanaryStepsOutputKey]
+	if !ok {
+		return nil, fmt.Errorf("service %s is not deployed with canary releases in environment %s", o.svcName, o.envName)
+	}
+	steps, err := stack.ParseCanarySteps(rawSteps)
+	if err != nil {
+		return nil, err
+	}
+	params, err := o.releaser.ServiceStackParameters(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of stack %s: %w", stackName, err)
+	}
+	weight, err := strconv.Atoi(params[stack.LBWebServiceCanaryWeightParamKey])
+	if err != nil {
+		return nil, fmt.Errorf("convert canary weight %s to an integer: %w", params[stack.LBWebServiceCanaryWeightParamKey], err)
+	}
+	return &canaryRelease{
+		params: params,
+		steps:  steps,
+		weight: weight,
+	}, nil
+}
+
+// shiftTraffic redeploys the stack of the service with the parameters that split its traffic differently.
+func (o *svcCanaryOpts) shiftTraffic(params map[string]string, fmtStart, fmtComplete string) error {
+	svcName, envName := color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)
+	o.spinner.Start(fmt.Sprintf(fmtStart, svcName, envName))
+	err := o.releaser.UpdateServiceParameters(deploy.UpdateServiceParametersInput{
+		Name:       o.svcName,
+		EnvName:    o.envName,
+		AppName:    o.AppName(),
+		Parameters: params,
+	}, svcStackOptions(o.targetEnv)...)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcCanaryShiftFailed, svcName, envName))
+		return fmt.Errorf("shift the traffic of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtComplete, svcName, envName))
+	return nil
+}
+
+// auditedTarget returns the service whose traffic is shifted by the command.
+func (o *svcCanaryOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.envName,
+		svc: o.svcName,
+		stacks: []auditStack{
+			{name: stack.NameForService(o.AppName(), o.envName, o.svcName), env: o.envName},
+		},
+	}
+}
+
+// canaryRelease is the traffic split between the stable tasks of a service and its canary.
+type canaryRelease struct {
+	params map[string]string // Parameters of the stack of the service.
+	steps  []int             // Percentages of the traffic sent to the canary at each step.
+	weight int               // Percentage of the traffic currently sent to the canary.
+}
+
+// isReleased returns true if a new version of the service is released as a canary.
+func (c *canaryRelease) isReleased() bool {
+	return c.params[stack.LBWebServiceCanaryContainerImageParamKey] != ""
+}
+
+// nextStep returns the percentage of the traffic that the next promotion sends to the canary,
+// or false if the next promotion makes the canary the stable version.
+func (c *canaryRelease) nextStep() (int, bool) {
+	for _, step := range c.steps {
+		if step > c.weight {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// withWeight returns the parameters of the stack that send the percentage of the traffic to the canary.
+func (c *canaryRelease) withWeight(weight int) map[string]string {
+	params := make(map[string]string, len(c.params))
+	for k, v := range c.params {
+		params[k] = v
+	}
+	params[stack.LBWebServiceStableWeightParamKey] = strconv.Itoa(100 - weight)
+	params[stack.LBWebServiceCanaryWeightParamKey] = strconv.Itoa(weight)
+	return params
+}
+
+// promoted returns the parameters of the stack in which the stable tasks run the image of the canary and receive all the traffic.
+func (c *canaryRelease) promoted() map[string]string {
+	params := c.withWeight(0)
+	params[stack.ServiceContainerImageParamKey] = c.params[stack.LBWebServiceCanaryContainerImageParamKey]
+	params[stack.LBWebServiceCanaryContainerImageParamKey] = ""
+	return params
+}
+
+// aborted returns the parameters of the stack in which the stable tasks receive all the traffic and the canary is removed.
+func (c *canaryRelease) aborted() map[string]string {
+	params := c.withWeight(0)
+	params[stack.LBWebServiceCanaryContainerImageParamKey] = ""
+	return params
+}
@@ -7,6 +7,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	awssession "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -40,28 +42,32 @@ var (
 
 type deleteSvcVars struct {
 	*GlobalOpts
-	SkipConfirmation bool
-	Name             string
-	EnvName          string
+	SkipConfirmation     bool
+	SkipProdConfirmation bool
+	Name                 string
+	EnvName              string
+	WaitForLock          time.Duration
 }
 
 type deleteSvcOpts struct {
 	deleteSvcVars
 
 	// Interfaces to dependencies.
-	store     store
-	sess      sessionProvider
-	spinner   progress
-	appCFN    svcRemoverFromApp
-	getSvcCFN func(session *awssession.Session) svcDeleter
-	getECR    func(session *awssession.Session) imageRemover
+	store       store
+	locker      deploymentLocker
+	deployments deploymentDeleter
+	sess        sessionProvider
+	spinner     progress
+	appCFN      svcRemoverFromApp
+	getSvcCFN   func(session *awssession.Session) svcDeleter
+	getECR      func(session *awssession.Session) imageRemover
 
 	// Internal state.
 	environments []*config.Environment
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
@@ -75,10 +81,12 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 	return &deleteSvcOpts{
 		deleteSvcVars: vars,
 
-		store:   store,
-		spinner: termprogress.NewSpinner(),
-		sess:    provider,
-		appCFN:  cloudformation.New(defaultSession),
+		store:       store,
+		locker:      store,
+		deployments: store,
+		spinner:     termprogress.NewSpinner(),
+		sess:        provider,
+		appCFN:      cloudformation.New(defaultSession),
 		getSvcCFN: func(session *awssession.Session) svcDeleter {
 			return cloudformation.New(session)
 		},
@@ -133,6 +141,13 @@ func (o *deleteSvcOpts) Execute() error {
 	if err := o.appEnvironments(); err != nil {
 		return err
 	}
+	confirmed, err := confirmProd(o.prompt, o.SkipProdConfirmation, fmt.Sprintf("delete service %s", o.Name), o.environments)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errSvcDeleteCancelled
+	}
 
 	if err := o.deleteStacks(); err != nil {
 		return err
@@ -222,22 +237,52 @@ func (o *deleteSvcOpts) appEnvironments() error {
 
 func (o *deleteSvcOpts) deleteStacks() error {
 	for _, env := range o.environments {
-		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
-		if err != nil {
-			return err
+		lock := &config.DeploymentLock{
+			App:       o.appName,
+			Env:       env.Name,
+			Service:   o.Name,
+			Operation: svcDeleteLockOperation,
 		}
-
-		cfClient := o.getSvcCFN(sess)
-		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.Name, env.Name))
-		if err := cfClient.DeleteService(deploy.DeleteServiceInput{
-			Name:    o.Name,
-			EnvName: env.Name,
-			AppName: o.appName,
+		env := env
+		if err := withDeploymentLock(o.locker, lock, o.WaitForLock, func() error {
+			if err := o.deleteStack(env); err != nil {
+				return err
+			}
+			return o.deleteDeployments(env)
 		}); err != nil {
-			o.spinner.Stop(log.Serrorf(fmtSvcDeleteFailed, o.Name, env.Name, err))
 			return err
 		}
-		o.spinner.Stop(log.Ssuccessf(fmtSvcDeleteComplete, o.Name, env.Name))
+	}
+	return nil
+}
+
+func (o *deleteSvcOpts) deleteStack(env *config.Environment) error {
+	sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+
+	cfClient := o.getSvcCFN(sess)
+	o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.Name, env.Name))
+	events, resp := cfClient.StreamServiceDeletion(deploy.DeleteServiceInput{
+		Name:    o.Name,
+		EnvName: env.Name,
+		AppName: o.appName,
+	})
+	for ev := range events {
+		o.spinner.Events(humanizeServiceEvents(ev, nil))
+	}
+	if err := <-resp; err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcDeleteFailed, o.Name, env.Name, err))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcDeleteComplete, o.Name, env.Name))
+	return nil
+}
+
+func (o *deleteSvcOpts) deleteDeployments(env *config.Environment) error {
+	if err := o.deployments.DeleteDeployments(o.appName, env.Name, o.Name); err != nil {
+		return fmt.Errorf("delete deployment history of service %s in environment %s: %w", o.Name, env.Name, err)
 	}
 	return nil
 }
@@ -291,6 +336,22 @@ func (o *deleteSvcOpts) deleteSSMParam() error {
 	return nil
 }
 
+// auditedTarget returns the service deleted by the command, and its stacks in each environment.
+func (o *deleteSvcOpts) auditedTarget() auditTarget {
+	target := auditTarget{
+		app: o.AppName(),
+		env: o.EnvName,
+		svc: o.Name,
+	}
+	for _, env := range o.environments {
+		target.stacks = append(target.stacks, auditStack{
+			name: stack.NameForService(o.AppName(), env.Name, o.Name),
+			env:  env.Name,
+		})
+	}
+	return target
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deleteSvcOpts) RecommendedActions() []string {
 	return []string{
@@ -312,7 +373,10 @@ func BuildSvcDeleteCmd() *cobra.Command {
   /code $ copilot svc delete --name test
 
   Delete the "test" service without confirmation prompt.
-  /code $ copilot svc delete --name test --yes`,
+  /code $ copilot svc delete --name test --yes
+
+  Delete the "test" service from every environment, including production environments, without confirmation prompts.
+  /code $ copilot svc delete --name test --yes --yes-i-mean-prod`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteSvcOpts(vars)
 			if err != nil {
@@ -324,7 +388,7 @@ func BuildSvcDeleteCmd() *cobra.Command {
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			if err := opts.Execute(); err != nil {
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
 				return err
 			}
 
@@ -336,8 +400,11 @@ func BuildSvcDeleteCmd() *cobra.Command {
 		}),
 	}
 
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipProdConfirmation, yesIMeanProdFlag, false, yesIMeanProdFlagDescription)
+	cmd.Flags().DurationVar(&vars.WaitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
 	return cmd
 }
@@ -12,7 +12,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -274,6 +277,9 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MocksvcDeleter
 	ecr            *mocks.MockimageRemover
+	prompt         *mocks.Mockprompter
+	locker         *mocks.MockdeploymentLocker
+	deployments    *mocks.MockdeploymentDeleter
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -287,6 +293,13 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 		Region:         "us-west-2",
 	}
 	mockEnvs := []*config.Environment{mockEnv}
+	mockProdEnv := &config.Environment{
+		App:            mockAppName,
+		Name:           "prod",
+		ManagerRoleARN: "some-arn",
+		Region:         "us-west-2",
+		Prod:           true,
+	}
 	mockApp := &config.Application{
 		Name: mockAppName,
 	}
@@ -299,6 +312,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 		inEnvName string
 		inSvcName string
 
+		inSkipProdConfirmation bool
+
 		setupMocks func(mocks deleteSvcMocks)
 
 		wantedError error
@@ -311,9 +326,23 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 					// deleteStacks
+					mocks.locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{App: mockAppName, Env: mockEnvName, Service: mockSvcName, Operation: svcDeleteLockOperation}).Return(nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
-					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(nil),
+					mocks.svcCFN.EXPECT().StreamServiceDeletion(gomock.Any()).Return(mockServiceDeletionStream([]deploy.ResourceEvent{
+						{
+							Resource: deploy.Resource{
+								LogicalName: "Service",
+								Type:        "AWS::ECS::Service",
+							},
+							Status: "DELETE_COMPLETE",
+						},
+					}, nil)),
+					mocks.spinner.EXPECT().Events([]termprogress.TabRow{
+						termprogress.TabRow(fmt.Sprintf("%s\t[%s]", textECSService, termprogress.StatusComplete)),
+					}),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.deployments.EXPECT().DeleteDeployments(mockAppName, mockEnvName, mockSvcName).Return(nil),
+					mocks.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
@@ -338,9 +367,12 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{App: mockAppName, Env: mockEnvName, Service: mockSvcName, Operation: svcDeleteLockOperation}).Return(nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
-					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(nil),
+					mocks.svcCFN.EXPECT().StreamServiceDeletion(gomock.Any()).Return(mockServiceDeletionStream(nil, nil)),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.deployments.EXPECT().DeleteDeployments(mockAppName, mockEnvName, mockSvcName).Return(nil),
+					mocks.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
@@ -365,9 +397,58 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{App: mockAppName, Env: mockEnvName, Service: mockSvcName, Operation: svcDeleteLockOperation}).Return(nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
-					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(testError),
+					mocks.svcCFN.EXPECT().StreamServiceDeletion(gomock.Any()).Return(mockServiceDeletionStream(nil, testError)),
 					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, mockEnvName, testError)),
+					mocks.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil),
+				)
+			},
+			wantedError: testError,
+		},
+		"errors if the service is locked in the environment": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					// deleteStacks
+					mocks.locker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(testError),
+				)
+				mocks.svcCFN.EXPECT().StreamServiceDeletion(gomock.Any()).Times(0)
+				mocks.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Times(0)
+			},
+			wantedError: testError,
+		},
+		"cancels deleting the service from a production environment": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{mockEnv, mockProdEnv}, nil),
+					mocks.prompt.EXPECT().Confirm(fmt.Sprintf(fmtProdConfirmPrompt, "delete service backend", color.HighlightUserInput("prod")), prodConfirmHelp).Return(false, nil),
+				)
+			},
+			wantedError: errSvcDeleteCancelled,
+		},
+		"skips confirming deleting the service from a production environment with --yes-i-mean-prod": {
+			inAppName:              mockAppName,
+			inSvcName:              mockSvcName,
+			inEnvName:              "prod",
+			inSkipProdConfirmation: true,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().GetEnvironment(mockAppName, "prod").Return(mockProdEnv, nil),
+					// deleteStacks
+					mocks.locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{App: mockAppName, Env: "prod", Service: mockSvcName, Operation: svcDeleteLockOperation}).Return(nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, "prod")),
+					mocks.svcCFN.EXPECT().StreamServiceDeletion(gomock.Any()).Return(mockServiceDeletionStream(nil, testError)),
+					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, "prod", testError)),
+					mocks.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil),
 				)
 			},
 			wantedError: testError,
@@ -387,6 +468,9 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockSvcCFN := mocks.NewMocksvcDeleter(ctrl)
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockImageRemover := mocks.NewMockimageRemover(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			mockLocker := mocks.NewMockdeploymentLocker(ctrl)
+			mockDeployments := mocks.NewMockdeploymentDeleter(ctrl)
 			mockGetSvcCFN := func(_ *awssession.Session) svcDeleter {
 				return mockSvcCFN
 			}
@@ -402,6 +486,9 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mockSpinner,
 				svcCFN:         mockSvcCFN,
 				ecr:            mockImageRemover,
+				prompt:         mockPrompter,
+				locker:         mockLocker,
+				deployments:    mockDeployments,
 			}
 
 			test.setupMocks(mocks)
@@ -410,16 +497,20 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				deleteSvcVars: deleteSvcVars{
 					GlobalOpts: &GlobalOpts{
 						appName: test.inAppName,
+						prompt:  mockPrompter,
 					},
-					Name:    test.inSvcName,
-					EnvName: test.inEnvName,
+					Name:                 test.inSvcName,
+					EnvName:              test.inEnvName,
+					SkipProdConfirmation: test.inSkipProdConfirmation,
 				},
-				store:     mockstore,
-				sess:      mockSession,
-				spinner:   mockSpinner,
-				appCFN:    mockAppCFN,
-				getSvcCFN: mockGetSvcCFN,
-				getECR:    mockGetImageRemover,
+				store:       mockstore,
+				locker:      mockLocker,
+				deployments: mockDeployments,
+				sess:        mockSession,
+				spinner:     mockSpinner,
+				appCFN:      mockAppCFN,
+				getSvcCFN:   mockGetSvcCFN,
+				getECR:      mockGetImageRemover,
 			}
 
 			// WHEN
@@ -434,3 +525,16 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+// mockServiceDeletionStream returns closed channels holding a batch of events, if any, and the result of the deletion.
+func mockServiceDeletionStream(events []deploy.ResourceEvent, err error) (<-chan []deploy.ResourceEvent, <-chan error) {
+	eventsCh := make(chan []deploy.ResourceEvent, 1)
+	respCh := make(chan error, 1)
+	if events != nil {
+		eventsCh <- events
+	}
+	respCh <- err
+	close(eventsCh)
+	close(respCh)
+	return eventsCh, respCh
+}
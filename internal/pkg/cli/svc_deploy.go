@@ -4,13 +4,24 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
@@ -25,14 +36,32 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/watch"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	inputImageTagPrompt = "Input an image tag value:"
+
+	fmtPreviewSvcStart    = "Proposing infrastructure changes for %s in %s."
+	fmtPreviewSvcFailed   = "Failed to propose infrastructure changes for %s in %s.\n"
+	fmtPreviewSvcComplete = "Proposed infrastructure changes for %s in %s.\n"
+	fmtPreviewSvcEmpty    = "No infrastructure changes to deploy for %s in %s.\n"
+
+	fmtShiftTrafficStart     = "Shifting the traffic of %s in %s to the new tasks with deployment %s."
+	fmtShiftTrafficComplete  = "Shifted the traffic of %s in %s to the new tasks.\n"
+	fmtShiftTrafficFailed    = "Failed to shift the traffic of %s in %s to the new tasks.\n"
+	fmtBlueGreenDeployStatus = "- Blue/green deployment %s\t[%s]"
+
+	watchLogEventsLimit = 100
+	gitDirName          = ".git"
 )
 
+// codeDeployPollInterval is the time to wait between two checks of the status of a blue/green deployment.
+var codeDeployPollInterval = 10 * time.Second
+
 var (
 	errNoLocalManifestsFound = errors.New("no manifest files found")
 )
@@ -43,6 +72,12 @@ type deploySvcVars struct {
 	EnvName      string
 	ImageTag     string
 	ResourceTags map[string]string
+	Watch        bool   // Redeploy the service whenever its build context or manifest changes.
+	Platform     string // Overrides the platform in the manifest to build the image for and run the tasks on.
+
+	SkipProdConfirmation bool          // Deploy changes that delete or replace resources of a production environment without confirmation.
+	WaitForLock          time.Duration // How long to wait for another operation to release the deployment lock of the service.
+	changeSetReviewVars
 }
 
 type deploySvcOpts struct {
@@ -51,34 +86,63 @@ type deploySvcOpts struct {
 	store        store
 	ws           wsSvcReader
 	ecr          ecrService
-	docker       dockerService
+	builder      imageBuilder
 	s3           artifactUploader
 	cmd          runner
 	addons       templater
 	appCFN       appResourcesGetter
-	svcCFN       cloudformation.CloudFormation
+	svcCFN       svcDeploymentStreamer
+	recoverer    stackRecoverer
+	svcPreviewer svcPreviewer
 	sessProvider sessionProvider
+	deployments  deploymentStore
+	locker       deploymentLocker
+	identity     identityService
+	ecsDescriber ecsServiceDescriber
+	svcOutputs   svcStackOutputsGetter
+	codeDeploy   codeDeployDeployer
+	rollbacker   svcRollbacker
+
+	// Dependencies to run the pre-deploy and post-deploy hooks of the service.
+	hookRunner      hookTaskRunner
+	hookLogs        logStreamReader
+	newSvcDescriber func(o *deploySvcOpts) (serviceArnGetter, error)
 
 	spinner progress
 	sel     wsSelector
 
+	// Dependencies to watch the service for changes.
+	newWatcher func(paths []string) (fileWatcher, error)
+	streamLogs func(o *deploySvcOpts, since time.Time, stop <-chan struct{}) error // Overridden in tests.
+
 	// cached variables
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetSvc         *config.Service
+	imageDigest       string                     // Digest of the latest pushed image.
+	imagePlatform     string                     // Platform the image is built for, empty for the platform of the host.
+	blueGreen         *manifest.DeploymentConfig // Set if the service is deployed with blue/green deployments.
+	canary            *manifest.DeploymentConfig // Set if the service is deployed with canary releases.
+	canaryTaskDef     string                     // Task definition of the canary released by the deployment, if any.
+	canaryWeight      string                     // Percentage of the traffic sent to the canary released by the deployment.
 }
 
 func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
 
+	builder, err := docker.NewBuilder()
+	if err != nil {
+		return nil, fmt.Errorf("new image builder: %w", err)
+	}
+
 	return &deploySvcOpts{
 		deploySvcVars: vars,
 
@@ -86,9 +150,16 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 		ws:           ws,
 		spinner:      termprogress.NewSpinner(),
 		sel:          selector.NewWorkspaceSelect(vars.prompt, store, ws),
-		docker:       docker.New(),
+		builder:      builder,
 		cmd:          command.New(),
 		sessProvider: session.NewProvider(),
+		deployments:  store,
+		locker:       store,
+		newWatcher: func(paths []string) (fileWatcher, error) {
+			return watch.New(afero.NewOsFs(), paths, watch.WithSkippedDirs(gitDirName, workspace.CopilotDirName))
+		},
+		streamLogs:      streamSvcLogs,
+		newSvcDescriber: newSvcDeployDescriber,
 	}, nil
 }
 
@@ -107,6 +178,14 @@ func (o *deploySvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.Watch && o.dryRun {
+		return fmt.Errorf("cannot specify --%s and --%s options at once", watchFlag, dryRunFlag)
+	}
+	if o.Platform != "" {
+		if err := validatePlatform(o.Platform); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -131,6 +210,12 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 	o.targetEnvironment = env
+	if err := validatePipelineOnly(env); err != nil {
+		return err
+	}
+	if env.Prod && o.Watch {
+		return fmt.Errorf("cannot watch for changes to redeploy to production environment %s", env.Name)
+	}
 
 	app, err := o.store.GetApplication(o.AppName())
 	if err != nil {
@@ -148,26 +233,86 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
-	if err := o.pushToECRRepo(); err != nil {
+	var deployed bool
+	if err := o.withDeploymentLock(func() error {
+		deployed, err = o.deploy()
+		return err
+	}); err != nil {
 		return err
 	}
+	if !deployed {
+		return nil
+	}
+
+	if err := o.showAppURI(); err != nil {
+		return err
+	}
+	if !o.Watch {
+		return nil
+	}
+	return o.watch()
+}
+
+// auditedTarget returns the service deployed by the command.
+func (o *deploySvcOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.EnvName,
+		svc: o.Name,
+		stacks: []auditStack{
+			{name: stack.NameForService(o.AppName(), o.EnvName, o.Name), env: o.EnvName},
+		},
+	}
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *deploySvcOpts) RecommendedActions() []string {
+	if o.canaryTaskDef == "" {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Run %s to shift more traffic to the canary once it's healthy.",
+			color.HighlightCode(fmt.Sprintf("copilot svc promote -n %s -e %s", o.Name, o.targetEnvironment.Name))),
+		fmt.Sprintf("Run %s to send all the traffic back to the stable version.",
+			color.HighlightCode(fmt.Sprintf("copilot svc abort -n %s -e %s", o.Name, o.targetEnvironment.Name))),
+	}
+}
+
+// deploy builds and pushes the container image of the service and deploys its stack.
+// It returns false if the infrastructure changes of the deployment weren't confirmed.
+func (o *deploySvcOpts) deploy() (bool, error) {
+	// Deployments to production environments are previewed to catch changes that delete or replace resources.
+	if o.shouldReview() || o.targetEnvironment.Prod {
+		return o.reviewAndDeploySvc()
+	}
+	if err := o.pushToECRRepo(); err != nil {
+		return false, err
+	}
 
 	// TODO: delete addons template from S3 bucket when deleting the environment.
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if err := o.deploySvc(addonsURL); err != nil {
-		return err
+		return false, err
 	}
-
-	return o.showAppURI()
+	return true, nil
 }
 
-// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
-func (o *deploySvcOpts) RecommendedActions() []string {
-	return nil
+// withDeploymentLock runs fn while holding the deployment lock of the service in the environment.
+// Dry runs don't deploy anything, so they don't need the lock.
+func (o *deploySvcOpts) withDeploymentLock(fn func() error) error {
+	if o.dryRun {
+		return fn()
+	}
+	return withDeploymentLock(o.locker, &config.DeploymentLock{
+		App:       o.AppName(),
+		Env:       o.targetEnvironment.Name,
+		Service:   o.Name,
+		Operation: svcDeployLockOperation,
+	}, o.WaitForLock, fn)
 }
 
 func (o *deploySvcOpts) validateSvcName() error {
@@ -264,7 +409,19 @@ func (o *deploySvcOpts) configureClients() error {
 	o.s3 = s3.New(defaultSessEnvRegion)
 
 	// CF client against env account profile AND target environment region
-	o.svcCFN = cloudformation.New(envSession)
+	svcCFN := cloudformation.New(envSession)
+	o.svcCFN = svcCFN
+	o.recoverer = svcCFN
+	o.svcPreviewer = svcCFN
+	o.svcOutputs = svcCFN
+	o.rollbacker = svcCFN
+	// ECS client to display the task counts of the service while it's deployed, and to run the tasks of its hooks.
+	ecsClient := ecs.New(envSession)
+	o.ecsDescriber = ecsClient
+	o.hookRunner = ecsClient
+	o.hookLogs = cloudwatchlogs.New(envSession)
+	// CodeDeploy client to shift the traffic of services deployed with blue/green deployments.
+	o.codeDeploy = codedeploy.New(envSession)
 
 	addonsSvc, err := addon.New(o.Name)
 	if err != nil {
@@ -278,45 +435,178 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	o.appCFN = cloudformation.New(defaultSess)
+	o.identity = identity.New(defaultSess)
 	return nil
 }
 
+func (o *deploySvcOpts) repoName() string {
+	return fmt.Sprintf("%s/%s", o.appName, o.Name)
+}
+
+// pushToECRRepo builds the image of the service and pushes it unless the repository already has the same content.
 func (o *deploySvcOpts) pushToECRRepo() error {
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.Name)
+	uri, err := o.buildImage()
+	if err != nil {
+		return err
+	}
+	return o.pushImage(uri)
+}
 
-	uri, err := o.ecr.GetRepository(repoName)
+// buildImage builds the image of the service and returns the URI of its repository.
+// If the repository already has the content of the image, the digest of the image is stored so that the service is deployed with it.
+func (o *deploySvcOpts) buildImage() (string, error) {
+	uri, err := o.ecr.GetRepository(o.repoName())
 	if err != nil {
-		return fmt.Errorf("get ECR repository URI: %w", err)
+		return "", fmt.Errorf("get ECR repository URI: %w", err)
 	}
 
 	path, err := o.getDockerfilePath()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	if err := o.docker.Build(uri, o.ImageTag, path); err != nil {
-		return fmt.Errorf("build Dockerfile at %s with tag %s: %w", path, o.ImageTag, err)
+	o.imagePlatform = o.Platform
+	if o.imagePlatform == "" {
+		platform, err := svcPlatform(o.ws, o.Name, o.targetEnvironment.Name)
+		if err != nil {
+			return "", err
+		}
+		o.imagePlatform = platform
+	}
+	if o.imagePlatform != "" {
+		if err := validatePlatform(o.imagePlatform); err != nil {
+			return "", err
+		}
+		// Images for a platform are built and pushed at once, so their content is only known once pushed.
+		o.imageDigest = ""
+		return uri, nil
 	}
 
-	auth, err := o.ecr.GetECRAuth()
+	if err := o.builder.Build(uri, o.ImageTag, path); err != nil {
+		return "", fmt.Errorf("build Dockerfile at %s with tag %s: %w", path, o.ImageTag, err)
+	}
+
+	digest, err := pushedImageDigest(o.builder, o.ecr, uri, o.repoName(), o.ImageTag)
+	if err != nil {
+		return "", err
+	}
+	o.imageDigest = digest
+	return uri, nil
+}
+
+// pushImage pushes the built image to the repository uri and stores its digest, unless the repository already has its content.
+func (o *deploySvcOpts) pushImage(uri string) error {
+	if o.imageDigest != "" {
+		log.Infof("Skipped pushing image %s since its content already exists in repository %s.\n", color.HighlightUserInput(o.ImageTag), color.HighlightResource(o.repoName()))
+		return nil
+	}
+	if o.imagePlatform != "" {
+		return o.pushPlatformImage(uri)
+	}
+
+	digest, err := pushImage(o.builder, o.ecr, uri, o.repoName(), o.ImageTag)
+	if err != nil {
+		return err
+	}
+	o.imageDigest = digest
+	return nil
+}
 
+// pushPlatformImage builds the image for its platform, pushes it to the repository uri as a manifest list and stores its digest.
+func (o *deploySvcOpts) pushPlatformImage(uri string) error {
+	path, err := o.getDockerfilePath()
+	if err != nil {
+		return err
+	}
+	auth, err := o.ecr.GetECRAuth()
 	if err != nil {
 		return fmt.Errorf("get ECR auth data: %w", err)
 	}
+	if err := o.builder.Login(uri, auth.Username, auth.Password); err != nil {
+		return err
+	}
+	digests, err := pushPlatformImage(o.builder, []string{uri}, o.ImageTag, path, []string{o.imagePlatform})
+	if err != nil {
+		return err
+	}
+	o.imageDigest = digests[0]
+	return nil
+}
 
-	o.docker.Login(uri, auth.Username, auth.Password)
+// pushPlatformImage builds the image for each of the platforms and pushes it as a manifest list to the repository of each uri.
+// It returns the digest of the manifest list in each repository, in the order of the uris.
+func pushPlatformImage(builder imageBuilder, uris []string, tag, path string, platforms []string) ([]string, error) {
+	if err := builder.BuildPlatforms(uris, tag, path, platforms); err != nil {
+		return nil, fmt.Errorf("build Dockerfile at %s with tag %s: %w", path, tag, err)
+	}
+	var digests []string
+	for _, uri := range uris {
+		digest, err := builder.IndexDigest(uri, tag)
+		if err != nil {
+			return nil, fmt.Errorf("get digest of image %s: %w", tag, err)
+		}
+		digests = append(digests, digest)
+	}
+	return digests, nil
+}
+
+// pushedImageDigest returns the digest of the image built for the uri and tag if the repository already has its content,
+// or an empty string if the image needs to be pushed.
+func pushedImageDigest(builder imageBuilder, registry ecrService, uri, repoName, tag string) (string, error) {
+	digest, err := builder.RepoDigest(uri, tag)
+	if err != nil {
+		return "", fmt.Errorf("get digest of image %s: %w", tag, err)
+	}
+	if digest == "" {
+		return "", nil
+	}
+	images, err := registry.ListImages(repoName)
+	if err != nil {
+		return "", fmt.Errorf("list images in repository %s: %w", repoName, err)
+	}
+	for _, image := range images {
+		if image.Digest == digest {
+			return digest, nil
+		}
+	}
+	return "", nil
+}
 
-	return o.docker.Push(uri, o.ImageTag)
+// pushImage pushes the image built for the uri and tag to the repository and returns its digest.
+func pushImage(builder imageBuilder, registry ecrService, uri, repoName, tag string) (string, error) {
+	auth, err := registry.GetECRAuth()
+	if err != nil {
+		return "", fmt.Errorf("get ECR auth data: %w", err)
+	}
+	if err := builder.Login(uri, auth.Username, auth.Password); err != nil {
+		return "", err
+	}
+	if err := builder.Push(uri, tag); err != nil {
+		return "", err
+	}
+	digest, err := builder.RepoDigest(uri, tag)
+	if err != nil {
+		return "", fmt.Errorf("get digest of image %s: %w", tag, err)
+	}
+	if digest == "" {
+		return "", fmt.Errorf("image %s has no digest in repository %s after being pushed", tag, repoName)
+	}
+	return digest, nil
 }
 
 func (o *deploySvcOpts) getDockerfilePath() (string, error) {
+	return dockerfilePath(o.ws, o.Name)
+}
+
+// dockerfilePath returns the path to the Dockerfile of the service from its manifest in the workspace.
+func dockerfilePath(ws svcManifestReader, svcName string) (string, error) {
 	type dfPath interface {
 		DockerfilePath() string
 	}
 
-	manifestBytes, err := o.ws.ReadServiceManifest(o.Name)
+	manifestBytes, err := ws.ReadServiceManifest(svcName)
 	if err != nil {
-		return "", fmt.Errorf("read manifest file %s: %w", o.Name, err)
+		return "", fmt.Errorf("read manifest file %s: %w", svcName, err)
 	}
 
 	svc, err := manifest.UnmarshalService(manifestBytes)
@@ -326,11 +616,47 @@ func (o *deploySvcOpts) getDockerfilePath() (string, error) {
 
 	mf, ok := svc.(dfPath)
 	if !ok {
-		return "", fmt.Errorf("service %s does not have a dockerfile path", o.Name)
+		return "", fmt.Errorf("service %s does not have a dockerfile path", svcName)
 	}
 	return mf.DockerfilePath(), nil
 }
 
+// svcPlatform returns the platform that the tasks of the service run on in the environment,
+// or an empty string if they run on the default platform.
+func svcPlatform(ws svcManifestReader, svcName, envName string) (string, error) {
+	type taskPlatform interface {
+		TaskPlatform(envName string) (string, error)
+	}
+
+	manifestBytes, err := ws.ReadServiceManifest(svcName)
+	if err != nil {
+		return "", fmt.Errorf("read manifest file %s: %w", svcName, err)
+	}
+
+	svc, err := manifest.UnmarshalService(manifestBytes)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal svc manifest: %w", err)
+	}
+
+	mf, ok := svc.(taskPlatform)
+	if !ok {
+		return "", nil
+	}
+	platform, err := mf.TaskPlatform(envName)
+	if err != nil {
+		return "", fmt.Errorf("apply environment %s override: %w", envName, err)
+	}
+	return platform, nil
+}
+
+// validatePlatform returns an error if the platform is not supported.
+func validatePlatform(platform string) error {
+	if !contains(platform, manifest.Platforms) {
+		return fmt.Errorf("platform %s must be one of %s", platform, strings.Join(manifest.Platforms, ", "))
+	}
+	return nil
+}
+
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
 // If the service doesn't have any addons, it returns the empty string and no errors.
 // If the service has addons, it returns the URL of the S3 object storing the addons template.
@@ -357,6 +683,31 @@ func (o *deploySvcOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
+// addonsTemplateURL returns the url of the addons template to deploy with the service.
+// Dry runs don't upload anything to the application's bucket, so the changes are previewed
+// with the addons template of the latest deployment instead.
+func (o *deploySvcOpts) addonsTemplateURL() (string, error) {
+	if !o.dryRun {
+		return o.pushAddonsTemplateToS3Bucket()
+	}
+	if _, err := o.addons.Template(); err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template: %w", err)
+	}
+	log.Warningf("Changes to the addons of service %s are not previewed in a dry run.\n", o.Name)
+	deployments, err := o.deployments.ListDeployments(o.AppName(), o.targetEnvironment.Name, o.Name)
+	if err != nil {
+		return "", err
+	}
+	if len(deployments) == 0 {
+		return "", nil
+	}
+	return deployments[len(deployments)-1].Parameters[stack.ServiceAddonsTemplateURLParamKey], nil
+}
+
 func (o *deploySvcOpts) manifest() (interface{}, error) {
 	raw, err := o.ws.ReadServiceManifest(o.Name)
 	if err != nil {
@@ -385,6 +736,8 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	return &stack.RuntimeConfig{
 		ImageRepoURL:      repoURL,
 		ImageTag:          o.ImageTag,
+		ImageDigest:       o.imageDigest,
+		Platform:          o.Platform,
 		AddonsTemplateURL: addonsURL,
 		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.ResourceTags),
 	}, nil
@@ -402,6 +755,12 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
+		if err := o.pinDeployedVersion(t, rc); err != nil {
+			return nil, err
+		}
+		if err := o.validateTestListenerPort(); err != nil {
+			return nil, err
+		}
 		if o.targetApp.RequiresDNSDelegation() {
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		} else {
@@ -423,19 +782,443 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	if err != nil {
 		return err
 	}
-	o.spinner.Start(
-		fmt.Sprintf("Deploying %s to %s.",
-			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.Name), color.HighlightUserInput(o.ImageTag)),
-			color.HighlightUserInput(o.targetEnvironment.Name)))
+	hooks, err := o.deployHooks()
+	if err != nil {
+		return err
+	}
+	prev, err := o.latestDeployment(hooks)
+	if err != nil {
+		return err
+	}
+	provision, err := o.needsProvisioning(hooks.PreDeploy, conf)
+	if err != nil {
+		return err
+	}
+	if provision {
+		if err := o.provisionForPreDeployHooks(conf); err != nil {
+			return err
+		}
+	}
+	if err := o.runPreDeployHooks(hooks.PreDeploy, conf); err != nil {
+		return err
+	}
+	if err := o.deployStack(conf); err != nil {
+		return fmt.Errorf("deploy service: %w", err)
+	}
+	if err := o.shiftTraffic(conf); err != nil {
+		return err
+	}
+	if err := o.releaseCanary(conf); err != nil {
+		return err
+	}
+	o.recordDeployment(conf)
+	return o.runPostDeployHooks(hooks.PostDeploy, prev)
+}
+
+// deployStack deploys the stack of the service and streams its events.
+// The deployment is retried once if a previous deployment left the stack in a failed state and it's recovered.
+func (o *deploySvcOpts) deployStack(conf cloudformation.StackConfiguration) error {
+	err := o.streamDeployment(conf)
+	if err == nil {
+		return nil
+	}
+	recovered, err := recoverStack(stackRecovery{
+		prompt:    o.prompt,
+		recoverer: o.recoverer,
+		spinner:   o.spinner,
+	}, err)
+	if !recovered {
+		return err
+	}
+	return o.streamDeployment(conf)
+}
 
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+// streamDeployment deploys the stack of the service and displays the events of its resources until the deployment is done.
+func (o *deploySvcOpts) streamDeployment(conf cloudformation.StackConfiguration) error {
+	o.startDeploySpinner()
+	events, resp := o.svcCFN.StreamServiceDeployment(conf, svcStackOptions(o.targetEnvironment)...)
+	for ev := range events {
+		o.spinner.Events(humanizeServiceEvents(ev, o.ecsDescriber))
+	}
+	if err := <-resp; err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
-		return fmt.Errorf("deploy service: %w", err)
+		return err
 	}
 	o.spinner.Stop("\n")
 	return nil
 }
 
+// reviewAndDeploySvc shows the infrastructure changes of the deployment before deploying them.
+// It returns false if the changes are not deployed.
+func (o *deploySvcOpts) reviewAndDeploySvc() (bool, error) {
+	if err := o.imageForReview(); err != nil {
+		return false, err
+	}
+	addonsURL, err := o.addonsTemplateURL()
+	if err != nil {
+		return false, err
+	}
+	conf, err := o.stackConfiguration(addonsURL)
+	if err != nil {
+		return false, err
+	}
+	hooks, err := o.deployHooks()
+	if err != nil {
+		return false, err
+	}
+	prev, err := o.latestDeployment(hooks)
+	if err != nil {
+		return false, err
+	}
+	provision, err := o.needsProvisioning(hooks.PreDeploy, conf)
+	if err != nil {
+		return false, err
+	}
+	previewConf := conf
+	if provision {
+		// The reviewed changes create the service without tasks, which are started once the pre-deploy hooks ran.
+		log.Infof(fmtProvisionSvcForHooks, o.Name, preDeployHook)
+		previewConf = stackWithoutTasks{conf}
+	}
+	svcName, envName := color.HighlightUserInput(o.Name), color.HighlightUserInput(o.targetEnvironment.Name)
+	o.spinner.Start(fmt.Sprintf(fmtPreviewSvcStart, svcName, envName))
+	cs, err := o.svcPreviewer.PreviewService(previewConf, svcStackOptions(o.targetEnvironment)...)
+	var errRecovery *awscloudformation.ErrStackRequiresRecovery
+	if errors.As(err, &errRecovery) {
+		// Run the same recovery as deployments before previewing the changes again.
+		o.spinner.Stop(log.Serrorf(fmtPreviewSvcFailed, svcName, envName))
+		recovered, recoverErr := recoverStack(stackRecovery{
+			prompt:    o.prompt,
+			recoverer: o.recoverer,
+			spinner:   o.spinner,
+		}, err)
+		if !recovered {
+			return false, fmt.Errorf("preview changes to service %s: %w", o.Name, recoverErr)
+		}
+		o.spinner.Start(fmt.Sprintf(fmtPreviewSvcStart, svcName, envName))
+		cs, err = o.svcPreviewer.PreviewService(previewConf, svcStackOptions(o.targetEnvironment)...)
+	}
+	if err != nil {
+		var errEmpty *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmpty) {
+			o.spinner.Stop(log.Ssuccessf(fmtPreviewSvcEmpty, svcName, envName))
+			return false, nil
+		}
+		o.spinner.Stop(log.Serrorf(fmtPreviewSvcFailed, svcName, envName))
+		return false, fmt.Errorf("preview changes to service %s: %w", o.Name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtPreviewSvcComplete, svcName, envName))
+
+	confirmed, err := o.confirmChanges(cs)
+	if err != nil || !confirmed {
+		return false, err
+	}
+	if !provision {
+		if err := o.runPreDeployHooks(hooks.PreDeploy, conf); err != nil {
+			// Discard the change set since the deployment is aborted.
+			o.svcPreviewer.DeleteChangeSet(cs)
+			return false, err
+		}
+	}
+	o.startDeploySpinner()
+	if err := o.svcPreviewer.ExecuteChangeSetAndWait(cs); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
+		return false, fmt.Errorf("deploy service: %w", err)
+	}
+	o.spinner.Stop("\n")
+	if provision {
+		if err := o.runPreDeployHooks(hooks.PreDeploy, conf); err != nil {
+			return false, err
+		}
+		if err := o.deployStack(conf); err != nil {
+			return false, fmt.Errorf("deploy service: %w", err)
+		}
+	}
+	if err := o.shiftTraffic(conf); err != nil {
+		return false, err
+	}
+	if err := o.releaseCanary(conf); err != nil {
+		return false, err
+	}
+	o.recordDeployment(conf)
+	if err := o.runPostDeployHooks(hooks.PostDeploy, prev); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// imageForReview pushes the image of the service before its changes are reviewed, so that they refer to the image
+// by the digest it's deployed with. Dry runs don't push anything, so their changes refer to a new image by tag.
+func (o *deploySvcOpts) imageForReview() error {
+	if o.dryRun {
+		_, err := o.buildImage()
+		return err
+	}
+	return o.pushToECRRepo()
+}
+
+// confirmChanges asks for confirmation of the changes if they're reviewed, or if they delete or replace resources
+// of a production environment.
+func (o *deploySvcOpts) confirmChanges(cs *awscloudformation.ChangeSet) (bool, error) {
+	if o.shouldReview() {
+		return o.review(o.prompt, o.svcPreviewer, cs)
+	}
+	return confirmProdChangeSet(o.prompt, o.svcPreviewer, cs, o.targetEnvironment, o.SkipProdConfirmation)
+}
+
+// pinDeployedVersion keeps the deployed version of a service unchanged by the stack update if it isn't replaced right away.
+// The task definition of a service deployed with blue/green deployments is only replaced by CodeDeploy deployments,
+// and the image of the stable tasks of a service deployed with canary releases is only replaced by promotions.
+func (o *deploySvcOpts) pinDeployedVersion(mft *manifest.LoadBalancedWebService, rc *stack.RuntimeConfig) error {
+	envMft, err := mft.ApplyEnv(o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.targetEnvironment.Name, err)
+	}
+	o.blueGreen, o.canary = nil, nil
+	switch {
+	case envMft.Deployment.IsBlueGreen():
+		o.blueGreen = envMft.Deployment
+	case envMft.Deployment.IsCanary():
+		o.canary = envMft.Deployment
+	default:
+		return nil
+	}
+	stackName := stack.NameForService(o.AppName(), o.targetEnvironment.Name, o.Name)
+	outputs, err := o.svcOutputs.ServiceStackOutputs(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get outputs of stack %s: %w", stackName, err)
+	}
+	// If the service was deployed with rolling updates, the stack update replaces it and it starts with the latest version.
+	if o.canary != nil {
+		rc.StableImage = outputs[stack.LBWebServiceStableContainerImageOutputKey]
+		return nil
+	}
+	rc.DeployedTaskDefinition = outputs[stack.LBWebServicePinnedTaskDefinitionOutputKey]
+	return nil
+}
+
+// validateTestListenerPort returns an error if the test listener of a service deployed with blue/green deployments
+// would use the same port of the environment's load balancer as the test listener of another service.
+func (o *deploySvcOpts) validateTestListenerPort() error {
+	if o.blueGreen == nil {
+		return nil
+	}
+	port := strconv.Itoa(int(stack.BlueGreenTestListenerPort(o.Name, o.blueGreen)))
+	svcs, err := o.store.ListServices(o.AppName())
+	if err != nil {
+		return fmt.Errorf("list services in application %s: %w", o.AppName(), err)
+	}
+	for _, svc := range svcs {
+		if svc.Name == o.Name || svc.Type != manifest.LoadBalancedWebServiceType {
+			continue
+		}
+		stackName := stack.NameForService(o.AppName(), o.targetEnvironment.Name, svc.Name)
+		outputs, err := o.svcOutputs.ServiceStackOutputs(stackName)
+		if err != nil {
+			var errNotFound *awscloudformation.ErrStackNotFound
+			if errors.As(err, &errNotFound) {
+				continue
+			}
+			return fmt.Errorf("get outputs of stack %s: %w", stackName, err)
+		}
+		if outputs[stack.LBWebServiceTestListenerPortOutputKey] == port {
+			return fmt.Errorf("test port %s is already used by service %s in environment %s, set a different %s in the manifest",
+				port, svc.Name, o.targetEnvironment.Name, color.HighlightCode("deployment.testPort"))
+		}
+	}
+	return nil
+}
+
+// shiftTraffic replaces the tasks of a service deployed with blue/green deployments if they don't run the latest task definition,
+// and waits until CodeDeploy shifts the traffic to the new tasks or rolls it back.
+func (o *deploySvcOpts) shiftTraffic(conf cloudformation.StackConfiguration) error {
+	if o.blueGreen == nil {
+		return nil
+	}
+	outputs, err := o.svcOutputs.ServiceStackOutputs(conf.StackName())
+	if err != nil {
+		return fmt.Errorf("get outputs of stack %s: %w", conf.StackName(), err)
+	}
+	taskDef := outputs[stack.LBWebServiceTaskDefinitionOutputKey]
+	running, err := o.runningTaskDefinition(outputs[stack.LBWebServiceServiceArnOutputKey])
+	if err != nil {
+		return err
+	}
+	if running == taskDef {
+		return nil
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return fmt.Errorf("generate stack parameters: %w", err)
+	}
+	values := parametersMap(params)
+	port, err := strconv.Atoi(values[stack.LBWebServiceTargetPortParamKey])
+	if err != nil {
+		return fmt.Errorf("convert target port %s to an integer: %w", values[stack.LBWebServiceTargetPortParamKey], err)
+	}
+	id, err := o.codeDeploy.CreateECSDeployment(&codedeploy.ECSDeploymentInput{
+		Application:     outputs[stack.LBWebServiceCodeDeployApplicationOutputKey],
+		DeploymentGroup: outputs[stack.LBWebServiceCodeDeployDeploymentGroupOutputKey],
+		TaskDefinition:  taskDef,
+		ContainerName:   values[stack.LBWebServiceTargetContainerParamKey],
+		ContainerPort:   port,
+		Hooks:           o.blueGreen.Hooks,
+	})
+	if err != nil {
+		return fmt.Errorf("start blue/green deployment of service %s: %w", o.Name, err)
+	}
+	return o.waitForTrafficShift(id)
+}
+
+// releaseCanary looks up the canary of a service deployed with canary releases if the deployment released a new image as one.
+func (o *deploySvcOpts) releaseCanary(conf cloudformation.StackConfiguration) error {
+	o.canaryTaskDef, o.canaryWeight = "", ""
+	if o.canary == nil {
+		return nil
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return fmt.Errorf("generate stack parameters: %w", err)
+	}
+	values := parametersMap(params)
+	if values[stack.LBWebServiceCanaryContainerImageParamKey] == "" {
+		return nil
+	}
+	outputs, err := o.svcOutputs.ServiceStackOutputs(conf.StackName())
+	if err != nil {
+		return fmt.Errorf("get outputs of stack %s: %w", conf.StackName(), err)
+	}
+	o.canaryTaskDef = outputs[stack.LBWebServiceCanaryTaskDefinitionOutputKey]
+	o.canaryWeight = values[stack.LBWebServiceCanaryWeightParamKey]
+	log.Infof("Released the image of service %s as a canary that receives %s%% of the traffic in environment %s.\n",
+		color.HighlightUserInput(o.Name), color.HighlightUserInput(o.canaryWeight), color.HighlightUserInput(o.targetEnvironment.Name))
+	return nil
+}
+
+// runningTaskDefinition returns the task definition of the primary tasks of the ECS service.
+func (o *deploySvcOpts) runningTaskDefinition(serviceARN string) (string, error) {
+	arn := ecs.ServiceArn(serviceARN)
+	clusterName, err := arn.ClusterName()
+	if err != nil {
+		return "", fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := arn.ServiceName()
+	if err != nil {
+		return "", fmt.Errorf("get service name: %w", err)
+	}
+	svc, err := o.ecsDescriber.Service(clusterName, serviceName)
+	if err != nil {
+		return "", fmt.Errorf("describe ECS service %s: %w", serviceName, err)
+	}
+	return aws.StringValue(svc.TaskDefinition), nil
+}
+
+// waitForTrafficShift displays the status of the blue/green deployment until it's done.
+func (o *deploySvcOpts) waitForTrafficShift(id string) error {
+	svcName, envName := color.HighlightUserInput(o.Name), color.HighlightUserInput(o.targetEnvironment.Name)
+	o.spinner.Start(fmt.Sprintf(fmtShiftTrafficStart, svcName, envName, color.HighlightResource(id)))
+	for {
+		d, err := o.codeDeploy.Deployment(id)
+		if err != nil {
+			o.spinner.Stop(log.Serrorf(fmtShiftTrafficFailed, svcName, envName))
+			return err
+		}
+		if !d.Done() {
+			o.spinner.Events([]termprogress.TabRow{termprogress.TabRow(fmt.Sprintf(fmtBlueGreenDeployStatus, d.ID, d.Status))})
+			time.Sleep(codeDeployPollInterval)
+			continue
+		}
+		if d.Succeeded() {
+			o.spinner.Stop(log.Ssuccessf(fmtShiftTrafficComplete, svcName, envName))
+			return nil
+		}
+		o.spinner.Stop(log.Serrorf(fmtShiftTrafficFailed, svcName, envName))
+		if d.RollbackDeploymentID != "" {
+			return fmt.Errorf("blue/green deployment %s of service %s is %s and the traffic was rolled back to the previous tasks: %s",
+				id, o.Name, strings.ToLower(d.Status), d.ErrorMessage)
+		}
+		return fmt.Errorf("blue/green deployment %s of service %s is %s: %s", id, o.Name, strings.ToLower(d.Status), d.ErrorMessage)
+	}
+}
+
+// recordDeployment adds the deployed image and stack template to the history of the service in the environment.
+// The service is already deployed at this point, so failing to record the deployment only logs a warning.
+func (o *deploySvcOpts) recordDeployment(conf cloudformation.StackConfiguration) {
+	d, err := o.deployment(conf)
+	if err == nil {
+		err = o.deployments.CreateDeployment(d)
+	}
+	if err != nil {
+		log.Warningf("Failed to record the deployment of service %s: %v\n", o.Name, err)
+		return
+	}
+	log.Infof("Recorded the deployment as revision %s of service %s in environment %s.\n",
+		color.HighlightUserInput(strconv.Itoa(d.Revision)), color.HighlightUserInput(o.Name), color.HighlightUserInput(o.targetEnvironment.Name))
+}
+
+// deployment returns the record of the deployment of the service with the stack configuration.
+// The stack template is uploaded to the application's bucket so that it can be redeployed as is.
+func (o *deploySvcOpts) deployment(conf cloudformation.StackConfiguration) (*config.Deployment, error) {
+	digest := o.imageDigest
+	if digest == "" {
+		d, err := o.ecr.ImageDigest(o.repoName(), o.ImageTag)
+		if err != nil {
+			return nil, fmt.Errorf("get digest of image %s: %w", o.ImageTag, err)
+		}
+		digest = d
+	}
+	mft, err := o.ws.ReadServiceManifest(o.Name)
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest from workspace: %w", o.Name, err)
+	}
+	mftHash := sha256.Sum256(mft)
+	template, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack template: %w", err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack parameters: %w", err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get app resources: %w", err)
+	}
+	url, err := o.s3.PutArtifact(resources.S3Bucket,
+		fmt.Sprintf(config.DeployedServiceCfnTemplateNameFormat, o.Name, o.targetEnvironment.Name), strings.NewReader(template))
+	if err != nil {
+		return nil, fmt.Errorf("put stack template to bucket %s: %w", resources.S3Bucket, err)
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get identity: %w", err)
+	}
+	return &config.Deployment{
+		App:          o.AppName(),
+		Env:          o.targetEnvironment.Name,
+		Service:      o.Name,
+		ImageTag:     o.ImageTag,
+		ImageDigest:  digest,
+		ManifestHash: hex.EncodeToString(mftHash[:]),
+		TemplateURL:  url,
+		Parameters:   parametersMap(params),
+		Tags:         tagsMap(conf.Tags()),
+		DeployedBy:   caller.ARN,
+		DeployedAt:   time.Now().UTC(),
+	}, nil
+}
+
+func (o *deploySvcOpts) startDeploySpinner() {
+	o.spinner.Start(
+		fmt.Sprintf("Deploying %s to %s.",
+			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.Name), color.HighlightUserInput(o.ImageTag)),
+			color.HighlightUserInput(o.targetEnvironment.Name)))
+}
+
 func (o *deploySvcOpts) showAppURI() error {
 	type identifier interface {
 		URI(string) (string, error)
@@ -468,6 +1251,126 @@ func (o *deploySvcOpts) showAppURI() error {
 	return nil
 }
 
+// watch redeploys the service each time its build context or manifest changes, and streams its logs in between.
+// It only returns when the files can't be watched anymore.
+func (o *deploySvcOpts) watch() error {
+	dfPath, err := o.getDockerfilePath()
+	if err != nil {
+		return err
+	}
+	buildContext := filepath.Dir(dfPath)
+	manifestPath, err := o.ws.ServiceManifestPath(o.Name)
+	if err != nil {
+		return fmt.Errorf("get manifest path of service %s: %w", o.Name, err)
+	}
+	watcher, err := o.newWatcher([]string{buildContext, manifestPath})
+	if err != nil {
+		return fmt.Errorf("watch service %s for changes: %w", o.Name, err)
+	}
+	log.Infof("Watching %s and %s for changes, press Ctrl+C to stop.\n", color.HighlightResource(buildContext), color.HighlightResource(manifestPath))
+
+	since := time.Now()
+	for {
+		stop, done := make(chan struct{}), make(chan struct{})
+		go func(since time.Time) {
+			defer close(done)
+			if err := o.streamLogs(o, since, stop); err != nil {
+				log.Errorf("Failed to stream logs of service %s: %v\n", o.Name, err)
+			}
+		}(since)
+		changed, err := watcher.Wait()
+		close(stop)
+		<-done
+		if err != nil {
+			return fmt.Errorf("watch service %s for changes: %w", o.Name, err)
+		}
+
+		since = time.Now()
+		var imageChanged, manifestChanged bool
+		for _, path := range changed {
+			imageChanged = imageChanged || path == buildContext
+			manifestChanged = manifestChanged || path == manifestPath
+		}
+		if err := o.redeploy(imageChanged, manifestChanged); err != nil {
+			// Keep watching so that the user can fix the error.
+			log.Errorf("Failed to redeploy service %s: %v\n", o.Name, err)
+		}
+	}
+}
+
+// redeploy rebuilds the image only if its build context changed, and updates the service's stack only if
+// its manifest or the content of its image changed.
+func (o *deploySvcOpts) redeploy(imageChanged, manifestChanged bool) error {
+	if imageChanged {
+		prevDigest := o.imageDigest
+		if err := o.pushToECRRepo(); err != nil {
+			return err
+		}
+		imageChanged = o.imageDigest != prevDigest
+	}
+	if !imageChanged && !manifestChanged {
+		return nil
+	}
+	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
+	if err != nil {
+		return err
+	}
+	return o.withDeploymentLock(func() error {
+		return o.deploySvc(addonsURL)
+	})
+}
+
+// streamSvcLogs follows the logs of the service emitted after since, until stop is closed.
+func streamSvcLogs(o *deploySvcOpts, since time.Time, stop <-chan struct{}) error {
+	logs, err := newSvcLogOpts(svcLogsVars{
+		follow:     true,
+		limit:      watchLogEventsLimit,
+		svcName:    o.Name,
+		envName:    o.targetEnvironment.Name,
+		GlobalOpts: o.GlobalOpts,
+	})
+	if err != nil {
+		return err
+	}
+	logs.startTime = since.Unix() * 1000
+	logs.stop = stop
+	if err := logs.initCwLogsSvc(logs, o.targetEnvironment); err != nil {
+		return err
+	}
+	return logs.Execute()
+}
+
+func parametersMap(params []*sdkcloudformation.Parameter) map[string]string {
+	m := make(map[string]string, len(params))
+	for _, p := range params {
+		m[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+	}
+	return m
+}
+
+func tagsMap(tags []*sdkcloudformation.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return m
+}
+
+// runSvcDeploy deploys a single service to an environment.
+func runSvcDeploy(cmd *cobra.Command, args []string, vars deploySvcVars) error {
+	opts, err := newSvcDeployOpts(vars)
+	if err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if err := opts.Ask(); err != nil {
+		return err
+	}
+	return executeAudited(cmd, args, opts, opts.Execute)
+}
+
 // BuildSvcDeployCmd builds the `svc deploy` subcommand.
 func BuildSvcDeployCmd() *cobra.Command {
 	vars := deploySvcVars{
@@ -481,28 +1384,25 @@ func BuildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Redeploys the "frontend" service to the "dev" environment each time its source code or manifest changes.
+  /code $ copilot svc deploy --name frontend --env dev --watch
+  Shows the infrastructure changes of deploying the "frontend" service to the "prod" environment and asks for confirmation.
+  /code $ copilot svc deploy --name frontend --env prod --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newSvcDeployOpts(vars)
-			if err != nil {
-				return err
-			}
-			if err := opts.Validate(); err != nil {
-				return err
-			}
-			if err := opts.Ask(); err != nil {
-				return err
-			}
-			if err := opts.Execute(); err != nil {
-				return err
-			}
-			return nil
+			return runSvcDeploy(cmd, args, vars)
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.ImageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.ResourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.Watch, watchFlag, false, watchFlagDescription)
+	cmd.Flags().StringVar(&vars.Platform, platformFlag, "", platformFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipProdConfirmation, yesIMeanProdFlag, false, yesIMeanProdFlagDescription)
+	cmd.Flags().DurationVar(&vars.WaitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	addChangeSetReviewFlags(cmd, &vars.changeSetReviewVars)
 
 	return cmd
 }
@@ -0,0 +1,317 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	preDeployHook  = "pre-deploy"
+	postDeployHook = "post-deploy"
+
+	fmtProvisionSvcForHooks = "Creating service %s without tasks to run its %s hooks before it starts.\n"
+)
+
+// hookPollInterval is the time to wait between two checks of the task running a hook.
+var hookPollInterval = 3 * time.Second
+
+type deployHooksGetter interface {
+	DeployHooks() *manifest.Hooks
+}
+
+// newSvcDeployDescriber returns a describer of the service in the target environment, to find its ECS service.
+func newSvcDeployDescriber(o *deploySvcOpts) (serviceArnGetter, error) {
+	return describe.NewServiceDescriber(o.AppName(), o.targetEnvironment.Name, o.Name)
+}
+
+// deployHooks returns the hooks in the manifest of the service, or no hooks if the manifest doesn't have any.
+func (o *deploySvcOpts) deployHooks() (*manifest.Hooks, error) {
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	getter, ok := mft.(deployHooksGetter)
+	if !ok || getter.DeployHooks() == nil {
+		return &manifest.Hooks{}, nil
+	}
+	hooks := getter.DeployHooks()
+	if err := hooks.Validate(); err != nil {
+		return nil, fmt.Errorf("validate hooks of service %s: %w", o.Name, err)
+	}
+	return hooks, nil
+}
+
+// latestDeployment returns the latest deployment of the service in the environment, or nil if it was never deployed.
+// It's only looked up if there are post-deploy hooks, since it's the revision to roll back to if one of them fails.
+func (o *deploySvcOpts) latestDeployment(hooks *manifest.Hooks) (*config.Deployment, error) {
+	if len(hooks.PostDeploy) == 0 {
+		return nil, nil
+	}
+	deployments, err := o.deployments.ListDeployments(o.AppName(), o.targetEnvironment.Name, o.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments) == 0 {
+		return nil, nil
+	}
+	return deployments[len(deployments)-1], nil
+}
+
+// runPreDeployHooks runs the pre-deploy hooks once the image of the stack configuration is pushed.
+// The hooks that run as tasks use a copy of the task definition of the ECS service with the new image,
+// so the service must be created with provisionForPreDeployHooks first if it isn't deployed yet.
+func (o *deploySvcOpts) runPreDeployHooks(hooks []manifest.Hook, conf cloudformation.StackConfiguration) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	return o.runHooks(preDeployHook, hooks, func() (*ecs.Service, string, error) {
+		if o.imagePlatform != "" && o.imagePlatform != manifest.PlatformLinuxAMD64 {
+			return nil, "", fmt.Errorf("hooks that run as tasks before the deployment are not supported on platform %s", o.imagePlatform)
+		}
+		svc, err := o.ecsService()
+		if err != nil {
+			return nil, "", err
+		}
+		params, err := conf.Parameters()
+		if err != nil {
+			return nil, "", fmt.Errorf("generate stack parameters: %w", err)
+		}
+		values := parametersMap(params)
+		image := values[stack.ServiceContainerImageParamKey]
+		// The new image of a service deployed with canary releases is released as the canary, not the stable tasks.
+		if canaryImage := values[stack.LBWebServiceCanaryContainerImageParamKey]; canaryImage != "" {
+			image = canaryImage
+		}
+		taskDef, err := o.hookRunner.RegisterTaskDefinitionWithImage(aws.StringValue(svc.TaskDefinition), o.Name, image)
+		if err != nil {
+			return nil, "", err
+		}
+		return svc, taskDef, nil
+	})
+}
+
+// needsProvisioning returns true if the service must be created without tasks before its pre-deploy hooks can run,
+// because some of them run as tasks and the service isn't deployed yet.
+func (o *deploySvcOpts) needsProvisioning(hooks []manifest.Hook, conf cloudformation.StackConfiguration) (bool, error) {
+	var hasTasks bool
+	for _, hook := range hooks {
+		hasTasks = hasTasks || !hook.IsLocal()
+	}
+	if !hasTasks {
+		return false, nil
+	}
+	deployed, err := o.isDeployed(conf)
+	if err != nil {
+		return false, err
+	}
+	return !deployed, nil
+}
+
+// provisionForPreDeployHooks creates the stack of a service that isn't deployed yet without running any task,
+// so that its pre-deploy hooks run with the task definition, roles and network of the new stack
+// before the first version of the service starts.
+func (o *deploySvcOpts) provisionForPreDeployHooks(conf cloudformation.StackConfiguration) error {
+	log.Infof(fmtProvisionSvcForHooks, o.Name, preDeployHook)
+	if err := o.deployStack(stackWithoutTasks{conf}); err != nil {
+		return fmt.Errorf("create service %s without tasks: %w", o.Name, err)
+	}
+	return nil
+}
+
+// stackWithoutTasks is the stack configuration of a service whose ECS service doesn't run any task.
+type stackWithoutTasks struct {
+	cloudformation.StackConfiguration
+}
+
+// Parameters returns the parameters of the stack configuration with a task count of zero.
+func (s stackWithoutTasks) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	params, err := s.StackConfiguration.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	withoutTasks := make([]*sdkcloudformation.Parameter, len(params))
+	for i, param := range params {
+		withoutTasks[i] = param
+		if aws.StringValue(param.ParameterKey) == stack.ServiceTaskCountParamKey {
+			withoutTasks[i] = &sdkcloudformation.Parameter{
+				ParameterKey:   param.ParameterKey,
+				ParameterValue: aws.String("0"),
+			}
+		}
+	}
+	return withoutTasks, nil
+}
+
+// runPostDeployHooks runs the post-deploy hooks with the task definition of the updated ECS service,
+// or of the canary if the deployment released one. If a hook fails, the service is rolled back to the previous deployment.
+func (o *deploySvcOpts) runPostDeployHooks(hooks []manifest.Hook, prev *config.Deployment) error {
+	err := o.runHooks(postDeployHook, hooks, func() (*ecs.Service, string, error) {
+		svc, err := o.ecsService()
+		if err != nil {
+			return nil, "", err
+		}
+		if o.canaryTaskDef != "" {
+			return svc, o.canaryTaskDef, nil
+		}
+		return svc, aws.StringValue(svc.TaskDefinition), nil
+	})
+	if err == nil {
+		return nil
+	}
+	if prev == nil {
+		return fmt.Errorf("%w: service %s has no previous revision to roll back to", err, o.Name)
+	}
+	log.Errorf("Rolling back service %s since a %s hook failed.\n", o.Name, postDeployHook)
+	rollback := svcRollback{
+		rollbacker:  o.rollbacker,
+		deployments: o.deployments,
+		identity:    o.identity,
+		spinner:     o.spinner,
+	}
+	if rbErr := rollbackSvc(rollback, prev, o.targetEnvironment); rbErr != nil {
+		return fmt.Errorf("%v, and failed to roll back: %w", err, rbErr)
+	}
+	return fmt.Errorf("%w: rolled back service %s to revision %d", err, o.Name, prev.Revision)
+}
+
+// runHooks runs the hooks in order and stops at the first one that fails.
+// The ECS service and the task definition of the hooks that run as tasks are only resolved by target if there are such hooks.
+func (o *deploySvcOpts) runHooks(kind string, hooks []manifest.Hook, target func() (*ecs.Service, string, error)) error {
+	var svc *ecs.Service
+	var taskDef string
+	for _, hook := range hooks {
+		log.Infof("Running %s hook %s.\n", kind, color.HighlightCode(hook.String()))
+		var err error
+		if hook.IsLocal() {
+			err = o.runLocalHook(hook)
+		} else {
+			if svc == nil {
+				if svc, taskDef, err = target(); err != nil {
+					return fmt.Errorf("prepare %s hooks: %w", kind, err)
+				}
+			}
+			err = o.runTaskHook(svc, taskDef, hook)
+		}
+		if err != nil {
+			return fmt.Errorf("%s hook %s: %w", kind, hook, err)
+		}
+		log.Successf("Ran %s hook %s.\n", kind, color.HighlightCode(hook.String()))
+	}
+	return nil
+}
+
+// runLocalHook runs the shell command of the hook on this machine.
+func (o *deploySvcOpts) runLocalHook(hook manifest.Hook) error {
+	return o.cmd.Run("sh", []string{"-c", aws.StringValue(hook.Local)}, command.Stdout(log.DiagnosticWriter))
+}
+
+// runTaskHook runs the command of the hook in a one-off task of the task definition with the network of the service.
+// It streams the logs of the task until it stops, and returns an error if the command doesn't exit successfully.
+func (o *deploySvcOpts) runTaskHook(svc *ecs.Service, taskDef string, hook manifest.Hook) error {
+	taskARN, err := o.hookRunner.RunServiceTask(svc, taskDef, o.Name, hook.Command)
+	if err != nil {
+		return err
+	}
+	td, err := o.hookRunner.TaskDefinition(taskDef)
+	if err != nil {
+		return err
+	}
+	group, prefix, hasLogs := td.AWSLogsConfig(o.Name)
+	cluster := aws.StringValue(svc.ClusterArn)
+	var token *string
+	for {
+		task, err := o.hookRunner.Task(cluster, taskARN)
+		if err != nil {
+			return err
+		}
+		if hasLogs {
+			stream, err := task.LogStreamName(prefix, o.Name)
+			if err != nil {
+				return fmt.Errorf("get log stream of task %s: %w", taskARN, err)
+			}
+			if token, err = o.writeHookLogs(group, stream, token); err != nil {
+				return err
+			}
+		}
+		if !task.IsStopped() {
+			time.Sleep(hookPollInterval)
+			continue
+		}
+		code, err := task.ExitCode(o.Name)
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return fmt.Errorf("task %s exited with code %d", taskARN, code)
+		}
+		return nil
+	}
+}
+
+// writeHookLogs writes the log events of the stream that follow the token, and returns the token to get the next events.
+func (o *deploySvcOpts) writeHookLogs(group, stream string, token *string) (*string, error) {
+	for {
+		events, next, err := o.hookLogs.LogStreamEvents(group, stream, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			fmt.Fprint(log.DiagnosticWriter, event.HumanString())
+		}
+		if len(events) == 0 || aws.StringValue(next) == aws.StringValue(token) {
+			return next, nil
+		}
+		token = next
+	}
+}
+
+// isDeployed returns true if the stack of the service exists.
+func (o *deploySvcOpts) isDeployed(conf cloudformation.StackConfiguration) (bool, error) {
+	_, err := o.svcOutputs.ServiceStackOutputs(conf.StackName())
+	if err == nil {
+		return true, nil
+	}
+	var errNotFound *awscloudformation.ErrStackNotFound
+	if errors.As(err, &errNotFound) {
+		return false, nil
+	}
+	return false, fmt.Errorf("get outputs of stack %s: %w", conf.StackName(), err)
+}
+
+// ecsService returns the ECS service of the service in the target environment.
+func (o *deploySvcOpts) ecsService() (*ecs.Service, error) {
+	d, err := o.newSvcDescriber(o)
+	if err != nil {
+		return nil, err
+	}
+	arn, err := d.GetServiceArn()
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of %s: %w", o.Name, err)
+	}
+	clusterName, err := arn.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := arn.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get service name: %w", err)
+	}
+	return o.hookRunner.Service(clusterName, serviceName)
+}
@@ -0,0 +1,357 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcDeployHooksMocks struct {
+	svcOutputs *mocks.MocksvcStackOutputsGetter
+	describer  *mocks.MockserviceArnGetter
+	hookRunner *mocks.MockhookTaskRunner
+	hookLogs   *mocks.MocklogStreamReader
+	cmd        *mocks.Mockrunner
+	rollbacker *mocks.MocksvcRollbacker
+	store      *mocks.MockdeploymentStore
+	identity   *mocks.MockidentityService
+	spinner    *mocks.Mockprogress
+}
+
+const (
+	mockHookServiceArn = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-serviceA-Service"
+	mockHookTaskArn    = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"
+)
+
+var (
+	mockHookService = &ecs.Service{
+		ClusterArn:     aws.String("phonetool-test-Cluster"),
+		TaskDefinition: aws.String("phonetool-test-serviceA:3"),
+	}
+	mockHookTaskDef = &ecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name: aws.String("serviceA"),
+				LogConfiguration: &sdkecs.LogConfiguration{
+					LogDriver: aws.String("awslogs"),
+					Options: map[string]*string{
+						"awslogs-group":         aws.String("/copilot/phonetool-test-serviceA"),
+						"awslogs-stream-prefix": aws.String("copilot"),
+					},
+				},
+			},
+		},
+	}
+)
+
+func mockHookTask(status string, exitCode int64) *ecs.Task {
+	return &ecs.Task{
+		TaskArn:    aws.String(mockHookTaskArn),
+		LastStatus: aws.String(status),
+		Containers: []*sdkecs.Container{
+			{Name: aws.String("serviceA"), ExitCode: aws.Int64(exitCode)},
+		},
+	}
+}
+
+func newSvcDeployHooksOpts(m *svcDeployHooksMocks) *deploySvcOpts {
+	return &deploySvcOpts{
+		deploySvcVars: deploySvcVars{
+			GlobalOpts: &GlobalOpts{appName: "phonetool"},
+			Name:       "serviceA",
+		},
+		svcOutputs:  m.svcOutputs,
+		hookRunner:  m.hookRunner,
+		hookLogs:    m.hookLogs,
+		cmd:         m.cmd,
+		rollbacker:  m.rollbacker,
+		deployments: m.store,
+		identity:    m.identity,
+		spinner:     m.spinner,
+		newSvcDescriber: func(o *deploySvcOpts) (serviceArnGetter, error) {
+			return m.describer, nil
+		},
+		targetEnvironment: &config.Environment{Name: "test"},
+	}
+}
+
+func TestSvcDeployOpts_runPreDeployHooks(t *testing.T) {
+	migrate := manifest.Hook{Command: []string{"./migrate", "up"}}
+	lint := manifest.Hook{Local: aws.String("make lint")}
+	conf := &mockStackConfig{
+		parameters: map[string]string{
+			stack.ServiceContainerImageParamKey: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA@sha256:abc",
+		},
+	}
+	mockRunsTask := func(m *svcDeployHooksMocks, exitCode int64) {
+		m.describer.EXPECT().GetServiceArn().Return((*ecs.ServiceArn)(aws.String(mockHookServiceArn)), nil)
+		m.hookRunner.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-serviceA-Service").Return(mockHookService, nil)
+		m.hookRunner.EXPECT().RegisterTaskDefinitionWithImage("phonetool-test-serviceA:3", "serviceA",
+			"123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA@sha256:abc").Return("phonetool-test-serviceA-hooks:1", nil)
+		m.hookRunner.EXPECT().RunServiceTask(mockHookService, "phonetool-test-serviceA-hooks:1", "serviceA", []string{"./migrate", "up"}).Return(mockHookTaskArn, nil)
+		m.hookRunner.EXPECT().TaskDefinition("phonetool-test-serviceA-hooks:1").Return(mockHookTaskDef, nil)
+		gomock.InOrder(
+			m.hookRunner.EXPECT().Task("phonetool-test-Cluster", mockHookTaskArn).Return(mockHookTask("RUNNING", 0), nil),
+			m.hookRunner.EXPECT().Task("phonetool-test-Cluster", mockHookTaskArn).Return(mockHookTask("STOPPED", exitCode), nil),
+		)
+		stream := "copilot/serviceA/4082490ee6c245e09d2145010aa1ba8d"
+		gomock.InOrder(
+			m.hookLogs.EXPECT().LogStreamEvents("/copilot/phonetool-test-serviceA", stream, nil).Return([]*cloudwatchlogs.Event{
+				{Message: "migrating..."},
+			}, aws.String("token1"), nil),
+			m.hookLogs.EXPECT().LogStreamEvents("/copilot/phonetool-test-serviceA", stream, aws.String("token1")).Return(nil, aws.String("token1"), nil),
+			m.hookLogs.EXPECT().LogStreamEvents("/copilot/phonetool-test-serviceA", stream, aws.String("token1")).Return(nil, aws.String("token1"), nil),
+		)
+	}
+	testCases := map[string]struct {
+		inHooks    []manifest.Hook
+		setupMocks func(m *svcDeployHooksMocks)
+
+		wantedErr error
+	}{
+		"does nothing without hooks": {
+			setupMocks: func(m *svcDeployHooksMocks) {},
+		},
+		"runs the task hook with the new image and streams its logs": {
+			inHooks: []manifest.Hook{migrate},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				mockRunsTask(m, 0)
+			},
+		},
+		"stops at the first hook that exits with a non-zero code": {
+			inHooks: []manifest.Hook{migrate, lint},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				mockRunsTask(m, 1)
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("pre-deploy hook ./migrate up: task " + mockHookTaskArn + " exited with code 1"),
+		},
+		"wraps the error from registering the task definition": {
+			inHooks: []manifest.Hook{migrate},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.describer.EXPECT().GetServiceArn().Return((*ecs.ServiceArn)(aws.String(mockHookServiceArn)), nil)
+				m.hookRunner.EXPECT().Service(gomock.Any(), gomock.Any()).Return(mockHookService, nil)
+				m.hookRunner.EXPECT().RegisterTaskDefinitionWithImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("prepare pre-deploy hooks: some error"),
+		},
+		"returns the error from a local hook": {
+			inHooks: []manifest.Hook{lint},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.cmd.EXPECT().Run("sh", []string{"-c", "make lint"}, gomock.Any()).Return(errors.New("exit status 2"))
+			},
+			wantedErr: errors.New("pre-deploy hook make lint: exit status 2"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			defer func(interval time.Duration) { hookPollInterval = interval }(hookPollInterval)
+			hookPollInterval = 0
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &svcDeployHooksMocks{
+				svcOutputs: mocks.NewMocksvcStackOutputsGetter(ctrl),
+				describer:  mocks.NewMockserviceArnGetter(ctrl),
+				hookRunner: mocks.NewMockhookTaskRunner(ctrl),
+				hookLogs:   mocks.NewMocklogStreamReader(ctrl),
+				cmd:        mocks.NewMockrunner(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newSvcDeployHooksOpts(m)
+
+			// WHEN
+			err := opts.runPreDeployHooks(tc.inHooks, conf)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDeployOpts_needsProvisioning(t *testing.T) {
+	migrate := manifest.Hook{Command: []string{"./migrate", "up"}}
+	lint := manifest.Hook{Local: aws.String("make lint")}
+	testCases := map[string]struct {
+		inHooks    []manifest.Hook
+		setupMocks func(m *svcDeployHooksMocks)
+
+		wanted    bool
+		wantedErr error
+	}{
+		"false if there are only local hooks": {
+			inHooks:    []manifest.Hook{lint},
+			setupMocks: func(m *svcDeployHooksMocks) {},
+		},
+		"false if the service is already deployed": {
+			inHooks: []manifest.Hook{lint, migrate},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(map[string]string{}, nil)
+			},
+		},
+		"true if a task hook runs before the first deployment": {
+			inHooks: []manifest.Hook{migrate},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wanted: true,
+		},
+		"returns the error from describing the stack": {
+			inHooks: []manifest.Hook{migrate},
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &svcDeployHooksMocks{
+				svcOutputs: mocks.NewMocksvcStackOutputsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newSvcDeployHooksOpts(m)
+
+			// WHEN
+			got, err := opts.needsProvisioning(tc.inHooks, &mockStackConfig{})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.Contains(t, err.Error(), tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestStackWithoutTasks_Parameters(t *testing.T) {
+	// GIVEN
+	conf := &mockStackConfig{
+		parameters: map[string]string{
+			stack.ServiceTaskCountParamKey:      "3",
+			stack.ServiceContainerImageParamKey: "phonetool/serviceA@sha256:abc",
+		},
+	}
+
+	// WHEN
+	params, err := stackWithoutTasks{conf}.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	got := make(map[string]string)
+	for _, p := range params {
+		got[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+	}
+	require.Equal(t, map[string]string{
+		stack.ServiceTaskCountParamKey:      "0",
+		stack.ServiceContainerImageParamKey: "phonetool/serviceA@sha256:abc",
+	}, got)
+}
+
+func TestSvcDeployOpts_runPostDeployHooks(t *testing.T) {
+	smokeTest := manifest.Hook{Local: aws.String("./smoke-test.sh")}
+	prev := &config.Deployment{
+		App:         "phonetool",
+		Env:         "test",
+		Service:     "serviceA",
+		Revision:    4,
+		TemplateURL: "https://bucket.s3.amazonaws.com/phonetool-test-serviceA.yml",
+	}
+	testCases := map[string]struct {
+		inPrev     *config.Deployment
+		setupMocks func(m *svcDeployHooksMocks)
+
+		wantedErr error
+	}{
+		"does not roll back if the hooks succeed": {
+			inPrev: prev,
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.cmd.EXPECT().Run("sh", []string{"-c", "./smoke-test.sh"}, gomock.Any()).Return(nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"rolls back to the previous revision if a hook fails": {
+			inPrev: prev,
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				m.store.EXPECT().CreateDeployment(gomock.Any()).Return(nil)
+			},
+			wantedErr: errors.New("post-deploy hook ./smoke-test.sh: exit status 1: rolled back service serviceA to revision 4"),
+		},
+		"returns both errors if the rollback fails": {
+			inPrev: prev,
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("post-deploy hook ./smoke-test.sh: exit status 1, and failed to roll back: roll back service serviceA to revision 4: some error"),
+		},
+		"does not roll back a service without a previous revision": {
+			setupMocks: func(m *svcDeployHooksMocks) {
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("post-deploy hook ./smoke-test.sh: exit status 1: service serviceA has no previous revision to roll back to"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &svcDeployHooksMocks{
+				cmd:        mocks.NewMockrunner(ctrl),
+				rollbacker: mocks.NewMocksvcRollbacker(ctrl),
+				store:      mocks.NewMockdeploymentStore(ctrl),
+				identity:   mocks.NewMockidentityService(ctrl),
+				spinner:    mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := newSvcDeployHooksOpts(m)
+
+			// WHEN
+			err := opts.runPostDeployHooks([]manifest.Hook{smokeTest}, tc.inPrev)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,10 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -19,9 +30,12 @@ import (
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName  string
+		inEnvName  string
+		inSvcName  string
+		inWatch    bool
+		inDryRun   bool
+		inPlatform string
 
 		mockWs    func(m *mocks.MockwsSvcReader)
 		mockStore func(m *mocks.Mockstore)
@@ -65,6 +79,23 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"with both watch and dry-run": {
+			inAppName: "phonetool",
+			inWatch:   true,
+			inDryRun:  true,
+			mockWs:    func(m *mocks.MockwsSvcReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("cannot specify --watch and --dry-run options at once"),
+		},
+		"with unsupported platform": {
+			inAppName:  "phonetool",
+			inPlatform: "linux/386",
+			mockWs:     func(m *mocks.MockwsSvcReader) {},
+			mockStore:  func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("platform linux/386 must be one of linux/amd64, linux/arm64"),
+		},
 		"successful validation": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -93,8 +124,13 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 					GlobalOpts: &GlobalOpts{
 						appName: tc.inAppName,
 					},
-					Name:    tc.inSvcName,
-					EnvName: tc.inEnvName,
+					Name:     tc.inSvcName,
+					EnvName:  tc.inEnvName,
+					Watch:    tc.inWatch,
+					Platform: tc.inPlatform,
+					changeSetReviewVars: changeSetReviewVars{
+						dryRun: tc.inDryRun,
+					},
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -406,3 +442,1053 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcDeployOpts_addonsTemplateURL(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inDryRun   bool
+		setupMocks func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore)
+
+		wantedURL string
+		wantedErr error
+	}{
+		"uploads the addons template": {
+			setupMocks: func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore) {
+				addons.EXPECT().Template().Return("some data", nil)
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				s3.EXPECT().PutArtifact("mockBucket", "mockSvc.addons.stack.yml", gomock.Any()).Return("https://mockBucket/new.addons.stack.yml", nil)
+				deployments.EXPECT().ListDeployments(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedURL: "https://mockBucket/new.addons.stack.yml",
+		},
+		"does not upload anything in a dry run": {
+			inDryRun: true,
+			setupMocks: func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore) {
+				addons.EXPECT().Template().Return("some data", nil)
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Times(0)
+				s3.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				deployments.EXPECT().ListDeployments("phonetool", "test", "mockSvc").Return([]*config.Deployment{
+					{
+						Parameters: map[string]string{
+							stack.ServiceAddonsTemplateURLParamKey: "https://mockBucket/old.addons.stack.yml",
+						},
+					},
+					{
+						Parameters: map[string]string{
+							stack.ServiceAddonsTemplateURLParamKey: "https://mockBucket/latest.addons.stack.yml",
+						},
+					},
+				}, nil)
+			},
+			wantedURL: "https://mockBucket/latest.addons.stack.yml",
+		},
+		"previews without addons in a dry run if the service was never deployed": {
+			inDryRun: true,
+			setupMocks: func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore) {
+				addons.EXPECT().Template().Return("some data", nil)
+				s3.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				deployments.EXPECT().ListDeployments("phonetool", "test", "mockSvc").Return(nil, nil)
+			},
+		},
+		"does not look up deployments in a dry run if the service doesn't have any addons": {
+			inDryRun: true,
+			setupMocks: func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore) {
+				addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{
+					SvcName: "mockSvc",
+				})
+				deployments.EXPECT().ListDeployments(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if the deployments can't be listed in a dry run": {
+			inDryRun: true,
+			setupMocks: func(addons *mocks.Mocktemplater, s3 *mocks.MockartifactUploader, appCFN *mocks.MockappResourcesGetter, deployments *mocks.MockdeploymentStore) {
+				addons.EXPECT().Template().Return("some data", nil)
+				deployments.EXPECT().ListDeployments("phonetool", "test", "mockSvc").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addons := mocks.NewMocktemplater(ctrl)
+			s3 := mocks.NewMockartifactUploader(ctrl)
+			appCFN := mocks.NewMockappResourcesGetter(ctrl)
+			deployments := mocks.NewMockdeploymentStore(ctrl)
+			tc.setupMocks(addons, s3, appCFN, deployments)
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					Name: "mockSvc",
+					changeSetReviewVars: changeSetReviewVars{
+						dryRun: tc.inDryRun,
+					},
+				},
+				addons:      addons,
+				s3:          s3,
+				appCFN:      appCFN,
+				deployments: deployments,
+				targetEnvironment: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+				targetApp: &config.Application{
+					Name: "phonetool",
+				},
+			}
+
+			// WHEN
+			got, err := opts.addonsTemplateURL()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURL, got)
+		})
+	}
+}
+
+func TestSvcDeployOpts_watch(t *testing.T) {
+	mockError := errors.New("some error")
+	mockManifest := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: serviceA/Dockerfile
+`)
+	type watchMocks struct {
+		ws         *mocks.MockwsSvcReader
+		watcher    *mocks.MockfileWatcher
+		ecr        *mocks.MockecrService
+		builder    *mocks.MockimageBuilder
+		addons     *mocks.Mocktemplater
+		newWatcher func(paths []string) (fileWatcher, error)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *watchMocks)
+
+		wantedLogStreams int
+		wantedErr        error
+	}{
+		"errors if the files can't be watched": {
+			setupMocks: func(m *watchMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil)
+				m.ws.EXPECT().ServiceManifestPath("serviceA").Return("copilot/serviceA/manifest.yml", nil)
+				m.newWatcher = func(paths []string) (fileWatcher, error) {
+					return nil, mockError
+				}
+			},
+			wantedErr: errors.New("watch service serviceA for changes: some error"),
+		},
+		"doesn't redeploy the service if the content of the image didn't change": {
+			setupMocks: func(m *watchMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(3)
+				m.ws.EXPECT().ServiceManifestPath("serviceA").Return("copilot/serviceA/manifest.yml", nil)
+				m.newWatcher = func(paths []string) (fileWatcher, error) {
+					require.Equal(t, []string{"serviceA", "copilot/serviceA/manifest.yml"}, paths)
+					return m.watcher, nil
+				}
+				gomock.InOrder(
+					m.watcher.EXPECT().Wait().Return([]string{"serviceA"}, nil),
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("sha256:abc", nil),
+					m.ecr.EXPECT().ListImages("phonetool/serviceA").Return([]ecr.Image{{Digest: "sha256:abc"}}, nil),
+					m.watcher.EXPECT().Wait().Return(nil, mockError),
+				)
+				m.addons.EXPECT().Template().Times(0)
+			},
+			wantedLogStreams: 2,
+			wantedErr:        errors.New("watch service serviceA for changes: some error"),
+		},
+		"pushes the image and updates the stack if the content of the image changed": {
+			setupMocks: func(m *watchMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(3)
+				m.ws.EXPECT().ServiceManifestPath("serviceA").Return("copilot/serviceA/manifest.yml", nil)
+				m.newWatcher = func(paths []string) (fileWatcher, error) {
+					return m.watcher, nil
+				}
+				gomock.InOrder(
+					m.watcher.EXPECT().Wait().Return([]string{"serviceA"}, nil),
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("", nil),
+					m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "hunter2"}, nil),
+					m.builder.EXPECT().Login("mockURI", "AWS", "hunter2").Return(nil),
+					m.builder.EXPECT().Push("mockURI", "v1").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("sha256:def", nil),
+					// Failing to update the stack doesn't stop watching.
+					m.addons.EXPECT().Template().Return("", mockError),
+					m.watcher.EXPECT().Wait().Return(nil, mockError),
+				)
+			},
+			wantedLogStreams: 2,
+			wantedErr:        errors.New("watch service serviceA for changes: some error"),
+		},
+		"keeps watching if the redeployment fails": {
+			setupMocks: func(m *watchMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(3)
+				m.ws.EXPECT().ServiceManifestPath("serviceA").Return("copilot/serviceA/manifest.yml", nil)
+				m.newWatcher = func(paths []string) (fileWatcher, error) {
+					return m.watcher, nil
+				}
+				gomock.InOrder(
+					m.watcher.EXPECT().Wait().Return([]string{"serviceA"}, nil),
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(mockError),
+					m.watcher.EXPECT().Wait().Return(nil, mockError),
+				)
+				m.addons.EXPECT().Template().Times(0)
+			},
+			wantedLogStreams: 2,
+			wantedErr:        errors.New("watch service serviceA for changes: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &watchMocks{
+				ws:      mocks.NewMockwsSvcReader(ctrl),
+				watcher: mocks.NewMockfileWatcher(ctrl),
+				ecr:     mocks.NewMockecrService(ctrl),
+				builder: mocks.NewMockimageBuilder(ctrl),
+				addons:  mocks.NewMocktemplater(ctrl),
+			}
+			tc.setupMocks(m)
+			var logStreams int
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+					EnvName:    "test",
+					ImageTag:   "v1",
+					Watch:      true,
+				},
+				ws:         m.ws,
+				ecr:        m.ecr,
+				builder:    m.builder,
+				addons:     m.addons,
+				newWatcher: m.newWatcher,
+				streamLogs: func(o *deploySvcOpts, since time.Time, stop <-chan struct{}) error {
+					logStreams++
+					<-stop
+					return nil
+				},
+				targetEnvironment: &config.Environment{Name: "test"},
+				imageDigest:       "sha256:abc",
+			}
+
+			// WHEN
+			err := opts.watch()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr.Error())
+			require.Equal(t, tc.wantedLogStreams, logStreams)
+		})
+	}
+}
+
+func TestSvcDeployOpts_pushToECRRepo(t *testing.T) {
+	mockError := errors.New("some error")
+	mockManifest := []byte(`name: serviceA
+type: 'Backend Service'
+image:
+  build: serviceA/Dockerfile
+environments:
+  prod:
+    platform: linux/arm64
+`)
+	type pushMocks struct {
+		ws      *mocks.MockwsSvcReader
+		ecr     *mocks.MockecrService
+		builder *mocks.MockimageBuilder
+	}
+	testCases := map[string]struct {
+		inEnvName  string
+		inPlatform string
+		setupMocks func(m pushMocks)
+
+		wantedDigest string
+		wantedErr    error
+	}{
+		"skips the push if the repository already has the content of the image": {
+			inEnvName: "test",
+			setupMocks: func(m pushMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(2)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("sha256:abc", nil),
+					m.ecr.EXPECT().ListImages("phonetool/serviceA").Return([]ecr.Image{{Digest: "sha256:abc"}}, nil),
+				)
+				m.builder.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:abc",
+		},
+		"pushes the image if its content is new": {
+			inEnvName: "test",
+			setupMocks: func(m pushMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(2)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("", nil),
+					m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "hunter2"}, nil),
+					m.builder.EXPECT().Login("mockURI", "AWS", "hunter2").Return(nil),
+					m.builder.EXPECT().Push("mockURI", "v1").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("sha256:def", nil),
+				)
+			},
+			wantedDigest: "sha256:def",
+		},
+		"builds and pushes the image for the platform of the environment": {
+			inEnvName: "prod",
+			setupMocks: func(m pushMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(3)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "hunter2"}, nil),
+					m.builder.EXPECT().Login("mockURI", "AWS", "hunter2").Return(nil),
+					m.builder.EXPECT().BuildPlatforms([]string{"mockURI"}, "v1", "serviceA/Dockerfile", []string{"linux/arm64"}).Return(nil),
+					m.builder.EXPECT().IndexDigest("mockURI", "v1").Return("sha256:123", nil),
+				)
+				m.builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:123",
+		},
+		"builds the image for the platform of the flag over the manifest": {
+			inEnvName:  "prod",
+			inPlatform: "linux/amd64",
+			setupMocks: func(m pushMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(2)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "hunter2"}, nil),
+					m.builder.EXPECT().Login("mockURI", "AWS", "hunter2").Return(nil),
+					m.builder.EXPECT().BuildPlatforms([]string{"mockURI"}, "v1", "serviceA/Dockerfile", []string{"linux/amd64"}).Return(mockError),
+				)
+			},
+			wantedErr: errors.New("build Dockerfile at serviceA/Dockerfile with tag v1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pushMocks{
+				ws:      mocks.NewMockwsSvcReader(ctrl),
+				ecr:     mocks.NewMockecrService(ctrl),
+				builder: mocks.NewMockimageBuilder(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+					ImageTag:   "v1",
+					Platform:   tc.inPlatform,
+				},
+				ws:                m.ws,
+				ecr:               m.ecr,
+				builder:           m.builder,
+				targetEnvironment: &config.Environment{Name: tc.inEnvName},
+			}
+
+			// WHEN
+			err := opts.pushToECRRepo()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, opts.imageDigest)
+		})
+	}
+}
+
+func TestSvcDeployOpts_imageForReview(t *testing.T) {
+	mockManifest := []byte(`name: serviceA
+type: 'Backend Service'
+image:
+  build: serviceA/Dockerfile
+`)
+	type reviewMocks struct {
+		ws      *mocks.MockwsSvcReader
+		ecr     *mocks.MockecrService
+		builder *mocks.MockimageBuilder
+		appCFN  *mocks.MockappResourcesGetter
+	}
+	testCases := map[string]struct {
+		inDryRun   bool
+		setupMocks func(m reviewMocks)
+
+		wantedDigest string
+	}{
+		"reviewed changes refer to a new image by the digest it's pushed with": {
+			setupMocks: func(m reviewMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(2)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("", nil),
+					m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "hunter2"}, nil),
+					m.builder.EXPECT().Login("mockURI", "AWS", "hunter2").Return(nil),
+					m.builder.EXPECT().Push("mockURI", "v1").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("sha256:def", nil),
+				)
+			},
+			wantedDigest: "sha256:def",
+		},
+		"dry runs don't push the image": {
+			inDryRun: true,
+			setupMocks: func(m reviewMocks) {
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil).Times(2)
+				gomock.InOrder(
+					m.ecr.EXPECT().GetRepository("phonetool/serviceA").Return("mockURI", nil),
+					m.builder.EXPECT().Build("mockURI", "v1", "serviceA/Dockerfile").Return(nil),
+					m.builder.EXPECT().RepoDigest("mockURI", "v1").Return("", nil),
+				)
+				m.builder.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := reviewMocks{
+				ws:      mocks.NewMockwsSvcReader(ctrl),
+				ecr:     mocks.NewMockecrService(ctrl),
+				builder: mocks.NewMockimageBuilder(ctrl),
+				appCFN:  mocks.NewMockappResourcesGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+				RepositoryURLs: map[string]string{"serviceA": "mockURI"},
+			}, nil)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+					ImageTag:   "v1",
+					changeSetReviewVars: changeSetReviewVars{
+						dryRun: tc.inDryRun,
+					},
+				},
+				ws:                m.ws,
+				ecr:               m.ecr,
+				builder:           m.builder,
+				appCFN:            m.appCFN,
+				targetApp:         &config.Application{Name: "phonetool"},
+				targetEnvironment: &config.Environment{Name: "test", Region: "us-west-2"},
+			}
+
+			// WHEN
+			err := opts.imageForReview()
+			require.NoError(t, err)
+			rc, err := opts.runtimeConfig("")
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, rc.ImageDigest)
+		})
+	}
+}
+
+type mockStackConfig struct {
+	template   string
+	parameters map[string]string
+	tags       map[string]string
+}
+
+func (m *mockStackConfig) StackName() string {
+	return "phonetool-test-serviceA"
+}
+
+func (m *mockStackConfig) Template() (string, error) {
+	return m.template, nil
+}
+
+func (m *mockStackConfig) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	var params []*sdkcloudformation.Parameter
+	for k, v := range m.parameters {
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(v),
+		})
+	}
+	return params, nil
+}
+
+func (m *mockStackConfig) Tags() []*sdkcloudformation.Tag {
+	var tags []*sdkcloudformation.Tag
+	for k, v := range m.tags {
+		tags = append(tags, &sdkcloudformation.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	return tags
+}
+
+func TestSvcDeployOpts_deployment(t *testing.T) {
+	mockError := errors.New("some error")
+	type deploymentMocks struct {
+		ecr      *mocks.MockecrService
+		ws       *mocks.MockwsSvcReader
+		appCFN   *mocks.MockappResourcesGetter
+		s3       *mocks.MockartifactUploader
+		identity *mocks.MockidentityService
+	}
+	testCases := map[string]struct {
+		imageDigest string
+		setupMocks  func(m deploymentMocks)
+
+		wantedDeployment *config.Deployment
+		wantedErr        error
+	}{
+		"errors if the image digest can't be retrieved": {
+			setupMocks: func(m deploymentMocks) {
+				m.ecr.EXPECT().ImageDigest("phonetool/serviceA", "v1").Return("", mockError)
+			},
+			wantedErr: errors.New("get digest of image v1: some error"),
+		},
+		"errors if the template can't be uploaded": {
+			imageDigest: "sha256:abc",
+			setupMocks: func(m deploymentMocks) {
+				m.ecr.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Times(0)
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return([]byte("name: serviceA"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.s3.EXPECT().PutArtifact("mockBucket", "serviceA-test.stack.yml", gomock.Any()).Return("", mockError)
+			},
+			wantedErr: errors.New("put stack template to bucket mockBucket: some error"),
+		},
+		"records the image, manifest and stack of the deployment": {
+			setupMocks: func(m deploymentMocks) {
+				m.ecr.EXPECT().ImageDigest("phonetool/serviceA", "v1").Return("sha256:abc", nil)
+				m.ws.EXPECT().ReadServiceManifest("serviceA").Return([]byte("name: serviceA"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.s3.EXPECT().PutArtifact("mockBucket", "serviceA-test.stack.yml", gomock.Any()).Return("https://mockBucket/serviceA-test.stack.yml", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1234567890:root", ARN: "arn:aws:iam::1234567890:user/alice"}, nil)
+			},
+			wantedDeployment: &config.Deployment{
+				App:          "phonetool",
+				Env:          "test",
+				Service:      "serviceA",
+				ImageTag:     "v1",
+				ImageDigest:  "sha256:abc",
+				ManifestHash: "2399912f68c07c4450d6e45aec67b3b407cc105c534c85ad878c07eedda81ba0",
+				TemplateURL:  "https://mockBucket/serviceA-test.stack.yml",
+				Parameters: map[string]string{
+					"ContainerImage": "mockURI:v1",
+				},
+				Tags: map[string]string{
+					"copilot-application": "phonetool",
+				},
+				DeployedBy: "arn:aws:iam::1234567890:user/alice",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deploymentMocks{
+				ecr:      mocks.NewMockecrService(ctrl),
+				ws:       mocks.NewMockwsSvcReader(ctrl),
+				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
+				s3:       mocks.NewMockartifactUploader(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+					ImageTag:   "v1",
+				},
+				ecr:               m.ecr,
+				ws:                m.ws,
+				appCFN:            m.appCFN,
+				s3:                m.s3,
+				identity:          m.identity,
+				targetApp:         &config.Application{Name: "phonetool"},
+				targetEnvironment: &config.Environment{Name: "test", Region: "us-west-2"},
+				imageDigest:       tc.imageDigest,
+			}
+			conf := &mockStackConfig{
+				template: "Resources: {}",
+				parameters: map[string]string{
+					"ContainerImage": "mockURI:v1",
+				},
+				tags: map[string]string{
+					"copilot-application": "phonetool",
+				},
+			}
+
+			// WHEN
+			d, err := opts.deployment(conf)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, d.DeployedAt.IsZero())
+			d.DeployedAt = time.Time{}
+			require.Equal(t, tc.wantedDeployment, d)
+		})
+	}
+}
+
+func TestSvcDeployOpts_pinDeployedVersion(t *testing.T) {
+	blueGreen := &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+	}
+	canary := &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.CanaryDeploymentStrategy),
+	}
+	testCases := map[string]struct {
+		deployment *manifest.DeploymentConfig
+		setupMocks func(m *mocks.MocksvcStackOutputsGetter)
+
+		wantedTaskDef     string
+		wantedStableImage string
+		wantedBlueGreen   *manifest.DeploymentConfig
+		wantedCanary      *manifest.DeploymentConfig
+		wantedErr         error
+	}{
+		"does nothing if the service is deployed with rolling updates": {
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs(gomock.Any()).Times(0)
+			},
+		},
+		"does not pin the task definition of a new service": {
+			deployment: blueGreen,
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedBlueGreen: blueGreen,
+		},
+		"errors if the outputs of the stack can't be retrieved": {
+			deployment: blueGreen,
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of stack phonetool-test-serviceA: some error"),
+		},
+		"pins the task definition that the service was created with": {
+			deployment: blueGreen,
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(map[string]string{
+					stack.LBWebServiceTaskDefinitionOutputKey:       "phonetool-test-serviceA:3",
+					stack.LBWebServicePinnedTaskDefinitionOutputKey: "phonetool-test-serviceA:1",
+				}, nil)
+			},
+			wantedTaskDef:   "phonetool-test-serviceA:1",
+			wantedBlueGreen: blueGreen,
+		},
+		"does not pin the stable image of a new service": {
+			deployment: canary,
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedCanary: canary,
+		},
+		"pins the image of the stable tasks of a service deployed with canary releases": {
+			deployment: canary,
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(map[string]string{
+					stack.LBWebServicePinnedTaskDefinitionOutputKey: "phonetool-test-serviceA:1",
+					stack.LBWebServiceStableContainerImageOutputKey: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea:v1",
+				}, nil)
+			},
+			wantedStableImage: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea:v1",
+			wantedCanary:      canary,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocksvcStackOutputsGetter(ctrl)
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+				},
+				svcOutputs:        m,
+				targetEnvironment: &config.Environment{Name: "test"},
+			}
+			mft := &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					Deployment: tc.deployment,
+				},
+			}
+			rc := &stack.RuntimeConfig{}
+
+			// WHEN
+			err := opts.pinDeployedVersion(mft, rc)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskDef, rc.DeployedTaskDefinition)
+			require.Equal(t, tc.wantedStableImage, rc.StableImage)
+			require.Equal(t, tc.wantedBlueGreen, opts.blueGreen)
+			require.Equal(t, tc.wantedCanary, opts.canary)
+		})
+	}
+}
+
+func TestSvcDeployOpts_validateTestListenerPort(t *testing.T) {
+	blueGreen := &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+		TestPort: aws.Uint16(10080),
+	}
+	svcs := []*config.Service{
+		{Name: "serviceA", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "serviceB", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "serviceC", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "worker", Type: manifest.BackendServiceType},
+	}
+	testCases := map[string]struct {
+		deployment *manifest.DeploymentConfig
+		setupMocks func(store *mocks.Mockstore, outputs *mocks.MocksvcStackOutputsGetter)
+
+		wantedErr error
+	}{
+		"does nothing if the service is deployed with rolling updates": {
+			setupMocks: func(store *mocks.Mockstore, outputs *mocks.MocksvcStackOutputsGetter) {
+				store.EXPECT().ListServices(gomock.Any()).Times(0)
+			},
+		},
+		"passes if no other service of the environment uses the test port": {
+			deployment: blueGreen,
+			setupMocks: func(store *mocks.Mockstore, outputs *mocks.MocksvcStackOutputsGetter) {
+				store.EXPECT().ListServices("phonetool").Return(svcs, nil)
+				outputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceB").Return(nil, &awscloudformation.ErrStackNotFound{})
+				outputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceC").Return(map[string]string{
+					stack.LBWebServiceTestListenerPortOutputKey: "10081",
+				}, nil)
+			},
+		},
+		"errors if another service of the environment uses the test port": {
+			deployment: blueGreen,
+			setupMocks: func(store *mocks.Mockstore, outputs *mocks.MocksvcStackOutputsGetter) {
+				store.EXPECT().ListServices("phonetool").Return(svcs, nil)
+				outputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceB").Return(map[string]string{
+					stack.LBWebServiceTestListenerPortOutputKey: "10080",
+				}, nil)
+			},
+			wantedErr: fmt.Errorf("test port 10080 is already used by service serviceB in environment test, set a different %s in the manifest",
+				color.HighlightCode("deployment.testPort")),
+		},
+		"errors if the outputs of another service can't be retrieved": {
+			deployment: blueGreen,
+			setupMocks: func(store *mocks.Mockstore, outputs *mocks.MocksvcStackOutputsGetter) {
+				store.EXPECT().ListServices("phonetool").Return(svcs, nil)
+				outputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceB").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of stack phonetool-test-serviceB: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			outputs := mocks.NewMocksvcStackOutputsGetter(ctrl)
+			tc.setupMocks(store, outputs)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+				},
+				store:             store,
+				svcOutputs:        outputs,
+				targetEnvironment: &config.Environment{Name: "test"},
+				blueGreen:         tc.deployment,
+			}
+
+			// WHEN
+			err := opts.validateTestListenerPort()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_shiftTraffic(t *testing.T) {
+	const (
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-serviceA-Service"
+		latestTaskDef  = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-serviceA:2"
+		runningTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-serviceA:1"
+	)
+	mockOutputs := map[string]string{
+		stack.LBWebServiceTaskDefinitionOutputKey:            latestTaskDef,
+		stack.LBWebServiceServiceArnOutputKey:                mockServiceARN,
+		stack.LBWebServiceCodeDeployApplicationOutputKey:     "phonetool-test-serviceA-app",
+		stack.LBWebServiceCodeDeployDeploymentGroupOutputKey: "phonetool-test-serviceA-group",
+	}
+	type shiftTrafficMocks struct {
+		svcOutputs   *mocks.MocksvcStackOutputsGetter
+		ecsDescriber *mocks.MockecsServiceDescriber
+		codeDeploy   *mocks.MockcodeDeployDeployer
+		spinner      *mocks.Mockprogress
+	}
+	testCases := map[string]struct {
+		blueGreen  *manifest.DeploymentConfig
+		setupMocks func(m shiftTrafficMocks)
+
+		wantedErr error
+	}{
+		"does nothing if the service is deployed with rolling updates": {
+			setupMocks: func(m shiftTrafficMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs(gomock.Any()).Times(0)
+			},
+		},
+		"does nothing if the tasks run the latest task definition": {
+			blueGreen: &manifest.DeploymentConfig{},
+			setupMocks: func(m shiftTrafficMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(mockOutputs, nil)
+				m.ecsDescriber.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-serviceA-Service").Return(&ecs.Service{
+					TaskDefinition: aws.String(latestTaskDef),
+				}, nil)
+				m.codeDeploy.EXPECT().CreateECSDeployment(gomock.Any()).Times(0)
+			},
+		},
+		"errors if the deployment can't be created": {
+			blueGreen: &manifest.DeploymentConfig{},
+			setupMocks: func(m shiftTrafficMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(mockOutputs, nil)
+				m.ecsDescriber.EXPECT().Service(gomock.Any(), gomock.Any()).Return(&ecs.Service{
+					TaskDefinition: aws.String(runningTaskDef),
+				}, nil)
+				m.codeDeploy.EXPECT().CreateECSDeployment(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("start blue/green deployment of service serviceA: some error"),
+		},
+		"shifts the traffic to the tasks of the latest task definition": {
+			blueGreen: &manifest.DeploymentConfig{
+				Hooks: map[string]string{
+					"BeforeAllowTraffic": "validate",
+				},
+			},
+			setupMocks: func(m shiftTrafficMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(mockOutputs, nil)
+				m.ecsDescriber.EXPECT().Service(gomock.Any(), gomock.Any()).Return(&ecs.Service{
+					TaskDefinition: aws.String(runningTaskDef),
+				}, nil)
+				m.codeDeploy.EXPECT().CreateECSDeployment(&codedeploy.ECSDeploymentInput{
+					Application:     "phonetool-test-serviceA-app",
+					DeploymentGroup: "phonetool-test-serviceA-group",
+					TaskDefinition:  latestTaskDef,
+					ContainerName:   "serviceA",
+					ContainerPort:   80,
+					Hooks: map[string]string{
+						"BeforeAllowTraffic": "validate",
+					},
+				}).Return("d-1234", nil)
+				gomock.InOrder(
+					m.spinner.EXPECT().Start("Shifting the traffic of serviceA in test to the new tasks with deployment d-1234."),
+					m.codeDeploy.EXPECT().Deployment("d-1234").Return(&codedeploy.Deployment{ID: "d-1234", Status: "InProgress"}, nil),
+					m.spinner.EXPECT().Events(gomock.Any()),
+					m.codeDeploy.EXPECT().Deployment("d-1234").Return(&codedeploy.Deployment{ID: "d-1234", Status: "Succeeded"}, nil),
+					m.spinner.EXPECT().Stop(log.Ssuccessf(fmtShiftTrafficComplete, "serviceA", "test")),
+				)
+			},
+		},
+		"errors if the traffic was rolled back": {
+			blueGreen: &manifest.DeploymentConfig{},
+			setupMocks: func(m shiftTrafficMocks) {
+				m.svcOutputs.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(mockOutputs, nil)
+				m.ecsDescriber.EXPECT().Service(gomock.Any(), gomock.Any()).Return(&ecs.Service{
+					TaskDefinition: aws.String(runningTaskDef),
+				}, nil)
+				m.codeDeploy.EXPECT().CreateECSDeployment(gomock.Any()).Return("d-1234", nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.codeDeploy.EXPECT().Deployment("d-1234").Return(&codedeploy.Deployment{
+					ID:                   "d-1234",
+					Status:               "Failed",
+					ErrorMessage:         "The BeforeAllowTraffic hook failed",
+					RollbackDeploymentID: "d-5678",
+				}, nil)
+				m.spinner.EXPECT().Stop(log.Serrorf(fmtShiftTrafficFailed, "serviceA", "test"))
+			},
+			wantedErr: errors.New("blue/green deployment d-1234 of service serviceA is failed and the traffic was rolled back to the previous tasks: The BeforeAllowTraffic hook failed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := shiftTrafficMocks{
+				svcOutputs:   mocks.NewMocksvcStackOutputsGetter(ctrl),
+				ecsDescriber: mocks.NewMockecsServiceDescriber(ctrl),
+				codeDeploy:   mocks.NewMockcodeDeployDeployer(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			defer func(interval time.Duration) { codeDeployPollInterval = interval }(codeDeployPollInterval)
+			codeDeployPollInterval = 0
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+				},
+				svcOutputs:        m.svcOutputs,
+				ecsDescriber:      m.ecsDescriber,
+				codeDeploy:        m.codeDeploy,
+				spinner:           m.spinner,
+				targetEnvironment: &config.Environment{Name: "test"},
+				blueGreen:         tc.blueGreen,
+			}
+			conf := &mockStackConfig{
+				parameters: map[string]string{
+					stack.LBWebServiceTargetContainerParamKey: "serviceA",
+					stack.LBWebServiceTargetPortParamKey:      "80",
+				},
+			}
+
+			// WHEN
+			err := opts.shiftTraffic(conf)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_releaseCanary(t *testing.T) {
+	const canaryTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-serviceA-canary:2"
+	canary := &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.CanaryDeploymentStrategy),
+	}
+	testCases := map[string]struct {
+		canary       *manifest.DeploymentConfig
+		inParameters map[string]string
+		setupMocks   func(m *mocks.MocksvcStackOutputsGetter)
+
+		wantedTaskDef string
+		wantedWeight  string
+		wantedErr     error
+	}{
+		"does nothing if the service is not deployed with canary releases": {
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs(gomock.Any()).Times(0)
+			},
+		},
+		"does nothing if the deployment did not release a canary": {
+			canary: canary,
+			inParameters: map[string]string{
+				stack.LBWebServiceCanaryContainerImageParamKey: "",
+				stack.LBWebServiceCanaryWeightParamKey:         "0",
+			},
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs(gomock.Any()).Times(0)
+			},
+		},
+		"errors if the outputs of the stack can't be retrieved": {
+			canary: canary,
+			inParameters: map[string]string{
+				stack.LBWebServiceCanaryContainerImageParamKey: "phonetool/servicea:v2",
+				stack.LBWebServiceCanaryWeightParamKey:         "10",
+			},
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of stack phonetool-test-serviceA: some error"),
+		},
+		"keeps the task definition and the weight of the released canary": {
+			canary: canary,
+			inParameters: map[string]string{
+				stack.LBWebServiceCanaryContainerImageParamKey: "phonetool/servicea:v2",
+				stack.LBWebServiceCanaryWeightParamKey:         "10",
+			},
+			setupMocks: func(m *mocks.MocksvcStackOutputsGetter) {
+				m.EXPECT().ServiceStackOutputs("phonetool-test-serviceA").Return(map[string]string{
+					stack.LBWebServiceCanaryTaskDefinitionOutputKey: canaryTaskDef,
+				}, nil)
+			},
+			wantedTaskDef: canaryTaskDef,
+			wantedWeight:  "10",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocksvcStackOutputsGetter(ctrl)
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "serviceA",
+				},
+				svcOutputs:        m,
+				targetEnvironment: &config.Environment{Name: "test"},
+				canary:            tc.canary,
+			}
+
+			// WHEN
+			err := opts.releaseCanary(&mockStackConfig{parameters: tc.inParameters})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskDef, opts.canaryTaskDef)
+			require.Equal(t, tc.wantedWeight, opts.canaryWeight)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDriftAppNamePrompt     = "Which application is the service in?"
+	svcDriftAppNameHelpPrompt = "An application groups all of your services together."
+	svcDriftNamePrompt        = "Which service would you like to detect drift for?"
+	svcDriftNameHelpPrompt    = "Drift is detected on the stack of the service and on the stacks of its addons."
+	svcDriftEnvNamePrompt     = "Which environment is the service deployed in?"
+	svcDriftEnvNameHelpPrompt = "Drift is detected on the stack of the service in this environment."
+
+	fmtSvcDriftStart    = "Detecting drift of service %s in environment %s."
+	fmtSvcDriftFailed   = "Failed to detect drift of service %s in environment %s."
+	fmtSvcDriftComplete = "Detected drift of service %s in environment %s."
+)
+
+type svcDriftVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+}
+
+type svcDriftOpts struct {
+	svcDriftVars
+
+	w                io.Writer
+	store            store
+	sel              configSelector
+	spinner          progress
+	newDriftDetector func(env *config.Environment) (driftDetector, error)
+}
+
+func newSvcDriftOpts(vars svcDriftVars) (*svcDriftOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &svcDriftOpts{
+		svcDriftVars:     vars,
+		w:                log.OutputWriter,
+		store:            store,
+		sel:              selector.NewConfigSelect(vars.prompt, store),
+		spinner:          termprogress.NewSpinner(),
+		newDriftDetector: newDriftDetector,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcDriftOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcDriftOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcDriftAppNamePrompt, svcDriftAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.svcName == "" {
+		svc, err := o.sel.Service(svcDriftNamePrompt, svcDriftNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.svcName = svc
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcDriftEnvNamePrompt, svcDriftEnvNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute detects drift on the stack of the service and its nested stacks, and writes the resources that drifted.
+func (o *svcDriftOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf(fmtSvcDriftStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	drifts, err := detectStackDrifts(detector, stack.NameForService(o.AppName(), o.envName, o.svcName))
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcDriftFailed, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+		return fmt.Errorf("detect drift of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcDriftComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	return writeStackDrifts(o.w, drifts, o.shouldOutputJSON)
+}
+
+// stackDrift is the result of a drift detection on a stack deployed by Copilot or on one of its nested stacks.
+type stackDrift struct {
+	Name string `json:"name"`
+	*cloudformation.StackDrift
+}
+
+// newDriftDetector returns a client to detect drift on the stacks deployed in the environment.
+func newDriftDetector(env *config.Environment) (driftDetector, error) {
+	sess, err := session.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return cloudformation.New(sess), nil
+}
+
+// detectStackDrifts detects drift on the stack and on each of its nested stacks, such as the stack of addons.
+func detectStackDrifts(detector driftDetector, stackName string) ([]stackDrift, error) {
+	drift, err := detector.DetectDrift(stackName)
+	if err != nil {
+		return nil, err
+	}
+	drifts := []stackDrift{
+		{
+			Name:       nestedStackName(stackName),
+			StackDrift: drift,
+		},
+	}
+	nestedStacks, err := detector.NestedStacks(stackName)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range nestedStacks {
+		nested, err := detectStackDrifts(detector, id)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, nested...)
+	}
+	return drifts, nil
+}
+
+// nestedStackName returns the name of a stack from its ID, which is an ARN for nested stacks.
+// For example, "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1ABC/6d2a" returns "phonetool-test-api-AddonsStack-1ABC".
+func nestedStackName(id string) string {
+	if !strings.HasPrefix(id, "arn:") {
+		return id
+	}
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 {
+		return id
+	}
+	return parts[1]
+}
+
+// writeStackDrifts writes the drift status of each stack followed by the property differences of the resources that drifted.
+func writeStackDrifts(w io.Writer, drifts []stackDrift, shouldOutputJSON bool) error {
+	if shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Stacks []stackDrift `json:"stacks"`
+		}{Stacks: drifts})
+		if err != nil {
+			return fmt.Errorf("marshal stack drifts: %w", err)
+		}
+		fmt.Fprintf(w, "%s\n", data)
+		return nil
+	}
+	for _, drift := range drifts {
+		fmt.Fprintf(w, "Stack %s: %s\n", drift.Name, drift.Status)
+		var drifted []cloudformation.ResourceDrift
+		for _, resource := range drift.Resources {
+			if resource.Drifted() {
+				drifted = append(drifted, resource)
+			}
+		}
+		if len(drifted) == 0 {
+			fmt.Fprintln(w)
+			continue
+		}
+		writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", "Resource", "Type", "Status", "Property", "Expected", "Actual")
+		for _, resource := range drifted {
+			if len(resource.Differences) == 0 {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", resource.LogicalID, resource.Type, resource.Status, emptyCell, emptyCell, emptyCell)
+				continue
+			}
+			for i, diff := range resource.Differences {
+				logicalID, resourceType, status := resource.LogicalID, resource.Type, resource.Status
+				if i > 0 {
+					logicalID, resourceType, status = "", "", ""
+				}
+				fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", logicalID, resourceType, status, diff.Path,
+					driftValue(diff.Expected), driftValue(diff.Actual))
+			}
+		}
+		writer.Flush()
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// driftValue returns the value of a property, or an empty cell if the property is not set.
+func driftValue(value string) string {
+	if value == "" {
+		return emptyCell
+	}
+	return value
+}
+
+// BuildSvcDriftCmd builds the command for detecting drift on the stacks of a service.
+func BuildSvcDriftCmd() *cobra.Command {
+	vars := svcDriftVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed service outside of Copilot.",
+		Long: `Detects changes made to a deployed service outside of Copilot.
+Runs CloudFormation drift detection on the stack of the service and of its addons,
+and shows the expected and actual values of each property that drifted.`,
+
+		Example: `
+  Shows the resources of the "frontend" service that drifted in the "prod" environment.
+  /code $ copilot svc drift -n frontend -e prod
+  Outputs the drift of the service in JSON, for example from a scheduled job.
+  /code $ copilot svc drift -n frontend -e prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
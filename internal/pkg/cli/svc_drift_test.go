@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockAddonsStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend-AddonsStack-1ABC/6d2a"
+
+var (
+	mockServiceDrift = &cloudformation.StackDrift{
+		Status: "DRIFTED",
+		Resources: []cloudformation.ResourceDrift{
+			{
+				LogicalID:  "Service",
+				PhysicalID: "frontend",
+				Type:       "AWS::ECS::Service",
+				Status:     "MODIFIED",
+				Differences: []cloudformation.PropertyDifference{
+					{
+						Path:     "/DesiredCount",
+						Type:     "NOT_EQUAL",
+						Expected: "1",
+						Actual:   "3",
+					},
+					{
+						Path:   "/Tags/1",
+						Type:   "ADD",
+						Actual: "{\"Key\":\"team\",\"Value\":\"web\"}",
+					},
+				},
+			},
+			{
+				LogicalID:  "LogGroup",
+				PhysicalID: "/copilot/phonetool-test-frontend",
+				Type:       "AWS::Logs::LogGroup",
+				Status:     "IN_SYNC",
+			},
+		},
+	}
+	mockAddonsDrift = &cloudformation.StackDrift{
+		Status: "IN_SYNC",
+		Resources: []cloudformation.ResourceDrift{
+			{
+				LogicalID:  "MyTable",
+				PhysicalID: "phonetool-test-frontend-MyTable",
+				Type:       "AWS::DynamoDB::Table",
+				Status:     "IN_SYNC",
+			},
+		},
+	}
+)
+
+func TestSvcDriftOpts_Execute(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockdriftDetector)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps error if drift can't be detected": {
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test-frontend").Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("detect drift of service frontend in environment test: %w", mockErr),
+		},
+		"wraps error if drift can't be detected on a nested stack": {
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test-frontend").Return(mockServiceDrift, nil)
+				m.EXPECT().NestedStacks("phonetool-test-frontend").Return([]string{mockAddonsStackID}, nil)
+				m.EXPECT().DetectDrift(mockAddonsStackID).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("detect drift of service frontend in environment test: %w", mockErr),
+		},
+		"writes the property differences of the resources that drifted": {
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test-frontend").Return(mockServiceDrift, nil)
+				m.EXPECT().NestedStacks("phonetool-test-frontend").Return([]string{mockAddonsStackID}, nil)
+				m.EXPECT().DetectDrift(mockAddonsStackID).Return(mockAddonsDrift, nil)
+				m.EXPECT().NestedStacks(mockAddonsStackID).Return(nil, nil)
+			},
+			wantedContent: `Stack phonetool-test-frontend: DRIFTED
+  Resource          Type                Status              Property            Expected            Actual
+  Service           AWS::ECS::Service   MODIFIED            /DesiredCount       1                   3
+                                                            /Tags/1             -                   {"Key":"team","Value":"web"}
+
+Stack phonetool-test-frontend-AddonsStack-1ABC: IN_SYNC
+
+`,
+		},
+		"writes the drift of every stack in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockdriftDetector) {
+				m.EXPECT().DetectDrift("phonetool-test-frontend").Return(mockServiceDrift, nil)
+				m.EXPECT().NestedStacks("phonetool-test-frontend").Return([]string{mockAddonsStackID}, nil)
+				m.EXPECT().DetectDrift(mockAddonsStackID).Return(mockAddonsDrift, nil)
+				m.EXPECT().NestedStacks(mockAddonsStackID).Return(nil, nil)
+			},
+			wantedContent: `{"stacks":[{"name":"phonetool-test-frontend","status":"DRIFTED","resources":[{"logicalID":"Service","physicalID":"frontend","type":"AWS::ECS::Service","status":"MODIFIED","differences":[{"path":"/DesiredCount","type":"NOT_EQUAL","expected":"1","actual":"3"},{"path":"/Tags/1","type":"ADD","expected":"","actual":"{\"Key\":\"team\",\"Value\":\"web\"}"}]},{"logicalID":"LogGroup","physicalID":"/copilot/phonetool-test-frontend","type":"AWS::Logs::LogGroup","status":"IN_SYNC"}]},{"name":"phonetool-test-frontend-AddonsStack-1ABC","status":"IN_SYNC","resources":[{"logicalID":"MyTable","physicalID":"phonetool-test-frontend-MyTable","type":"AWS::DynamoDB::Table","status":"IN_SYNC"}]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+				Name:           "test",
+				Region:         "us-west-2",
+				ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+			}, nil)
+			mockDetector := mocks.NewMockdriftDetector(ctrl)
+			tc.setupMocks(mockDetector)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any())
+			mockSpinner.EXPECT().Stop(gomock.Any())
+			b := &bytes.Buffer{}
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					shouldOutputJSON: tc.shouldOutputJSON,
+					svcName:          "frontend",
+					envName:          "test",
+				},
+				w:       b,
+				store:   mockStore,
+				spinner: mockSpinner,
+				newDriftDetector: func(env *config.Environment) (driftDetector, error) {
+					require.Equal(t, "us-west-2", env.Region)
+					return mockDetector, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcHistoryAppNamePrompt     = "Which application is the service in?"
+	svcHistoryAppNameHelpPrompt = "An application groups all of your services together."
+	svcHistoryNamePrompt        = "Which service's deployment history would you like to show?"
+	svcHistoryNameHelpPrompt    = "Lists the revisions of the service that were deployed with svc deploy or svc rollback."
+	svcHistoryEnvNamePrompt     = "Which environment is the service deployed in?"
+	svcHistoryEnvNameHelpPrompt = "Each environment has its own deployment history."
+
+	shortDigestLength = 19 // Length of "sha256:" followed by the first 12 characters of the hash.
+)
+
+type svcHistoryVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+}
+
+type svcHistoryOpts struct {
+	svcHistoryVars
+
+	w           io.Writer
+	store       store
+	deployments deploymentLister
+	sel         configSelector
+}
+
+func newSvcHistoryOpts(vars svcHistoryVars) (*svcHistoryOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &svcHistoryOpts{
+		svcHistoryVars: vars,
+		w:              log.OutputWriter,
+		store:          store,
+		deployments:    store,
+		sel:            selector.NewConfigSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcHistoryOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcHistoryOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcHistoryAppNamePrompt, svcHistoryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.svcName == "" {
+		svc, err := o.sel.Service(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.svcName = svc
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcHistoryEnvNamePrompt, svcHistoryEnvNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute lists the deployments of the service in the environment.
+func (o *svcHistoryOpts) Execute() error {
+	deployments, err := o.deployments.ListDeployments(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Deployments []*config.Deployment `json:"deployments"`
+		}{Deployments: deployments})
+		if err != nil {
+			return fmt.Errorf("marshal deployments: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(deployments) == 0 {
+		log.Infof("No deployments recorded for service %s in environment %s.\n",
+			color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	writeDeploymentHistory(o.w, deployments)
+	return nil
+}
+
+// writeDeploymentHistory writes a table of the deployments, from the oldest to the latest revision.
+func writeDeploymentHistory(w io.Writer, deployments []*config.Deployment) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", "Revision", "Image tag", "Image digest", "Deployed by", "Deployed at", "Description")
+	for _, d := range deployments {
+		description := emptyCell
+		if d.RollbackOf != 0 {
+			description = fmt.Sprintf("Rollback to revision %d", d.RollbackOf)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", strconv.Itoa(d.Revision), d.ImageTag, shortDigest(d.ImageDigest),
+			d.DeployedBy, d.DeployedAt.Format(time.RFC3339), description)
+	}
+	writer.Flush()
+}
+
+// shortDigest truncates an image digest to the length used by the docker CLI.
+func shortDigest(digest string) string {
+	if digest == "" {
+		return emptyCell
+	}
+	if !strings.HasPrefix(digest, "sha256:") || len(digest) <= shortDigestLength {
+		return digest
+	}
+	return digest[:shortDigestLength]
+}
+
+// BuildSvcHistoryCmd builds the command for listing the deployments of a service.
+func BuildSvcHistoryCmd() *cobra.Command {
+	vars := svcHistoryVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the deployments of a service in an environment.",
+		Long: `Lists the deployments of a service in an environment.
+Each deployment is a revision that can be redeployed with svc rollback.`,
+
+		Example: `
+  Lists the deployments of the "frontend" service in the "prod" environment.
+  /code $ copilot svc history -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
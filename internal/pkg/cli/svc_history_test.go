@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcHistoryOpts_Execute(t *testing.T) {
+	deployedAt := time.Date(2020, time.July, 1, 12, 0, 0, 0, time.UTC)
+	deployments := []*config.Deployment{
+		{
+			Revision:    1,
+			ImageTag:    "v1",
+			ImageDigest: "sha256:0123456789abcdef0123456789abcdef",
+			DeployedBy:  "arn:aws:iam::1234567890:user/alice",
+			DeployedAt:  deployedAt,
+		},
+		{
+			Revision:    2,
+			ImageTag:    "v1",
+			ImageDigest: "sha256:0123456789abcdef0123456789abcdef",
+			DeployedBy:  "arn:aws:iam::1234567890:user/bob",
+			DeployedAt:  deployedAt.Add(time.Hour),
+			RollbackOf:  1,
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockdeploymentLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"errors if the deployments can't be listed": {
+			setupMocks: func(m *mocks.MockdeploymentLister) {
+				m.EXPECT().ListDeployments("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"writes nothing if there are no deployments": {
+			setupMocks: func(m *mocks.MockdeploymentLister) {
+				m.EXPECT().ListDeployments("phonetool", "test", "api").Return(nil, nil)
+			},
+		},
+		"writes a table of the deployments": {
+			setupMocks: func(m *mocks.MockdeploymentLister) {
+				m.EXPECT().ListDeployments("phonetool", "test", "api").Return(deployments, nil)
+			},
+			wantedContent: `Revision            Image tag           Image digest         Deployed by                         Deployed at           Description
+1                   v1                  sha256:0123456789ab  arn:aws:iam::1234567890:user/alice  2020-07-01T12:00:00Z  -
+2                   v1                  sha256:0123456789ab  arn:aws:iam::1234567890:user/bob    2020-07-01T13:00:00Z  Rollback to revision 1
+`,
+		},
+		"writes the deployments in JSON": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockdeploymentLister) {
+				m.EXPECT().ListDeployments("phonetool", "test", "api").Return(deployments[:1], nil)
+			},
+			wantedContent: `{"deployments":[{"app":"","env":"","service":"","revision":1,"imageTag":"v1","imageDigest":"sha256:0123456789abcdef0123456789abcdef","manifestHash":"","templateURL":"","parameters":null,"tags":null,"deployedBy":"arn:aws:iam::1234567890:user/alice","deployedAt":"2020-07-01T12:00:00Z"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentLister(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					GlobalOpts:       &GlobalOpts{appName: "phonetool"},
+					shouldOutputJSON: tc.shouldOutputJSON,
+					svcName:          "api",
+					envName:          "test",
+				},
+				w:           b,
+				deployments: m,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -4,6 +4,7 @@ package cli
 
 import (
 	"encoding"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -59,6 +60,8 @@ type initSvcVars struct {
 	Name           string
 	DockerfilePath string
 	Port           uint16
+	Force          bool // Regenerate the manifest if it already exists.
+	metadataVars
 }
 
 type initSvcOpts struct {
@@ -80,12 +83,12 @@ type initSvcOpts struct {
 }
 
 func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("couldn't connect to config store: %w", err)
 	}
 
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("workspace cannot be created: %w", err)
 	}
@@ -177,11 +180,18 @@ func (o *initSvcOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtAddSvcToAppComplete, o.Name))
 
-	if err := o.store.CreateService(&config.Service{
-		App:  o.AppName(),
-		Name: o.Name,
-		Type: o.ServiceType,
-	}); err != nil {
+	err = o.store.CreateService(&config.Service{
+		App:      o.AppName(),
+		Name:     o.Name,
+		Type:     o.ServiceType,
+		Metadata: o.metadata(),
+	})
+	var errExists *config.ErrServiceAlreadyExists
+	if errors.As(err, &errExists) {
+		// Re-running svc init on an existing service only writes its manifest, the stored configuration is kept.
+		return nil
+	}
+	if err != nil {
 		return fmt.Errorf("saving service %s: %w", o.Name, err)
 	}
 	return nil
@@ -192,6 +202,22 @@ func (o *initSvcOpts) createManifest() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	var manifestPath string
+	if o.Force {
+		manifestPath, err = o.updateManifest(manifest)
+	} else {
+		manifestPath, err = o.writeManifest(manifest)
+	}
+	if err != nil {
+		return "", err
+	}
+	log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.Port)))
+	log.Infoln()
+
+	return manifestPath, nil
+}
+
+func (o *initSvcOpts) writeManifest(manifest encoding.BinaryMarshaler) (string, error) {
 	var manifestExists bool
 	manifestPath, err := o.ws.WriteServiceManifest(manifest, o.Name)
 	if err != nil {
@@ -212,12 +238,17 @@ func (o *initSvcOpts) createManifest() (string, error) {
 		manifestMsgFmt = "Manifest file for service %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.Name), color.HighlightResource(manifestPath))
-	log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.Port)))
-	log.Infoln()
-
 	return manifestPath, nil
 }
 
+func (o *initSvcOpts) updateManifest(manifest encoding.BinaryMarshaler) (string, error) {
+	update, err := o.ws.UpdateServiceManifest(manifest, o.Name)
+	if err != nil {
+		return "", fmt.Errorf("update manifest for service %s: %w", o.Name, err)
+	}
+	return applyFileUpdate(o.ws, update, fmt.Sprintf("manifest for service %s", color.HighlightUserInput(o.Name)))
+}
+
 func (o *initSvcOpts) newManifest() (encoding.BinaryMarshaler, error) {
 	switch o.ServiceType {
 	case manifest.LoadBalancedWebServiceType:
@@ -394,6 +425,14 @@ func (o *initSvcOpts) parseHealthCheck() (*manifest.ContainerHealthCheck, error)
 	}, nil
 }
 
+// auditedTarget returns the service created by the command.
+func (o *initSvcOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		svc: o.Name,
+	}
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initSvcOpts) RecommendedActions() []string {
 	return []string{
@@ -419,7 +458,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Regenerate the manifest of the "frontend" service after upgrading copilot, keeping your edits.
+  /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile --force`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -432,7 +474,7 @@ This command is also run as part of "copilot init".`,
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			if err := opts.Execute(); err != nil {
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
 				return err
 			}
 			log.Infoln("Recommended follow-up actions:")
@@ -442,29 +484,44 @@ This command is also run as part of "copilot init".`,
 			return nil
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.ServiceType, svcTypeFlag, svcTypeFlagShort, "", svcTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.DockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().Uint16Var(&vars.Port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().BoolVar(&vars.Force, forceFlag, false, svcInitForceFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "service")
 
 	// Bucket flags by service type.
 	requiredFlags := pflag.NewFlagSet("Required Flags", pflag.ContinueOnError)
+	requiredFlags.AddFlag(cmd.Flags().Lookup(appFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(svcTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(dockerFileFlag))
 
+	updateFlags := pflag.NewFlagSet("Update", pflag.ContinueOnError)
+	updateFlags.AddFlag(cmd.Flags().Lookup(forceFlag))
+
 	lbWebSvcFlags := pflag.NewFlagSet(manifest.LoadBalancedWebServiceType, pflag.ContinueOnError)
 	lbWebSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
 
 	backendSvcFlags := pflag.NewFlagSet(manifest.BackendServiceType, pflag.ContinueOnError)
 	backendSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
 
+	metadataFlags := pflag.NewFlagSet("Metadata", pflag.ContinueOnError)
+	metadataFlags.AddFlag(cmd.Flags().Lookup(descriptionFlag))
+	metadataFlags.AddFlag(cmd.Flags().Lookup(ownerFlag))
+	metadataFlags.AddFlag(cmd.Flags().Lookup(contactFlag))
+	metadataFlags.AddFlag(cmd.Flags().Lookup(labelsFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                          fmt.Sprintf(`Required,%s`, strings.Join(manifest.ServiceTypes, ",")),
+		"sections":                          fmt.Sprintf(`Required,%s,Metadata,Update`, strings.Join(manifest.ServiceTypes, ",")),
 		"Required":                          requiredFlags.FlagUsages(),
 		manifest.LoadBalancedWebServiceType: lbWebSvcFlags.FlagUsages(),
 		manifest.BackendServiceType:         lbWebSvcFlags.FlagUsages(),
+		"Metadata":                          metadataFlags.FlagUsages(),
+		"Update":                            updateFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
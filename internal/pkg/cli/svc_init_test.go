@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -359,6 +360,7 @@ func TestAppInitOpts_Execute(t *testing.T) {
 		inSvcName        string
 		inDockerfilePath string
 		inAppName        string
+		inForce          bool
 		mockDependencies func(*gomock.Controller, *initSvcOpts)
 		wantedErr        error
 	}{
@@ -436,6 +438,75 @@ func TestAppInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("some error"),
 		},
+		"regenerates the manifest if forced": {
+			inSvcType:        manifest.LoadBalancedWebServiceType,
+			inAppName:        "app",
+			inSvcName:        "frontend",
+			inDockerfilePath: "frontend/Dockerfile",
+			inSvcPort:        80,
+			inForce:          true,
+
+			mockDependencies: func(ctrl *gomock.Controller, opts *initSvcOpts) {
+				mockWriter := mocks.NewMocksvcManifestWriter(ctrl)
+				mockWriter.EXPECT().WriteServiceManifest(gomock.Any(), gomock.Any()).Times(0)
+				mockWriter.EXPECT().UpdateServiceManifest(gomock.Any(), opts.Name).Return(&workspace.FileUpdate{
+					Path:       "/frontend/manifest.yml",
+					BackupPath: "/frontend/manifest.yml.bak",
+					Diff:       "--- /frontend/manifest.yml\n+++ /frontend/manifest.yml\n",
+				}, nil)
+				mockWriter.EXPECT().ApplyUpdate(&workspace.FileUpdate{
+					Path:       "/frontend/manifest.yml",
+					BackupPath: "/frontend/manifest.yml.bak",
+					Diff:       "--- /frontend/manifest.yml\n+++ /frontend/manifest.yml\n",
+				}).Return(nil)
+
+				mockstore := mocks.NewMockstore(ctrl)
+				mockstore.EXPECT().ListServices("app").Return([]*config.Service{}, nil)
+				mockstore.EXPECT().CreateService(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+
+				mockappDeployer := mocks.NewMockappDeployer(ctrl)
+				mockappDeployer.EXPECT().AddServiceToApp(gomock.Any(), "frontend")
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				opts.ws = mockWriter
+				opts.store = mockstore
+				opts.appDeployer = mockappDeployer
+				opts.prog = mockProg
+			},
+		},
+		"update manifest error": {
+			inSvcType:        manifest.LoadBalancedWebServiceType,
+			inAppName:        "app",
+			inSvcName:        "frontend",
+			inDockerfilePath: "frontend/Dockerfile",
+			inSvcPort:        80,
+			inForce:          true,
+
+			mockDependencies: func(ctrl *gomock.Controller, opts *initSvcOpts) {
+				mockWriter := mocks.NewMocksvcManifestWriter(ctrl)
+				mockWriter.EXPECT().UpdateServiceManifest(gomock.Any(), opts.Name).Return(nil, errors.New("some error"))
+
+				mockstore := mocks.NewMockstore(ctrl)
+				mockstore.EXPECT().ListServices("app").Return([]*config.Service{}, nil)
+				mockstore.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+
+				opts.ws = mockWriter
+				opts.store = mockstore
+				opts.appDeployer = mocks.NewMockappDeployer(ctrl)
+				opts.prog = mocks.NewMockprogress(ctrl)
+			},
+			wantedErr: errors.New("update manifest for service frontend: some error"),
+		},
 		"app error": {
 			inSvcType:        manifest.LoadBalancedWebServiceType,
 			inAppName:        "app",
@@ -651,6 +722,7 @@ func TestAppInitOpts_Execute(t *testing.T) {
 					Name:           tc.inSvcName,
 					Port:           tc.inSvcPort,
 					DockerfilePath: tc.inDockerfilePath,
+					Force:          tc.inForce,
 					GlobalOpts:     &GlobalOpts{appName: tc.inAppName},
 				},
 				setupParser: func(o *initSvcOpts) {},
@@ -14,8 +14,8 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
@@ -34,6 +34,7 @@ type listSvcVars struct {
 	*GlobalOpts
 	ShouldOutputJSON        bool
 	ShouldShowLocalServices bool
+	Labels                  map[string]string
 }
 
 type listSvcOpts struct {
@@ -47,11 +48,11 @@ type listSvcOpts struct {
 }
 
 func newListSvcOpts(vars listSvcVars) (*listSvcOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, err
 	}
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +99,7 @@ func (o *listSvcOpts) Execute() error {
 		}
 		svcs = filterSvcsByName(svcs, localNames)
 	}
+	svcs = filterSvcsByLabels(svcs, o.Labels)
 
 	var out string
 	if o.ShouldOutputJSON {
@@ -156,6 +158,20 @@ func filterSvcsByName(svcs []*config.Service, wantedNames []string) []*config.Se
 	return filtered
 }
 
+func filterSvcsByLabels(svcs []*config.Service, labels map[string]string) []*config.Service {
+	if len(labels) == 0 {
+		return svcs
+	}
+	var filtered []*config.Service
+	for _, svc := range svcs {
+		if !svc.MatchLabels(labels) {
+			continue
+		}
+		filtered = append(filtered, svc)
+	}
+	return filtered
+}
+
 // BuildSvcListCmd builds the command for listing services in an appication.
 func BuildSvcListCmd() *cobra.Command {
 	vars := listSvcVars{
@@ -166,7 +182,10 @@ func BuildSvcListCmd() *cobra.Command {
 		Short: "Lists all the services in an application.",
 		Example: `
   Lists all the services for the "myapp" application.
-  /code $ copilot svc ls --app myapp`,
+  /code $ copilot svc ls --app myapp
+
+  Lists the services of the "myapp" application owned by the payments team.
+  /code $ copilot svc ls --app myapp --label team=payments`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListSvcOpts(vars)
 			if err != nil {
@@ -182,5 +201,13 @@ func BuildSvcListCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.ShouldShowLocalServices, localFlag, false, localSvcFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.Labels, labelFlag, nil, labelFilterFlagDescription)
+	// Also accept the name of the flag that sets the labels in init and update commands.
+	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == labelsFlag {
+			name = labelFlag
+		}
+		return pflag.NormalizedName(name)
+	})
 	return cmd
 }
@@ -144,6 +144,31 @@ func TestListSvcOpts_Execute(t *testing.T) {
 			},
 			expectedContent: "Name                Type\n------              -------------------------\nmy-svc              Load Balanced Web Service\n",
 		},
+		"with label filter": {
+			opts: listSvcOpts{
+				listSvcVars: listSvcVars{
+					Labels: map[string]string{"team": "payments"},
+					GlobalOpts: &GlobalOpts{
+						appName: "coolapp",
+					},
+				},
+				store: mockstore,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListServices(gomock.Eq("coolapp")).
+					Return([]*config.Service{
+						{Name: "my-svc", Type: "Load Balanced Web Service", Metadata: config.Metadata{Labels: map[string]string{"team": "payments"}}},
+						{Name: "lb-svc", Type: "Load Balanced Web Service", Metadata: config.Metadata{Labels: map[string]string{"team": "search"}}},
+						{Name: "be-svc", Type: "Backend Service"},
+					}, nil)
+			},
+			expectedContent: "Name                Type\n------              -------------------------\nmy-svc              Load Balanced Web Service\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -209,3 +234,17 @@ func TestListSvcOpts_Ask(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildSvcListCmd_LabelFlag(t *testing.T) {
+	// GIVEN
+	cmd := BuildSvcListCmd()
+
+	// WHEN
+	err := cmd.ParseFlags([]string{"--label", "team=payments", "--labels", "tier=frontend"})
+
+	// THEN
+	require.NoError(t, err)
+	labels, err := cmd.Flags().GetStringToString(labelFlag)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "payments", "tier": "frontend"}, labels)
+}
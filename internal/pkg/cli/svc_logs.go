@@ -53,10 +53,11 @@ type svcLogsOpts struct {
 	sel           configSelector
 	initCwLogsSvc func(*svcLogsOpts, *config.Environment) error // Overriden in tests.
 	cwlogsSvc     map[string]cwlogService
+	stop          <-chan struct{} // Stops following the logs when closed.
 }
 
 func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to environment config store: %w", err)
 	}
@@ -156,7 +157,11 @@ func (o *svcLogsOpts) Execute() error {
 		if logEventsOutput.LastEventTime == nil {
 			return nil
 		}
-		time.Sleep(cloudwatchlogs.SleepDuration)
+		select {
+		case <-o.stop:
+			return nil
+		case <-time.After(cloudwatchlogs.SleepDuration):
+		}
 	}
 }
 
@@ -19,7 +19,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
-	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
@@ -65,11 +64,11 @@ type packageSvcOpts struct {
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
@@ -399,6 +398,7 @@ func BuildSvcPackageCmd() *cobra.Command {
 		}),
 	}
 	// Set the defaults to opts.{Field} otherwise cobra overrides the values set by the constructor.
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.Tag, imageTagFlag, "", imageTagFlagDescription)
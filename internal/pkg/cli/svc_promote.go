@@ -0,0 +1,126 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPromoteAction = "promote"
+
+	fmtSvcPromoteStepStart    = "Shifting %d%%%% of the traffic of %%s in %%s to the canary."
+	fmtSvcPromoteStepComplete = "Shifted %d%%%% of the traffic of %%s in %%s to the canary.\n"
+	fmtSvcPromoteStart        = "Promoting the canary of %s in %s to the stable version."
+	fmtSvcPromoteComplete     = "Promoted the canary of %s in %s to the stable version.\n"
+)
+
+type svcPromoteOpts struct {
+	*svcCanaryOpts
+
+	promotedWeight int // Percentage of the traffic sent to the canary after the promotion, 0 once it's the stable version.
+}
+
+func newSvcPromoteOpts(vars svcCanaryVars) (*svcPromoteOpts, error) {
+	opts, err := newSvcCanaryOpts(vars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcPromoteOpts{
+		svcCanaryOpts: opts,
+	}, nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPromoteOpts) Ask() error {
+	return o.ask(svcPromoteAction)
+}
+
+// Execute sends the next step of the traffic to the canary of the service,
+// or makes the canary the stable version if it already receives the last step.
+func (o *svcPromoteOpts) Execute() error {
+	return o.withDeploymentLock(svcPromoteLockOperation, o.promote)
+}
+
+func (o *svcPromoteOpts) promote() error {
+	c, err := o.canary()
+	if err != nil {
+		return err
+	}
+	if err := validatePipelineOnly(o.targetEnv); err != nil {
+		return err
+	}
+	if !c.isReleased() {
+		return fmt.Errorf("service %s has no canary to promote in environment %s", o.svcName, o.envName)
+	}
+	if weight, ok := c.nextStep(); ok {
+		o.promotedWeight = weight
+		return o.shiftTraffic(c.withWeight(weight), fmt.Sprintf(fmtSvcPromoteStepStart, weight), fmt.Sprintf(fmtSvcPromoteStepComplete, weight))
+	}
+	o.promotedWeight = 0
+	return o.shiftTraffic(c.promoted(), fmtSvcPromoteStart, fmtSvcPromoteComplete)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcPromoteOpts) RecommendedActions() []string {
+	status := fmt.Sprintf("Run %s to see the health of the stable tasks and the canary.",
+		color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.svcName, o.envName)))
+	if o.promotedWeight == 0 {
+		return []string{status}
+	}
+	return []string{
+		status,
+		fmt.Sprintf("Run %s to shift more traffic to the canary.",
+			color.HighlightCode(fmt.Sprintf("copilot svc promote -n %s -e %s", o.svcName, o.envName))),
+		fmt.Sprintf("Run %s to send all the traffic back to the stable version.",
+			color.HighlightCode(fmt.Sprintf("copilot svc abort -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// BuildSvcPromoteCmd builds the command for shifting more traffic to the canary of a service.
+func BuildSvcPromoteCmd() *cobra.Command {
+	vars := svcCanaryVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Shifts more traffic to the canary of a service.",
+		Long: `Shifts more traffic to the canary of a service deployed with canary releases.
+Each promotion sends the next step of the traffic to the canary. Once the canary receives the last step,
+the next promotion makes it the stable version of the service.`,
+
+		Example: `
+  Shifts more traffic to the canary of the "frontend" service in the "prod" environment.
+  /code $ copilot svc promote -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPromoteOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().DurationVar(&vars.waitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	return cmd
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcCanaryMocks struct {
+	store    *mocks.Mockstore
+	releaser *mocks.MockcanaryReleaser
+	spinner  *mocks.Mockprogress
+	locker   *mocks.MockdeploymentLocker
+}
+
+func newSvcCanaryMocks(ctrl *gomock.Controller) svcCanaryMocks {
+	return svcCanaryMocks{
+		store:    mocks.NewMockstore(ctrl),
+		releaser: mocks.NewMockcanaryReleaser(ctrl),
+		spinner:  mocks.NewMockprogress(ctrl),
+		locker:   mocks.NewMockdeploymentLocker(ctrl),
+	}
+}
+
+// newSvcCanaryTestOpts returns the options of a command that holds the deployment lock of the service for its execution.
+func newSvcCanaryTestOpts(m svcCanaryMocks) *svcCanaryOpts {
+	m.locker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(nil)
+	m.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil)
+	return &svcCanaryOpts{
+		svcCanaryVars: svcCanaryVars{
+			GlobalOpts: &GlobalOpts{appName: "phonetool"},
+			svcName:    "frontend",
+			envName:    "test",
+		},
+		store:   m.store,
+		locker:  m.locker,
+		spinner: m.spinner,
+		newReleaser: func(env *config.Environment) (canaryReleaser, error) {
+			return m.releaser, nil
+		},
+	}
+}
+
+func TestSvcPromoteOpts_Execute(t *testing.T) {
+	const (
+		stableImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"
+		canaryImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v2"
+	)
+	mockEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		ExecutionRoleARN: "arn:aws:iam::1234567890:role/execution",
+	}
+	mockOutputs := map[string]string{
+		"CanarySteps": "10,50",
+	}
+	canaryParams := func(stableWeight, canaryWeight string) map[string]string {
+		return map[string]string{
+			"ContainerImage":       stableImage,
+			"CanaryContainerImage": canaryImage,
+			"StableWeight":         stableWeight,
+			"CanaryWeight":         canaryWeight,
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcCanaryMocks)
+
+		wantedWeight int
+		wantedErr    error
+	}{
+		"errors if the service is not deployed with canary releases": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(map[string]string{}, nil)
+			},
+			wantedErr: errors.New("service frontend is not deployed with canary releases in environment test"),
+		},
+		"errors if the environment only accepts deployments from a pipeline": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:          "phonetool",
+					Name:         "test",
+					PipelineOnly: true,
+				}, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(canaryParams("90", "10"), nil)
+				m.releaser.EXPECT().UpdateServiceParameters(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("environment test only accepts deployments from a pipeline"),
+		},
+		"errors if there is no canary to promote": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(map[string]string{
+					"ContainerImage":       stableImage,
+					"CanaryContainerImage": "",
+					"StableWeight":         "100",
+					"CanaryWeight":         "0",
+				}, nil)
+			},
+			wantedErr: errors.New("service frontend has no canary to promote in environment test"),
+		},
+		"shifts the next step of the traffic to the canary": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(canaryParams("90", "10"), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.releaser.EXPECT().UpdateServiceParameters(deploy.UpdateServiceParametersInput{
+					Name:       "frontend",
+					EnvName:    "test",
+					AppName:    "phonetool",
+					Parameters: canaryParams("50", "50"),
+				}, gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedWeight: 50,
+		},
+		"makes the canary the stable version after the last step": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(canaryParams("50", "50"), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.releaser.EXPECT().UpdateServiceParameters(deploy.UpdateServiceParametersInput{
+					Name:    "frontend",
+					EnvName: "test",
+					AppName: "phonetool",
+					Parameters: map[string]string{
+						"ContainerImage":       canaryImage,
+						"CanaryContainerImage": "",
+						"StableWeight":         "100",
+						"CanaryWeight":         "0",
+					},
+				}, gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"wraps the error from updating the stack": {
+			setupMocks: func(m svcCanaryMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.releaser.EXPECT().ServiceStackOutputs("phonetool-test-frontend").Return(mockOutputs, nil)
+				m.releaser.EXPECT().ServiceStackParameters("phonetool-test-frontend").Return(canaryParams("90", "10"), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.releaser.EXPECT().UpdateServiceParameters(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("shift the traffic of service frontend in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newSvcCanaryMocks(ctrl)
+			tc.setupMocks(m)
+			opts := &svcPromoteOpts{
+				svcCanaryOpts: newSvcCanaryTestOpts(m),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWeight, opts.promotedWeight)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt         = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt     = "The service is redeployed with the image and stack template of a previous deployment."
+	svcRollbackEnvNamePrompt      = "Which environment is the service deployed in?"
+	svcRollbackEnvNameHelpPrompt  = "Each environment has its own deployment history."
+	svcRollbackRevisionPrompt     = "Which revision would you like to redeploy?"
+	svcRollbackRevisionHelpPrompt = "The revisions are listed from the latest to the oldest deployment."
+
+	fmtSvcRollbackConfirmPrompt = "Are you sure you want to redeploy revision %s of service %s in environment %s?"
+	svcRollbackConfirmHelp      = "The service is redeployed with the image and stack template of the revision, without rebuilding the image."
+
+	fmtSvcRollbackStart    = "Rolling back %s in %s to revision %s."
+	fmtSvcRollbackFailed   = "Failed to roll back %s in %s to revision %s.\n"
+	fmtSvcRollbackComplete = "Rolled back %s in %s to revision %s.\n"
+	fmtSvcRollbackEmpty    = "Revision %s of %s is already deployed in %s.\n"
+)
+
+type svcRollbackVars struct {
+	*GlobalOpts
+	svcName          string
+	envName          string
+	revision         int
+	skipConfirmation bool
+	waitForLock      time.Duration
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store          store
+	deployments    deploymentStore
+	locker         deploymentLocker
+	sel            configSelector
+	spinner        progress
+	identity       identityService
+	rollbacker     svcRollbacker
+	initRollbacker func(o *svcRollbackOpts, env *config.Environment) error
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	provider := session.NewProvider()
+	defaultSess, err := provider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+
+	return &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           store,
+		deployments:     store,
+		locker:          store,
+		sel:             selector.NewConfigSelect(vars.prompt, store),
+		spinner:         termprogress.NewSpinner(),
+		identity:        identity.New(defaultSess),
+		initRollbacker: func(o *svcRollbackOpts, env *config.Environment) error {
+			envSess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			o.rollbacker = cloudformation.New(envSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRollbackOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.revision < 0 {
+		return fmt.Errorf("revision %d must be a positive number", o.revision)
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcRollbackOpts) Ask() error {
+	if o.svcName == "" {
+		svc, err := o.sel.Service(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.svcName = svc
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcRollbackEnvNamePrompt, svcRollbackEnvNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return o.askRevision()
+}
+
+func (o *svcRollbackOpts) askRevision() error {
+	if o.revision != 0 {
+		return nil
+	}
+	deployments, err := o.deployments.ListDeployments(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	if len(deployments) == 0 {
+		return fmt.Errorf("no deployments recorded for service %s in environment %s", o.svcName, o.envName)
+	}
+	revisions := make(map[string]int)
+	var options []string
+	for i := len(deployments) - 1; i >= 0; i-- {
+		d := deployments[i]
+		option := fmt.Sprintf("%d (tag %s, deployed at %s)", d.Revision, d.ImageTag, d.DeployedAt.Format(time.RFC3339))
+		revisions[option] = d.Revision
+		options = append(options, option)
+	}
+	option, err := o.prompt.SelectOne(svcRollbackRevisionPrompt, svcRollbackRevisionHelpPrompt, options)
+	if err != nil {
+		return fmt.Errorf("select revision: %w", err)
+	}
+	o.revision = revisions[option]
+	return nil
+}
+
+// Execute redeploys the image and stack template of the revision, and records the rollback as a new revision.
+func (o *svcRollbackOpts) Execute() error {
+	d, err := o.deployments.GetDeployment(o.AppName(), o.envName, o.svcName, o.revision)
+	if err != nil {
+		return err
+	}
+	svcName, envName, revision := color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), color.HighlightUserInput(strconv.Itoa(o.revision))
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcRollbackConfirmPrompt, revision, svcName, envName), svcRollbackConfirmHelp)
+		if err != nil {
+			return fmt.Errorf("confirm rollback: %w", err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	if err := o.initRollbacker(o, env); err != nil {
+		return err
+	}
+
+	// Rollbacks are allowed outside of pipelines since they redeploy a previous revision.
+	return withDeploymentLock(o.locker, &config.DeploymentLock{
+		App:       o.AppName(),
+		Env:       o.envName,
+		Service:   o.svcName,
+		Operation: svcRollbackLockOperation,
+	}, o.waitForLock, func() error {
+		return rollbackSvc(svcRollback{
+			rollbacker:  o.rollbacker,
+			deployments: o.deployments,
+			identity:    o.identity,
+			spinner:     o.spinner,
+		}, d, env)
+	})
+}
+
+// auditedTarget returns the service rolled back by the command.
+func (o *svcRollbackOpts) auditedTarget() auditTarget {
+	return auditTarget{
+		app: o.AppName(),
+		env: o.envName,
+		svc: o.svcName,
+		stacks: []auditStack{
+			{name: stack.NameForService(o.AppName(), o.envName, o.svcName), env: o.envName},
+		},
+	}
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to see the deployments of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc history -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// svcRollback holds the dependencies to redeploy a previous revision of a service.
+type svcRollback struct {
+	rollbacker  svcRollbacker
+	deployments deploymentCreator
+	identity    identityService
+	spinner     progress
+}
+
+// rollbackSvc redeploys the revision d of the service in the environment and records the rollback as a new revision.
+func rollbackSvc(r svcRollback, d *config.Deployment, env *config.Environment) error {
+	svcName, envName, revision := color.HighlightUserInput(d.Service), color.HighlightUserInput(d.Env), color.HighlightUserInput(strconv.Itoa(d.Revision))
+	r.spinner.Start(fmt.Sprintf(fmtSvcRollbackStart, svcName, envName, revision))
+	err := r.rollbacker.RollbackService(deploy.RollbackServiceInput{
+		Name:        d.Service,
+		EnvName:     d.Env,
+		AppName:     d.App,
+		TemplateURL: d.TemplateURL,
+		Parameters:  pinnedParameters(d),
+		Tags:        d.Tags,
+	}, svcStackOptions(env)...)
+	if err != nil {
+		var errEmpty *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmpty) {
+			r.spinner.Stop(log.Ssuccessf(fmtSvcRollbackEmpty, revision, svcName, envName))
+			return nil
+		}
+		r.spinner.Stop(log.Serrorf(fmtSvcRollbackFailed, svcName, envName, revision))
+		return fmt.Errorf("roll back service %s to revision %d: %w", d.Service, d.Revision, err)
+	}
+	r.spinner.Stop(log.Ssuccessf(fmtSvcRollbackComplete, svcName, envName, revision))
+	r.recordRollback(d)
+	return nil
+}
+
+// recordRollback adds the redeployed revision to the history of the service as a new revision.
+// The service is already rolled back at this point, so failing to record it only logs a warning.
+func (r svcRollback) recordRollback(d *config.Deployment) {
+	rollback := *d
+	rollback.RollbackOf = d.Revision
+	rollback.DeployedAt = time.Now().UTC()
+	caller, err := r.identity.Get()
+	if err == nil {
+		rollback.DeployedBy = caller.ARN
+		err = r.deployments.CreateDeployment(&rollback)
+	}
+	if err != nil {
+		log.Warningf("Failed to record the rollback of service %s: %v\n", d.Service, err)
+	}
+}
+
+// pinnedParameters returns the stack parameters of the deployment with the container image
+// referenced by its digest, so that the exact same image is redeployed even if its tag was overwritten since.
+func pinnedParameters(d *config.Deployment) map[string]string {
+	params := make(map[string]string, len(d.Parameters))
+	for k, v := range d.Parameters {
+		params[k] = v
+	}
+	image, ok := params[stack.ServiceContainerImageParamKey]
+	if !ok || d.ImageDigest == "" || !strings.HasSuffix(image, ":"+d.ImageTag) {
+		return params
+	}
+	params[stack.ServiceContainerImageParamKey] = fmt.Sprintf("%s@%s", strings.TrimSuffix(image, ":"+d.ImageTag), d.ImageDigest)
+	return params
+}
+
+// BuildSvcRollbackCmd builds the command for redeploying a previous revision of a service.
+func BuildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Redeploys a previous revision of a service.",
+		Long: `Redeploys a previous revision of a service.
+The service is redeployed with the exact image and stack template of the revision, without rebuilding the image.`,
+
+		Example: `
+  Redeploys revision 3 of the "frontend" service in the "prod" environment.
+  /code $ copilot svc rollback -n frontend -e prod --to 3`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := executeAudited(cmd, args, opts, opts.Execute); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().IntVar(&vars.revision, toRevisionFlag, 0, toRevisionFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().DurationVar(&vars.waitForLock, waitForLockFlag, 0, waitForLockFlagDescription)
+	return cmd
+}
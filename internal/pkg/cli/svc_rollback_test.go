@@ -0,0 +1,242 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRollbackOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inRevision int
+
+		wantedErr error
+	}{
+		"errors without an application": {
+			wantedErr: errNoAppInWorkspace,
+		},
+		"errors with a negative revision": {
+			inAppName:  "phonetool",
+			inRevision: -1,
+			wantedErr:  errors.New("revision -1 must be a positive number"),
+		},
+		"success": {
+			inAppName:  "phonetool",
+			inRevision: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
+					revision:   tc.inRevision,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcRollbackOpts_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		ExecutionRoleARN: "arn:aws:iam::1234567890:role/execution",
+	}
+	mockDeployment := &config.Deployment{
+		App:         "phonetool",
+		Env:         "test",
+		Service:     "api",
+		Revision:    1,
+		ImageTag:    "v1",
+		ImageDigest: "sha256:abc",
+		TemplateURL: "https://mockBucket/api.stack.yml",
+		Parameters: map[string]string{
+			"ContainerImage": "1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+			"TaskCount":      "2",
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+		DeployedBy: "arn:aws:iam::1234567890:user/alice",
+	}
+	wantedRollbackInput := deploy.RollbackServiceInput{
+		Name:        "api",
+		EnvName:     "test",
+		AppName:     "phonetool",
+		TemplateURL: "https://mockBucket/api.stack.yml",
+		Parameters: map[string]string{
+			"ContainerImage": "1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:abc",
+			"TaskCount":      "2",
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+	}
+	type rollbackMocks struct {
+		store       *mocks.Mockstore
+		deployments *mocks.MockdeploymentStore
+		prompt      *mocks.Mockprompter
+		spinner     *mocks.Mockprogress
+		identity    *mocks.MockidentityService
+		rollbacker  *mocks.MocksvcRollbacker
+		locker      *mocks.MockdeploymentLocker
+	}
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(m rollbackMocks)
+
+		wantedErr error
+	}{
+		"errors if the revision doesn't exist": {
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(nil, &config.ErrNoSuchDeployment{
+					ServiceName:     "api",
+					EnvironmentName: "test",
+					Revision:        1,
+				})
+			},
+			wantedErr: errors.New("couldn't find revision 1 of service api in environment test"),
+		},
+		"does nothing if the rollback is declined": {
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(mockDeployment, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if the service is locked": {
+			skipConfirmation: true,
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(mockDeployment, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.locker.EXPECT().AcquireDeploymentLock(&config.DeploymentLock{
+					App:       "phonetool",
+					Env:       "test",
+					Service:   "api",
+					Operation: svcRollbackLockOperation,
+				}).Return(mockError)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: mockError,
+		},
+		"errors if the rollback fails": {
+			skipConfirmation: true,
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(mockDeployment, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.locker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(nil)
+				m.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.rollbacker.EXPECT().RollbackService(wantedRollbackInput, gomock.Any()).Return(mockError)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployments.EXPECT().CreateDeployment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("roll back service api to revision 1: some error"),
+		},
+		"does not record a revision that is already deployed": {
+			skipConfirmation: true,
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(mockDeployment, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.locker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(nil)
+				m.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.rollbacker.EXPECT().RollbackService(wantedRollbackInput, gomock.Any()).Return(&awscloudformation.ErrChangeSetEmpty{})
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployments.EXPECT().CreateDeployment(gomock.Any()).Times(0)
+			},
+		},
+		"redeploys the pinned image and records the rollback": {
+			setupMocks: func(m rollbackMocks) {
+				m.deployments.EXPECT().GetDeployment("phonetool", "test", "api", 1).Return(mockDeployment, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.locker.EXPECT().AcquireDeploymentLock(gomock.Any()).Return(nil)
+				m.locker.EXPECT().ReleaseDeploymentLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.rollbacker.EXPECT().RollbackService(wantedRollbackInput, gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1234567890:root", ARN: "arn:aws:iam::1234567890:user/bob"}, nil)
+				m.deployments.EXPECT().CreateDeployment(gomock.Any()).DoAndReturn(func(d *config.Deployment) error {
+					require.Equal(t, 1, d.RollbackOf)
+					require.Equal(t, "sha256:abc", d.ImageDigest)
+					require.Equal(t, mockDeployment.TemplateURL, d.TemplateURL)
+					require.Equal(t, "arn:aws:iam::1234567890:user/bob", d.DeployedBy)
+					require.False(t, d.DeployedAt.IsZero())
+					return nil
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rollbackMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployments: mocks.NewMockdeploymentStore(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				spinner:     mocks.NewMockprogress(ctrl),
+				identity:    mocks.NewMockidentityService(ctrl),
+				rollbacker:  mocks.NewMocksvcRollbacker(ctrl),
+				locker:      mocks.NewMockdeploymentLocker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					GlobalOpts:       &GlobalOpts{appName: "phonetool", prompt: m.prompt},
+					svcName:          "api",
+					envName:          "test",
+					revision:         1,
+					skipConfirmation: tc.skipConfirmation,
+				},
+				store:       m.store,
+				deployments: m.deployments,
+				locker:      m.locker,
+				spinner:     m.spinner,
+				identity:    m.identity,
+				initRollbacker: func(o *svcRollbackOpts, env *config.Environment) error {
+					o.rollbacker = m.rollbacker
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
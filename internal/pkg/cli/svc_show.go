@@ -14,7 +14,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
-	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -44,11 +43,11 @@ type showSvcOpts struct {
 }
 
 func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
-	ssmStore, err := config.NewStore()
+	ssmStore, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to environment datastore: %w", err)
 	}
-	ws, err := workspace.New()
+	ws, err := newWorkspace(vars.GlobalOpts)
 	if err != nil {
 		return nil, err
 	}
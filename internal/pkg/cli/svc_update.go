@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcUpdateAppNamePrompt     = "Which application's service would you like to update?"
+	svcUpdateAppNameHelpPrompt = "An application groups all of your services together."
+	svcUpdateSvcNamePrompt     = "Which service of %s would you like to update?"
+	svcUpdateSvcNameHelpPrompt = "The description, owner, contact and labels of the service will be updated."
+)
+
+type updateSvcVars struct {
+	*GlobalOpts
+	metadataVars
+	svcName string
+	isSet   func(flag string) bool // Returns true if a flag was set by the user.
+}
+
+type updateSvcOpts struct {
+	updateSvcVars
+
+	store store
+	sel   configSelector
+}
+
+func newUpdateSvcOpts(vars updateSvcVars) (*updateSvcOpts, error) {
+	store, err := config.NewConfigStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+
+	return &updateSvcOpts{
+		updateSvcVars: vars,
+		store:         store,
+		sel:           selector.NewConfigSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *updateSvcOpts) Validate() error {
+	if err := validateMetadataFlagsSet(o.isSet); err != nil {
+		return err
+	}
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *updateSvcOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcUpdateAppNamePrompt, svcUpdateAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application name: %w", err)
+		}
+		o.appName = app
+	}
+	if o.svcName == "" {
+		svc, err := o.sel.Service(fmt.Sprintf(svcUpdateSvcNamePrompt, color.HighlightUserInput(o.AppName())), svcUpdateSvcNameHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select service for application %s: %w", o.AppName(), err)
+		}
+		o.svcName = svc
+	}
+	return nil
+}
+
+// Execute updates the metadata of the service.
+func (o *updateSvcOpts) Execute() error {
+	svc, err := o.store.GetService(o.AppName(), o.svcName)
+	if err != nil {
+		return fmt.Errorf("get service %s: %w", o.svcName, err)
+	}
+	o.update(&svc.Metadata, o.isSet)
+	if err := o.store.UpdateService(svc); err != nil {
+		return fmt.Errorf("update service %s: %w", o.svcName, err)
+	}
+	log.Successf("Updated service %s in application %s.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.AppName()))
+	return nil
+}
+
+// BuildSvcUpdateCmd builds the command for updating the metadata of a service.
+func BuildSvcUpdateCmd() *cobra.Command {
+	vars := updateSvcVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Updates the description, owner, contact or labels of a service.",
+		Example: `
+  Sets the owner and contact of the "api" service.
+  /code $ copilot svc update -n api --owner payments --contact payments@example.com
+
+  Labels the "api" service so that it shows up in "copilot svc ls --label tier=1".
+  /code $ copilot svc update -n api --labels tier=1`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.isSet = cmd.Flags().Changed
+			opts, err := newUpdateSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	addMetadataFlags(cmd, &vars.metadataVars, "service")
+	return cmd
+}
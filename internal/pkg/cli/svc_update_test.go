@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName  string
+		inFlagsSet []string
+		mockStore  func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if no metadata flag is set": {
+			mockStore: func(m *mocks.Mockstore) {},
+			wantedErr: errors.New("at least one of --description, --owner, --contact or --labels must be provided"),
+		},
+		"errors if the service doesn't exist": {
+			inSvcName:  "api",
+			inFlagsSet: []string{descriptionFlag},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &updateSvcOpts{
+				updateSvcVars: updateSvcVars{
+					GlobalOpts: &GlobalOpts{appName: "my-app"},
+					svcName:    tc.inSvcName,
+					isSet:      flagsSet(tc.inFlagsSet...),
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateSvcOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockStore func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if failed to update the service": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("my-app", "api").Return(&config.Service{App: "my-app", Name: "api"}, nil)
+				m.EXPECT().UpdateService(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("update service api: some error"),
+		},
+		"sets the description and contact": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("my-app", "api").Return(&config.Service{
+					App:  "my-app",
+					Name: "api",
+					Type: "Backend Service",
+				}, nil)
+				m.EXPECT().UpdateService(&config.Service{
+					App:  "my-app",
+					Name: "api",
+					Type: "Backend Service",
+					Metadata: config.Metadata{
+						Description: "Public API",
+						Contact:     "#payments",
+					},
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &updateSvcOpts{
+				updateSvcVars: updateSvcVars{
+					GlobalOpts: &GlobalOpts{appName: "my-app"},
+					svcName:    "api",
+					metadataVars: metadataVars{
+						description: "Public API",
+						contact:     "#payments",
+					},
+					isSet: flagsSet(descriptionFlag, contactFlag),
+				},
+				store: mockStore,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,15 +6,32 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/cli/selector"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
+const (
+	taskImageTag          = "latest"
+	defaultTaskDockerfile = "Dockerfile"
+	fmtTaskResourceName   = "copilot-%s"
+)
+
 var (
 	errNumNotPositive = errors.New("number of tasks must be positive")
 	errCpuNotPositive = errors.New("CPU units must be positive")
@@ -38,6 +55,7 @@ type runTaskVars struct {
 
 	image          string
 	dockerfilePath string
+	platform       string
 
 	taskRole string
 
@@ -53,27 +71,82 @@ type runTaskOpts struct {
 	runTaskVars
 
 	// Interfaces to interact with dependencies.
-	fs     afero.Fs
-	store  store
-	parser dockerfileParser
-	sel    appEnvWithNoneSelector
+	fs           afero.Fs
+	store        store
+	parser       dockerfileParser
+	sel          appEnvWithNoneSelector
+	sessProvider sessionProvider
+	builder      imageBuilder
+
+	// Clients configured once the environment of the tasks is known.
+	deployer     taskDeployer
+	ecr          ecrService
+	runner       taskRunner
+	subnetGetter defaultSubnetsGetter
+	envOutputs   svcStackOutputsGetter
+
+	configureClients func(o *runTaskOpts) error
 }
 
 func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
 
+	builder, err := docker.NewBuilder()
+	if err != nil {
+		return nil, fmt.Errorf("new image builder: %w", err)
+	}
+
 	return &runTaskOpts{
 		runTaskVars: vars,
 
-		fs:    &afero.Afero{Fs: afero.NewOsFs()},
-		store: store,
-		sel:   selector.NewSelect(vars.prompt, store),
+		fs:           &afero.Afero{Fs: afero.NewOsFs()},
+		store:        store,
+		sel:          selector.NewSelect(vars.prompt, store),
+		sessProvider: session.NewProvider(),
+		builder:      builder,
+
+		configureClients: configureTaskRunClients,
 	}, nil
 }
 
+// configureTaskRunClients sets up the clients against the account and region of the tasks' environment,
+// or against the default profile if the tasks don't run in an environment.
+func configureTaskRunClients(o *runTaskOpts) error {
+	sess, err := o.session()
+	if err != nil {
+		return err
+	}
+	cfn := cloudformation.New(sess)
+	o.deployer = cfn
+	o.envOutputs = cfn
+	o.ecr = ecr.New(sess)
+	o.runner = ecs.New(sess)
+	o.subnetGetter = ec2.New(sess)
+	return nil
+}
+
+func (o *runTaskOpts) session() (*awssession.Session, error) {
+	if o.env == config.EnvNameNone {
+		sess, err := o.sessProvider.Default()
+		if err != nil {
+			return nil, fmt.Errorf("create default session: %w", err)
+		}
+		return sess, nil
+	}
+	env, err := o.store.GetEnvironment(o.AppName(), o.env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.env, err)
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assuming environment manager role: %w", err)
+	}
+	return sess, nil
+}
+
 // Validate returns an error if the flag values passed by the user are invalid.
 func (o *runTaskOpts) Validate() error {
 	if o.count <= 0 {
@@ -104,6 +177,12 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.platform != "" {
+		if err := validatePlatform(o.platform); err != nil {
+			return err
+		}
+	}
+
 	if o.env != "" && (o.subnet != "" || o.securityGroups != nil) {
 		return errors.New("neither subnet nor security groups should be specified if environment is specified")
 	}
@@ -190,6 +269,110 @@ func (o *runTaskOpts) askEnvName() error {
 	return nil
 }
 
+// Execute deploys the resources of the task group, builds and pushes its image if needed, and starts the tasks.
+func (o *runTaskOpts) Execute() error {
+	if err := o.configureClients(o); err != nil {
+		return err
+	}
+	if err := o.deployer.DeployTask(o.deployInput()); err != nil {
+		return fmt.Errorf("deploy resources for task %s: %w", o.groupName, err)
+	}
+	if o.image == "" {
+		if err := o.buildAndPushImage(); err != nil {
+			return err
+		}
+	}
+	input, err := o.runInput()
+	if err != nil {
+		return err
+	}
+	arns, err := o.runner.RunTask(input)
+	if err != nil {
+		return fmt.Errorf("run task %s: %w", o.groupName, err)
+	}
+	log.Successf("Started %d task(s) of group %s.\n", len(arns), color.HighlightUserInput(o.groupName))
+	return nil
+}
+
+func (o *runTaskOpts) deployInput() *deploy.CreateTaskResourcesInput {
+	in := &deploy.CreateTaskResourcesInput{
+		Name:     o.groupName,
+		CPU:      int(o.cpu),
+		Memory:   int(o.memory),
+		Image:    o.image,
+		TaskRole: o.taskRole,
+		Command:  o.commands,
+		EnvVars:  o.envVars,
+		Platform: o.platform,
+	}
+	if o.env != config.EnvNameNone {
+		in.App = o.AppName()
+		in.Env = o.env
+	}
+	return in
+}
+
+// buildAndPushImage builds the image of the task for its platform and pushes it to the repository of the task group.
+func (o *runTaskOpts) buildAndPushImage() error {
+	uri, err := o.deployer.TaskRepositoryURI(o.groupName)
+	if err != nil {
+		return fmt.Errorf("get repository of task %s: %w", o.groupName, err)
+	}
+	path := o.dockerfilePath
+	if path == "" {
+		path = defaultTaskDockerfile
+	}
+	if o.platform == "" {
+		if err := o.builder.Build(uri, taskImageTag, path); err != nil {
+			return fmt.Errorf("build Dockerfile at %s with tag %s: %w", path, taskImageTag, err)
+		}
+		_, err := pushImage(o.builder, o.ecr, uri, fmt.Sprintf(fmtTaskResourceName, o.groupName), taskImageTag)
+		return err
+	}
+	auth, err := o.ecr.GetECRAuth()
+	if err != nil {
+		return fmt.Errorf("get ECR auth data: %w", err)
+	}
+	if err := o.builder.Login(uri, auth.Username, auth.Password); err != nil {
+		return err
+	}
+	_, err = pushPlatformImage(o.builder, []string{uri}, taskImageTag, path, []string{o.platform})
+	return err
+}
+
+// runInput returns the network configuration of the tasks: the environment's cluster, public subnets and security group,
+// or the subnet and security groups from the flags, defaulting to the default subnets, if the tasks don't run in an environment.
+func (o *runTaskOpts) runInput() (ecs.RunTaskInput, error) {
+	input := ecs.RunTaskInput{
+		Count:          int(o.count),
+		TaskFamilyName: fmt.Sprintf(fmtTaskResourceName, o.groupName),
+	}
+	if o.env != config.EnvNameNone {
+		outputs, err := o.envOutputs.ServiceStackOutputs(stack.NameForEnv(o.AppName(), o.env))
+		if err != nil {
+			return ecs.RunTaskInput{}, fmt.Errorf("get outputs of environment %s: %w", o.env, err)
+		}
+		input.Cluster = outputs[stack.EnvOutputClusterID]
+		input.Subnets = strings.Split(outputs[stack.EnvOutputPublicSubnets], ",")
+		input.SecurityGroups = []string{outputs[stack.EnvOutputSecurityGroup]}
+		return input, nil
+	}
+	input.SecurityGroups = o.securityGroups
+	if o.subnet != "" {
+		input.Subnets = []string{o.subnet}
+		return input, nil
+	}
+	subnets, err := o.subnetGetter.DefaultSubnets()
+	if err != nil {
+		return ecs.RunTaskInput{}, fmt.Errorf("get default subnets: %w", err)
+	}
+	if len(subnets) == 0 {
+		return ecs.RunTaskInput{}, errors.New("no default subnets found in the default VPC, specify a subnet with --" + subnetFlag)
+	}
+	input.Subnets = subnets
+	return input, nil
+}
+
 // BuildTaskRunCmd build the command for running a new task
 func BuildTaskRunCmd() *cobra.Command {
 	vars := runTaskVars{
@@ -221,7 +404,7 @@ Run a task with environment variables.
 			if err := opts.Ask(); err != nil {
 				return err
 			}
-			return nil
+			return opts.Execute()
 		}),
 	}
 
@@ -233,6 +416,7 @@ Run a task with environment variables.
 
 	cmd.Flags().StringVar(&vars.image, imageFlag, "", imageFlagDescription)
 	cmd.Flags().StringVar(&vars.dockerfilePath, dockerFileFlag, "", dockerFileFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, platformFlag, "", platformFlagDescription)
 
 	cmd.Flags().StringVar(&vars.taskRole, taskRoleFlag, "", taskRoleFlagDescription)
 
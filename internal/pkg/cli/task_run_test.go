@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -35,6 +38,7 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inImage          string
 		inDockerfilePath string
+		inPlatform       string
 
 		inTaskRole string
 
@@ -147,6 +151,18 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			inDockerfilePath: "world/hello/Dockerfile",
 			wantedError:      errors.New("open world/hello/Dockerfile: file does not exist"),
 		},
+		"valid platform": {
+			basicOpts: defaultOpts,
+
+			inPlatform:  "linux/arm64",
+			wantedError: nil,
+		},
+		"invalid platform": {
+			basicOpts: defaultOpts,
+
+			inPlatform:  "windows/amd64",
+			wantedError: errors.New("platform windows/amd64 must be one of linux/amd64, linux/arm64"),
+		},
 		"specified app exists": {
 			basicOpts: defaultOpts,
 
@@ -254,6 +270,7 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					subnet:         tc.inSubnet,
 					securityGroups: tc.inSecurityGroups,
 					dockerfilePath: tc.inDockerfilePath,
+					platform:       tc.inPlatform,
 					envVars:        tc.inEnvVars,
 					commands:       tc.inCommands,
 				},
@@ -409,3 +426,205 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRunOpts_Execute(t *testing.T) {
+	type runTaskMocks struct {
+		deployer     *mocks.MocktaskDeployer
+		builder      *mocks.MockimageBuilder
+		ecr          *mocks.MockecrService
+		runner       *mocks.MocktaskRunner
+		subnetGetter *mocks.MockdefaultSubnetsGetter
+		envOutputs   *mocks.MocksvcStackOutputsGetter
+	}
+	const (
+		mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/copilot-my-task"
+	)
+	testCases := map[string]struct {
+		inImage          string
+		inDockerfilePath string
+		inPlatform       string
+		inEnv            string
+		inSubnet         string
+		inSecurityGroups []string
+
+		setupMocks func(m runTaskMocks)
+
+		wantedError error
+	}{
+		"builds the image and runs the tasks for the requested platform": {
+			inDockerfilePath: "build/Dockerfile",
+			inPlatform:       "linux/arm64",
+			inEnv:            "test",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:     "my-task",
+					CPU:      256,
+					Memory:   512,
+					Command:  []string{"echo", "hello"},
+					EnvVars:  map[string]string{"NAME": "my-name"},
+					Platform: "linux/arm64",
+					App:      "my-app",
+					Env:      "test",
+				}).Return(nil)
+				m.deployer.EXPECT().TaskRepositoryURI("my-task").Return(mockRepoURI, nil)
+				m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "secret"}, nil)
+				m.builder.EXPECT().Login(mockRepoURI, "AWS", "secret").Return(nil)
+				m.builder.EXPECT().BuildPlatforms([]string{mockRepoURI}, "latest", "build/Dockerfile", []string{"linux/arm64"}).Return(nil)
+				m.builder.EXPECT().IndexDigest(mockRepoURI, "latest").Return("sha256:index", nil)
+				m.builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.envOutputs.EXPECT().ServiceStackOutputs("my-app-test").Return(map[string]string{
+					"ClusterId":                "my-cluster",
+					"PublicSubnets":            "subnet-1,subnet-2",
+					"EnvironmentSecurityGroup": "sg-1",
+				}, nil)
+				m.runner.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "my-cluster",
+					Count:          1,
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1"},
+					TaskFamilyName: "copilot-my-task",
+				}).Return([]string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1"}, nil)
+			},
+		},
+		"builds and pushes the image for the host platform by default": {
+			inEnv: config.EnvNameNone,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:    "my-task",
+					CPU:     256,
+					Memory:  512,
+					Command: []string{"echo", "hello"},
+					EnvVars: map[string]string{"NAME": "my-name"},
+				}).Return(nil)
+				m.deployer.EXPECT().TaskRepositoryURI("my-task").Return(mockRepoURI, nil)
+				m.builder.EXPECT().Build(mockRepoURI, "latest", "Dockerfile").Return(nil)
+				m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{Username: "AWS", Password: "secret"}, nil)
+				m.builder.EXPECT().Login(mockRepoURI, "AWS", "secret").Return(nil)
+				m.builder.EXPECT().Push(mockRepoURI, "latest").Return(nil)
+				m.builder.EXPECT().RepoDigest(mockRepoURI, "latest").Return("sha256:image", nil)
+				m.builder.EXPECT().BuildPlatforms(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.subnetGetter.EXPECT().DefaultSubnets().Return([]string{"subnet-default"}, nil)
+				m.runner.EXPECT().RunTask(ecs.RunTaskInput{
+					Count:          1,
+					Subnets:        []string{"subnet-default"},
+					TaskFamilyName: "copilot-my-task",
+				}).Return([]string{"arn"}, nil)
+			},
+		},
+		"skips the build if an image is provided": {
+			inImage:          "nginx",
+			inEnv:            config.EnvNameNone,
+			inSubnet:         "subnet-1",
+			inSecurityGroups: []string{"sg-1"},
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().TaskRepositoryURI(gomock.Any()).Times(0)
+				m.subnetGetter.EXPECT().DefaultSubnets().Times(0)
+				m.runner.EXPECT().RunTask(ecs.RunTaskInput{
+					Count:          1,
+					Subnets:        []string{"subnet-1"},
+					SecurityGroups: []string{"sg-1"},
+					TaskFamilyName: "copilot-my-task",
+				}).Return([]string{"arn"}, nil)
+			},
+		},
+		"error deploying the task resources": {
+			inImage: "nginx",
+			inEnv:   config.EnvNameNone,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("deploy resources for task my-task: some error"),
+		},
+		"error building the image for the platform": {
+			inPlatform: "linux/arm64",
+			inEnv:      config.EnvNameNone,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().TaskRepositoryURI("my-task").Return(mockRepoURI, nil)
+				m.ecr.EXPECT().GetECRAuth().Return(ecr.Auth{}, nil)
+				m.builder.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.builder.EXPECT().BuildPlatforms(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("build Dockerfile at Dockerfile with tag latest: some error"),
+		},
+		"error if the default VPC has no subnets": {
+			inImage: "nginx",
+			inEnv:   config.EnvNameNone,
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil)
+				m.subnetGetter.EXPECT().DefaultSubnets().Return(nil, nil)
+			},
+			wantedError: errors.New("no default subnets found in the default VPC, specify a subnet with --subnet"),
+		},
+		"error running the tasks": {
+			inImage:  "nginx",
+			inEnv:    config.EnvNameNone,
+			inSubnet: "subnet-1",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil)
+				m.runner.EXPECT().RunTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("run task my-task: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := runTaskMocks{
+				deployer:     mocks.NewMocktaskDeployer(ctrl),
+				builder:      mocks.NewMockimageBuilder(ctrl),
+				ecr:          mocks.NewMockecrService(ctrl),
+				runner:       mocks.NewMocktaskRunner(ctrl),
+				subnetGetter: mocks.NewMockdefaultSubnetsGetter(ctrl),
+				envOutputs:   mocks.NewMocksvcStackOutputsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := runTaskOpts{
+				runTaskVars: runTaskVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					count:  1,
+					cpu:    256,
+					memory: 512,
+
+					groupName:      "my-task",
+					image:          tc.inImage,
+					dockerfilePath: tc.inDockerfilePath,
+					platform:       tc.inPlatform,
+					env:            tc.inEnv,
+					subnet:         tc.inSubnet,
+					securityGroups: tc.inSecurityGroups,
+
+					envVars:  map[string]string{"NAME": "my-name"},
+					commands: []string{"echo", "hello"},
+				},
+				builder: m.builder,
+				configureClients: func(o *runTaskOpts) error {
+					o.deployer = m.deployer
+					o.ecr = m.ecr
+					o.runner = m.runner
+					o.subnetGetter = m.subnetGetter
+					o.envOutputs = m.envOutputs
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,9 @@ type Application struct {
 	Domain    string            `json:"domain"`         // Existing domain name in Route53. An empty domain name means the user does not have one.
 	Version   string            `json:"version"`        // The version of the app layout in the underlying datastore (e.g. SSM).
 	Tags      map[string]string `json:"tags,omitempty"` // Labels to apply to resources created within the app.
+	Metadata                    // Optional ownership and routing information.
+
+	version int64 // Version of the stored configuration when it was read, used to detect concurrent modifications.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -27,6 +30,7 @@ func (a *Application) RequiresDNSDelegation() bool {
 }
 
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
+// If the application already exists, it returns ErrApplicationAlreadyExists.
 func (s *Store) CreateApplication(application *Application) error {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
 	application.Version = schemaVersion
@@ -47,7 +51,9 @@ func (s *Store) CreateApplication(application *Application) error {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
 			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
+				return &ErrApplicationAlreadyExists{
+					ApplicationName: application.Name,
+				}
 			}
 		}
 		return fmt.Errorf("create application %s: %w", application.Name, err)
@@ -81,21 +87,52 @@ func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	if err := json.Unmarshal([]byte(*applicationParam.Parameter.Value), &application); err != nil {
 		return nil, fmt.Errorf("read configuration for application %s: %w", applicationName, err)
 	}
+	if err := checkSchemaVersion(applicationName, application.Version); err != nil {
+		return nil, err
+	}
+	application.version = aws.Int64Value(applicationParam.Parameter.Version)
 	return &application, nil
 }
 
+// UpdateApplication overwrites the configuration of an existing application. If the application was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *Store) UpdateApplication(application *Application) error {
+	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
+	version, err := s.putIfVersion(applicationPath, application.version, application)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				account, region := s.getCallerAccountAndRegion()
+				return &ErrNoSuchApplication{
+					ApplicationName: application.Name,
+					AccountID:       account,
+					Region:          region,
+				}
+			}
+		}
+		return fmt.Errorf("update application %s: %w", application.Name, err)
+	}
+	application.version = version
+	return nil
+}
+
 // ListApplications returns the list of existing applications in the customer's account and region.
 func (s *Store) ListApplications() ([]*Application, error) {
 	var applications []*Application
-	serializedApplications, err := s.listParams(rootApplicationPath)
+	params, err := s.listParameters(rootApplicationPath)
 	if err != nil {
 		return nil, fmt.Errorf("list applications: %w", err)
 	}
-	for _, serializedApplication := range serializedApplications {
+	for _, param := range params {
 		var application Application
-		if err := json.Unmarshal([]byte(*serializedApplication), &application); err != nil {
+		if err := json.Unmarshal([]byte(*param.Value), &application); err != nil {
 			return nil, fmt.Errorf("read application configuration: %w", err)
 		}
+		if err := checkSchemaVersion(application.Name, application.Version); err != nil {
+			return nil, err
+		}
+		application.version = aws.Int64Value(param.Version)
 
 		applications = append(applications, &application)
 	}
@@ -106,10 +143,7 @@ func (s *Store) ListApplications() ([]*Application, error) {
 func (s *Store) DeleteApplication(name string) error {
 	paramName := fmt.Sprintf(fmtApplicationPath, name)
 
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
+	err := s.deleteLocked(paramName)
 	if err != nil {
 		awserr, ok := err.(awserr.Error)
 		if !ok {
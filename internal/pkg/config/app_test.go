@@ -75,6 +75,19 @@ func TestStore_ListApplications(t *testing.T) {
 			wantedApplicationNames: nil,
 			wantedErr:              fmt.Errorf("list applications: broken"),
 		},
+		"with an application written by a newer version": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (output *ssm.GetParametersByPathOutput, e error) {
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Name:  aws.String("/copilot/applications/chicken"),
+							Value: aws.String(`{"name":"chicken","version":"100.0"}`),
+						},
+					},
+				}, nil
+			},
+			wantedErr: &ErrSchemaVersionTooNew{ApplicationName: "chicken", Version: "100.0", SupportedVersion: schemaVersion},
+		},
 		"with paginated response": {
 			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (output *ssm.GetParametersByPathOutput, e error) {
 				require.Equal(t, rootApplicationPath, *param.Path)
@@ -193,6 +206,22 @@ func TestStore_GetApplication(t *testing.T) {
 				Region:          "us-west-2",
 			},
 		},
+		"with application stored by a newer CLI": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testApplicationPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testApplicationPath),
+						Value: aws.String(`{"name":"chicken","version":"2.0"}`),
+					},
+				}, nil
+			},
+			wantedErr: &ErrSchemaVersionTooNew{
+				ApplicationName:  "chicken",
+				Version:          "2.0",
+				SupportedVersion: "1.0",
+			},
+		},
 		"with malformed json": {
 			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
 				require.Equal(t, testApplicationPath, *param.Name)
@@ -266,7 +295,7 @@ func TestStore_CreateApplication(t *testing.T) {
 			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
 				return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", fmt.Errorf("Already Exists"))
 			},
-			wantedErr: nil,
+			wantedErr: &ErrApplicationAlreadyExists{ApplicationName: "phonetool"},
 		},
 		"with SSM error": {
 			inApplication: &Application{Name: "phonetool", AccountID: "1234"},
@@ -337,10 +366,10 @@ func TestDeleteApplication(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssmClient: &mockSSM{
+				ssmClient: grantLocks(&mockSSM{
 					t:                   t,
 					mockDeleteParameter: test.mockDeleteParameter,
-				},
+				}),
 			}
 
 			got := store.DeleteApplication(mockApplicationName)
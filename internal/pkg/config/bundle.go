@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// bundleVersion is the current format version of an exported application bundle.
+const bundleVersion = "1.0"
+
+// Bundle is a portable snapshot of an application's configuration that can be imported
+// to recreate the application in another account or region.
+type Bundle struct {
+	Version      string               `json:"version"`      // Format version of the bundle.
+	Application  *BundleApplication   `json:"application"`  // The application without its account.
+	Environments []*BundleEnvironment `json:"environments"` // Environments without account-specific values.
+	Services     []*BundleService     `json:"services"`     // Services in the application.
+}
+
+// BundleApplication is an application's configuration stripped of account-specific values.
+type BundleApplication struct {
+	Name   string            `json:"name"`
+	Domain string            `json:"domain,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Metadata
+}
+
+// BundleEnvironment is an environment's configuration stripped of account-specific values,
+// along with the parameters of its deployed stack that carry over to another account.
+type BundleEnvironment struct {
+	Name   string `json:"name"`
+	Region string `json:"region"`
+	Prod   bool   `json:"prod"`
+	Metadata
+	StackParameters map[string]string `json:"stackParameters,omitempty"`
+}
+
+// BundleService is a service's configuration.
+type BundleService struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Metadata
+}
+
+// NewBundle returns a bundle for the application, its environments and services.
+// Account IDs, registry URLs and role ARNs are left out since they don't carry over to another account.
+func NewBundle(app *Application, envs []*Environment, svcs []*Service) *Bundle {
+	b := &Bundle{
+		Version: bundleVersion,
+		Application: &BundleApplication{
+			Name:     app.Name,
+			Domain:   app.Domain,
+			Tags:     app.Tags,
+			Metadata: app.Metadata,
+		},
+	}
+	for _, env := range envs {
+		b.Environments = append(b.Environments, &BundleEnvironment{
+			Name:     env.Name,
+			Region:   env.Region,
+			Prod:     env.Prod,
+			Metadata: env.Metadata,
+		})
+	}
+	for _, svc := range svcs {
+		b.Services = append(b.Services, &BundleService{
+			Name:     svc.Name,
+			Type:     svc.Type,
+			Metadata: svc.Metadata,
+		})
+	}
+	return b
+}
+
+// Marshal serializes the bundle to indented JSON.
+func (b *Bundle) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// UnmarshalBundle deserializes and validates a bundle.
+func UnmarshalBundle(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("unmarshal bundle: %w", err)
+	}
+	if b.Version != bundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %s, expected %s", b.Version, bundleVersion)
+	}
+	if b.Application == nil || b.Application.Name == "" {
+		return nil, errors.New("bundle is missing the application name")
+	}
+	return &b, nil
+}
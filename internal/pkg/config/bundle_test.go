@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBundle(t *testing.T) {
+	app := &Application{Name: "phonetool", AccountID: "1234", Domain: "example.com", Version: "1.0"}
+	envs := []*Environment{
+		{
+			App:              "phonetool",
+			Name:             "test",
+			Region:           "us-west-2",
+			AccountID:        "1234",
+			RegistryURL:      "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool",
+			ExecutionRoleARN: "arn:aws:iam::1234:role/phonetool-test-CFNExecutionRole",
+			ManagerRoleARN:   "arn:aws:iam::1234:role/phonetool-test-EnvManagerRole",
+		},
+	}
+	svcs := []*Service{{App: "phonetool", Name: "api", Type: "Backend Service"}}
+
+	b := NewBundle(app, envs, svcs)
+
+	require.Equal(t, &Bundle{
+		Version:      bundleVersion,
+		Application:  &BundleApplication{Name: "phonetool", Domain: "example.com"},
+		Environments: []*BundleEnvironment{{Name: "test", Region: "us-west-2"}},
+		Services:     []*BundleService{{Name: "api", Type: "Backend Service"}},
+	}, b)
+}
+
+func TestUnmarshalBundle(t *testing.T) {
+	testCases := map[string]struct {
+		in        string
+		wanted    *Bundle
+		wantedErr error
+	}{
+		"round trips a marshaled bundle": {
+			in: `{"version":"1.0","application":{"name":"phonetool"},"environments":[{"name":"test","region":"us-west-2","prod":false,"stackParameters":{"IncludePublicLoadBalancer":"false"}}],"services":null}`,
+			wanted: &Bundle{
+				Version:     "1.0",
+				Application: &BundleApplication{Name: "phonetool"},
+				Environments: []*BundleEnvironment{
+					{Name: "test", Region: "us-west-2", StackParameters: map[string]string{"IncludePublicLoadBalancer": "false"}},
+				},
+			},
+		},
+		"unsupported version": {
+			in:        `{"version":"2.0","application":{"name":"phonetool"}}`,
+			wantedErr: errors.New("unsupported bundle version 2.0, expected 1.0"),
+		},
+		"missing application": {
+			in:        `{"version":"1.0"}`,
+			wantedErr: errors.New("bundle is missing the application name"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b, err := UnmarshalBundle([]byte(tc.in))
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b)
+
+			data, err := b.Marshal()
+			require.NoError(t, err)
+			require.JSONEq(t, tc.in, string(data))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	// fmtSvcDeploymentsParamPath is the path guarding the deployment history of a service in an environment.
+	// Revisions are stored under a nested path so that the lock of the history is not returned when listing them.
+	fmtSvcDeploymentsParamPath = "/copilot/applications/%s/environments/%s/deployments/%s"
+	rootDeploymentParamPath    = fmtSvcDeploymentsParamPath + "/revisions/"
+	fmtDeploymentParamPath     = rootDeploymentParamPath + "%d" // path for a revision of a service in an environment
+
+	// MaxDeploymentRevisions is the number of revisions kept in the history of a service in an environment.
+	MaxDeploymentRevisions = 20
+)
+
+// Deployment represents a deployment of a service to an environment.
+type Deployment struct {
+	App          string            `json:"app"`                  // Name of the app the service belongs to.
+	Env          string            `json:"env"`                  // Name of the environment the service was deployed to.
+	Service      string            `json:"service"`              // Name of the deployed service.
+	Revision     int               `json:"revision"`             // Sequence number of the deployment, starting at 1.
+	ImageTag     string            `json:"imageTag"`             // Tag of the deployed container image.
+	ImageDigest  string            `json:"imageDigest"`          // Digest of the deployed container image.
+	ManifestHash string            `json:"manifestHash"`         // SHA-256 of the service's manifest at the time of the deployment.
+	TemplateURL  string            `json:"templateURL"`          // URL of the deployed stack template.
+	Parameters   map[string]string `json:"parameters"`           // Parameters of the deployed stack.
+	Tags         map[string]string `json:"tags"`                 // Tags of the deployed stack.
+	DeployedBy   string            `json:"deployedBy"`           // ARN of the identity that deployed the service.
+	DeployedAt   time.Time         `json:"deployedAt"`           // Time of the deployment.
+	RollbackOf   int               `json:"rollbackOf,omitempty"` // Revision that was redeployed, if the deployment is a rollback.
+}
+
+// CreateDeployment adds the deployment to the history of the service in the environment
+// and sets its revision to the revision following the latest one.
+// Only the latest MaxDeploymentRevisions revisions are kept, older revisions are deleted.
+func (s *Store) CreateDeployment(d *Deployment) error {
+	historyPath := fmt.Sprintf(fmtSvcDeploymentsParamPath, d.App, d.Env, d.Service)
+	err := s.withLock(historyPath, func() error {
+		deployments, err := s.ListDeployments(d.App, d.Env, d.Service)
+		if err != nil {
+			return err
+		}
+		d.Revision = nextRevision(deployments)
+		data, err := marshal(d)
+		if err != nil {
+			return fmt.Errorf("serializing deployment: %w", err)
+		}
+		_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+			Name:        aws.String(fmt.Sprintf(fmtDeploymentParamPath, d.App, d.Env, d.Service, d.Revision)),
+			Description: aws.String(fmt.Sprintf("Copilot deployment %d of service %s", d.Revision, d.Service)),
+			Type:        aws.String(ssm.ParameterTypeString),
+			Value:       aws.String(data),
+		})
+		if err != nil {
+			return err
+		}
+		return s.deleteRevisions(expiredDeployments(append(deployments, d)))
+	})
+	if err != nil {
+		return fmt.Errorf("record deployment of service %s in environment %s: %w", d.Service, d.Env, err)
+	}
+	return nil
+}
+
+// DeleteDeployments deletes the deployment history of a service in an environment.
+func (s *Store) DeleteDeployments(appName, envName, svcName string) error {
+	historyPath := fmt.Sprintf(fmtSvcDeploymentsParamPath, appName, envName, svcName)
+	err := s.withLock(historyPath, func() error {
+		deployments, err := s.ListDeployments(appName, envName, svcName)
+		if err != nil {
+			return err
+		}
+		return s.deleteRevisions(deployments)
+	})
+	if err != nil {
+		return fmt.Errorf("delete deployments of service %s in environment %s: %w", svcName, envName, err)
+	}
+	return nil
+}
+
+// deleteRevisions deletes the parameters of the deployments, the caller must hold the lock of their history.
+func (s *Store) deleteRevisions(deployments []*Deployment) error {
+	for _, d := range deployments {
+		_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+			Name: aws.String(fmt.Sprintf(fmtDeploymentParamPath, d.App, d.Env, d.Service, d.Revision)),
+		})
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+				continue
+			}
+			return fmt.Errorf("delete revision %d: %w", d.Revision, err)
+		}
+	}
+	return nil
+}
+
+// GetDeployment gets a revision of a service in an environment. If the revision can't be found,
+// it returns ErrNoSuchDeployment.
+func (s *Store) GetDeployment(appName, envName, svcName string, revision int) (*Deployment, error) {
+	param, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtDeploymentParamPath, appName, envName, svcName, revision)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil, &ErrNoSuchDeployment{
+					ServiceName:     svcName,
+					EnvironmentName: envName,
+					Revision:        revision,
+				}
+			}
+		}
+		return nil, fmt.Errorf("get revision %d of service %s in environment %s: %w", revision, svcName, envName, err)
+	}
+	var d Deployment
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Parameter.Value)), &d); err != nil {
+		return nil, fmt.Errorf("read revision %d of service %s in environment %s: %w", revision, svcName, envName, err)
+	}
+	return &d, nil
+}
+
+// ListDeployments returns the deployments of a service in an environment, from the oldest to the latest revision.
+func (s *Store) ListDeployments(appName, envName, svcName string) ([]*Deployment, error) {
+	params, err := s.listParameters(fmt.Sprintf(rootDeploymentParamPath, appName, envName, svcName))
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of service %s in environment %s: %w", svcName, envName, err)
+	}
+	var deployments []*Deployment
+	for _, param := range params {
+		var d Deployment
+		if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &d); err != nil {
+			return nil, fmt.Errorf("read deployments of service %s in environment %s: %w", svcName, envName, err)
+		}
+		deployments = append(deployments, &d)
+	}
+	sortDeployments(deployments)
+	return deployments, nil
+}
+
+// sortDeployments sorts deployments from the oldest to the latest revision.
+func sortDeployments(deployments []*Deployment) {
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].Revision < deployments[j].Revision
+	})
+}
+
+// expiredDeployments returns the oldest of the sorted deployments that exceed MaxDeploymentRevisions.
+func expiredDeployments(deployments []*Deployment) []*Deployment {
+	if len(deployments) <= MaxDeploymentRevisions {
+		return nil
+	}
+	return deployments[:len(deployments)-MaxDeploymentRevisions]
+}
+
+// nextRevision returns the revision following the latest of the sorted deployments.
+func nextRevision(deployments []*Deployment) int {
+	if len(deployments) == 0 {
+		return 1
+	}
+	return deployments[len(deployments)-1].Revision + 1
+}
@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	// Paths of the deployment locks of an environment. The lock of the environment itself guards the operations
+	// on its stack, while the lock of each service guards the operations on the stack of the service in the environment.
+	fmtEnvDeploymentLockParamPath  = "/copilot/applications/%s/environments/%s/deployment-locks/environment"
+	fmtSvcDeploymentLocksParamPath = "/copilot/applications/%s/environments/%s/deployment-locks/services"
+	fmtSvcDeploymentLockParamPath  = fmtSvcDeploymentLocksParamPath + "/%s"
+
+	// DeploymentLockTTL is how long a deployment lock is held before it expires, unless it's released earlier.
+	// Expired locks can be acquired by anyone, so that a lock that wasn't released by an interrupted operation
+	// doesn't block deployments forever.
+	DeploymentLockTTL = time.Hour
+
+	unknownLockHolder = "unknown"
+)
+
+// DeploymentLock is a lease on the deployments of a service, or of the environment itself, in an environment.
+// Only the holder of the lease can deploy until it releases the lease or the lease expires.
+type DeploymentLock struct {
+	App        string    `json:"app"`               // Name of the app the environment belongs to.
+	Env        string    `json:"env"`               // Name of the environment.
+	Service    string    `json:"service,omitempty"` // Name of the service, empty if the lock guards the environment itself.
+	ID         string    `json:"id"`                // Identifies the lease, so that it's only released by its holder.
+	Operation  string    `json:"operation"`         // Command holding the lock, for example "svc deploy".
+	Holder     string    `json:"holder"`            // ARN of the identity holding the lock.
+	Host       string    `json:"host"`              // Name of the machine the lock is held from.
+	AcquiredAt time.Time `json:"acquiredAt"`        // Time the lock was acquired.
+	ExpiresAt  time.Time `json:"expiresAt"`         // Time after which the lock can be acquired by anyone.
+}
+
+// Expired returns true if the lease of the lock is over.
+func (l *DeploymentLock) Expired() bool {
+	return !time.Now().Before(l.ExpiresAt)
+}
+
+// resource returns a human readable name of the resource guarded by the lock.
+func (l *DeploymentLock) resource() string {
+	return lockedResource(l.Env, l.Service)
+}
+
+// lease starts a new lease of the lock held by holder.
+func (l *DeploymentLock) lease(holder string) error {
+	if l.ID == "" {
+		id := make([]byte, 8)
+		if _, err := rand.Read(id); err != nil {
+			return fmt.Errorf("generate lock ID: %w", err)
+		}
+		l.ID = hex.EncodeToString(id)
+	}
+	l.Holder = holder
+	l.Host, _ = os.Hostname()
+	l.AcquiredAt = time.Now().UTC()
+	l.ExpiresAt = l.AcquiredAt.Add(DeploymentLockTTL)
+	return nil
+}
+
+// isHeldByOther returns true if the current lock is an unexpired lease of someone else than l.
+func (l *DeploymentLock) isHeldByOther(current *DeploymentLock) bool {
+	return current != nil && current.ID != l.ID && !current.Expired()
+}
+
+// AcquireDeploymentLock leases the lock to the caller of the store, and fills in the details of the lease.
+// If the lock is held by someone else, it returns ErrDeploymentLocked with the current holder of the lock.
+// Acquiring a lock that's already held by the same lease renews the lease.
+// The lock of a service can't be acquired while the lock of its environment is held by someone else, and the lock of
+// an environment can't be acquired while the lock of any of its services is held by someone else.
+func (s *Store) AcquireDeploymentLock(lock *DeploymentLock) error {
+	path := deploymentLockParamPath(lock.App, lock.Env, lock.Service)
+	// All the deployment locks of an environment are written under the same configuration lock, so that the locks of
+	// the environment and of its services are checked against each other consistently.
+	err := s.withLock(deploymentLockParamPath(lock.App, lock.Env, ""), func() error {
+		current, err := s.getDeploymentLock(path)
+		if err != nil {
+			return err
+		}
+		if lock.isHeldByOther(current) {
+			return &ErrDeploymentLocked{Lock: current}
+		}
+		if err := s.checkRelatedDeploymentLocks(lock); err != nil {
+			return err
+		}
+		if err := lock.lease(s.callerARN()); err != nil {
+			return err
+		}
+		data, err := marshal(lock)
+		if err != nil {
+			return fmt.Errorf("serializing lock: %w", err)
+		}
+		_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+			Name:        aws.String(path),
+			Description: aws.String(fmt.Sprintf("Copilot deployment lock of %s", lock.resource())),
+			Type:        aws.String(ssm.ParameterTypeString),
+			Value:       aws.String(data),
+			Overwrite:   aws.Bool(true),
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("acquire deployment lock of %s: %w", lock.resource(), err)
+	}
+	return nil
+}
+
+// checkRelatedDeploymentLocks returns ErrDeploymentLocked if the environment of a service lock, or any service of an
+// environment lock, is locked by someone else.
+func (s *Store) checkRelatedDeploymentLocks(lock *DeploymentLock) error {
+	if lock.Service != "" {
+		// Services can't be changed while their environment is locked, for example while it's deleted.
+		envLock, err := s.getDeploymentLock(deploymentLockParamPath(lock.App, lock.Env, ""))
+		if err != nil {
+			return err
+		}
+		if lock.isHeldByOther(envLock) {
+			return &ErrDeploymentLocked{Lock: envLock}
+		}
+		return nil
+	}
+	// The environment can't be changed while any of its services is being changed.
+	params, err := s.listParameters(fmt.Sprintf(fmtSvcDeploymentLocksParamPath, lock.App, lock.Env))
+	if err != nil {
+		return err
+	}
+	for _, param := range params {
+		var svcLock DeploymentLock
+		if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &svcLock); err != nil {
+			return fmt.Errorf("read lock %s: %w", aws.StringValue(param.Name), err)
+		}
+		if lock.isHeldByOther(&svcLock) {
+			return &ErrDeploymentLocked{Lock: &svcLock}
+		}
+	}
+	return nil
+}
+
+// ReleaseDeploymentLock ends the lease of the lock. If the lock was broken and acquired by someone else in the
+// meantime, it's left untouched.
+func (s *Store) ReleaseDeploymentLock(lock *DeploymentLock) error {
+	path := deploymentLockParamPath(lock.App, lock.Env, lock.Service)
+	err := s.withLock(deploymentLockParamPath(lock.App, lock.Env, ""), func() error {
+		current, err := s.getDeploymentLock(path)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.ID != lock.ID {
+			return nil
+		}
+		return s.deleteDeploymentLock(path)
+	})
+	if err != nil {
+		return fmt.Errorf("release deployment lock of %s: %w", lock.resource(), err)
+	}
+	return nil
+}
+
+// GetDeploymentLock gets the lock of a service in an environment, or of the environment itself if svcName is empty.
+// If nobody ever acquired the lock or it was released, it returns ErrNoSuchDeploymentLock. Expired locks are returned.
+func (s *Store) GetDeploymentLock(appName, envName, svcName string) (*DeploymentLock, error) {
+	lock, err := s.getDeploymentLock(deploymentLockParamPath(appName, envName, svcName))
+	if err != nil {
+		return nil, fmt.Errorf("get deployment lock of %s: %w", lockedResource(envName, svcName), err)
+	}
+	if lock == nil {
+		return nil, &ErrNoSuchDeploymentLock{
+			ServiceName:     svcName,
+			EnvironmentName: envName,
+		}
+	}
+	return lock, nil
+}
+
+// BreakDeploymentLock releases the lock of a service in an environment, or of the environment itself if svcName
+// is empty, regardless of its holder.
+func (s *Store) BreakDeploymentLock(appName, envName, svcName string) error {
+	path := deploymentLockParamPath(appName, envName, svcName)
+	if err := s.withLock(deploymentLockParamPath(appName, envName, ""), func() error {
+		return s.deleteDeploymentLock(path)
+	}); err != nil {
+		return fmt.Errorf("break deployment lock of %s: %w", lockedResource(envName, svcName), err)
+	}
+	return nil
+}
+
+// getDeploymentLock returns the lock stored at path, or nil if there is none.
+func (s *Store) getDeploymentLock(path string) (*DeploymentLock, error) {
+	param, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(path),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var lock DeploymentLock
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Parameter.Value)), &lock); err != nil {
+		return nil, fmt.Errorf("read lock %s: %w", path, err)
+	}
+	return &lock, nil
+}
+
+// deleteDeploymentLock deletes the lock stored at path. A missing lock is not an error.
+func (s *Store) deleteDeploymentLock(path string) error {
+	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(path),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+		return nil
+	}
+	return err
+}
+
+// callerARN returns the ARN of the caller with a best effort. If it fails to fetch the ARN, this returns "unknown".
+func (s *Store) callerARN() string {
+	caller, err := s.idClient.Get()
+	if err != nil {
+		return unknownLockHolder
+	}
+	return caller.ARN
+}
+
+func deploymentLockParamPath(appName, envName, svcName string) string {
+	if svcName == "" {
+		return fmt.Sprintf(fmtEnvDeploymentLockParamPath, appName, envName)
+	}
+	return fmt.Sprintf(fmtSvcDeploymentLockParamPath, appName, envName, svcName)
+}
+
+// lockedResource returns a human readable name of the resource guarded by a deployment lock.
+func lockedResource(envName, svcName string) string {
+	if svcName == "" {
+		return fmt.Sprintf("environment %s", envName)
+	}
+	return fmt.Sprintf("service %s in environment %s", svcName, envName)
+}
@@ -0,0 +1,239 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_AcquireDeploymentLock(t *testing.T) {
+	lockPath := fmt.Sprintf(fmtSvcDeploymentLockParamPath, "phonetool", "test", "api")
+	envLockPath := fmt.Sprintf(fmtEnvDeploymentLockParamPath, "phonetool", "test")
+	svcLocksPath := fmt.Sprintf(fmtSvcDeploymentLocksParamPath, "phonetool", "test")
+	heldLock := DeploymentLock{
+		App:        "phonetool",
+		Env:        "test",
+		Service:    "api",
+		ID:         "abcd",
+		Operation:  "svc deploy",
+		Holder:     "arn:aws:iam::1234:user/alice",
+		Host:       "laptop",
+		AcquiredAt: time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC),
+		ExpiresAt:  time.Now().Add(time.Minute),
+	}
+	held, err := marshal(heldLock)
+	require.NoError(t, err)
+	expiredLock := heldLock
+	expiredLock.ExpiresAt = time.Now().Add(-time.Minute)
+	expired, err := marshal(expiredLock)
+	require.NoError(t, err)
+	heldEnvLock := heldLock
+	heldEnvLock.Service = ""
+	heldEnvLock.Operation = "env delete"
+	heldEnv, err := marshal(heldEnvLock)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		inService               string
+		inLockID                string
+		mockGetParameter        func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+		mockPutParameter        func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+
+		wantedErr error
+	}{
+		"acquires a lock that nobody holds": {
+			inService: "api",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Contains(t, []string{lockPath, envLockPath}, aws.StringValue(param.Name))
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, lockPath, aws.StringValue(param.Name))
+				require.Contains(t, aws.StringValue(param.Value), `"holder":"arn:aws:iam::1234:user/bob"`)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"acquires an expired lock": {
+			inService: "api",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(expired)}}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.True(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"renews a lock held by the same lease": {
+			inService: "api",
+			inLockID:  "abcd",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(held)}}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Contains(t, aws.StringValue(param.Value), `"id":"abcd"`)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"does not acquire a lock held by someone else": {
+			inService: "api",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(held)}}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.FailNow(t, "a lock held by someone else must not be overwritten")
+				return nil, nil
+			},
+			wantedErr: fmt.Errorf(`acquire deployment lock of service api in environment test: service api in environment test is locked by arn:aws:iam::1234:user/alice from laptop for "svc deploy" since 2020-08-01T10:00:00Z until %s`,
+				heldLock.ExpiresAt.Format(time.RFC3339)),
+		},
+		"does not acquire the lock of a service while its environment is locked by someone else": {
+			inService: "api",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				if aws.StringValue(param.Name) == envLockPath {
+					return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(heldEnv)}}, nil
+				}
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.FailNow(t, "a service must not be locked while its environment is locked by someone else")
+				return nil, nil
+			},
+			wantedErr: fmt.Errorf(`acquire deployment lock of service api in environment test: environment test is locked by arn:aws:iam::1234:user/alice from laptop for "env delete" since 2020-08-01T10:00:00Z until %s`,
+				heldLock.ExpiresAt.Format(time.RFC3339)),
+		},
+		"acquires the lock of an environment while its services are not locked by someone else": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, envLockPath, aws.StringValue(param.Name))
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, svcLocksPath, aws.StringValue(param.Path))
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{{Name: aws.String(lockPath), Value: aws.String(expired)}},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, envLockPath, aws.StringValue(param.Name))
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"does not acquire the lock of an environment while one of its services is locked by someone else": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{{Name: aws.String(lockPath), Value: aws.String(held)}},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.FailNow(t, "an environment must not be locked while its services are locked by someone else")
+				return nil, nil
+			},
+			wantedErr: fmt.Errorf(`acquire deployment lock of environment test: service api in environment test is locked by arn:aws:iam::1234:user/alice from laptop for "svc deploy" since 2020-08-01T10:00:00Z until %s`,
+				heldLock.ExpiresAt.Format(time.RFC3339)),
+		},
+		"with SSM error": {
+			inService: "api",
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("acquire deployment lock of service api in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				idClient: mockIdentityService{
+					mockIdentityServiceGet: func() (identity.Caller, error) {
+						return identity.Caller{
+							ARN:         "arn:aws:iam::1234:user/bob",
+							RootUserARN: "arn:aws:iam::1234:root",
+						}, nil
+					},
+				},
+				ssmClient: grantLocks(&mockSSM{
+					t:                       t,
+					mockGetParameter:        tc.mockGetParameter,
+					mockGetParametersByPath: tc.mockGetParametersByPath,
+					mockPutParameter:        tc.mockPutParameter,
+				}),
+			}
+			lock := &DeploymentLock{App: "phonetool", Env: "test", Service: tc.inService, ID: tc.inLockID, Operation: "svc deploy"}
+
+			// WHEN
+			err := store.AcquireDeploymentLock(lock)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, lock.ID)
+			require.Equal(t, "arn:aws:iam::1234:user/bob", lock.Holder)
+			require.False(t, lock.Expired())
+		})
+	}
+}
+
+func TestStore_ReleaseDeploymentLock(t *testing.T) {
+	envLockPath := fmt.Sprintf(fmtEnvDeploymentLockParamPath, "phonetool", "test")
+	held, err := marshal(DeploymentLock{App: "phonetool", Env: "test", ID: "abcd"})
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		inLockID string
+
+		wantedDeleted bool
+	}{
+		"deletes the lock held by the lease": {
+			inLockID:      "abcd",
+			wantedDeleted: true,
+		},
+		"leaves the lock acquired by someone else untouched": {
+			inLockID: "efgh",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			deleted := false
+			store := &Store{
+				ssmClient: grantLocks(&mockSSM{
+					t: t,
+					mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+						require.Equal(t, envLockPath, aws.StringValue(param.Name))
+						return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(held)}}, nil
+					},
+					mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+						require.Equal(t, envLockPath, aws.StringValue(param.Name))
+						deleted = true
+						return &ssm.DeleteParameterOutput{}, nil
+					},
+				}),
+			}
+
+			// WHEN
+			err := store.ReleaseDeploymentLock(&DeploymentLock{App: "phonetool", Env: "test", ID: tc.inLockID})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeleted, deleted)
+		})
+	}
+}
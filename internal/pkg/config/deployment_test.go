@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_CreateDeployment(t *testing.T) {
+	revisionsPath := fmt.Sprintf(rootDeploymentParamPath, "phonetool", "test", "api")
+	first, err := marshal(Deployment{App: "phonetool", Env: "test", Service: "api", Revision: 1, ImageTag: "v1"})
+	require.NoError(t, err)
+	second, err := marshal(Deployment{App: "phonetool", Env: "test", Service: "api", Revision: 2, ImageTag: "v2"})
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+		mockPutParameter        func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+
+		wantedRevision int
+		wantedDeletes  []string
+		wantedErr      error
+	}{
+		"first deployment of the service": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, revisionsPath, aws.StringValue(param.Path))
+				return &ssm.GetParametersByPathOutput{}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, revisionsPath+"1", aws.StringValue(param.Name))
+				require.False(t, aws.BoolValue(param.Overwrite), "revisions must never be overwritten")
+				return &ssm.PutParameterOutput{}, nil
+			},
+			wantedRevision: 1,
+		},
+		"follows the latest revision": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{Name: aws.String(revisionsPath + "2"), Value: aws.String(second)},
+						{Name: aws.String(revisionsPath + "1"), Value: aws.String(first)},
+					},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, revisionsPath+"3", aws.StringValue(param.Name))
+				return &ssm.PutParameterOutput{}, nil
+			},
+			wantedRevision: 3,
+		},
+		"deletes the revisions that exceed the retained history": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				var params []*ssm.Parameter
+				for revision := 1; revision <= MaxDeploymentRevisions; revision++ {
+					data, err := marshal(Deployment{App: "phonetool", Env: "test", Service: "api", Revision: revision})
+					require.NoError(t, err)
+					params = append(params, &ssm.Parameter{Name: aws.String(fmt.Sprintf("%s%d", revisionsPath, revision)), Value: aws.String(data)})
+				}
+				return &ssm.GetParametersByPathOutput{Parameters: params}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf("%s%d", revisionsPath, MaxDeploymentRevisions+1), aws.StringValue(param.Name))
+				return &ssm.PutParameterOutput{}, nil
+			},
+			wantedRevision: MaxDeploymentRevisions + 1,
+			wantedDeletes:  []string{revisionsPath + "1"},
+		},
+		"with SSM error": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedRevision: 1,
+			wantedErr:      errors.New("record deployment of service api in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var deletes []string
+			store := &Store{
+				ssmClient: grantLocks(&mockSSM{
+					t:                       t,
+					mockGetParametersByPath: tc.mockGetParametersByPath,
+					mockPutParameter:        tc.mockPutParameter,
+					mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+						deletes = append(deletes, aws.StringValue(param.Name))
+						return &ssm.DeleteParameterOutput{}, nil
+					},
+				}),
+			}
+			d := &Deployment{App: "phonetool", Env: "test", Service: "api", ImageTag: "v3"}
+
+			// WHEN
+			err := store.CreateDeployment(d)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedRevision, d.Revision)
+			require.Equal(t, tc.wantedDeletes, deletes)
+		})
+	}
+}
+
+func TestStore_DeleteDeployments(t *testing.T) {
+	revisionsPath := fmt.Sprintf(rootDeploymentParamPath, "phonetool", "test", "api")
+	first, err := marshal(Deployment{App: "phonetool", Env: "test", Service: "api", Revision: 1})
+	require.NoError(t, err)
+	second, err := marshal(Deployment{App: "phonetool", Env: "test", Service: "api", Revision: 2})
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		mockDeleteParameter func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedDeletes []string
+		wantedErr     error
+	}{
+		"deletes every revision": {
+			wantedDeletes: []string{revisionsPath + "1", revisionsPath + "2"},
+		},
+		"ignores revisions that are already deleted": {
+			mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			},
+			wantedDeletes: []string{revisionsPath + "1", revisionsPath + "2"},
+		},
+		"with SSM error": {
+			mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedDeletes: []string{revisionsPath + "1"},
+			wantedErr:     errors.New("delete deployments of service api in environment test: delete revision 1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var deletes []string
+			store := &Store{
+				ssmClient: grantLocks(&mockSSM{
+					t: t,
+					mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+						require.Equal(t, revisionsPath, aws.StringValue(param.Path))
+						return &ssm.GetParametersByPathOutput{
+							Parameters: []*ssm.Parameter{
+								{Name: aws.String(revisionsPath + "2"), Value: aws.String(second)},
+								{Name: aws.String(revisionsPath + "1"), Value: aws.String(first)},
+							},
+						}, nil
+					},
+					mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+						deletes = append(deletes, aws.StringValue(param.Name))
+						if tc.mockDeleteParameter != nil {
+							return tc.mockDeleteParameter(t, param)
+						}
+						return &ssm.DeleteParameterOutput{}, nil
+					},
+				}),
+			}
+
+			// WHEN
+			err := store.DeleteDeployments("phonetool", "test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDeletes, deletes)
+		})
+	}
+}
+
+func TestStore_GetDeployment(t *testing.T) {
+	deployment := Deployment{App: "phonetool", Env: "test", Service: "api", Revision: 2, ImageTag: "v2", ImageDigest: "sha256:abc"}
+	data, err := marshal(deployment)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+
+		wantedDeployment *Deployment
+		wantedErr        error
+	}{
+		"existing revision": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, "/copilot/applications/phonetool/environments/test/deployments/api/revisions/2", aws.StringValue(param.Name))
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(data)},
+				}, nil
+			},
+			wantedDeployment: &deployment,
+		},
+		"missing revision": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			},
+			wantedErr: &ErrNoSuchDeployment{ServiceName: "api", EnvironmentName: "test", Revision: 2},
+		},
+		"with SSM error": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("get revision 2 of service api in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockGetParameter: tc.mockGetParameter,
+				},
+			}
+
+			// WHEN
+			d, err := store.GetDeployment("phonetool", "test", "api", 2)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, d)
+		})
+	}
+}
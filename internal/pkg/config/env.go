@@ -17,18 +17,30 @@ const EnvNameNone = "None"
 
 // Environment represents a deployment environment in an application.
 type Environment struct {
-	App              string `json:"app"`              // Name of the app this environment belongs to.
-	Name             string `json:"name"`             // Name of the environment, must be unique within a App.
-	Region           string `json:"region"`           // Name of the region this environment is stored in.
-	AccountID        string `json:"accountID"`        // Account ID of the account this environment is stored in.
-	Prod             bool   `json:"prod"`             // Whether or not this environment is a production environment.
-	RegistryURL      string `json:"registryURL"`      // URL For ECR Registry for this environment.
-	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
-	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its services.
+	App              string `json:"app"`                    // Name of the app this environment belongs to.
+	Name             string `json:"name"`                   // Name of the environment, must be unique within a App.
+	Region           string `json:"region"`                 // Name of the region this environment is stored in.
+	AccountID        string `json:"accountID"`              // Account ID of the account this environment is stored in.
+	Prod             bool   `json:"prod"`                   // Whether or not this environment is a production environment.
+	PipelineOnly     bool   `json:"pipelineOnly,omitempty"` // Whether or not services can only be deployed to this production environment from a pipeline.
+	RegistryURL      string `json:"registryURL"`            // URL For ECR Registry for this environment.
+	ExecutionRoleARN string `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
+	ManagerRoleARN   string `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
+	ImportVPC        *VPC   `json:"importVPC,omitempty"`    // Existing VPC the environment is deployed in, nil if the environment created its own VPC.
+	Metadata                // Optional ownership and routing information.
+
+	version int64 // Version of the stored configuration when it was read, used to detect concurrent modifications.
 }
 
-// CreateEnvironment instantiates a new environment within an existing App. Skip if
-// the environment already exists in the App.
+// VPC represents an existing VPC and its subnets imported in an environment.
+type VPC struct {
+	ID               string   `json:"id"`                         // ID of the VPC.
+	PublicSubnetIDs  []string `json:"publicSubnetIDs,omitempty"`  // IDs of the public subnets, used by the public load balancer.
+	PrivateSubnetIDs []string `json:"privateSubnetIDs,omitempty"` // IDs of the private subnets.
+}
+
+// CreateEnvironment instantiates a new environment within an existing App. If the environment
+// already exists in the App, it returns ErrEnvironmentAlreadyExists.
 func (s *Store) CreateEnvironment(environment *Environment) error {
 	if _, err := s.GetApplication(environment.App); err != nil {
 		return err
@@ -50,7 +62,10 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
 			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
+				return &ErrEnvironmentAlreadyExists{
+					ApplicationName: environment.App,
+					EnvironmentName: environment.Name,
+				}
 			}
 		}
 		return fmt.Errorf("create environment %s in application %s: %w", environment.Name, environment.App, err)
@@ -84,23 +99,49 @@ func (s *Store) GetEnvironment(appName string, environmentName string) (*Environ
 	if err != nil {
 		return nil, fmt.Errorf("read configuration for environment %s in application %s: %w", environmentName, appName, err)
 	}
+	env.version = aws.Int64Value(environmentParam.Parameter.Version)
 	return &env, nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment. If the environment was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	if _, err := s.GetApplication(environment.App); err != nil {
+		return err
+	}
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	version, err := s.putIfVersion(environmentPath, environment.version, environment)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return &ErrNoSuchEnvironment{
+					ApplicationName: environment.App,
+					EnvironmentName: environment.Name,
+				}
+			}
+		}
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	environment.version = version
+	return nil
+}
+
 // ListEnvironments returns all environments belonging to a particular application.
 func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	var environments []*Environment
 
 	environmentsPath := fmt.Sprintf(rootEnvParamPath, appName)
-	serializedEnvs, err := s.listParams(environmentsPath)
+	params, err := s.listParameters(environmentsPath)
 	if err != nil {
 		return nil, fmt.Errorf("list environments for application %s: %w", appName, err)
 	}
-	for _, serializedEnv := range serializedEnvs {
+	for _, param := range params {
 		var env Environment
-		if err := json.Unmarshal([]byte(*serializedEnv), &env); err != nil {
+		if err := json.Unmarshal([]byte(*param.Value), &env); err != nil {
 			return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
 		}
+		env.version = aws.Int64Value(param.Version)
 
 		environments = append(environments, &env)
 	}
@@ -114,10 +155,7 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 // If the environment does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteEnvironment(appName, environmentName string) error {
 	paramName := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
+	err := s.deleteLocked(paramName)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
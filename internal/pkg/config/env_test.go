@@ -263,7 +263,7 @@ func TestStore_CreateEnvironment(t *testing.T) {
 					},
 				}, nil
 			},
-			wantedErr: nil,
+			wantedErr: &ErrEnvironmentAlreadyExists{ApplicationName: "chicken", EnvironmentName: "test"},
 		},
 		"with SSM error": {
 			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
@@ -346,10 +346,10 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
+				ssmClient: grantLocks(&mockSSM{
 					t:                   t,
 					mockDeleteParameter: tc.mockDeleteParam,
-				},
+				}),
 			}
 
 			// WHEN
@@ -364,3 +364,111 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testAppPath := fmt.Sprintf(fmtApplicationPath, "phonetool")
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, "phonetool", "test")
+	testLockPath := fmt.Sprintf(fmtLockParamPath, testEnvironmentPath)
+
+	testCases := map[string]struct {
+		inVersion        int64
+		inAppVersion     string
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+
+		wantedVersion int64
+		wantedErr     error
+	}{
+		"returns ErrSchemaVersionTooNew if the application was written by a newer version": {
+			inAppVersion: "100.0",
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.FailNow(t, "an environment of an application with a newer schema must not be overwritten")
+				return nil, nil
+			},
+			wantedErr: &ErrSchemaVersionTooNew{ApplicationName: "phonetool", Version: "100.0", SupportedVersion: schemaVersion},
+		},
+		"overwrites the environment if it wasn't modified since it was read": {
+			inVersion: 3,
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Name: param.Name, Version: aws.Int64(3)},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				require.True(t, *param.Overwrite)
+				require.Equal(t, `{"app":"phonetool","name":"test","region":"us-west-2","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}`, *param.Value)
+				return &ssm.PutParameterOutput{Version: aws.Int64(4)}, nil
+			},
+			wantedVersion: 4,
+		},
+		"returns ErrConcurrentModification if the environment was modified since it was read": {
+			inVersion: 3,
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Name: param.Name, Version: aws.Int64(4)},
+				}, nil
+			},
+			wantedErr: fmt.Errorf("update environment test in application phonetool: %w", &ErrConcurrentModification{Name: testEnvironmentPath}),
+		},
+		"returns ErrConcurrentModification if the lock is held": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testLockPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Name: param.Name, Value: aws.String("2100-01-01T00:00:00Z")},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testLockPath, *param.Name)
+				return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", nil)
+			},
+			wantedErr: fmt.Errorf("update environment test in application phonetool: %w", &ErrConcurrentModification{Name: testEnvironmentPath}),
+		},
+		"returns ErrNoSuchEnvironment if the environment doesn't exist": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "No Parameter", nil)
+			},
+			wantedErr: &ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mock := &mockSSM{
+				t: t,
+				mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					if aws.StringValue(param.Name) == testAppPath {
+						return &ssm.GetParameterOutput{
+							Parameter: &ssm.Parameter{Name: param.Name, Value: aws.String(fmt.Sprintf(`{"name":"phonetool","version":"%s"}`, tc.inAppVersion))},
+						}, nil
+					}
+					return tc.mockGetParameter(t, param)
+				},
+				mockPutParameter: tc.mockPutParameter,
+			}
+			if tc.mockPutParameter == nil || tc.wantedVersion != 0 {
+				mock = grantLocks(mock)
+			} else if tc.inAppVersion == "" {
+				mock.mockDeleteParameter = func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+					require.Fail(t, "a lock held by someone else must not be released")
+					return nil, nil
+				}
+			}
+			store := &Store{ssmClient: mock}
+			env := &Environment{App: "phonetool", Name: "test", Region: "us-west-2", version: tc.inVersion}
+
+			// WHEN
+			err := store.UpdateEnvironment(env)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVersion, env.version)
+		})
+	}
+}
@@ -3,7 +3,10 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrNoSuchApplication means an application couldn't be found within a specific account and region.
 type ErrNoSuchApplication struct {
@@ -69,3 +72,108 @@ func (e *ErrNoSuchService) Error() string {
 	return fmt.Sprintf("couldn't find service %s in the application %s",
 		e.ServiceName, e.ApplicationName)
 }
+
+// ErrApplicationAlreadyExists means an application with the same name is already stored.
+type ErrApplicationAlreadyExists struct {
+	ApplicationName string
+}
+
+func (e *ErrApplicationAlreadyExists) Error() string {
+	return fmt.Sprintf("application %s already exists", e.ApplicationName)
+}
+
+// ErrEnvironmentAlreadyExists means an environment with the same name is already stored in the application.
+type ErrEnvironmentAlreadyExists struct {
+	ApplicationName string
+	EnvironmentName string
+}
+
+func (e *ErrEnvironmentAlreadyExists) Error() string {
+	return fmt.Sprintf("environment %s already exists in the application %s", e.EnvironmentName, e.ApplicationName)
+}
+
+// ErrServiceAlreadyExists means a service with the same name is already stored in the application.
+type ErrServiceAlreadyExists struct {
+	ApplicationName string
+	ServiceName     string
+}
+
+func (e *ErrServiceAlreadyExists) Error() string {
+	return fmt.Sprintf("service %s already exists in the application %s", e.ServiceName, e.ApplicationName)
+}
+
+// ErrNoSuchDeployment means a revision of a service couldn't be found in a specific environment.
+type ErrNoSuchDeployment struct {
+	ServiceName     string
+	EnvironmentName string
+	Revision        int
+}
+
+// Is returns whether the provided error equals this error.
+func (e *ErrNoSuchDeployment) Is(target error) bool {
+	t, ok := target.(*ErrNoSuchDeployment)
+	if !ok {
+		return false
+	}
+	return e.ServiceName == t.ServiceName &&
+		e.EnvironmentName == t.EnvironmentName &&
+		e.Revision == t.Revision
+}
+
+func (e *ErrNoSuchDeployment) Error() string {
+	return fmt.Sprintf("couldn't find revision %d of service %s in environment %s",
+		e.Revision, e.ServiceName, e.EnvironmentName)
+}
+
+// ErrSchemaVersionTooNew means an application's configuration was written by a newer version of the CLI.
+type ErrSchemaVersionTooNew struct {
+	ApplicationName  string
+	Version          string
+	SupportedVersion string
+}
+
+func (e *ErrSchemaVersionTooNew) Error() string {
+	return fmt.Sprintf("application %s is stored with schema version %s but this version of copilot only supports up to %s, please upgrade copilot",
+		e.ApplicationName, e.Version, e.SupportedVersion)
+}
+
+// ErrConcurrentModification means a configuration parameter was modified by someone else
+// since it was read, or is currently being modified.
+type ErrConcurrentModification struct {
+	Name string
+}
+
+func (e *ErrConcurrentModification) Error() string {
+	return fmt.Sprintf("%s was modified concurrently by another operation, please retry", e.Name)
+}
+
+// ErrDeploymentLocked means a deployment lock is held by someone else.
+type ErrDeploymentLocked struct {
+	Lock *DeploymentLock
+}
+
+func (e *ErrDeploymentLocked) Error() string {
+	return fmt.Sprintf("%s is locked by %s from %s for %q since %s until %s",
+		e.Lock.resource(), e.Lock.Holder, e.Lock.Host, e.Lock.Operation,
+		e.Lock.AcquiredAt.Format(time.RFC3339), e.Lock.ExpiresAt.Format(time.RFC3339))
+}
+
+// ErrNoSuchDeploymentLock means nobody holds the deployment lock of a service, or of an environment itself, in an environment.
+type ErrNoSuchDeploymentLock struct {
+	ServiceName     string
+	EnvironmentName string
+}
+
+// Is returns whether the provided error equals this error.
+func (e *ErrNoSuchDeploymentLock) Is(target error) bool {
+	t, ok := target.(*ErrNoSuchDeploymentLock)
+	if !ok {
+		return false
+	}
+	return e.ServiceName == t.ServiceName &&
+		e.EnvironmentName == t.EnvironmentName
+}
+
+func (e *ErrNoSuchDeploymentLock) Error() string {
+	return fmt.Sprintf("%s is not locked", lockedResource(e.EnvironmentName, e.ServiceName))
+}
@@ -0,0 +1,796 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+// File layout of a local store. Each application is a directory under the root of the store
+// that holds the application's configuration along with directories for its environments, services
+// and the deployment history and deployment locks of each service in each environment:
+//  .
+//  └── my-app
+//      ├── application.json
+//      ├── deployment-locks
+//      │   └── test
+//      │       ├── environment.json
+//      │       └── services
+//      │           └── frontend.json
+//      ├── deployments
+//      │   └── test
+//      │       └── frontend
+//      │           └── 1.json
+//      ├── environments
+//      │   └── test.json
+//      └── services
+//          └── frontend.json
+const (
+	localAppFileName        = "application.json"
+	localEnvDirName         = "environments"
+	localSvcDirName         = "services"
+	localDeploymentsDirName = "deployments"
+	localDeploymentLocksDir = "deployment-locks"
+	localEnvLockFileName    = "environment.json"
+
+	jsonFileExtension      = ".json"
+	localLockFileExtension = ".lock"
+
+	// Placeholders for the account and region of applications that can't be found in a local store.
+	localAccountID = "local"
+	localRegion    = "local"
+)
+
+// LocalStore is in charge of fetching and creating applications, environment and services configuration
+// in a directory of the local file system.
+type LocalStore struct {
+	rootDir    string
+	fs         *afero.Afero
+	migrations []Migration
+
+	lockRetryInterval time.Duration
+}
+
+// NewLocalStore returns a new store that reads and writes configuration under rootDir.
+func NewLocalStore(rootDir string) *LocalStore {
+	return &LocalStore{
+		rootDir:    rootDir,
+		fs:         &afero.Afero{Fs: afero.NewOsFs()},
+		migrations: migrations,
+
+		lockRetryInterval: defaultLockInterval,
+	}
+}
+
+// CreateApplication instantiates a new application and stores it in the local directory.
+// If the application already exists, it returns ErrApplicationAlreadyExists.
+func (s *LocalStore) CreateApplication(application *Application) error {
+	application.Version = schemaVersion
+	if err := s.create(s.appPath(application.Name), application, &ErrApplicationAlreadyExists{
+		ApplicationName: application.Name,
+	}); err != nil {
+		return fmt.Errorf("create application %s: %w", application.Name, err)
+	}
+	return nil
+}
+
+// GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication.
+func (s *LocalStore) GetApplication(applicationName string) (*Application, error) {
+	var application Application
+	version, exists, err := s.read(s.appPath(applicationName), &application)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", applicationName, err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchApplication{
+			ApplicationName: applicationName,
+			AccountID:       localAccountID,
+			Region:          localRegion,
+		}
+	}
+	if err := checkSchemaVersion(applicationName, application.Version); err != nil {
+		return nil, err
+	}
+	application.version = version
+	return &application, nil
+}
+
+// UpdateApplication overwrites the configuration of an existing application. If the application was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *LocalStore) UpdateApplication(application *Application) error {
+	version, exists, err := s.update(s.appPath(application.Name), application.version, application)
+	if err != nil {
+		return fmt.Errorf("update application %s: %w", application.Name, err)
+	}
+	if !exists {
+		return &ErrNoSuchApplication{
+			ApplicationName: application.Name,
+			AccountID:       localAccountID,
+			Region:          localRegion,
+		}
+	}
+	application.version = version
+	return nil
+}
+
+// ListApplications returns the list of existing applications in the local directory.
+func (s *LocalStore) ListApplications() ([]*Application, error) {
+	exists, err := s.fs.DirExists(s.rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("list applications: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := s.fs.ReadDir(s.rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("list applications: %w", err)
+	}
+	var applications []*Application
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		var application Application
+		version, exists, err := s.read(s.appPath(f.Name()), &application)
+		if err != nil {
+			return nil, fmt.Errorf("read application configuration: %w", err)
+		}
+		if !exists {
+			continue
+		}
+		if err := checkSchemaVersion(application.Name, application.Version); err != nil {
+			return nil, err
+		}
+		application.version = version
+		applications = append(applications, &application)
+	}
+	return applications, nil
+}
+
+// DeleteApplication deletes the file related to the application.
+func (s *LocalStore) DeleteApplication(name string) error {
+	if err := s.remove(s.appPath(name)); err != nil {
+		return fmt.Errorf("delete application %s: %w", name, err)
+	}
+	return nil
+}
+
+// CreateEnvironment instantiates a new environment within an existing App. If the environment
+// already exists in the App, it returns ErrEnvironmentAlreadyExists.
+func (s *LocalStore) CreateEnvironment(environment *Environment) error {
+	if _, err := s.GetApplication(environment.App); err != nil {
+		return err
+	}
+	if err := s.create(s.envPath(environment.App, environment.Name), environment, &ErrEnvironmentAlreadyExists{
+		ApplicationName: environment.App,
+		EnvironmentName: environment.Name,
+	}); err != nil {
+		return fmt.Errorf("create environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
+// GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
+// it returns ErrNoSuchEnvironment.
+func (s *LocalStore) GetEnvironment(appName string, environmentName string) (*Environment, error) {
+	var env Environment
+	version, exists, err := s.read(s.envPath(appName, environmentName), &env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s in application %s: %w", environmentName, appName, err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchEnvironment{
+			ApplicationName: appName,
+			EnvironmentName: environmentName,
+		}
+	}
+	env.version = version
+	return &env, nil
+}
+
+// UpdateEnvironment overwrites the configuration of an existing environment. If the environment was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *LocalStore) UpdateEnvironment(environment *Environment) error {
+	if _, err := s.GetApplication(environment.App); err != nil {
+		return err
+	}
+	version, exists, err := s.update(s.envPath(environment.App, environment.Name), environment.version, environment)
+	if err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	if !exists {
+		return &ErrNoSuchEnvironment{
+			ApplicationName: environment.App,
+			EnvironmentName: environment.Name,
+		}
+	}
+	environment.version = version
+	return nil
+}
+
+// ListEnvironments returns all environments belonging to a particular application.
+func (s *LocalStore) ListEnvironments(appName string) ([]*Environment, error) {
+	var environments []*Environment
+	err := s.list(filepath.Join(s.rootDir, appName, localEnvDirName), func(data []byte, version int64) error {
+		var env Environment
+		if err := json.Unmarshal(data, &env); err != nil {
+			return err
+		}
+		env.version = version
+		environments = append(environments, &env)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list environments for application %s: %w", appName, err)
+	}
+	// non-prod env before prod env. sort by alphabetically if same
+	sort.SliceStable(environments, func(i, j int) bool { return environments[i].Name < environments[j].Name })
+	sort.SliceStable(environments, func(i, j int) bool { return !environments[i].Prod && environments[j].Prod })
+	return environments, nil
+}
+
+// DeleteEnvironment removes an environment from the local directory.
+// If the environment does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *LocalStore) DeleteEnvironment(appName, environmentName string) error {
+	if err := s.remove(s.envPath(appName, environmentName)); err != nil {
+		return fmt.Errorf("delete environment %s from application %s: %w", environmentName, appName, err)
+	}
+	return nil
+}
+
+// CreateService instantiates a new service within an existing application. If the service
+// already exists in the application, it returns ErrServiceAlreadyExists.
+func (s *LocalStore) CreateService(svc *Service) error {
+	if _, err := s.GetApplication(svc.App); err != nil {
+		return err
+	}
+	if err := s.create(s.svcPath(svc.App, svc.Name), svc, &ErrServiceAlreadyExists{
+		ApplicationName: svc.App,
+		ServiceName:     svc.Name,
+	}); err != nil {
+		return fmt.Errorf("create service %s in application %s: %w", svc.Name, svc.App, err)
+	}
+	return nil
+}
+
+// GetService gets a service belonging to a particular application by name. If no svc is found
+// it returns ErrNoSuchService.
+func (s *LocalStore) GetService(appName, svcName string) (*Service, error) {
+	var svc Service
+	version, exists, err := s.read(s.svcPath(appName, svcName), &svc)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s in application %s: %w", svcName, appName, err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchService{
+			ApplicationName: appName,
+			ServiceName:     svcName,
+		}
+	}
+	svc.version = version
+	return &svc, nil
+}
+
+// UpdateService overwrites the configuration of an existing service. If the service was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *LocalStore) UpdateService(svc *Service) error {
+	if _, err := s.GetApplication(svc.App); err != nil {
+		return err
+	}
+	version, exists, err := s.update(s.svcPath(svc.App, svc.Name), svc.version, svc)
+	if err != nil {
+		return fmt.Errorf("update service %s in application %s: %w", svc.Name, svc.App, err)
+	}
+	if !exists {
+		return &ErrNoSuchService{
+			ApplicationName: svc.App,
+			ServiceName:     svc.Name,
+		}
+	}
+	svc.version = version
+	return nil
+}
+
+// ListServices returns all services belonging to a particular application.
+func (s *LocalStore) ListServices(appName string) ([]*Service, error) {
+	var services []*Service
+	err := s.list(filepath.Join(s.rootDir, appName, localSvcDirName), func(data []byte, version int64) error {
+		var svc Service
+		if err := json.Unmarshal(data, &svc); err != nil {
+			return err
+		}
+		svc.version = version
+		services = append(services, &svc)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list services for application %s: %w", appName, err)
+	}
+	return services, nil
+}
+
+// DeleteService removes a service from the local directory.
+// If the service does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *LocalStore) DeleteService(appName, svcName string) error {
+	if err := s.remove(s.svcPath(appName, svcName)); err != nil {
+		return fmt.Errorf("delete service %s from application %s: %w", svcName, appName, err)
+	}
+	return nil
+}
+
+// CreateDeployment adds the deployment to the history of the service in the environment
+// and sets its revision to the revision following the latest one.
+// Only the latest MaxDeploymentRevisions revisions are kept, older revisions are deleted.
+func (s *LocalStore) CreateDeployment(d *Deployment) error {
+	historyDir := s.deploymentsDir(d.App, d.Env, d.Service)
+	err := s.withLock(historyDir, func() error {
+		deployments, err := s.ListDeployments(d.App, d.Env, d.Service)
+		if err != nil {
+			return err
+		}
+		d.Revision = nextRevision(deployments)
+		path := s.deploymentPath(d.App, d.Env, d.Service, d.Revision)
+		if err := s.create(path, d, &ErrConcurrentModification{Name: path}); err != nil {
+			return err
+		}
+		for _, expired := range expiredDeployments(append(deployments, d)) {
+			path := s.deploymentPath(expired.App, expired.Env, expired.Service, expired.Revision)
+			if err := s.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("delete revision %d: %w", expired.Revision, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("record deployment of service %s in environment %s: %w", d.Service, d.Env, err)
+	}
+	return nil
+}
+
+// DeleteDeployments deletes the deployment history of a service in an environment.
+func (s *LocalStore) DeleteDeployments(appName, envName, svcName string) error {
+	historyDir := s.deploymentsDir(appName, envName, svcName)
+	err := s.withLock(historyDir, func() error {
+		return s.fs.RemoveAll(historyDir)
+	})
+	if err != nil {
+		return fmt.Errorf("delete deployments of service %s in environment %s: %w", svcName, envName, err)
+	}
+	return nil
+}
+
+// GetDeployment gets a revision of a service in an environment. If the revision can't be found,
+// it returns ErrNoSuchDeployment.
+func (s *LocalStore) GetDeployment(appName, envName, svcName string, revision int) (*Deployment, error) {
+	var d Deployment
+	_, exists, err := s.read(s.deploymentPath(appName, envName, svcName, revision), &d)
+	if err != nil {
+		return nil, fmt.Errorf("get revision %d of service %s in environment %s: %w", revision, svcName, envName, err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchDeployment{
+			ServiceName:     svcName,
+			EnvironmentName: envName,
+			Revision:        revision,
+		}
+	}
+	return &d, nil
+}
+
+// ListDeployments returns the deployments of a service in an environment, from the oldest to the latest revision.
+func (s *LocalStore) ListDeployments(appName, envName, svcName string) ([]*Deployment, error) {
+	var deployments []*Deployment
+	err := s.list(s.deploymentsDir(appName, envName, svcName), func(data []byte, _ int64) error {
+		var d Deployment
+		if err := json.Unmarshal(data, &d); err != nil {
+			return err
+		}
+		deployments = append(deployments, &d)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of service %s in environment %s: %w", svcName, envName, err)
+	}
+	sortDeployments(deployments)
+	return deployments, nil
+}
+
+// AcquireDeploymentLock leases the lock to the current user of the machine, and fills in the details of the lease.
+// If the lock is held by someone else, it returns ErrDeploymentLocked with the current holder of the lock.
+// Acquiring a lock that's already held by the same lease renews the lease.
+// The lock of a service can't be acquired while the lock of its environment is held by someone else, and the lock of
+// an environment can't be acquired while the lock of any of its services is held by someone else.
+func (s *LocalStore) AcquireDeploymentLock(lock *DeploymentLock) error {
+	path := s.deploymentLockPath(lock.App, lock.Env, lock.Service)
+	// All the deployment locks of an environment are written under the same file lock, so that the locks of
+	// the environment and of its services are checked against each other consistently.
+	err := s.withLock(s.deploymentLockPath(lock.App, lock.Env, ""), func() error {
+		var current DeploymentLock
+		_, exists, err := s.read(path, &current)
+		if err != nil {
+			return err
+		}
+		if exists && lock.isHeldByOther(&current) {
+			return &ErrDeploymentLocked{Lock: &current}
+		}
+		if err := s.checkRelatedDeploymentLocks(lock); err != nil {
+			return err
+		}
+		if err := lock.lease(localLockHolder()); err != nil {
+			return err
+		}
+		return s.write(path, lock)
+	})
+	if err != nil {
+		return fmt.Errorf("acquire deployment lock of %s: %w", lock.resource(), err)
+	}
+	return nil
+}
+
+// checkRelatedDeploymentLocks returns ErrDeploymentLocked if the environment of a service lock, or any service of an
+// environment lock, is locked by someone else.
+func (s *LocalStore) checkRelatedDeploymentLocks(lock *DeploymentLock) error {
+	if lock.Service != "" {
+		var envLock DeploymentLock
+		_, exists, err := s.read(s.deploymentLockPath(lock.App, lock.Env, ""), &envLock)
+		if err != nil {
+			return err
+		}
+		if exists && lock.isHeldByOther(&envLock) {
+			return &ErrDeploymentLocked{Lock: &envLock}
+		}
+		return nil
+	}
+	return s.list(s.svcDeploymentLocksDir(lock.App, lock.Env), func(data []byte, _ int64) error {
+		var svcLock DeploymentLock
+		if err := json.Unmarshal(data, &svcLock); err != nil {
+			return err
+		}
+		if lock.isHeldByOther(&svcLock) {
+			return &ErrDeploymentLocked{Lock: &svcLock}
+		}
+		return nil
+	})
+}
+
+// ReleaseDeploymentLock ends the lease of the lock. If the lock was broken and acquired by someone else in the
+// meantime, it's left untouched.
+func (s *LocalStore) ReleaseDeploymentLock(lock *DeploymentLock) error {
+	path := s.deploymentLockPath(lock.App, lock.Env, lock.Service)
+	err := s.withLock(s.deploymentLockPath(lock.App, lock.Env, ""), func() error {
+		var current DeploymentLock
+		_, exists, err := s.read(path, &current)
+		if err != nil {
+			return err
+		}
+		if !exists || current.ID != lock.ID {
+			return nil
+		}
+		if err := s.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("release deployment lock of %s: %w", lock.resource(), err)
+	}
+	return nil
+}
+
+// GetDeploymentLock gets the lock of a service in an environment, or of the environment itself if svcName is empty.
+// If nobody ever acquired the lock or it was released, it returns ErrNoSuchDeploymentLock. Expired locks are returned.
+func (s *LocalStore) GetDeploymentLock(appName, envName, svcName string) (*DeploymentLock, error) {
+	var lock DeploymentLock
+	_, exists, err := s.read(s.deploymentLockPath(appName, envName, svcName), &lock)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment lock of %s: %w", lockedResource(envName, svcName), err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchDeploymentLock{
+			ServiceName:     svcName,
+			EnvironmentName: envName,
+		}
+	}
+	return &lock, nil
+}
+
+// BreakDeploymentLock releases the lock of a service in an environment, or of the environment itself if svcName
+// is empty, regardless of its holder.
+func (s *LocalStore) BreakDeploymentLock(appName, envName, svcName string) error {
+	path := s.deploymentLockPath(appName, envName, svcName)
+	if err := s.withLock(s.deploymentLockPath(appName, envName, ""), func() error {
+		if err := s.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("break deployment lock of %s: %w", lockedResource(envName, svcName), err)
+	}
+	return nil
+}
+
+// MigrateApplication brings the configuration of an application, its environments and services up to the
+// schema version supported by this CLI. If dryRun is true, the migrations are computed without writing any file.
+// Environments and services are written before the application so that a failed migration can be retried.
+func (s *LocalStore) MigrateApplication(appName string, dryRun bool) (*MigrationResult, error) {
+	appPath := s.appPath(appName)
+	app := make(map[string]interface{})
+	_, exists, err := s.read(appPath, &app)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", appName, err)
+	}
+	if !exists {
+		return nil, &ErrNoSuchApplication{
+			ApplicationName: appName,
+			AccountID:       localAccountID,
+			Region:          localRegion,
+		}
+	}
+	result, pending, err := planMigrations(s.migrations, appName, app)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	envs, envPaths, err := s.readDocuments(filepath.Join(s.rootDir, appName, localEnvDirName))
+	if err != nil {
+		return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
+	}
+	svcs, svcPaths, err := s.readDocuments(filepath.Join(s.rootDir, appName, localSvcDirName))
+	if err != nil {
+		return nil, fmt.Errorf("read service configuration for application %s: %w", appName, err)
+	}
+	if err := applyMigrations(pending, result, app, envs, svcs); err != nil {
+		return nil, err
+	}
+
+	docs := make(map[string]map[string]interface{}, len(envs)+len(svcs)+1)
+	for path, doc := range envs {
+		docs[path] = doc
+	}
+	for path, doc := range svcs {
+		docs[path] = doc
+	}
+	docs[appPath] = app
+	for _, path := range append(append(envPaths, svcPaths...), appPath) {
+		result.Parameters = append(result.Parameters, path)
+		if dryRun {
+			continue
+		}
+		if _, _, err := s.update(path, 0, docs[path]); err != nil {
+			return nil, fmt.Errorf("update file %s: %w", path, err)
+		}
+	}
+	return result, nil
+}
+
+// readDocuments decodes every JSON file in dir, keyed by path, and returns the paths in order.
+// A missing directory has no files.
+func (s *LocalStore) readDocuments(dir string) (map[string]map[string]interface{}, []string, error) {
+	docs := make(map[string]map[string]interface{})
+	var paths []string
+	exists, err := s.fs.DirExists(dir)
+	if err != nil || !exists {
+		return docs, nil, err
+	}
+	files, err := s.fs.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), jsonFileExtension) {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		doc := make(map[string]interface{})
+		if _, _, err := s.read(path, &doc); err != nil {
+			return nil, nil, err
+		}
+		docs[path] = doc
+		paths = append(paths, path)
+	}
+	return docs, paths, nil
+}
+
+func (s *LocalStore) appPath(appName string) string {
+	return filepath.Join(s.rootDir, appName, localAppFileName)
+}
+
+func (s *LocalStore) envPath(appName, envName string) string {
+	return filepath.Join(s.rootDir, appName, localEnvDirName, envName+jsonFileExtension)
+}
+
+func (s *LocalStore) svcPath(appName, svcName string) string {
+	return filepath.Join(s.rootDir, appName, localSvcDirName, svcName+jsonFileExtension)
+}
+
+func (s *LocalStore) deploymentsDir(appName, envName, svcName string) string {
+	return filepath.Join(s.rootDir, appName, localDeploymentsDirName, envName, svcName)
+}
+
+func (s *LocalStore) deploymentPath(appName, envName, svcName string, revision int) string {
+	return filepath.Join(s.deploymentsDir(appName, envName, svcName), strconv.Itoa(revision)+jsonFileExtension)
+}
+
+func (s *LocalStore) deploymentLockPath(appName, envName, svcName string) string {
+	if svcName == "" {
+		return filepath.Join(s.rootDir, appName, localDeploymentLocksDir, envName, localEnvLockFileName)
+	}
+	return filepath.Join(s.svcDeploymentLocksDir(appName, envName), svcName+jsonFileExtension)
+}
+
+func (s *LocalStore) svcDeploymentLocksDir(appName, envName string) string {
+	return filepath.Join(s.rootDir, appName, localDeploymentLocksDir, envName, localSvcDirName)
+}
+
+// create writes the serialized value to path. If the file already exists, it's left untouched and errExists is returned.
+func (s *LocalStore) create(path string, v interface{}, errExists error) error {
+	exists, err := s.fs.Exists(path)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errExists
+	}
+	return s.write(path, v)
+}
+
+// write overwrites the file at path with the serialized value, without holding its lock.
+func (s *LocalStore) write(path string, v interface{}) error {
+	data, err := marshal(v)
+	if err != nil {
+		return fmt.Errorf("serialize %s: %w", path, err)
+	}
+	if err := s.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(path), err)
+	}
+	return s.fs.WriteFile(path, []byte(data), 0644)
+}
+
+// read deserializes the file at path into v and returns the version of its content.
+// Returns false if the file doesn't exist.
+func (s *LocalStore) read(path string, v interface{}) (int64, bool, error) {
+	exists, err := s.fs.Exists(path)
+	if err != nil {
+		return 0, false, err
+	}
+	if !exists {
+		return 0, false, nil
+	}
+	data, err := s.fs.ReadFile(path)
+	if err != nil {
+		return 0, false, fmt.Errorf("read file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return 0, false, fmt.Errorf("read configuration %s: %w", path, err)
+	}
+	return contentVersion(data), true, nil
+}
+
+// update overwrites the file at path with the serialized value, if the content of the file is still at version.
+// A version of 0 means the caller never read the file and the value is written unconditionally.
+// Returns the version of the new content, or false if the file doesn't exist.
+func (s *LocalStore) update(path string, version int64, v interface{}) (int64, bool, error) {
+	var newVersion int64
+	exists := true
+	err := s.withLock(path, func() error {
+		current, err := s.fs.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				exists = false
+				return nil
+			}
+			return fmt.Errorf("read file %s: %w", path, err)
+		}
+		if version != 0 && contentVersion(current) != version {
+			return &ErrConcurrentModification{Name: path}
+		}
+		data, err := marshal(v)
+		if err != nil {
+			return fmt.Errorf("serialize %s: %w", path, err)
+		}
+		if err := s.fs.WriteFile(path, []byte(data), 0644); err != nil {
+			return err
+		}
+		newVersion = contentVersion([]byte(data))
+		return nil
+	})
+	return newVersion, exists, err
+}
+
+// list calls fn with the contents and version of every JSON file in dir. A missing directory has no files.
+func (s *LocalStore) list(dir string, fn func(data []byte, version int64) error) error {
+	exists, err := s.fs.DirExists(dir)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	files, err := s.fs.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), jsonFileExtension) {
+			continue
+		}
+		data, err := s.fs.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", f.Name(), err)
+		}
+		if err := fn(data, contentVersion(data)); err != nil {
+			return fmt.Errorf("read configuration %s: %w", f.Name(), err)
+		}
+	}
+	return nil
+}
+
+// remove deletes the file at path while holding its lock. A missing file is not an error.
+func (s *LocalStore) remove(path string) error {
+	return s.withLock(path, func() error {
+		if err := s.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// withLock runs fn while holding the lock file of the file at path.
+// If the lock is held by someone else, it retries a few times before returning ErrConcurrentModification.
+// A lock that outlived its TTL, for example because the process holding it was interrupted, is broken.
+func (s *LocalStore) withLock(path string, fn func() error) error {
+	lockPath := path + localLockFileExtension
+	if err := s.fs.MkdirAll(filepath.Dir(lockPath), 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(lockPath), err)
+	}
+	for attempt := 0; attempt < maxLockAttempts; attempt++ {
+		f, err := s.fs.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			f.Close()
+			defer s.fs.Remove(lockPath)
+			return fn()
+		}
+		if !os.IsExist(err) {
+			return fmt.Errorf("acquire lock %s: %w", lockPath, err)
+		}
+		if info, err := s.fs.Stat(lockPath); err == nil && time.Since(info.ModTime()) > lockTTL {
+			s.fs.Remove(lockPath)
+			continue
+		}
+		time.Sleep(s.lockRetryInterval)
+	}
+	return &ErrConcurrentModification{Name: path}
+}
+
+// localLockHolder returns the name of the current user of the machine with a best effort.
+// If it fails to get the user, this returns "unknown".
+func localLockHolder() string {
+	u, err := user.Current()
+	if err != nil {
+		return unknownLockHolder
+	}
+	return u.Username
+}
+
+// contentVersion returns a version identifying the content of a file.
+func contentVersion(data []byte) int64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return int64(h.Sum64())
+}
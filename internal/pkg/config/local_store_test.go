@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemLocalStore() *LocalStore {
+	return &LocalStore{
+		rootDir: "/store",
+		fs:      &afero.Afero{Fs: afero.NewMemMapFs()},
+	}
+}
+
+func TestLocalStore_Application(t *testing.T) {
+	s := newMemLocalStore()
+
+	_, err := s.GetApplication("phonetool")
+	require.True(t, errors.Is(err, &ErrNoSuchApplication{ApplicationName: "phonetool", AccountID: localAccountID, Region: localRegion}))
+
+	require.NoError(t, s.CreateApplication(&Application{Name: "phonetool", AccountID: "1234"}))
+	// Creating the same application again leaves it untouched.
+	err = s.CreateApplication(&Application{Name: "phonetool", AccountID: "5678"})
+	var errExists *ErrApplicationAlreadyExists
+	require.True(t, errors.As(err, &errExists))
+
+	app, err := s.GetApplication("phonetool")
+	require.NoError(t, err)
+	require.Equal(t, "1234", app.AccountID)
+	require.Equal(t, schemaVersion, app.Version)
+
+	apps, err := s.ListApplications()
+	require.NoError(t, err)
+	require.Equal(t, []*Application{app}, apps)
+
+	require.NoError(t, s.DeleteApplication("phonetool"))
+	require.NoError(t, s.DeleteApplication("phonetool"))
+	apps, err = s.ListApplications()
+	require.NoError(t, err)
+	require.Empty(t, apps)
+}
+
+func TestLocalStore_Environment(t *testing.T) {
+	s := newMemLocalStore()
+
+	err := s.CreateEnvironment(&Environment{App: "phonetool", Name: "test"})
+	require.True(t, errors.Is(err, &ErrNoSuchApplication{ApplicationName: "phonetool", AccountID: localAccountID, Region: localRegion}))
+
+	require.NoError(t, s.CreateApplication(&Application{Name: "phonetool"}))
+	require.NoError(t, s.CreateEnvironment(&Environment{App: "phonetool", Name: "prod", Prod: true}))
+	require.NoError(t, s.CreateEnvironment(&Environment{App: "phonetool", Name: "test", Region: "us-west-2"}))
+
+	env, err := s.GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, "us-west-2", env.Region)
+
+	envs, err := s.ListEnvironments("phonetool")
+	require.NoError(t, err)
+	require.Len(t, envs, 2)
+	require.Equal(t, "test", envs[0].Name, "non-prod environments should be listed first")
+	require.Equal(t, "prod", envs[1].Name)
+
+	require.NoError(t, s.DeleteEnvironment("phonetool", "test"))
+	_, err = s.GetEnvironment("phonetool", "test")
+	require.True(t, errors.Is(err, &ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"}))
+
+	// Environments of an application written by a newer version aren't overwritten.
+	app, err := s.GetApplication("phonetool")
+	require.NoError(t, err)
+	app.Version = "100.0"
+	require.NoError(t, s.UpdateApplication(app))
+	var errTooNew *ErrSchemaVersionTooNew
+	require.True(t, errors.As(s.UpdateEnvironment(envs[1]), &errTooNew))
+	_, err = s.ListApplications()
+	require.True(t, errors.As(err, &errTooNew))
+}
+
+func TestLocalStore_Service(t *testing.T) {
+	s := newMemLocalStore()
+	require.NoError(t, s.CreateApplication(&Application{Name: "phonetool"}))
+
+	svcs, err := s.ListServices("phonetool")
+	require.NoError(t, err)
+	require.Empty(t, svcs)
+
+	require.NoError(t, s.CreateService(&Service{App: "phonetool", Name: "api", Type: "Backend Service"}))
+
+	svc, err := s.GetService("phonetool", "api")
+	require.NoError(t, err)
+	require.Equal(t, "Backend Service", svc.Type)
+
+	svcs, err = s.ListServices("phonetool")
+	require.NoError(t, err)
+	require.Equal(t, []*Service{svc}, svcs)
+
+	require.NoError(t, s.DeleteService("phonetool", "api"))
+	_, err = s.GetService("phonetool", "api")
+	require.True(t, errors.Is(err, &ErrNoSuchService{ApplicationName: "phonetool", ServiceName: "api"}))
+}
+
+func TestLocalStore_Update(t *testing.T) {
+	s := newMemLocalStore()
+
+	err := s.UpdateApplication(&Application{Name: "phonetool"})
+	require.True(t, errors.Is(err, &ErrNoSuchApplication{ApplicationName: "phonetool", AccountID: localAccountID, Region: localRegion}))
+
+	require.NoError(t, s.CreateApplication(&Application{Name: "phonetool"}))
+	require.NoError(t, s.CreateService(&Service{App: "phonetool", Name: "api", Type: "Backend Service"}))
+
+	mine, err := s.GetService("phonetool", "api")
+	require.NoError(t, err)
+	theirs, err := s.GetService("phonetool", "api")
+	require.NoError(t, err)
+
+	theirs.Type = "Load Balanced Web Service"
+	require.NoError(t, s.UpdateService(theirs))
+	// Consecutive updates from the same reader don't conflict.
+	require.NoError(t, s.UpdateService(theirs))
+
+	mine.Type = "Scheduled Job"
+	err = s.UpdateService(mine)
+	var conflict *ErrConcurrentModification
+	require.True(t, errors.As(err, &conflict))
+
+	svc, err := s.GetService("phonetool", "api")
+	require.NoError(t, err)
+	require.Equal(t, "Load Balanced Web Service", svc.Type)
+}
+
+func TestLocalStore_Lock(t *testing.T) {
+	s := newMemLocalStore()
+	require.NoError(t, s.CreateApplication(&Application{Name: "phonetool"}))
+	app, err := s.GetApplication("phonetool")
+	require.NoError(t, err)
+
+	require.NoError(t, s.fs.WriteFile(s.appPath("phonetool")+localLockFileExtension, nil, 0644))
+	err = s.UpdateApplication(app)
+	require.EqualError(t, err, "update application phonetool: /store/phonetool/application.json was modified concurrently by another operation, please retry")
+
+	require.NoError(t, s.fs.Remove(s.appPath("phonetool")+localLockFileExtension))
+	require.NoError(t, s.UpdateApplication(app))
+}
+
+func TestLocalStore_MalformedConfiguration(t *testing.T) {
+	s := newMemLocalStore()
+	require.NoError(t, s.fs.WriteFile("/store/phonetool/application.json", []byte("oops"), 0644))
+
+	_, err := s.GetApplication("phonetool")
+	require.EqualError(t, err, "get application phonetool: read configuration /store/phonetool/application.json: invalid character 'o' looking for beginning of value")
+}
+
+func TestLocalStore_Deployment(t *testing.T) {
+	s := newMemLocalStore()
+
+	deployments, err := s.ListDeployments("phonetool", "test", "api")
+	require.NoError(t, err)
+	require.Empty(t, deployments)
+
+	for _, tag := range []string{"v1", "v2"} {
+		require.NoError(t, s.CreateDeployment(&Deployment{App: "phonetool", Env: "test", Service: "api", ImageTag: tag}))
+	}
+	rollback := &Deployment{App: "phonetool", Env: "test", Service: "api", ImageTag: "v1", RollbackOf: 1}
+	require.NoError(t, s.CreateDeployment(rollback))
+	require.Equal(t, 3, rollback.Revision)
+
+	deployments, err = s.ListDeployments("phonetool", "test", "api")
+	require.NoError(t, err)
+	require.Len(t, deployments, 3)
+	require.Equal(t, rollback, deployments[2])
+
+	d, err := s.GetDeployment("phonetool", "test", "api", 2)
+	require.NoError(t, err)
+	require.Equal(t, "v2", d.ImageTag)
+
+	_, err = s.GetDeployment("phonetool", "prod", "api", 1)
+	require.True(t, errors.Is(err, &ErrNoSuchDeployment{ServiceName: "api", EnvironmentName: "prod", Revision: 1}))
+
+	// Only the latest revisions are kept.
+	for i := len(deployments); i < MaxDeploymentRevisions+2; i++ {
+		require.NoError(t, s.CreateDeployment(&Deployment{App: "phonetool", Env: "test", Service: "api", ImageTag: "v3"}))
+	}
+	deployments, err = s.ListDeployments("phonetool", "test", "api")
+	require.NoError(t, err)
+	require.Len(t, deployments, MaxDeploymentRevisions)
+	require.Equal(t, 3, deployments[0].Revision)
+
+	require.NoError(t, s.DeleteDeployments("phonetool", "test", "api"))
+	deployments, err = s.ListDeployments("phonetool", "test", "api")
+	require.NoError(t, err)
+	require.Empty(t, deployments)
+}
+
+func TestLocalStore_DeploymentLock(t *testing.T) {
+	s := newMemLocalStore()
+
+	_, err := s.GetDeploymentLock("phonetool", "test", "api")
+	require.True(t, errors.Is(err, &ErrNoSuchDeploymentLock{ServiceName: "api", EnvironmentName: "test"}))
+
+	first := &DeploymentLock{App: "phonetool", Env: "test", Service: "api", Operation: "svc deploy"}
+	require.NoError(t, s.AcquireDeploymentLock(first))
+	// Environments can't be locked while one of their services is locked by someone else.
+	envLock := &DeploymentLock{App: "phonetool", Env: "test", Operation: "env init"}
+	err = s.AcquireDeploymentLock(envLock)
+	var locked *ErrDeploymentLocked
+	require.True(t, errors.As(err, &locked))
+	require.Equal(t, first.ID, locked.Lock.ID)
+
+	second := &DeploymentLock{App: "phonetool", Env: "test", Service: "api", Operation: "svc delete"}
+	err = s.AcquireDeploymentLock(second)
+	require.True(t, errors.As(err, &locked))
+	require.Equal(t, first.ID, locked.Lock.ID)
+
+	// Only the holder of the lease releases the lock.
+	require.NoError(t, s.ReleaseDeploymentLock(second))
+	lock, err := s.GetDeploymentLock("phonetool", "test", "api")
+	require.NoError(t, err)
+	require.Equal(t, "svc deploy", lock.Operation)
+
+	require.NoError(t, s.BreakDeploymentLock("phonetool", "test", "api"))
+	require.NoError(t, s.AcquireDeploymentLock(envLock))
+	// Services can't be locked while their environment is locked by someone else.
+	err = s.AcquireDeploymentLock(second)
+	require.True(t, errors.As(err, &locked))
+	require.Equal(t, envLock.ID, locked.Lock.ID)
+	require.NoError(t, s.ReleaseDeploymentLock(envLock))
+	require.NoError(t, s.AcquireDeploymentLock(second))
+	require.NoError(t, s.ReleaseDeploymentLock(second))
+	_, err = s.GetDeploymentLock("phonetool", "test", "api")
+	require.True(t, errors.Is(err, &ErrNoSuchDeploymentLock{ServiceName: "api", EnvironmentName: "test"}))
+}
+
+func TestLocalStore_MigrateApplication(t *testing.T) {
+	s := newMemLocalStore()
+	s.migrations = []Migration{
+		{
+			Version:     "1.0",
+			Description: "add owner to services",
+			Service: func(svc map[string]interface{}) error {
+				svc["owner"] = "unknown"
+				return nil
+			},
+		},
+	}
+	require.NoError(t, s.fs.WriteFile("/store/phonetool/application.json", []byte(`{"name":"phonetool","version":"0.9"}`), 0644))
+	require.NoError(t, s.fs.WriteFile("/store/phonetool/environments/test.json", []byte(`{"app":"phonetool","name":"test"}`), 0644))
+	require.NoError(t, s.fs.WriteFile("/store/phonetool/services/api.json", []byte(`{"app":"phonetool","name":"api"}`), 0644))
+
+	_, err := s.MigrateApplication("inventory", false)
+	require.True(t, errors.Is(err, &ErrNoSuchApplication{ApplicationName: "inventory", AccountID: localAccountID, Region: localRegion}))
+
+	// Dry runs don't write any file.
+	result, err := s.MigrateApplication("phonetool", true)
+	require.NoError(t, err)
+	require.Equal(t, &MigrationResult{
+		Application: "phonetool",
+		FromVersion: "0.9",
+		ToVersion:   "1.0",
+		Migrations:  []string{"add owner to services"},
+		Parameters: []string{
+			"/store/phonetool/environments/test.json",
+			"/store/phonetool/services/api.json",
+			"/store/phonetool/application.json",
+		},
+	}, result)
+	data, err := s.fs.ReadFile("/store/phonetool/services/api.json")
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"phonetool","name":"api"}`, string(data))
+
+	_, err = s.MigrateApplication("phonetool", false)
+	require.NoError(t, err)
+	data, err = s.fs.ReadFile("/store/phonetool/services/api.json")
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"phonetool","name":"api","owner":"unknown"}`, string(data))
+	app, err := s.GetApplication("phonetool")
+	require.NoError(t, err)
+	require.Equal(t, "1.0", app.Version)
+
+	result, err = s.MigrateApplication("phonetool", false)
+	require.NoError(t, err)
+	require.False(t, result.HasChanges())
+}
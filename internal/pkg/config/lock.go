@@ -0,0 +1,205 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	// fmtLockParamPath is the path of the parameter guarding writes to another parameter.
+	// Lock parameters are nested under the parameter they guard so that they're not returned when listing.
+	fmtLockParamPath = "%s/lock"
+
+	lockTTL             = time.Minute
+	maxLockAttempts     = 5
+	defaultLockInterval = 200 * time.Millisecond
+)
+
+// withLock runs fn while holding the lock parameter of the parameter at path.
+// If the lock is held by someone else, it retries a few times before returning ErrConcurrentModification.
+// A lock that outlived its TTL, for example because the process holding it was interrupted, is broken.
+func (s *Store) withLock(path string, fn func() error) error {
+	lockPath := fmt.Sprintf(fmtLockParamPath, path)
+	holderID, err := newLockHolderID()
+	if err != nil {
+		return err
+	}
+	acquired := false
+	for attempt := 0; attempt < maxLockAttempts; attempt++ {
+		ok, err := s.tryLock(lockPath, holderID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			acquired = true
+			break
+		}
+		time.Sleep(s.lockRetryInterval)
+	}
+	if !acquired {
+		return &ErrConcurrentModification{Name: path}
+	}
+	defer s.unlock(lockPath, holderID)
+	return fn()
+}
+
+// tryLock returns true if the lock was acquired by holderID, and false if the lock is currently held by someone else.
+func (s *Store) tryLock(lockPath, holderID string) (bool, error) {
+	_, err := s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(lockPath),
+		Description: aws.String("Copilot configuration lock"),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(configLock{holderID: holderID, expiresAt: time.Now().Add(lockTTL)}.String()),
+	})
+	if err == nil {
+		return true, nil
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr.Code() != ssm.ErrCodeParameterAlreadyExists {
+		return false, fmt.Errorf("acquire lock %s: %w", lockPath, err)
+	}
+	current, err := s.getLock(lockPath)
+	if err != nil {
+		return false, err
+	}
+	if current == nil || !current.expired() {
+		// The lock was released in the meantime, or is still held by someone else.
+		return false, nil
+	}
+	// The lock expired, break it so that it can be acquired on the next attempt. The lock is read again right before it's
+	// deleted so that a lock broken and acquired by someone else in the meantime isn't deleted with it.
+	s.deleteLockIf(lockPath, current.sameLease)
+	return false, nil
+}
+
+// unlock deletes the lock parameter if it's still held by holderID, with a best effort.
+// An orphaned lock expires after its TTL.
+func (s *Store) unlock(lockPath, holderID string) {
+	s.deleteLockIf(lockPath, func(current configLock) bool {
+		return current.holderID == holderID
+	})
+}
+
+// deleteLockIf reads the lock parameter and deletes it only if it matches.
+func (s *Store) deleteLockIf(lockPath string, matches func(current configLock) bool) {
+	current, err := s.getLock(lockPath)
+	if err != nil || current == nil || !matches(*current) {
+		return
+	}
+	s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(lockPath),
+	})
+}
+
+// getLock returns the lock parameter at lockPath, or nil if nobody holds it.
+func (s *Store) getLock(lockPath string) (*configLock, error) {
+	out, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(lockPath),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get lock %s: %w", lockPath, err)
+	}
+	lock := parseConfigLock(aws.StringValue(out.Parameter.Value))
+	return &lock, nil
+}
+
+// configLock is the value of a lock parameter: the ID of its holder followed by its expiration time.
+// Locks written by previous versions of the CLI only hold the expiration time.
+type configLock struct {
+	holderID  string
+	expiresAt time.Time
+}
+
+// parseConfigLock parses the value of a lock parameter. A malformed expiration time is parsed as an expired lock
+// so that it's broken.
+func parseConfigLock(value string) configLock {
+	var lock configLock
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return lock
+	}
+	if len(fields) > 1 {
+		lock.holderID = fields[0]
+	}
+	lock.expiresAt, _ = time.Parse(time.RFC3339, fields[len(fields)-1])
+	return lock
+}
+
+func (l configLock) String() string {
+	return fmt.Sprintf("%s %s", l.holderID, l.expiresAt.UTC().Format(time.RFC3339))
+}
+
+func (l configLock) expired() bool {
+	return !time.Now().Before(l.expiresAt)
+}
+
+// sameLease returns true if other is the same acquisition of the lock.
+func (l configLock) sameLease(other configLock) bool {
+	return l.holderID == other.holderID && l.expiresAt.Equal(other.expiresAt)
+}
+
+// newLockHolderID returns a random ID identifying a holder of a configuration lock.
+func newLockHolderID() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("generate lock holder ID: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// putIfVersion overwrites the parameter at path with the serialized value, if the parameter is still at version.
+// A version of 0 means the caller never read the parameter and the value is written unconditionally.
+// Returns the version of the parameter after the write.
+func (s *Store) putIfVersion(path string, version int64, v interface{}) (int64, error) {
+	var newVersion int64
+	err := s.withLock(path, func() error {
+		current, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+			Name: aws.String(path),
+		})
+		if err != nil {
+			return err
+		}
+		if version != 0 && aws.Int64Value(current.Parameter.Version) != version {
+			return &ErrConcurrentModification{Name: path}
+		}
+		data, err := marshal(v)
+		if err != nil {
+			return fmt.Errorf("serializing %s: %w", path, err)
+		}
+		out, err := s.ssmClient.PutParameter(&ssm.PutParameterInput{
+			Name:      aws.String(path),
+			Type:      aws.String(ssm.ParameterTypeString),
+			Value:     aws.String(data),
+			Overwrite: aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+		newVersion = aws.Int64Value(out.Version)
+		return nil
+	})
+	return newVersion, err
+}
+
+// deleteLocked deletes the parameter at path while holding its lock.
+func (s *Store) deleteLocked(path string) error {
+	return s.withLock(path, func() error {
+		_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+			Name: aws.String(path),
+		})
+		return err
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_withLock(t *testing.T) {
+	const (
+		path     = "/copilot/applications/phonetool"
+		lockPath = path + "/lock"
+
+		expiredLock = "0123456789abcdef 2000-01-01T00:00:00Z"
+		heldLock    = "fedcba9876543210 2100-01-01T00:00:00Z"
+	)
+	testCases := map[string]struct {
+		inLocks     []string // Values of the lock parameter held by someone else returned by successive reads, the last one is repeated.
+		inHeld      bool     // Whether the lock parameter exists when the first write is attempted.
+		inTakenOver bool     // Whether the lock is broken and acquired by someone else once it's acquired.
+
+		wantedDeletes []string // Values of the lock parameter when it's deleted.
+		wantedRun     bool
+		wantedErr     error
+	}{
+		"acquires and releases a free lock": {
+			wantedDeletes: []string{"self"},
+			wantedRun:     true,
+		},
+		"breaks an expired lock that is still held by the same lease": {
+			inHeld:        true,
+			inLocks:       []string{expiredLock},
+			wantedDeletes: []string{expiredLock, "self"},
+			wantedRun:     true,
+		},
+		"breaks an expired lock written by a previous version": {
+			inHeld:        true,
+			inLocks:       []string{"2000-01-01T00:00:00Z"},
+			wantedDeletes: []string{"2000-01-01T00:00:00Z", "self"},
+			wantedRun:     true,
+		},
+		"does not break an expired lock that was acquired by someone else in the meantime": {
+			inHeld:    true,
+			inLocks:   []string{expiredLock, heldLock},
+			wantedErr: &ErrConcurrentModification{Name: path},
+		},
+		"does not break a lock held by someone else": {
+			inHeld:    true,
+			inLocks:   []string{heldLock},
+			wantedErr: &ErrConcurrentModification{Name: path},
+		},
+		"does not release a lock that was broken and acquired by someone else": {
+			inTakenOver: true,
+			wantedRun:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			held := tc.inHeld
+			var self string
+			var reads int
+			var deletes []string
+			currentLock := func() string {
+				if self != "" && tc.inTakenOver {
+					return heldLock
+				}
+				if self != "" {
+					return self
+				}
+				i := reads
+				if i >= len(tc.inLocks) {
+					i = len(tc.inLocks) - 1
+				}
+				return tc.inLocks[i]
+			}
+			store := &Store{
+				ssmClient: &mockSSM{
+					t: t,
+					mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+						require.Equal(t, lockPath, aws.StringValue(param.Name))
+						require.False(t, aws.BoolValue(param.Overwrite), "locks must never be overwritten")
+						if held {
+							return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", nil)
+						}
+						self = aws.StringValue(param.Value)
+						held = true
+						return &ssm.PutParameterOutput{}, nil
+					},
+					mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+						require.Equal(t, lockPath, aws.StringValue(param.Name))
+						value := currentLock()
+						reads++
+						return &ssm.GetParameterOutput{
+							Parameter: &ssm.Parameter{Name: param.Name, Value: aws.String(value)},
+						}, nil
+					},
+					mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+						require.Equal(t, lockPath, aws.StringValue(param.Name))
+						value := currentLock()
+						if value == self {
+							value = "self"
+						}
+						deletes = append(deletes, value)
+						held = false
+						return &ssm.DeleteParameterOutput{}, nil
+					},
+				},
+			}
+			var ran bool
+
+			// WHEN
+			err := store.withLock(path, func() error {
+				ran = true
+				require.Len(t, strings.Fields(self), 2, fmt.Sprintf("lock %q should hold the holder ID and expiration time", self))
+				return nil
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedRun, ran)
+			require.Equal(t, tc.wantedDeletes, deletes)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Metadata holds optional ownership and routing information about an application, environment or service.
+// Unlike resource tags, metadata is only stored in the configuration and isn't applied to any AWS resource.
+type Metadata struct {
+	Description string            `json:"description,omitempty"` // Human readable description.
+	Owner       string            `json:"owner,omitempty"`       // Team or individual owning the resource.
+	Contact     string            `json:"contact,omitempty"`     // How to reach the owner (ex: email address, chat channel).
+	Labels      map[string]string `json:"labels,omitempty"`      // Free-form key value pairs used to group and filter resources.
+}
+
+// IsEmpty returns true if none of the metadata fields are set.
+func (m Metadata) IsEmpty() bool {
+	return m.Description == "" && m.Owner == "" && m.Contact == "" && len(m.Labels) == 0
+}
+
+// MatchLabels returns true if every label in selector is present in the metadata with the same value.
+func (m Metadata) MatchLabels(selector map[string]string) bool {
+	for k, v := range selector {
+		if val, ok := m.Labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+// LabelsString returns the labels as comma separated "key=value" pairs sorted by key.
+func (m Metadata) LabelsString() string {
+	keys := make([]string, 0, len(m.Labels))
+	for k := range m.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%s", k, m.Labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
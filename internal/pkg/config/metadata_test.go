@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadata_MatchLabels(t *testing.T) {
+	testCases := map[string]struct {
+		labels   map[string]string
+		selector map[string]string
+
+		wanted bool
+	}{
+		"empty selector matches everything": {
+			wanted: true,
+		},
+		"matches a subset of the labels": {
+			labels:   map[string]string{"team": "payments", "tier": "1"},
+			selector: map[string]string{"team": "payments"},
+			wanted:   true,
+		},
+		"doesn't match a different value": {
+			labels:   map[string]string{"team": "payments"},
+			selector: map[string]string{"team": "search"},
+			wanted:   false,
+		},
+		"doesn't match a missing label": {
+			labels:   map[string]string{"team": "payments"},
+			selector: map[string]string{"team": "payments", "tier": "1"},
+			wanted:   false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := Metadata{Labels: tc.labels}
+
+			require.Equal(t, tc.wanted, m.MatchLabels(tc.selector))
+		})
+	}
+}
+
+func TestMetadata_LabelsString(t *testing.T) {
+	m := Metadata{Labels: map[string]string{"tier": "1", "team": "payments"}}
+
+	require.Equal(t, "team=payments,tier=1", m.LabelsString())
+	require.Equal(t, "", Metadata{}.LabelsString())
+}
+
+func TestService_MarshalMetadata(t *testing.T) {
+	svc := &Service{
+		App:  "phonetool",
+		Name: "api",
+		Type: "Backend Service",
+		Metadata: Metadata{
+			Owner:  "payments",
+			Labels: map[string]string{"tier": "1"},
+		},
+	}
+
+	data, err := marshal(svc)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app":"phonetool","name":"api","type":"Backend Service","owner":"payments","labels":{"tier":"1"}}`, data)
+}
@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// initialSchemaVersion is the version of configuration written before the version was recorded.
+const initialSchemaVersion = "1.0"
+
+// Migration upgrades the stored configuration of an application, its environments and services to Version.
+// Each transformation operates on the decoded JSON document of a parameter and is optional.
+// Transformations must be idempotent so that a partially applied migration can be run again.
+type Migration struct {
+	Version     string // Schema version of the configuration once the migration is applied.
+	Description string // Human readable summary of the changes.
+
+	Application func(app map[string]interface{}) error
+	Environment func(env map[string]interface{}) error
+	Service     func(svc map[string]interface{}) error
+}
+
+// migrations is the list of migrations ordered by version to bring configuration up to schemaVersion.
+// A migration is added here whenever schemaVersion is bumped; configuration at the first schema version
+// has nothing to migrate.
+var migrations []Migration
+
+// MigrationResult describes the migrations that were, or would be in dry-run mode, applied to an application.
+type MigrationResult struct {
+	Application string   // Name of the application.
+	FromVersion string   // Schema version the application was stored with.
+	ToVersion   string   // Schema version of the application after the migrations.
+	Migrations  []string // Descriptions of the migrations applied in order.
+	Parameters  []string // Names of the parameters, or paths of the files in a local store, updated.
+}
+
+// HasChanges returns true if there is at least one migration to apply.
+func (r *MigrationResult) HasChanges() bool {
+	return len(r.Migrations) != 0
+}
+
+// MigrateApplication brings the configuration of an application, its environments and services up to the
+// schema version supported by this CLI. If dryRun is true, the migrations are computed without being written to SSM.
+// Environments and services are written before the application so that a failed migration can be retried.
+func (s *Store) MigrateApplication(appName string, dryRun bool) (*MigrationResult, error) {
+	appPath := fmt.Sprintf(fmtApplicationPath, appName)
+	appParam, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(appPath),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			account, region := s.getCallerAccountAndRegion()
+			return nil, &ErrNoSuchApplication{
+				ApplicationName: appName,
+				AccountID:       account,
+				Region:          region,
+			}
+		}
+		return nil, fmt.Errorf("get application %s: %w", appName, err)
+	}
+	app := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(aws.StringValue(appParam.Parameter.Value)), &app); err != nil {
+		return nil, fmt.Errorf("read configuration for application %s: %w", appName, err)
+	}
+	result, pending, err := planMigrations(s.migrations, appName, app)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	envParams, err := s.listParameters(fmt.Sprintf(rootEnvParamPath, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list environments for application %s: %w", appName, err)
+	}
+	svcParams, err := s.listParameters(fmt.Sprintf(rootSvcParamPath, appName))
+	if err != nil {
+		return nil, fmt.Errorf("list services for application %s: %w", appName, err)
+	}
+	envs, err := decodeParameters(envParams)
+	if err != nil {
+		return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
+	}
+	svcs, err := decodeParameters(svcParams)
+	if err != nil {
+		return nil, fmt.Errorf("read service configuration for application %s: %w", appName, err)
+	}
+	if err := applyMigrations(pending, result, app, envs, svcs); err != nil {
+		return nil, err
+	}
+
+	updates := make([]*ssm.Parameter, 0, len(envParams)+len(svcParams)+1)
+	for _, param := range append(envParams, svcParams...) {
+		doc := envs[aws.StringValue(param.Name)]
+		if doc == nil {
+			doc = svcs[aws.StringValue(param.Name)]
+		}
+		data, err := marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("serializing parameter %s: %w", aws.StringValue(param.Name), err)
+		}
+		updates = append(updates, &ssm.Parameter{Name: param.Name, Value: aws.String(data)})
+	}
+	data, err := marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("serializing application %s: %w", appName, err)
+	}
+	updates = append(updates, &ssm.Parameter{Name: aws.String(appPath), Value: aws.String(data)})
+
+	for _, param := range updates {
+		result.Parameters = append(result.Parameters, aws.StringValue(param.Name))
+		if dryRun {
+			continue
+		}
+		if _, err := s.ssmClient.PutParameter(&ssm.PutParameterInput{
+			Name:      param.Name,
+			Type:      aws.String(ssm.ParameterTypeString),
+			Value:     param.Value,
+			Overwrite: aws.Bool(true),
+		}); err != nil {
+			return nil, fmt.Errorf("update parameter %s: %w", aws.StringValue(param.Name), err)
+		}
+	}
+	return result, nil
+}
+
+// planMigrations returns the migrations to apply to the decoded configuration of an application, along with the
+// result of the migration before any migration is applied.
+func planMigrations(all []Migration, appName string, app map[string]interface{}) (*MigrationResult, []Migration, error) {
+	storedVersion, _ := app["version"].(string)
+	if storedVersion == "" {
+		storedVersion = initialSchemaVersion
+	}
+	if err := checkSchemaVersion(appName, storedVersion); err != nil {
+		return nil, nil, err
+	}
+	pending, err := pendingMigrations(all, storedVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &MigrationResult{
+		Application: appName,
+		FromVersion: storedVersion,
+		ToVersion:   storedVersion,
+	}, pending, nil
+}
+
+// applyMigrations applies the pending migrations in order to the decoded configuration of an application, its
+// environments and services keyed by name, and records them in result.
+func applyMigrations(pending []Migration, result *MigrationResult, app map[string]interface{}, envs, svcs map[string]map[string]interface{}) error {
+	for _, m := range pending {
+		if err := applyMigration(m.Application, app); err != nil {
+			return fmt.Errorf("migrate application %s to version %s: %w", result.Application, m.Version, err)
+		}
+		for name, env := range envs {
+			if err := applyMigration(m.Environment, env); err != nil {
+				return fmt.Errorf("migrate environment parameter %s to version %s: %w", name, m.Version, err)
+			}
+		}
+		for name, svc := range svcs {
+			if err := applyMigration(m.Service, svc); err != nil {
+				return fmt.Errorf("migrate service parameter %s to version %s: %w", name, m.Version, err)
+			}
+		}
+		app["version"] = m.Version
+		result.ToVersion = m.Version
+		result.Migrations = append(result.Migrations, m.Description)
+	}
+	return nil
+}
+
+func applyMigration(fn func(map[string]interface{}) error, doc map[string]interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(doc)
+}
+
+func decodeParameters(params []*ssm.Parameter) (map[string]map[string]interface{}, error) {
+	docs := make(map[string]map[string]interface{}, len(params))
+	for _, param := range params {
+		doc := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &doc); err != nil {
+			return nil, err
+		}
+		docs[aws.StringValue(param.Name)] = doc
+	}
+	return docs, nil
+}
+
+// pendingMigrations returns the migrations that upgrade configuration stored at version up to schemaVersion.
+func pendingMigrations(all []Migration, version string) ([]Migration, error) {
+	var pending []Migration
+	for _, m := range all {
+		newer, err := compareSchemaVersions(m.Version, version)
+		if err != nil {
+			return nil, err
+		}
+		if newer <= 0 {
+			continue
+		}
+		supported, err := compareSchemaVersions(m.Version, schemaVersion)
+		if err != nil {
+			return nil, err
+		}
+		if supported > 0 {
+			continue
+		}
+		pending = append(pending, m)
+	}
+	return pending, nil
+}
+
+// checkSchemaVersion returns an ErrSchemaVersionTooNew if the application's configuration was written
+// by a newer version of the CLI than this one.
+func checkSchemaVersion(appName, version string) error {
+	if version == "" {
+		return nil
+	}
+	cmp, err := compareSchemaVersions(version, schemaVersion)
+	if err != nil {
+		return fmt.Errorf("check schema version of application %s: %w", appName, err)
+	}
+	if cmp > 0 {
+		return &ErrSchemaVersionTooNew{
+			ApplicationName:  appName,
+			Version:          version,
+			SupportedVersion: schemaVersion,
+		}
+	}
+	return nil
+}
+
+// compareSchemaVersions returns -1, 0, or 1 if version a is respectively lower, equal, or higher than version b.
+// Versions are dot separated lists of numbers like "1.0".
+func compareSchemaVersions(a, b string) (int, error) {
+	aParts, err := parseSchemaVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bParts, err := parseSchemaVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var x, y int
+		if i < len(aParts) {
+			x = aParts[i]
+		}
+		if i < len(bParts) {
+			y = bParts[i]
+		}
+		if x < y {
+			return -1, nil
+		}
+		if x > y {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func parseSchemaVersion(version string) ([]int, error) {
+	var parts []int
+	for _, field := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema version %s", version)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
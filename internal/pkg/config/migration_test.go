@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareSchemaVersions(t *testing.T) {
+	testCases := map[string]struct {
+		a, b      string
+		wanted    int
+		wantedErr error
+	}{
+		"equal":               {a: "1.0", b: "1.0", wanted: 0},
+		"lower minor":         {a: "1.0", b: "1.1", wanted: -1},
+		"higher major":        {a: "2.0", b: "1.9", wanted: 1},
+		"numeric not lexical": {a: "1.10", b: "1.9", wanted: 1},
+		"missing parts":       {a: "1", b: "1.0", wanted: 0},
+		"invalid":             {a: "one", b: "1.0", wantedErr: errors.New("invalid schema version one")},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := compareSchemaVersions(tc.a, tc.b)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStore_MigrateApplication(t *testing.T) {
+	const (
+		appPath = "/copilot/applications/phonetool"
+		envPath = "/copilot/applications/phonetool/environments/test"
+		svcPath = "/copilot/applications/phonetool/components/api"
+	)
+	addOwner := Migration{
+		Version:     "1.0",
+		Description: "add owner to services",
+		Service: func(svc map[string]interface{}) error {
+			svc["owner"] = "unknown"
+			return nil
+		},
+	}
+	getParametersByPath := func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+		switch aws.StringValue(in.Path) {
+		case fmt.Sprintf(rootEnvParamPath, "phonetool"):
+			return &ssm.GetParametersByPathOutput{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String(envPath), Value: aws.String(`{"app":"phonetool","name":"test"}`)},
+				},
+			}, nil
+		case fmt.Sprintf(rootSvcParamPath, "phonetool"):
+			return &ssm.GetParametersByPathOutput{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String(svcPath), Value: aws.String(`{"app":"phonetool","name":"api"}`)},
+				},
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected path %s", aws.StringValue(in.Path))
+	}
+
+	testCases := map[string]struct {
+		storedApp  string
+		migrations []Migration
+		dryRun     bool
+
+		wantedPuts   map[string]string
+		wantedResult *MigrationResult
+		wantedErr    error
+	}{
+		"stored data is newer than the CLI": {
+			storedApp: `{"name":"phonetool","version":"99.0"}`,
+			wantedErr: &ErrSchemaVersionTooNew{ApplicationName: "phonetool", Version: "99.0", SupportedVersion: schemaVersion},
+		},
+		"no pending migrations": {
+			storedApp:  `{"name":"phonetool","version":"1.0"}`,
+			migrations: []Migration{addOwner},
+			wantedResult: &MigrationResult{
+				Application: "phonetool",
+				FromVersion: "1.0",
+				ToVersion:   "1.0",
+			},
+		},
+		"dry run does not write parameters": {
+			storedApp:  `{"name":"phonetool","version":"0.9"}`,
+			migrations: []Migration{addOwner},
+			dryRun:     true,
+			wantedResult: &MigrationResult{
+				Application: "phonetool",
+				FromVersion: "0.9",
+				ToVersion:   "1.0",
+				Migrations:  []string{"add owner to services"},
+				Parameters:  []string{envPath, svcPath, appPath},
+			},
+		},
+		"applies migrations in order": {
+			storedApp:  `{"name":"phonetool","version":"0.9"}`,
+			migrations: []Migration{addOwner},
+			wantedPuts: map[string]string{
+				envPath: `{"app":"phonetool","name":"test"}`,
+				svcPath: `{"app":"phonetool","name":"api","owner":"unknown"}`,
+				appPath: `{"name":"phonetool","version":"1.0"}`,
+			},
+			wantedResult: &MigrationResult{
+				Application: "phonetool",
+				FromVersion: "0.9",
+				ToVersion:   "1.0",
+				Migrations:  []string{"add owner to services"},
+				Parameters:  []string{envPath, svcPath, appPath},
+			},
+		},
+		"migration failure": {
+			storedApp: `{"name":"phonetool","version":"0.9"}`,
+			migrations: []Migration{
+				{
+					Version: "1.0",
+					Environment: func(env map[string]interface{}) error {
+						return errors.New("some error")
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("migrate environment parameter %s to version 1.0: some error", envPath),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			puts := make(map[string]string)
+			store := &Store{
+				ssmClient: &mockSSM{
+					t: t,
+					mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+						require.Equal(t, appPath, aws.StringValue(in.Name))
+						return &ssm.GetParameterOutput{
+							Parameter: &ssm.Parameter{Name: in.Name, Value: aws.String(tc.storedApp)},
+						}, nil
+					},
+					mockGetParametersByPath: getParametersByPath,
+					mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+						require.True(t, aws.BoolValue(in.Overwrite))
+						puts[aws.StringValue(in.Name)] = aws.StringValue(in.Value)
+						return &ssm.PutParameterOutput{}, nil
+					},
+				},
+				migrations: tc.migrations,
+			}
+
+			// WHEN
+			result, err := store.MigrateApplication("phonetool", tc.dryRun)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResult, result)
+			if tc.wantedPuts == nil {
+				require.Empty(t, puts)
+				return
+			}
+			require.Equal(t, len(tc.wantedPuts), len(puts))
+			for path, value := range tc.wantedPuts {
+				require.JSONEq(t, value, puts[path])
+			}
+		})
+	}
+}
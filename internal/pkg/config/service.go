@@ -23,17 +23,23 @@ const (
 	// AddonsCfnTemplateNameFormat is the addons output file name when `service package`
 	// is called.
 	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
+	// DeployedServiceCfnTemplateNameFormat is the file name of the stack template uploaded
+	// when a service is deployed to an environment.
+	DeployedServiceCfnTemplateNameFormat = "%s-%s.stack.yml"
 )
 
 // Service represents a deployable long running service or task.
 type Service struct {
-	App  string `json:"app"`  // Name of the app this service belongs to.
-	Name string `json:"name"` // Name of the service, which must be unique within a app.
-	Type string `json:"type"` // Type of the service (ex: Load Balanced Web Server, etc)
+	App      string `json:"app"`  // Name of the app this service belongs to.
+	Name     string `json:"name"` // Name of the service, which must be unique within a app.
+	Type     string `json:"type"` // Type of the service (ex: Load Balanced Web Server, etc)
+	Metadata        // Optional ownership and routing information.
+
+	version int64 // Version of the stored configuration when it was read, used to detect concurrent modifications.
 }
 
-// CreateService instantiates a new service within an existing application. Skip if
-// the service already exists in the application.
+// CreateService instantiates a new service within an existing application. If the service
+// already exists in the application, it returns ErrServiceAlreadyExists.
 func (s *Store) CreateService(svc *Service) error {
 	if _, err := s.GetApplication(svc.App); err != nil {
 		return err
@@ -55,7 +61,10 @@ func (s *Store) CreateService(svc *Service) error {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
 			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
+				return &ErrServiceAlreadyExists{
+					ApplicationName: svc.App,
+					ServiceName:     svc.Name,
+				}
 			}
 		}
 		return fmt.Errorf("create service %s in application %s: %w", svc.Name, svc.App, err)
@@ -89,23 +98,49 @@ func (s *Store) GetService(appName, svcName string) (*Service, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read configuration for service %s in application %s: %w", svcName, appName, err)
 	}
+	svc.version = aws.Int64Value(svcParam.Parameter.Version)
 	return &svc, nil
 }
 
+// UpdateService overwrites the configuration of an existing service. If the service was
+// modified since it was read from the store, it returns ErrConcurrentModification.
+func (s *Store) UpdateService(svc *Service) error {
+	if _, err := s.GetApplication(svc.App); err != nil {
+		return err
+	}
+	servicePath := fmt.Sprintf(fmtSvcParamPath, svc.App, svc.Name)
+	version, err := s.putIfVersion(servicePath, svc.version, svc)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return &ErrNoSuchService{
+					ApplicationName: svc.App,
+					ServiceName:     svc.Name,
+				}
+			}
+		}
+		return fmt.Errorf("update service %s in application %s: %w", svc.Name, svc.App, err)
+	}
+	svc.version = version
+	return nil
+}
+
 // ListServices returns all services belonging to a particular application.
 func (s *Store) ListServices(appName string) ([]*Service, error) {
 	var services []*Service
 
 	servicesPath := fmt.Sprintf(rootSvcParamPath, appName)
-	serializedSvcs, err := s.listParams(servicesPath)
+	params, err := s.listParameters(servicesPath)
 	if err != nil {
 		return nil, fmt.Errorf("list services for application %s: %w", appName, err)
 	}
-	for _, serializedSvc := range serializedSvcs {
+	for _, param := range params {
 		var svc Service
-		if err := json.Unmarshal([]byte(*serializedSvc), &svc); err != nil {
+		if err := json.Unmarshal([]byte(*param.Value), &svc); err != nil {
 			return nil, fmt.Errorf("read service configuration for application %s: %w", appName, err)
 		}
+		svc.version = aws.Int64Value(param.Version)
 
 		services = append(services, &svc)
 	}
@@ -116,10 +151,7 @@ func (s *Store) ListServices(appName string) ([]*Service, error) {
 // If the service does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteService(appName, svcName string) error {
 	paramName := fmt.Sprintf(fmtSvcParamPath, appName, svcName)
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
+	err := s.deleteLocked(paramName)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -263,7 +263,7 @@ func TestStore_CreateService(t *testing.T) {
 					},
 				}, nil
 			},
-			wantedErr: nil,
+			wantedErr: &ErrServiceAlreadyExists{ApplicationName: "chicken", ServiceName: "api"},
 		},
 		"with SSM error": {
 			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
@@ -342,11 +342,11 @@ func TestDeleteService(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			s := &Store{
-				ssmClient: &mockSSM{
+				ssmClient: grantLocks(&mockSSM{
 					t: t,
 
 					mockDeleteParameter: test.mockDeleteParam,
-				},
+				}),
 			}
 
 			got := s.DeleteService(mockApplicationName, mockSvcName)
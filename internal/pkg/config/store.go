@@ -12,6 +12,8 @@ package config
 import (
 	"encoding/json"
 	"log"
+	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -39,6 +41,45 @@ const (
 	fmtSvcParamPath     = "/copilot/applications/%s/components/%s" // path for a service in an application
 )
 
+// EnvVarLocalStoreDir is the environment variable that, when set, points the CLI to a local directory
+// to store application, environment and service configuration instead of SSM Parameter Store.
+const EnvVarLocalStoreDir = "COPILOT_LOCAL_STORE_DIR"
+
+// ConfigStore is the interface for fetching and creating applications, environments and services configuration,
+// along with the deployment history of services, the locks guarding their deployments, and the migrations of
+// the stored configuration.
+type ConfigStore interface {
+	CreateApplication(application *Application) error
+	GetApplication(applicationName string) (*Application, error)
+	UpdateApplication(application *Application) error
+	ListApplications() ([]*Application, error)
+	DeleteApplication(name string) error
+
+	CreateEnvironment(environment *Environment) error
+	GetEnvironment(appName string, environmentName string) (*Environment, error)
+	UpdateEnvironment(environment *Environment) error
+	ListEnvironments(appName string) ([]*Environment, error)
+	DeleteEnvironment(appName, environmentName string) error
+
+	CreateService(svc *Service) error
+	GetService(appName, svcName string) (*Service, error)
+	UpdateService(svc *Service) error
+	ListServices(appName string) ([]*Service, error)
+	DeleteService(appName, svcName string) error
+
+	CreateDeployment(d *Deployment) error
+	GetDeployment(appName, envName, svcName string, revision int) (*Deployment, error)
+	ListDeployments(appName, envName, svcName string) ([]*Deployment, error)
+	DeleteDeployments(appName, envName, svcName string) error
+
+	AcquireDeploymentLock(lock *DeploymentLock) error
+	ReleaseDeploymentLock(lock *DeploymentLock) error
+	GetDeploymentLock(appName, envName, svcName string) (*DeploymentLock, error)
+	BreakDeploymentLock(appName, envName, svcName string) error
+
+	MigrateApplication(appName string, dryRun bool) (*MigrationResult, error)
+}
+
 type identityGetter interface {
 	Get() (identity.Caller, error)
 }
@@ -48,6 +89,9 @@ type Store struct {
 	idClient      identityGetter
 	ssmClient     ssmiface.SSMAPI
 	sessionRegion string
+	migrations    []Migration
+
+	lockRetryInterval time.Duration
 }
 
 // NewStore returns a new store, allowing you to query or create Applications, Environments, and Services.
@@ -63,11 +107,24 @@ func NewStore() (*Store, error) {
 		idClient:      identity.New(sess),
 		ssmClient:     ssm.New(sess),
 		sessionRegion: *sess.Config.Region,
+		migrations:    migrations,
+
+		lockRetryInterval: defaultLockInterval,
 	}, nil
 }
 
-func (s *Store) listParams(path string) ([]*string, error) {
-	var serializedParams []*string
+// NewConfigStore returns the ConfigStore selected by the user's environment.
+// If the EnvVarLocalStoreDir environment variable is set, the configuration is read from and written to that
+// directory. Otherwise, the configuration is stored in SSM Parameter Store.
+func NewConfigStore() (ConfigStore, error) {
+	if dir := os.Getenv(EnvVarLocalStoreDir); dir != "" {
+		return NewLocalStore(dir), nil
+	}
+	return NewStore()
+}
+
+func (s *Store) listParameters(path string) ([]*ssm.Parameter, error) {
+	var parameters []*ssm.Parameter
 
 	var nextToken *string
 	for {
@@ -81,16 +138,14 @@ func (s *Store) listParams(path string) ([]*string, error) {
 			return nil, err
 		}
 
-		for _, param := range params.Parameters {
-			serializedParams = append(serializedParams, param.Value)
-		}
+		parameters = append(parameters, params.Parameters...)
 
 		nextToken = params.NextToken
 		if nextToken == nil {
 			break
 		}
 	}
-	return serializedParams, nil
+	return parameters, nil
 }
 
 // Retrieves the caller's Account ID with a best effort. If it fails to fetch the Account ID,
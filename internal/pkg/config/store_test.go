@@ -4,11 +4,15 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/stretchr/testify/require"
 )
 
 type mockSSM struct {
@@ -43,3 +47,42 @@ type mockIdentityService struct {
 func (m mockIdentityService) Get() (identity.Caller, error) {
 	return m.mockIdentityServiceGet()
 }
+
+// grantLocks wraps the mock so that every configuration lock is acquired and released successfully,
+// while calls on other parameters are forwarded to the wrapped mock functions.
+func grantLocks(m *mockSSM) *mockSSM {
+	isLock := func(name *string) bool {
+		return strings.HasSuffix(aws.StringValue(name), "/lock")
+	}
+	locks := make(map[string]string)
+	put, get, del := m.mockPutParameter, m.mockGetParameter, m.mockDeleteParameter
+	m.mockPutParameter = func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+		if isLock(in.Name) {
+			require.False(t, aws.BoolValue(in.Overwrite), "locks must never be overwritten")
+			locks[aws.StringValue(in.Name)] = aws.StringValue(in.Value)
+			return &ssm.PutParameterOutput{}, nil
+		}
+		return put(t, in)
+	}
+	m.mockGetParameter = func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+		if isLock(in.Name) {
+			value, ok := locks[aws.StringValue(in.Name)]
+			if !ok {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "No Parameter", nil)
+			}
+			return &ssm.GetParameterOutput{
+				Parameter: &ssm.Parameter{Name: in.Name, Value: aws.String(value)},
+			}, nil
+		}
+		return get(t, in)
+	}
+	m.mockDeleteParameter = func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+		if isLock(in.Name) {
+			require.Contains(t, locks, aws.StringValue(in.Name), "only held locks are released")
+			delete(locks, aws.StringValue(in.Name))
+			return &ssm.DeleteParameterOutput{}, nil
+		}
+		return del(t, in)
+	}
+	return m
+}
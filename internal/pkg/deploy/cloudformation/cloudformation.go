@@ -18,6 +18,8 @@ import (
 	"github.com/gobuffalo/packd"
 )
 
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
 type StackConfiguration interface {
 	StackName() string
@@ -34,8 +36,15 @@ type cfnClient interface {
 	UpdateAndWait(*cloudformation.Stack) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
+	DisableTerminationProtection(stackName string) error
+	ValidateDeployable(stackName string) error
+	ContinueUpdateRollbackAndWait(stackName string, skipResources []string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
+	CreateChangeSet(*cloudformation.Stack) (*cloudformation.ChangeSet, error)
+	ExecuteChangeSet(*cloudformation.ChangeSet) error
+	ExecuteChangeSetAndWait(*cloudformation.ChangeSet) error
+	DeleteChangeSet(*cloudformation.ChangeSet) error
 }
 
 type stackSetClient interface {
@@ -69,27 +78,71 @@ func New(sess *session.Session) CloudFormation {
 	}
 }
 
+// ExecuteChangeSet executes a change set returned by one of the Preview methods without waiting for the deployment.
+func (cf CloudFormation) ExecuteChangeSet(cs *cloudformation.ChangeSet) error {
+	return cf.cfnClient.ExecuteChangeSet(cs)
+}
+
+// ExecuteChangeSetAndWait executes a change set returned by one of the Preview methods and waits until the deployment is done.
+func (cf CloudFormation) ExecuteChangeSetAndWait(cs *cloudformation.ChangeSet) error {
+	return cf.cfnClient.ExecuteChangeSetAndWait(cs)
+}
+
+// DeleteChangeSet discards a change set returned by one of the Preview methods.
+func (cf CloudFormation) DeleteChangeSet(cs *cloudformation.ChangeSet) error {
+	return cf.cfnClient.DeleteChangeSet(cs)
+}
+
+// RecoverStack recovers a stack left in a failed state by a previous deployment so that it can be deployed again.
+// If the stack failed to roll back an update, its rollback is continued without the resources to skip.
+// Otherwise, the stack failed to be created and it's deleted after disabling its termination protection.
+func (cf CloudFormation) RecoverStack(failed *cloudformation.ErrStackRequiresRecovery, skipResources []string) error {
+	if failed.CanContinueRollback() {
+		return cf.cfnClient.ContinueUpdateRollbackAndWait(failed.Name, skipResources)
+	}
+	if err := cf.cfnClient.DisableTerminationProtection(failed.Name); err != nil {
+		return err
+	}
+	return cf.cfnClient.DeleteAndWait(failed.Name)
+}
+
 // streamResourceEvents sends a list of ResourceEvent every 3 seconds to the events channel.
+// Only the events that happened after since are sent, including the events of the resources in nested stacks.
 // The events channel is closed only when the done channel receives a message.
 // If an error occurs while describing stack events, it is ignored so that the stream is not interrupted.
-func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string) {
+func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string, since time.Time) {
 	sendStatusUpdates := func() {
 		// Send a list of ResourceEvent to events if there was no error.
-		cfEvents, err := cf.cfnClient.Events(stackName)
+		cfEvents, err := cf.stackEvents(stackName, since)
 		if err != nil {
 			return
 		}
 		var transformedEvents []deploy.ResourceEvent
+		nestedStacks := make(map[string]string) // Physical ID to logical name of the nested stacks.
+		var nestedStackIDs []string
 		for _, cfEvent := range cfEvents {
-			transformedEvents = append(transformedEvents, deploy.ResourceEvent{
-				Resource: deploy.Resource{
-					LogicalName: aws.StringValue(cfEvent.LogicalResourceId),
-					Type:        aws.StringValue(cfEvent.ResourceType),
-				},
-				Status: aws.StringValue(cfEvent.ResourceStatus),
-				// CFN error messages end with a '.' and only the first sentence is useful, the rest is error codes.
-				StatusReason: strings.Split(aws.StringValue(cfEvent.ResourceStatusReason), ".")[0],
-			})
+			transformedEvents = append(transformedEvents, toResourceEvent(cfEvent, ""))
+			if !isNestedStackEvent(cfEvent) {
+				continue
+			}
+			id := aws.StringValue(cfEvent.PhysicalResourceId)
+			if _, ok := nestedStacks[id]; !ok {
+				nestedStackIDs = append(nestedStackIDs, id)
+			}
+			nestedStacks[id] = aws.StringValue(cfEvent.LogicalResourceId)
+		}
+		for _, id := range nestedStackIDs {
+			nestedEvents, err := cf.stackEvents(id, since)
+			if err != nil {
+				return
+			}
+			for _, cfEvent := range nestedEvents {
+				if aws.StringValue(cfEvent.PhysicalResourceId) == aws.StringValue(cfEvent.StackId) {
+					// Skip the events of the nested stack itself, they're already reported by the parent stack.
+					continue
+				}
+				transformedEvents = append(transformedEvents, toResourceEvent(cfEvent, nestedStacks[id]))
+			}
 		}
 		events <- transformedEvents
 	}
@@ -106,6 +159,52 @@ func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan
 	}
 }
 
+// stackEvents returns the events of the stack that happened after since, in chronological order.
+func (cf CloudFormation) stackEvents(stackName string, since time.Time) ([]cloudformation.StackEvent, error) {
+	cfEvents, err := cf.cfnClient.Events(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var recent []cloudformation.StackEvent
+	for _, cfEvent := range cfEvents {
+		if aws.TimeValue(cfEvent.Timestamp).After(since) {
+			recent = append(recent, cfEvent)
+		}
+	}
+	return recent, nil
+}
+
+// latestEventTime returns the time of the most recent event of the stack.
+// If the stack doesn't exist or its events can't be described, it returns the zero time so that all events are streamed.
+func (cf CloudFormation) latestEventTime(stackName string) time.Time {
+	cfEvents, err := cf.cfnClient.Events(stackName)
+	if err != nil || len(cfEvents) == 0 {
+		return time.Time{}
+	}
+	return aws.TimeValue(cfEvents[len(cfEvents)-1].Timestamp)
+}
+
+func toResourceEvent(cfEvent cloudformation.StackEvent, nestedStack string) deploy.ResourceEvent {
+	return deploy.ResourceEvent{
+		Resource: deploy.Resource{
+			LogicalName: aws.StringValue(cfEvent.LogicalResourceId),
+			Type:        aws.StringValue(cfEvent.ResourceType),
+			PhysicalID:  aws.StringValue(cfEvent.PhysicalResourceId),
+			NestedStack: nestedStack,
+		},
+		Status: aws.StringValue(cfEvent.ResourceStatus),
+		// CFN error messages end with a '.' and only the first sentence is useful, the rest is error codes.
+		StatusReason: strings.Split(aws.StringValue(cfEvent.ResourceStatusReason), ".")[0],
+	}
+}
+
+// isNestedStackEvent returns true if the event is about a nested stack resource of the stack, rather than the stack itself.
+func isNestedStackEvent(cfEvent cloudformation.StackEvent) bool {
+	physicalID := aws.StringValue(cfEvent.PhysicalResourceId)
+	return aws.StringValue(cfEvent.ResourceType) == nestedStackResourceType &&
+		physicalID != "" && physicalID != aws.StringValue(cfEvent.StackId)
+}
+
 func toStack(config StackConfiguration) (*cloudformation.Stack, error) {
 	template, err := config.Template()
 	if err != nil {
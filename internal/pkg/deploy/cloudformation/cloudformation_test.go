@@ -4,8 +4,15 @@
 package cloudformation
 
 import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/gobuffalo/packd"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -19,3 +26,73 @@ func boxWithTemplateFile() packd.Box {
 
 	return box
 }
+
+func TestCloudFormation_RecoverStack(t *testing.T) {
+	testCases := map[string]struct {
+		inFailed        *cloudformation.ErrStackRequiresRecovery
+		inSkipResources []string
+		createMock      func(ctrl *gomock.Controller) cfnClient
+
+		wantedErr error
+	}{
+		"continues the rollback of a stack that failed to roll back an update": {
+			inFailed: &cloudformation.ErrStackRequiresRecovery{
+				Name:   "phonetool-test-api",
+				Status: "UPDATE_ROLLBACK_FAILED",
+			},
+			inSkipResources: []string{"Service"},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ContinueUpdateRollbackAndWait("phonetool-test-api", []string{"Service"}).Return(nil)
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"deletes a stack that failed to be created": {
+			inFailed: &cloudformation.ErrStackRequiresRecovery{
+				Name:   "phonetool-test-api",
+				Status: "ROLLBACK_COMPLETE",
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DisableTerminationProtection("phonetool-test-api").Return(nil)
+				m.EXPECT().DeleteAndWait("phonetool-test-api").Return(nil)
+				return m
+			},
+		},
+		"does not delete the stack if its termination protection can't be disabled": {
+			inFailed: &cloudformation.ErrStackRequiresRecovery{
+				Name:   "phonetool-test-api",
+				Status: "ROLLBACK_FAILED",
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DisableTerminationProtection("phonetool-test-api").Return(errors.New("some error"))
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.RecoverStack(tc.inFailed, tc.inSkipResources)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
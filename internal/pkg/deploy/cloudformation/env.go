@@ -5,6 +5,9 @@
 package cloudformation
 
 import (
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -14,16 +17,30 @@ import (
 //
 // If the deployment succeeds, returns nil.
 // If the stack already exists, returns a ErrStackAlreadyExists.
+// If a previous deployment left the stack in a failed state, returns a ErrStackRequiresRecovery.
 // If the change set to create the stack cannot be executed, returns a ErrNotExecutableChangeSet.
 // Otherwise, returns a wrapped error.
 func (cf CloudFormation) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
-	s, err := toStack(stack.NewEnvStackConfig(env))
+	s, err := toEnvStack(env)
 	if err != nil {
 		return err
 	}
+	if err := cf.cfnClient.ValidateDeployable(s.Name); err != nil {
+		return err
+	}
 	return cf.cfnClient.Create(s)
 }
 
+// PreviewEnvironment creates a change set to create or update the CloudFormation stack of an environment
+// without executing it. If there are no changes to deploy, returns a ErrChangeSetEmpty.
+func (cf CloudFormation) PreviewEnvironment(env *deploy.CreateEnvironmentInput) (*cloudformation.ChangeSet, error) {
+	s, err := toEnvStack(env)
+	if err != nil {
+		return nil, err
+	}
+	return cf.cfnClient.CreateChangeSet(s)
+}
+
 // StreamEnvironmentCreation streams resource update events while a deployment is taking place.
 // Once the CloudFormation stack operation halts, the update channel is closed and a
 // CreateEnvironmentResponse is sent to the second channel.
@@ -33,17 +50,20 @@ func (cf CloudFormation) StreamEnvironmentCreation(env *deploy.CreateEnvironment
 	resp := make(chan deploy.CreateEnvironmentResponse, 1)
 
 	stack := stack.NewEnvStackConfig(env)
-	go cf.streamResourceEvents(done, events, stack.StackName())
+	go cf.streamResourceEvents(done, events, stack.StackName(), time.Time{})
 	go cf.streamEnvironmentResponse(done, resp, stack)
 	return events, resp
 }
 
-// DeleteEnvironment deletes the CloudFormation stack of an environment.
+// DeleteEnvironment deletes the CloudFormation stack of an environment, after disabling its termination protection.
 func (cf CloudFormation) DeleteEnvironment(appName, envName string) error {
 	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
 		AppName: appName,
 		Name:    envName,
 	})
+	if err := cf.cfnClient.DisableTerminationProtection(conf.StackName()); err != nil {
+		return err
+	}
 	return cf.cfnClient.DeleteAndWait(conf.StackName())
 }
 
@@ -79,3 +99,17 @@ func (cf CloudFormation) GetEnvironment(appName, envName string) (*config.Enviro
 	}
 	return conf.ToEnv(descr.SDK())
 }
+
+// toEnvStack returns the stack of the environment, protected if it's a production environment.
+func toEnvStack(env *deploy.CreateEnvironmentInput) (*cloudformation.Stack, error) {
+	s, err := toStack(stack.NewEnvStackConfig(env))
+	if err != nil {
+		return nil, err
+	}
+	if env.Prod {
+		for _, opt := range ProdStackOptions() {
+			opt(s)
+		}
+	}
+	return s, nil
+}
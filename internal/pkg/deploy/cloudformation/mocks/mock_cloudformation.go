@@ -214,6 +214,48 @@ func (mr *MockcfnClientMockRecorder) DeleteAndWait(stackName interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWait", reflect.TypeOf((*MockcfnClient)(nil).DeleteAndWait), stackName)
 }
 
+// DisableTerminationProtection mocks base method
+func (m *MockcfnClient) DisableTerminationProtection(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTerminationProtection", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTerminationProtection indicates an expected call of DisableTerminationProtection
+func (mr *MockcfnClientMockRecorder) DisableTerminationProtection(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTerminationProtection", reflect.TypeOf((*MockcfnClient)(nil).DisableTerminationProtection), stackName)
+}
+
+// ValidateDeployable mocks base method
+func (m *MockcfnClient) ValidateDeployable(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDeployable", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateDeployable indicates an expected call of ValidateDeployable
+func (mr *MockcfnClientMockRecorder) ValidateDeployable(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDeployable", reflect.TypeOf((*MockcfnClient)(nil).ValidateDeployable), stackName)
+}
+
+// ContinueUpdateRollbackAndWait mocks base method
+func (m *MockcfnClient) ContinueUpdateRollbackAndWait(stackName string, skipResources []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueUpdateRollbackAndWait", stackName, skipResources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ContinueUpdateRollbackAndWait indicates an expected call of ContinueUpdateRollbackAndWait
+func (mr *MockcfnClientMockRecorder) ContinueUpdateRollbackAndWait(stackName, skipResources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueUpdateRollbackAndWait", reflect.TypeOf((*MockcfnClient)(nil).ContinueUpdateRollbackAndWait), stackName, skipResources)
+}
+
 // Describe mocks base method
 func (m *MockcfnClient) Describe(stackName string) (*cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +286,63 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// CreateChangeSet mocks base method
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (*cloudformation0.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockcfnClient) ExecuteChangeSet(arg0 *cloudformation0.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSet), arg0)
+}
+
+// ExecuteChangeSetAndWait mocks base method
+func (m *MockcfnClient) ExecuteChangeSetAndWait(arg0 *cloudformation0.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSetAndWait", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSetAndWait indicates an expected call of ExecuteChangeSetAndWait
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSetAndWait(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSetAndWait", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSetAndWait), arg0)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockcfnClient) DeleteChangeSet(arg0 *cloudformation0.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockcfnClientMockRecorder) DeleteChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).DeleteChangeSet), arg0)
+}
+
 // MockstackSetClient is a mock of stackSetClient interface
 type MockstackSetClient struct {
 	ctrl     *gomock.Controller
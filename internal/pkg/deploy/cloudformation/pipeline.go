@@ -29,20 +29,28 @@ func (cf CloudFormation) PipelineExists(in *deploy.CreatePipelineInput) (bool, e
 }
 
 // CreatePipeline sets up a new CodePipeline for deploying services.
+// If a previous deployment left the stack of the pipeline in a failed state, returns a ErrStackRequiresRecovery.
 func (cf CloudFormation) CreatePipeline(in *deploy.CreatePipelineInput) error {
 	s, err := toStack(stack.NewPipelineStackConfig(in))
 	if err != nil {
 		return err
 	}
+	if err := cf.cfnClient.ValidateDeployable(s.Name); err != nil {
+		return err
+	}
 	return cf.cfnClient.CreateAndWait(s)
 }
 
 // UpdatePipeline updates an existing CodePipeline for deploying services.
+// If a previous deployment left the stack of the pipeline in a failed state, returns a ErrStackRequiresRecovery.
 func (cf CloudFormation) UpdatePipeline(in *deploy.CreatePipelineInput) error {
 	s, err := toStack(stack.NewPipelineStackConfig(in))
 	if err != nil {
 		return err
 	}
+	if err := cf.cfnClient.ValidateDeployable(s.Name); err != nil {
+		return err
+	}
 	if err := cf.cfnClient.UpdateAndWait(s); err != nil {
 		var errNoUpdates *cloudformation.ErrChangeSetEmpty
 		if errors.As(err, &errNoUpdates) {
@@ -53,6 +61,16 @@ func (cf CloudFormation) UpdatePipeline(in *deploy.CreatePipelineInput) error {
 	return nil
 }
 
+// PreviewPipeline creates a change set to create or update a CodePipeline without executing it.
+// If there are no changes to deploy, returns a ErrChangeSetEmpty.
+func (cf CloudFormation) PreviewPipeline(in *deploy.CreatePipelineInput) (*cloudformation.ChangeSet, error) {
+	s, err := toStack(stack.NewPipelineStackConfig(in))
+	if err != nil {
+		return nil, err
+	}
+	return cf.cfnClient.CreateChangeSet(s)
+}
+
 // DeletePipeline removes the CodePipeline stack.
 func (cf CloudFormation) DeletePipeline(stackName string) error {
 	return cf.cfnClient.DeleteAndWait(stackName)
@@ -171,8 +171,9 @@ func TestPipelineCreation(t *testing.T) {
 		artifactBuckets := regionalResourcesToArtifactBuckets(t, resources)
 
 		pipelineInput := &deploy.CreatePipelineInput{
-			AppName: app.Name,
-			Name:    pipelineStackName,
+			AppName:       app.Name,
+			Name:          pipelineStackName,
+			BuildspecPath: "copilot/buildspec.yml",
 			Source: &deploy.Source{
 				ProviderName: manifest.GithubProviderName,
 				Properties: map[string]interface{}{
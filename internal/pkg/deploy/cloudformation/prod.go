@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+)
+
+// prodStackPolicy allows all updates to the resources of a stack, except for the updates that replace or delete
+// its stateful resources. Nested stacks are included since deleting the stack of addons deletes its resources.
+const prodStackPolicy = `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "Update:*",
+      "Principal": "*",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Action": ["Update:Replace", "Update:Delete"],
+      "Principal": "*",
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {
+          "ResourceType": [
+            "AWS::CloudFormation::Stack",
+            "AWS::DynamoDB::Table",
+            "AWS::EC2::VPC",
+            "AWS::EFS::FileSystem",
+            "AWS::Logs::LogGroup",
+            "AWS::RDS::DBCluster",
+            "AWS::RDS::DBInstance",
+            "AWS::S3::Bucket"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+// ProdStackOptions returns the options to protect a stack deployed to a production environment.
+// The stack can't be deleted until its termination protection is disabled, and its updates can't replace
+// or delete its stateful resources.
+func ProdStackOptions() []cloudformation.StackOption {
+	return []cloudformation.StackOption{
+		cloudformation.WithTerminationProtection(),
+		cloudformation.WithStackPolicy(prodStackPolicy),
+	}
+}
@@ -5,20 +5,25 @@ package cloudformation
 
 import (
 	"errors"
-	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
 // DeployService deploys a service stack and waits until the deployment is done.
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
+// If a previous deployment left the stack in a failed state, returns a ErrStackRequiresRecovery.
 func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudformation.StackOption) error {
 	stack, err := toStack(conf)
 	if err != nil {
 		return err
 	}
+	if err := cf.cfnClient.ValidateDeployable(stack.Name); err != nil {
+		return err
+	}
 	for _, opt := range opts {
 		opt(stack)
 	}
@@ -35,7 +40,132 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 	return cf.cfnClient.UpdateAndWait(stack)
 }
 
-// DeleteService removes the CloudFormation stack of a deployed service.
+// StreamServiceDeployment deploys a service stack and streams the events of its resources while the deployment is taking place.
+// Once the deployment halts, the events channel is closed and the result of the deployment is sent to the second channel.
+func (cf CloudFormation) StreamServiceDeployment(conf StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan error, 1)
+
+	since := cf.latestEventTime(conf.StackName())
+	go cf.streamResourceEvents(done, events, conf.StackName(), since)
+	go func() {
+		defer close(done)
+		resp <- cf.DeployService(conf, opts...)
+	}()
+	return events, resp
+}
+
+// PreviewService creates a change set to deploy a service stack without executing it.
+// If there are no changes to deploy, returns a ErrChangeSetEmpty.
+// If a previous deployment left the stack in a failed state, returns a ErrStackRequiresRecovery.
+func (cf CloudFormation) PreviewService(conf StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation.ChangeSet, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	if err := cf.cfnClient.ValidateDeployable(stack.Name); err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	return cf.cfnClient.CreateChangeSet(stack)
+}
+
+// RollbackService redeploys the stack template and parameters of a previous revision of a service
+// and waits until the deployment is done. If the revision is already deployed, returns a ErrChangeSetEmpty.
+func (cf CloudFormation) RollbackService(in deploy.RollbackServiceInput, opts ...cloudformation.StackOption) error {
+	s := cloudformation.NewStack(stack.NameForService(in.AppName, in.EnvName, in.Name), "",
+		cloudformation.WithTemplateURL(in.TemplateURL),
+		cloudformation.WithParameters(in.Parameters),
+		cloudformation.WithTags(in.Tags))
+	for _, opt := range opts {
+		opt(s)
+	}
+	return cf.cfnClient.UpdateAndWait(s)
+}
+
+// UpdateServiceParameters redeploys the current stack template of a service with new parameters
+// and waits until the deployment is done. If the parameters are already deployed, returns a ErrChangeSetEmpty.
+func (cf CloudFormation) UpdateServiceParameters(in deploy.UpdateServiceParametersInput, opts ...cloudformation.StackOption) error {
+	s := cloudformation.NewStack(stack.NameForService(in.AppName, in.EnvName, in.Name), "",
+		cloudformation.WithPreviousTemplate(),
+		cloudformation.WithParameters(in.Parameters))
+	for _, opt := range opts {
+		opt(s)
+	}
+	return cf.cfnClient.UpdateAndWait(s)
+}
+
+// DeleteService removes the CloudFormation stack of a deployed service, after disabling its termination protection.
 func (cf CloudFormation) DeleteService(in deploy.DeleteServiceInput) error {
-	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
+	stackName := stack.NameForService(in.AppName, in.EnvName, in.Name)
+	if err := cf.cfnClient.DisableTerminationProtection(stackName); err != nil {
+		return err
+	}
+	return cf.cfnClient.DeleteAndWait(stackName)
+}
+
+// StreamServiceDeletion removes the CloudFormation stack of a deployed service and streams the events of its resources
+// while the deletion is taking place. The termination protection of the stack is disabled before it's removed. Once the deletion halts, the events channel is closed and the result of the deletion
+// is sent to the second channel. If the stack doesn't exist, no events are sent and the deletion succeeds.
+func (cf CloudFormation) StreamServiceDeletion(in deploy.DeleteServiceInput) (<-chan []deploy.ResourceEvent, <-chan error) {
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan error, 1)
+
+	stackName := stack.NameForService(in.AppName, in.EnvName, in.Name)
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		close(events)
+		var errNotFound *cloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			resp <- err
+			return events, resp
+		}
+		resp <- nil
+		return events, resp
+	}
+	// The events of a deleted stack can only be described with its ID.
+	stackID := aws.StringValue(descr.StackId)
+	since := cf.latestEventTime(stackID)
+	go cf.streamResourceEvents(done, events, stackID, since)
+	go func() {
+		defer close(done)
+		if err := cf.cfnClient.DisableTerminationProtection(stackName); err != nil {
+			resp <- err
+			return
+		}
+		resp <- cf.cfnClient.DeleteAndWait(stackName)
+	}()
+	return events, resp
+}
+
+// ServiceStackOutputs returns the outputs of the stack of a deployed service.
+// If the stack doesn't exist, returns a ErrStackNotFound.
+func (cf CloudFormation) ServiceStackOutputs(stackName string) (map[string]string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string, len(descr.Outputs))
+	for _, out := range descr.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs, nil
+}
+
+// ServiceStackParameters returns the parameters of the stack of a deployed service.
+// If the stack doesn't exist, returns a ErrStackNotFound.
+func (cf CloudFormation) ServiceStackParameters(stackName string) (map[string]string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string, len(descr.Parameters))
+	for _, param := range descr.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	return params, nil
 }
@@ -4,7 +4,9 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -55,6 +57,7 @@ func (m *mockStackConfig) Tags() []*sdkcloudformation.Tag {
 func TestCloudFormation_DeployService(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) cfnClient
+		wantedErr  error
 	}{
 		"does not call update if the stack is new": {
 			createMock: func(ctrl *gomock.Controller) cfnClient {
@@ -67,6 +70,7 @@ func TestCloudFormation_DeployService(t *testing.T) {
 					}),
 					cloudformation.WithRoleARN("myrole"))
 				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ValidateDeployable("webhook").Return(nil)
 				m.EXPECT().CreateAndWait(stack).Return(nil)
 				m.EXPECT().UpdateAndWait(gomock.Any()).Times(0)
 				return m
@@ -75,6 +79,7 @@ func TestCloudFormation_DeployService(t *testing.T) {
 		"calls update if the stack already exists": {
 			createMock: func(ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ValidateDeployable("webhook").Return(nil)
 				m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
 					Name: "name",
 				})
@@ -82,6 +87,18 @@ func TestCloudFormation_DeployService(t *testing.T) {
 				return m
 			},
 		},
+		"does not deploy a stack that requires recovery": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ValidateDeployable("webhook").Return(&cloudformation.ErrStackRequiresRecovery{
+					Name:   "webhook",
+					Status: "ROLLBACK_COMPLETE",
+				})
+				m.EXPECT().CreateAndWait(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: errors.New("stack webhook is in status ROLLBACK_COMPLETE and must be recovered before it can be deployed to"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -107,11 +124,149 @@ func TestCloudFormation_DeployService(t *testing.T) {
 			err := c.DeployService(conf, cloudformation.WithRoleARN("myrole"))
 
 			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_PreviewService(t *testing.T) {
+	wantedChangeSet := &cloudformation.ChangeSet{
+		Name:      "ecscli-1234",
+		StackName: "webhook",
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedChangeSet *cloudformation.ChangeSet
+		wantedErr       error
+	}{
+		"creates a change set without executing it": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ValidateDeployable("webhook").Return(nil)
+				m.EXPECT().CreateChangeSet(cloudformation.NewStack("webhook", "template",
+					cloudformation.WithParameters(map[string]string{
+						"port": "80",
+					}),
+					cloudformation.WithRoleARN("myrole"))).Return(wantedChangeSet, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedChangeSet: wantedChangeSet,
+		},
+		"does not preview a stack that requires recovery": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ValidateDeployable("webhook").Return(&cloudformation.ErrStackRequiresRecovery{
+					Name:   "webhook",
+					Status: "ROLLBACK_COMPLETE",
+				})
+				m.EXPECT().CreateChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: errors.New("stack webhook is in status ROLLBACK_COMPLETE and must be recovered before it can be deployed to"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+				parameters: map[string]string{
+					"port": "80",
+				},
+			}
+
+			// WHEN
+			cs, err := c.PreviewService(conf, cloudformation.WithRoleARN("myrole"))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
 			require.NoError(t, err)
+			require.Equal(t, tc.wantedChangeSet, cs)
 		})
 	}
 }
 
+func TestCloudFormation_RollbackService(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().UpdateAndWait(cloudformation.NewStack("phonetool-test-api", "",
+		cloudformation.WithTemplateURL("https://bucket.s3.amazonaws.com/api.stack.yml"),
+		cloudformation.WithParameters(map[string]string{
+			"ContainerImage": "repo@sha256:abc",
+		}),
+		cloudformation.WithTags(map[string]string{
+			"copilot-application": "phonetool",
+		}),
+		cloudformation.WithRoleARN("myrole"))).Return(nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := c.RollbackService(deploy.RollbackServiceInput{
+		Name:        "api",
+		EnvName:     "test",
+		AppName:     "phonetool",
+		TemplateURL: "https://bucket.s3.amazonaws.com/api.stack.yml",
+		Parameters: map[string]string{
+			"ContainerImage": "repo@sha256:abc",
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+	}, cloudformation.WithRoleARN("myrole"))
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestCloudFormation_UpdateServiceParameters(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().UpdateAndWait(cloudformation.NewStack("phonetool-test-api", "",
+		cloudformation.WithPreviousTemplate(),
+		cloudformation.WithParameters(map[string]string{
+			"CanaryWeight": "50",
+		}),
+		cloudformation.WithRoleARN("myrole"))).Return(nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := c.UpdateServiceParameters(deploy.UpdateServiceParametersInput{
+		Name:    "api",
+		EnvName: "test",
+		AppName: "phonetool",
+		Parameters: map[string]string{
+			"CanaryWeight": "50",
+		},
+	}, cloudformation.WithRoleARN("myrole"))
+
+	// THEN
+	require.NoError(t, err)
+}
+
 func TestCloudFormation_DeleteService(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteServiceInput
@@ -125,7 +280,10 @@ func TestCloudFormation_DeleteService(t *testing.T) {
 			},
 			createMock: func(ctrl *gomock.Controller) cfnClient {
 				m := mocks.NewMockcfnClient(ctrl)
-				m.EXPECT().DeleteAndWait("kudos-test-webhook")
+				gomock.InOrder(
+					m.EXPECT().DisableTerminationProtection("kudos-test-webhook").Return(nil),
+					m.EXPECT().DeleteAndWait("kudos-test-webhook"),
+				)
 				return m
 			},
 		},
@@ -148,3 +306,342 @@ func TestCloudFormation_DeleteService(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_StreamServiceDeployment(t *testing.T) {
+	lastDeployment := time.Date(2020, time.October, 1, 10, 0, 0, 0, time.UTC)
+	oldEvent := cloudformation.StackEvent{
+		LogicalResourceId: aws.String("TaskDefinition"),
+		ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+		ResourceStatus:    aws.String("CREATE_COMPLETE"),
+		StackId:           aws.String("arn:root"),
+		Timestamp:         aws.Time(lastDeployment),
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"streams the events of the deployment including nested stacks": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().Events("phonetool-test-api").Return([]cloudformation.StackEvent{oldEvent}, nil),
+					m.EXPECT().ValidateDeployable("phonetool-test-api").Return(nil),
+					m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{Name: "phonetool-test-api"}),
+					m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil),
+				)
+				m.EXPECT().Events("phonetool-test-api").Return([]cloudformation.StackEvent{
+					oldEvent,
+					{
+						LogicalResourceId:    aws.String("TaskDefinition"),
+						ResourceType:         aws.String("AWS::ECS::TaskDefinition"),
+						PhysicalResourceId:   aws.String("arn:taskdef"),
+						ResourceStatus:       aws.String("UPDATE_FAILED"),
+						ResourceStatusReason: aws.String("Invalid memory. Status Code: 400"),
+						StackId:              aws.String("arn:root"),
+						Timestamp:            aws.Time(lastDeployment.Add(time.Minute)),
+					},
+					{
+						LogicalResourceId:  aws.String("AddonsStack"),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						PhysicalResourceId: aws.String("arn:addons"),
+						ResourceStatus:     aws.String("UPDATE_IN_PROGRESS"),
+						StackId:            aws.String("arn:root"),
+						Timestamp:          aws.Time(lastDeployment.Add(time.Minute)),
+					},
+				}, nil).AnyTimes()
+				m.EXPECT().Events("arn:addons").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:  aws.String("phonetool-test-api-AddonsStack"),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						PhysicalResourceId: aws.String("arn:addons"),
+						ResourceStatus:     aws.String("UPDATE_IN_PROGRESS"),
+						StackId:            aws.String("arn:addons"),
+						Timestamp:          aws.Time(lastDeployment.Add(time.Minute)),
+					},
+					{
+						LogicalResourceId: aws.String("MyTable"),
+						ResourceType:      aws.String("AWS::DynamoDB::Table"),
+						ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+						StackId:           aws.String("arn:addons"),
+						Timestamp:         aws.Time(lastDeployment.Add(time.Minute)),
+					},
+				}, nil).AnyTimes()
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "TaskDefinition",
+						Type:        "AWS::ECS::TaskDefinition",
+						PhysicalID:  "arn:taskdef",
+					},
+					Status:       "UPDATE_FAILED",
+					StatusReason: "Invalid memory",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "AddonsStack",
+						Type:        "AWS::CloudFormation::Stack",
+						PhysicalID:  "arn:addons",
+					},
+					Status: "UPDATE_IN_PROGRESS",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "MyTable",
+						Type:        "AWS::DynamoDB::Table",
+						NestedStack: "AddonsStack",
+					},
+					Status: "UPDATE_IN_PROGRESS",
+				},
+			},
+		},
+		"sends the deployment error": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Events("phonetool-test-api").Return(nil, errors.New("stack does not exist")).AnyTimes()
+				m.EXPECT().ValidateDeployable("phonetool-test-api").Return(nil)
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, resp := c.StreamServiceDeployment(&mockStackConfig{
+				name:     "phonetool-test-api",
+				template: "template",
+			})
+			var lastEvents []deploy.ResourceEvent
+			for ev := range events {
+				lastEvents = ev
+			}
+			err := <-resp
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, lastEvents)
+		})
+	}
+}
+
+func TestCloudFormation_StreamServiceDeletion(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"succeeds without events if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns the error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"streams the events of the stack by ID": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(&cloudformation.StackDescription{
+					StackId: aws.String("arn:webhook"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().Events("arn:webhook").Return(nil, nil),
+					m.EXPECT().DisableTerminationProtection("kudos-test-webhook").Return(nil),
+					m.EXPECT().DeleteAndWait("kudos-test-webhook").Return(nil),
+				)
+				m.EXPECT().Events("arn:webhook").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:service"),
+						ResourceStatus:     aws.String("DELETE_COMPLETE"),
+						StackId:            aws.String("arn:webhook"),
+						Timestamp:          aws.Time(time.Now()),
+					},
+				}, nil).AnyTimes()
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "Service",
+						Type:        "AWS::ECS::Service",
+						PhysicalID:  "arn:service",
+					},
+					Status: "DELETE_COMPLETE",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, resp := c.StreamServiceDeletion(deploy.DeleteServiceInput{
+				Name:    "webhook",
+				EnvName: "test",
+				AppName: "kudos",
+			})
+			var lastEvents []deploy.ResourceEvent
+			for ev := range events {
+				lastEvents = ev
+			}
+			err := <-resp
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, lastEvents)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceStackOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedOutputs map[string]string
+		wantedErr     error
+	}{
+		"returns the error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+			wantedErr: &cloudformation.ErrStackNotFound{},
+		},
+		"returns the outputs of the stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(&cloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String("TaskDefinitionArn"),
+							OutputValue: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/kudos-test-api:3"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedOutputs: map[string]string{
+				"TaskDefinitionArn": "arn:aws:ecs:us-west-2:123456789012:task-definition/kudos-test-api:3",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			outputs, err := c.ServiceStackOutputs("kudos-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, outputs)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceStackParameters(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedParams map[string]string
+		wantedErr    error
+	}{
+		"returns the error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+			wantedErr: &cloudformation.ErrStackNotFound{},
+		},
+		"returns the parameters of the stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-api").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("CanaryWeight"),
+							ParameterValue: aws.String("10"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedParams: map[string]string{
+				"CanaryWeight": "10",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			params, err := c.ServiceStackParameters("kudos-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedParams, params)
+		})
+	}
+}
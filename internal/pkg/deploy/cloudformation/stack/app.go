@@ -63,6 +63,7 @@ const (
 
 var cfTemplateFunctions = map[string]interface{}{
 	"logicalIDSafe": template.ReplaceDashesFunc,
+	"fmtSlice":      template.FmtSliceFunc,
 }
 
 // AppConfigFrom takes a template file and extracts the metadata block,
@@ -149,7 +150,7 @@ func (c *AppStackConfig) Tags() []*cloudformation.Tag {
 
 // StackName returns the name of the CloudFormation stack (based on the application name).
 func (c *AppStackConfig) StackName() string {
-	return fmt.Sprintf("%s-infrastructure-roles", c.Name)
+	return NameForAppStack(c.Name)
 }
 
 // StackSetName returns the name of the CloudFormation StackSet (based on the application name).
@@ -69,6 +69,10 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	platform, err := s.platformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform of service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseBackendService(template.ServiceOpts{
 		Variables:   s.manifest.BackendServiceConfig.Variables,
 		Secrets:     s.manifest.BackendServiceConfig.Secrets,
@@ -76,6 +80,7 @@ func (s *BackendService) Template() (string, error) {
 		Sidecars:    sidecars,
 		HealthCheck: s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
 		LogConfig:   s.manifest.LogConfigOpts(),
+		Platform:    platform,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
@@ -29,7 +29,7 @@ const (
 
 // Parameter keys.
 const (
-	envParamIncludeLBKey             = "IncludePublicLoadBalancer"
+	EnvParamIncludeLBKey             = "IncludePublicLoadBalancer"
 	envParamAppNameKey               = "AppName"
 	envParamEnvNameKey               = "EnvironmentName"
 	envParamToolsAccountPrincipalKey = "ToolsAccountPrincipalARN"
@@ -43,6 +43,9 @@ const (
 	EnvOutputManagerRoleKey            = "EnvironmentManagerRoleARN"
 	EnvOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
 	EnvOutputSubdomain                 = "EnvironmentSubdomain"
+	EnvOutputClusterID                 = "ClusterId"
+	EnvOutputPublicSubnets             = "PublicSubnets"
+	EnvOutputSecurityGroup             = "EnvironmentSecurityGroup"
 )
 
 // NewEnvStackConfig sets up a struct which can provide values to CloudFormation for
@@ -73,11 +76,13 @@ func (e *EnvStackConfig) Template() (string, error) {
 		DNSDelegationLambda       string
 		ACMValidationLambda       string
 		EnableLongARNFormatLambda string
+		ImportVPC                 *deploy.ImportVPCConfig
 	}{
 		dnsLambda.String(),
 		acmLambda.String(),
 		enableLongARNsLambda.String(),
-	})
+		e.ImportVPCConfig,
+	}, template.WithFuncs(cfTemplateFunctions))
 	if err != nil {
 		return "", err
 	}
@@ -89,7 +94,7 @@ func (e *EnvStackConfig) Template() (string, error) {
 func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
 		{
-			ParameterKey:   aws.String(envParamIncludeLBKey),
+			ParameterKey:   aws.String(EnvParamIncludeLBKey),
 			ParameterValue: aws.String(strconv.FormatBool(e.PublicLoadBalancer)),
 		},
 		{
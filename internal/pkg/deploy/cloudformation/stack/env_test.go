@@ -22,6 +22,7 @@ import (
 
 func TestEnvTemplate(t *testing.T) {
 	testCases := map[string]struct {
+		importVPC        *deploy.ImportVPCConfig
 		mockDependencies func(ctrl *gomock.Controller, e *EnvStackConfig)
 		expectedOutput   string
 		want             error
@@ -44,11 +45,43 @@ func TestEnvTemplate(t *testing.T) {
 					DNSDelegationLambda       string
 					ACMValidationLambda       string
 					EnableLongARNFormatLambda string
+					ImportVPC                 *deploy.ImportVPCConfig
 				}{
 					"customresources",
 					"customresources",
 					"customresources",
-				}).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+					nil,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should pass the imported VPC to the template": {
+			importVPC: &deploy.ImportVPCConfig{
+				ID:               "vpc-1234",
+				PublicSubnetIDs:  []string{"subnet-pub1", "subnet-pub2"},
+				PrivateSubnetIDs: []string{"subnet-priv1"},
+			},
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Read(dnsDelegationTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().Read(acmValidationTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().Read(enableLongARNsTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().Parse(EnvTemplatePath, struct {
+					DNSDelegationLambda       string
+					ACMValidationLambda       string
+					EnableLongARNFormatLambda string
+					ImportVPC                 *deploy.ImportVPCConfig
+				}{
+					"customresources",
+					"customresources",
+					"customresources",
+					&deploy.ImportVPCConfig{
+						ID:               "vpc-1234",
+						PublicSubnetIDs:  []string{"subnet-pub1", "subnet-pub2"},
+						PrivateSubnetIDs: []string{"subnet-priv1"},
+					},
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
 				e.parser = m
 			},
 			expectedOutput: mockTemplate,
@@ -63,6 +96,7 @@ func TestEnvTemplate(t *testing.T) {
 			envStack := &EnvStackConfig{
 				CreateEnvironmentInput: mockDeployEnvironmentInput(),
 			}
+			envStack.ImportVPCConfig = tc.importVPC
 			tc.mockDependencies(ctrl, envStack)
 
 			// WHEN
@@ -91,7 +125,7 @@ func TestEnvParameters(t *testing.T) {
 			input: deploymentInput,
 			want: []*cloudformation.Parameter{
 				{
-					ParameterKey:   aws.String(envParamIncludeLBKey),
+					ParameterKey:   aws.String(EnvParamIncludeLBKey),
 					ParameterValue: aws.String(strconv.FormatBool(deploymentInput.PublicLoadBalancer)),
 				},
 				{
@@ -120,7 +154,7 @@ func TestEnvParameters(t *testing.T) {
 			input: deploymentInputWithDNS,
 			want: []*cloudformation.Parameter{
 				{
-					ParameterKey:   aws.String(envParamIncludeLBKey),
+					ParameterKey:   aws.String(EnvParamIncludeLBKey),
 					ParameterValue: aws.String(strconv.FormatBool(deploymentInputWithDNS.PublicLoadBalancer)),
 				},
 				{
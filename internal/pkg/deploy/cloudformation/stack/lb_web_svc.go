@@ -5,7 +5,9 @@ package stack
 
 import (
 	"fmt"
+	"hash/crc32"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -17,8 +19,18 @@ import (
 // Template rendering configuration.
 const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
+
+	// The test listeners of blue/green services share the load balancer of their environment, so each service
+	// defaults to its own port in the range [lbWebSvcTestListenerPortBase, lbWebSvcTestListenerPortBase+lbWebSvcTestListenerPortRange).
+	lbWebSvcTestListenerPortBase  = 10000
+	lbWebSvcTestListenerPortRange = 1000
+	fmtLambdaFunctionARN          = "arn:${AWS::Partition}:lambda:${AWS::Region}:${AWS::AccountId}:function:%s"
+	lbWebSvcCanaryStepsSeparator  = "," // Separator of the canary steps in the output of the stack.
 )
 
+// Ports of the listeners of the environment's load balancer.
+var lbWebSvcEnvListenerPorts = []uint16{80, 443}
+
 // Parameter logical IDs for a load balanced web service.
 const (
 	LBWebServiceHTTPSParamKey           = "HTTPSEnabled"
@@ -27,8 +39,38 @@ const (
 	LBWebServiceHealthCheckPathParamKey = "HealthCheckPath"
 	LBWebServiceTargetContainerParamKey = "TargetContainer"
 	LBWebServiceTargetPortParamKey      = "TargetPort"
+
+	LBWebServiceDeployedTaskDefinitionParamKey = "DeployedTaskDefinition"
+
+	LBWebServiceCanaryContainerImageParamKey = "CanaryContainerImage"
+	LBWebServiceStableWeightParamKey         = "StableWeight"
+	LBWebServiceCanaryWeightParamKey         = "CanaryWeight"
 )
 
+// Output logical IDs of a load balanced web service deployed with blue/green deployments.
+const (
+	LBWebServiceTaskDefinitionOutputKey            = "TaskDefinitionArn"
+	LBWebServicePinnedTaskDefinitionOutputKey      = "PinnedTaskDefinitionArn"
+	LBWebServiceServiceArnOutputKey                = "ServiceArn"
+	LBWebServiceCodeDeployApplicationOutputKey     = "CodeDeployApplication"
+	LBWebServiceCodeDeployDeploymentGroupOutputKey = "CodeDeployDeploymentGroup"
+	LBWebServiceTestListenerPortOutputKey          = "TestListenerPort"
+)
+
+// Output logical IDs of a load balanced web service deployed with canary releases.
+const (
+	LBWebServiceStableContainerImageOutputKey = "StableContainerImage"
+	LBWebServiceCanaryTaskDefinitionOutputKey = "CanaryTaskDefinitionArn"
+	LBWebServiceCanaryStepsOutputKey          = "CanarySteps"
+)
+
+// codeDeployConfigNames maps the traffic shifting patterns of the manifest to CodeDeploy deployment configurations.
+var codeDeployConfigNames = map[string]string{
+	manifest.AllAtOnceTrafficShifting: "CodeDeployDefault.ECSAllAtOnce",
+	manifest.CanaryTrafficShifting:    "CodeDeployDefault.ECSCanary10Percent5Minutes",
+	manifest.LinearTrafficShifting:    "CodeDeployDefault.ECSLinear10PercentEvery1Minutes",
+}
+
 type loadBalancedWebSvcReadParser interface {
 	template.ReadParser
 	ParseLoadBalancedWebService(template.ServiceOpts) (*template.Content, error)
@@ -97,13 +139,28 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	blueGreen, err := s.blueGreenOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	canary, err := s.canaryOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	platform, err := s.platformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform of service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.ServiceOpts{
 		Variables:          s.manifest.Variables,
 		Secrets:            s.manifest.Secrets,
 		NestedStack:        outputs,
 		Sidecars:           sidecars,
 		LogConfig:          s.manifest.LogConfigOpts(),
+		Platform:           platform,
 		RulePriorityLambda: rulePriorityLambda.String(),
+		BlueGreen:          blueGreen,
+		Canary:             canary,
 	})
 	if err != nil {
 		return "", err
@@ -111,6 +168,96 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	return content.String(), nil
 }
 
+// blueGreenOpts returns the blue/green deployment configuration of the service, or nil if the service
+// is deployed with rolling updates.
+func (s *LoadBalancedWebService) blueGreenOpts() (*template.BlueGreenOpts, error) {
+	d := s.manifest.Deployment
+	if d != nil && d.Strategy != nil && *d.Strategy != manifest.RollingDeploymentStrategy && !d.IsBlueGreen() && !d.IsCanary() {
+		return nil, fmt.Errorf("deployment strategy %s must be one of %s, %s or %s", *d.Strategy,
+			manifest.RollingDeploymentStrategy, manifest.BlueGreenDeploymentStrategy, manifest.CanaryDeploymentStrategy)
+	}
+	if !d.IsBlueGreen() {
+		return nil, nil
+	}
+	traffic := manifest.AllAtOnceTrafficShifting
+	if d.Traffic != nil {
+		traffic = *d.Traffic
+	}
+	configName, ok := codeDeployConfigNames[traffic]
+	if !ok {
+		return nil, fmt.Errorf("traffic shifting pattern %s must be one of %s, %s or %s", traffic,
+			manifest.AllAtOnceTrafficShifting, manifest.CanaryTrafficShifting, manifest.LinearTrafficShifting)
+	}
+	port := BlueGreenTestListenerPort(s.name, d)
+	if port == aws.Uint16Value(s.manifest.Image.Port) {
+		return nil, fmt.Errorf("test port %d must be different from the port of the container", port)
+	}
+	for _, envPort := range lbWebSvcEnvListenerPorts {
+		if port == envPort {
+			return nil, fmt.Errorf("test port %d must be different from the ports of the environment's load balancer", port)
+		}
+	}
+	var hooks []string
+	for _, event := range manifest.BlueGreenLifecycleEvents {
+		fn, ok := d.Hooks[event]
+		if !ok {
+			continue
+		}
+		if !strings.HasPrefix(fn, "arn:") {
+			fn = fmt.Sprintf(fmtLambdaFunctionARN, fn)
+		}
+		hooks = append(hooks, fn)
+	}
+	if len(hooks) != len(d.Hooks) {
+		return nil, fmt.Errorf("hooks must be invoked at one of the lifecycle events %s", strings.Join(manifest.BlueGreenLifecycleEvents, ", "))
+	}
+	return &template.BlueGreenOpts{
+		DeploymentConfigName: configName,
+		TestListenerPort:     port,
+		TestCIDRs:            d.TestCIDRs,
+		HookFunctions:        hooks,
+	}, nil
+}
+
+// BlueGreenTestListenerPort returns the port of the listener that routes the test traffic of a service deployed with
+// blue/green deployments. Unless the manifest sets it, the port is derived from the name of the service.
+func BlueGreenTestListenerPort(svc string, d *manifest.DeploymentConfig) uint16 {
+	if d != nil && d.TestPort != nil {
+		return *d.TestPort
+	}
+	return uint16(lbWebSvcTestListenerPortBase + crc32.ChecksumIEEE([]byte(svc))%lbWebSvcTestListenerPortRange)
+}
+
+// canaryOpts returns the canary release configuration of the service, or nil if the service isn't deployed with canary releases.
+func (s *LoadBalancedWebService) canaryOpts() (*template.CanaryOpts, error) {
+	steps, err := s.canarySteps()
+	if err != nil || steps == nil {
+		return nil, err
+	}
+	return &template.CanaryOpts{
+		Steps: steps,
+	}, nil
+}
+
+// canarySteps returns the percentages of the traffic sent to a canary, or nil if the service isn't deployed with canary releases.
+func (s *LoadBalancedWebService) canarySteps() ([]int, error) {
+	d := s.manifest.Deployment
+	if !d.IsCanary() {
+		return nil, nil
+	}
+	if len(d.Steps) == 0 {
+		return manifest.DefaultCanarySteps, nil
+	}
+	prev := 0
+	for _, step := range d.Steps {
+		if step <= prev || step >= 100 {
+			return nil, fmt.Errorf("canary steps %v must be increasing percentages between 1 and 99", d.Steps)
+		}
+		prev = step
+	}
+	return d.Steps, nil
+}
+
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
 	containerName := s.name
 	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)
@@ -136,7 +283,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 	if err != nil {
 		return nil, err
 	}
-	return append(s.svc.Parameters(), []*cloudformation.Parameter{
+	params := append(s.svc.Parameters(), []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
 			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)),
@@ -161,7 +308,67 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 			ParameterValue: targetPort,
 		},
-	}...), nil
+	}...)
+	if s.manifest.Deployment.IsBlueGreen() {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+			ParameterValue: aws.String(s.rc.DeployedTaskDefinition),
+		})
+	}
+	if s.manifest.Deployment.IsCanary() {
+		canaryParams, err := s.canaryParameters(params)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, canaryParams...)
+	}
+	return params, nil
+}
+
+// canaryParameters returns the parameters that release the deployed image as a canary next to the stable image.
+// The stable tasks keep running the stable image and receive the rest of the traffic. If there's no stable image yet,
+// or if it's the deployed image, the deployed image becomes the stable image without a canary.
+func (s *LoadBalancedWebService) canaryParameters(params []*cloudformation.Parameter) ([]*cloudformation.Parameter, error) {
+	steps, err := s.canarySteps()
+	if err != nil {
+		return nil, err
+	}
+	canaryImage, canaryWeight := "", 0
+	if stable := s.rc.StableImage; stable != "" && stable != s.image() {
+		for _, param := range params {
+			if aws.StringValue(param.ParameterKey) == ServiceContainerImageParamKey {
+				param.ParameterValue = aws.String(stable)
+			}
+		}
+		canaryImage, canaryWeight = s.image(), steps[0]
+	}
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(LBWebServiceCanaryContainerImageParamKey),
+			ParameterValue: aws.String(canaryImage),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceStableWeightParamKey),
+			ParameterValue: aws.String(strconv.Itoa(100 - canaryWeight)),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceCanaryWeightParamKey),
+			ParameterValue: aws.String(strconv.Itoa(canaryWeight)),
+		},
+	}, nil
+}
+
+// ParseCanarySteps parses the percentages of the traffic sent to a canary from the output of the stack of a service.
+func ParseCanarySteps(output string) ([]int, error) {
+	var steps []int
+	for _, step := range strings.Split(output, lbWebSvcCanaryStepsSeparator) {
+		percent, err := strconv.Atoi(step)
+		if err != nil {
+			return nil, fmt.Errorf("parse canary step %s: %w", step, err)
+		}
+		steps = append(steps, percent)
+	}
+	return steps, nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
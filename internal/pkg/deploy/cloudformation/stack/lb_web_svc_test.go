@@ -196,6 +196,155 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with blue/green deployments": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					BlueGreen: &template.BlueGreenOpts{
+						DeploymentConfigName: "CodeDeployDefault.ECSCanary10Percent5Minutes",
+						TestListenerPort:     9000,
+						HookFunctions: []string{
+							"arn:${AWS::Partition}:lambda:${AWS::Region}:${AWS::AccountId}:function:validate",
+							"arn:aws:lambda:us-west-2:123456789012:function:notify",
+						},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+					Traffic:  aws.String(manifest.CanaryTrafficShifting),
+					TestPort: aws.Uint16(9000),
+					Hooks: map[string]string{
+						"AfterAllowTraffic":  "arn:aws:lambda:us-west-2:123456789012:function:notify",
+						"BeforeAllowTraffic": "validate",
+					},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with canary releases": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					Canary: &template.CanaryOpts{
+						Steps: []int{5, 25, 50},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.CanaryDeploymentStrategy),
+					Steps:    []int{5, 25, 50},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"invalid canary steps": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.CanaryDeploymentStrategy),
+					Steps:    []int{50, 10},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("canary steps [50 10] must be increasing percentages between 1 and 99")),
+		},
+		"invalid deployment strategy": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String("red-black"),
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("deployment strategy red-black must be one of rolling, blue-green or canary")),
+		},
+		"invalid traffic shifting pattern": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+					Traffic:  aws.String("gradual"),
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("traffic shifting pattern gradual must be one of all-at-once, canary or linear")),
+		},
+		"test port used by the container": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+					TestPort: aws.Uint16(80),
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("test port 80 must be different from the port of the container")),
+		},
+		"test port used by the environment's load balancer": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+					TestPort: aws.Uint16(443),
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("test port 443 must be different from the ports of the environment's load balancer")),
+		},
+		"hook on an unknown lifecycle event": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				mft := *testLBWebServiceManifest
+				mft.Deployment = &manifest.DeploymentConfig{
+					Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+					Hooks: map[string]string{
+						"BeforeTraffic": "validate",
+					},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedError: fmt.Errorf("convert the deployment configuration for service frontend: %w",
+				errors.New("hooks must be invoked at one of the lifecycle events BeforeInstall, AfterInstall, AfterAllowTestTraffic, BeforeAllowTraffic, AfterAllowTraffic")),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -259,6 +408,28 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		Port: 80,
 	})
 	testLBWebServiceManifestWithBadSidecar.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithBlueGreen := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "frontend/Dockerfile",
+		},
+		Path: "frontend",
+		Port: 80,
+	})
+	testLBWebServiceManifestWithBlueGreen.Deployment = &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+	}
+	testLBWebServiceManifestWithCanary := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "frontend/Dockerfile",
+		},
+		Path: "frontend",
+		Port: 80,
+	})
+	testLBWebServiceManifestWithCanary.Deployment = &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.CanaryDeploymentStrategy),
+	}
 	expectedParams := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(ServiceAppNameParamKey),
@@ -310,8 +481,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		},
 	}
 	testCases := map[string]struct {
-		httpsEnabled bool
-		manifest     *manifest.LoadBalancedWebService
+		httpsEnabled           bool
+		manifest               *manifest.LoadBalancedWebService
+		deployedTaskDefinition string
+		stableImage            string
 
 		expectedParams []*cloudformation.Parameter
 		expectedErr    error
@@ -373,6 +546,92 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with blue/green deployments": {
+			httpsEnabled:           false,
+			manifest:               testLBWebServiceManifestWithBlueGreen,
+			deployedTaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1",
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+					ParameterValue: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1"),
+				},
+			}...),
+		},
+		"with canary releases without a stable image": {
+			manifest: testLBWebServiceManifestWithCanary,
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceCanaryContainerImageParamKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStableWeightParamKey),
+					ParameterValue: aws.String("100"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceCanaryWeightParamKey),
+					ParameterValue: aws.String("0"),
+				},
+			}...),
+		},
+		"with canary releases next to a stable image": {
+			manifest:    testLBWebServiceManifestWithCanary,
+			stableImage: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-a1b2c3d",
+
+			expectedParams: append(withParameter(expectedParams, ServiceContainerImageParamKey, "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-a1b2c3d"),
+				[]*cloudformation.Parameter{
+					{
+						ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+						ParameterValue: aws.String("false"),
+					},
+					{
+						ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+						ParameterValue: aws.String("frontend"),
+					},
+					{
+						ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+						ParameterValue: aws.String("80"),
+					},
+					{
+						ParameterKey:   aws.String(LBWebServiceCanaryContainerImageParamKey),
+						ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c"),
+					},
+					{
+						ParameterKey:   aws.String(LBWebServiceStableWeightParamKey),
+						ParameterValue: aws.String("90"),
+					},
+					{
+						ParameterKey:   aws.String(LBWebServiceCanaryWeightParamKey),
+						ParameterValue: aws.String("10"),
+					},
+				}...),
+		},
 		"with bad sidecar container": {
 			httpsEnabled: true,
 			manifest:     testLBWebServiceManifestWithBadSidecar,
@@ -391,8 +650,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					app:  testAppName,
 					tc:   tc.manifest.TaskConfig,
 					rc: RuntimeConfig{
-						ImageRepoURL: testImageRepoURL,
-						ImageTag:     testImageTag,
+						ImageRepoURL:           testImageRepoURL,
+						ImageTag:               testImageTag,
+						DeployedTaskDefinition: tc.deployedTaskDefinition,
+						StableImage:            tc.stableImage,
 					},
 				},
 				manifest: tc.manifest,
@@ -413,6 +674,27 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 	}
 }
 
+// withParameter returns a copy of the parameters in which the parameter with the key has the value.
+func withParameter(params []*cloudformation.Parameter, key, value string) []*cloudformation.Parameter {
+	var copied []*cloudformation.Parameter
+	for _, param := range params {
+		if aws.StringValue(param.ParameterKey) == key {
+			param = &cloudformation.Parameter{ParameterKey: param.ParameterKey, ParameterValue: aws.String(value)}
+		}
+		copied = append(copied, param)
+	}
+	return copied
+}
+
+func TestParseCanarySteps(t *testing.T) {
+	steps, err := ParseCanarySteps("10,50")
+	require.NoError(t, err)
+	require.Equal(t, []int{10, 50}, steps)
+
+	_, err = ParseCanarySteps("")
+	require.Error(t, err)
+}
+
 func TestLoadBalancedWebService_SerializedParameters(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, c *LoadBalancedWebService)
@@ -516,3 +798,25 @@ func TestLoadBalancedWebService_Tags(t *testing.T) {
 		},
 	}, tags)
 }
+
+func TestBlueGreenTestListenerPort(t *testing.T) {
+	blueGreen := &manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+	}
+
+	t.Run("uses the port of the manifest", func(t *testing.T) {
+		d := *blueGreen
+		d.TestPort = aws.Uint16(9000)
+		require.Equal(t, uint16(9000), BlueGreenTestListenerPort("frontend", &d))
+	})
+	t.Run("defaults to a port derived from the name of the service", func(t *testing.T) {
+		frontend := BlueGreenTestListenerPort("frontend", blueGreen)
+		require.Equal(t, frontend, BlueGreenTestListenerPort("frontend", blueGreen))
+		require.NotEqual(t, frontend, BlueGreenTestListenerPort("payments", blueGreen))
+		for _, svc := range []string{"frontend", "payments"} {
+			port := BlueGreenTestListenerPort(svc, blueGreen)
+			require.GreaterOrEqual(t, port, uint16(lbWebSvcTestListenerPortBase))
+			require.Less(t, port, uint16(lbWebSvcTestListenerPortBase+lbWebSvcTestListenerPortRange))
+		}
+	})
+}
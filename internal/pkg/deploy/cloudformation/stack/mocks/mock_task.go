@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/deploy/cloudformation/stack/task.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	template "github.com/aws/copilot-cli/internal/pkg/template"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MocktaskParser is a mock of taskParser interface
+type MocktaskParser struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskParserMockRecorder
+}
+
+// MocktaskParserMockRecorder is the mock recorder for MocktaskParser
+type MocktaskParserMockRecorder struct {
+	mock *MocktaskParser
+}
+
+// NewMocktaskParser creates a new mock instance
+func NewMocktaskParser(ctrl *gomock.Controller) *MocktaskParser {
+	mock := &MocktaskParser{ctrl: ctrl}
+	mock.recorder = &MocktaskParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskParser) EXPECT() *MocktaskParserMockRecorder {
+	return m.recorder
+}
+
+// ParseTask mocks base method
+func (m *MocktaskParser) ParseTask(data template.TaskOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTask", data)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTask indicates an expected call of ParseTask
+func (mr *MocktaskParserMockRecorder) ParseTask(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTask", reflect.TypeOf((*MocktaskParser)(nil).ParseTask), data)
+}
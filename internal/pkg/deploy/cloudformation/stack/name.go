@@ -21,3 +21,13 @@ func NameForService(app, env, svc string) string {
 func NameForEnv(app, env string) string {
 	return fmt.Sprintf("%s-%s", app, env)
 }
+
+// NameForAppStack returns the stack name for the roles of an application.
+func NameForAppStack(app string) string {
+	return fmt.Sprintf("%s-infrastructure-roles", app)
+}
+
+// NameForTask returns the stack name for the resources of a task group.
+func NameForTask(task string) string {
+	return fmt.Sprintf("task-%s", task)
+}
@@ -123,8 +123,9 @@ func mockAssociatedEnv(envName, region string, isProd bool) *deploy.AssociatedEn
 
 func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 	return &deploy.CreatePipelineInput{
-		AppName: projectName,
-		Name:    pipelineName,
+		AppName:       projectName,
+		Name:          pipelineName,
+		BuildspecPath: "copilot/buildspec.yml",
 		Source: &deploy.Source{
 			ProviderName: "GitHub",
 			Properties: map[string]interface{}{
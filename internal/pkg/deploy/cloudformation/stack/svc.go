@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -34,6 +35,15 @@ const (
 	ServiceAddonsTemplateURLParamKey = "AddonsTemplateURL"
 )
 
+// Operating system family of the tasks of a service, Fargate only runs Linux tasks for the supported platforms.
+const linuxOperatingSystemFamily = "LINUX"
+
+// cpuArchitectures maps the platforms of the manifest to the CPU architectures of the task definition.
+var cpuArchitectures = map[string]string{
+	manifest.PlatformLinuxAMD64: "X86_64",
+	manifest.PlatformLinuxARM64: "ARM64",
+}
+
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
@@ -41,6 +51,17 @@ type RuntimeConfig struct {
 	ImageTag          string            // ImageTag is the container image's unique tag.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the service stack.
+	// Optional. DeployedTaskDefinition is the ARN of the task definition that a service deployed with
+	// blue/green deployments was created with, which stack updates must leave unchanged.
+	DeployedTaskDefinition string
+	// Optional. ImageDigest is the digest of the pushed container image. If set, the service runs the image
+	// referenced by its digest instead of its tag.
+	ImageDigest string
+	// Optional. Platform overrides the platform in the manifest that the tasks of the service run on.
+	Platform string
+	// Optional. StableImage is the image run by the stable tasks of a service deployed with canary releases.
+	// If set, the deployed image is released as a canary next to it.
+	StableImage string
 }
 
 type templater interface {
@@ -63,6 +84,38 @@ func (s *svc) StackName() string {
 	return NameForService(s.app, s.env, s.name)
 }
 
+// image returns the reference of the container image run by the service.
+func (s *svc) image() string {
+	if s.rc.ImageDigest != "" {
+		return fmt.Sprintf("%s@%s", s.rc.ImageRepoURL, s.rc.ImageDigest)
+	}
+	return fmt.Sprintf("%s:%s", s.rc.ImageRepoURL, s.rc.ImageTag)
+}
+
+// platformOpts returns the platform that the tasks of the service run on, or nil if they run on the default platform.
+func (s *svc) platformOpts() (*template.PlatformOpts, error) {
+	platform := s.rc.Platform
+	if platform == "" {
+		platform = aws.StringValue(s.tc.Platform)
+	}
+	return platformOpts(platform)
+}
+
+// platformOpts converts a platform to the runtime platform of a task definition, or nil if the platform is the default one.
+func platformOpts(platform string) (*template.PlatformOpts, error) {
+	if platform == "" {
+		return nil, nil
+	}
+	arch, ok := cpuArchitectures[platform]
+	if !ok {
+		return nil, fmt.Errorf("platform %s must be one of %s", platform, strings.Join(manifest.Platforms, ", "))
+	}
+	return &template.PlatformOpts{
+		OperatingSystemFamily: linuxOperatingSystemFamily,
+		CPUArchitecture:       arch,
+	}, nil
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *svc) Parameters() []*cloudformation.Parameter {
 	return []*cloudformation.Parameter{
@@ -80,7 +133,7 @@ func (s *svc) Parameters() []*cloudformation.Parameter {
 		},
 		{
 			ParameterKey:   aws.String(ServiceContainerImageParamKey),
-			ParameterValue: aws.String(fmt.Sprintf("%s:%s", s.rc.ImageRepoURL, s.rc.ImageTag)),
+			ParameterValue: aws.String(s.image()),
 		},
 		{
 			ParameterKey:   aws.String(ServiceTaskCPUParamKey),
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvc_image(t *testing.T) {
+	testCases := map[string]struct {
+		rc RuntimeConfig
+
+		wanted string
+	}{
+		"references the image by tag": {
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+			},
+			wanted: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c",
+		},
+		"references the image by digest if the image was pushed": {
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+				ImageDigest:  "sha256:18f7d23d0f2b4ef07e01cc7bc8ea7cc4d3a2f5ec1b7b07b43f1c4ce1d4ba3bbc",
+			},
+			wanted: "12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@sha256:18f7d23d0f2b4ef07e01cc7bc8ea7cc4d3a2f5ec1b7b07b43f1c4ce1d4ba3bbc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := &svc{
+				rc: tc.rc,
+			}
+
+			// WHEN
+			got := s.image()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSvc_platformOpts(t *testing.T) {
+	testCases := map[string]struct {
+		platform   *string
+		rcPlatform string
+
+		wanted    *template.PlatformOpts
+		wantedErr error
+	}{
+		"runs on the default platform if none is set": {},
+		"runs on the platform of the manifest": {
+			platform: aws.String("linux/arm64"),
+			wanted: &template.PlatformOpts{
+				OperatingSystemFamily: "LINUX",
+				CPUArchitecture:       "ARM64",
+			},
+		},
+		"runs on the platform of the runtime configuration over the manifest": {
+			platform:   aws.String("linux/arm64"),
+			rcPlatform: "linux/amd64",
+			wanted: &template.PlatformOpts{
+				OperatingSystemFamily: "LINUX",
+				CPUArchitecture:       "X86_64",
+			},
+		},
+		"errors if the platform is not supported": {
+			platform:  aws.String("windows/amd64"),
+			wantedErr: errors.New("platform windows/amd64 must be one of linux/amd64, linux/arm64"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := &svc{
+				tc: manifest.TaskConfig{
+					Platform: tc.platform,
+				},
+				rc: RuntimeConfig{
+					Platform: tc.rcPlatform,
+				},
+			}
+
+			// WHEN
+			got, err := s.platformOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
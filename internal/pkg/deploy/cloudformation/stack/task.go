@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Parameter logical IDs for a task group.
+const (
+	TaskNameParamKey           = "TaskName"
+	TaskContainerImageParamKey = "ContainerImage"
+	TaskCPUParamKey            = "TaskCPU"
+	TaskMemoryParamKey         = "TaskMemory"
+	TaskRoleParamKey           = "TaskRole"
+)
+
+// Output keys of a task group.
+const (
+	TaskOutputECRRepo = "ECRRepo"
+)
+
+type taskParser interface {
+	ParseTask(data template.TaskOpts) (*template.Content, error)
+}
+
+// TaskStackConfig represents the configuration needed to create the CloudFormation stack of a group of one-off tasks.
+type TaskStackConfig struct {
+	*deploy.CreateTaskResourcesInput
+	parser taskParser
+}
+
+// NewTaskStackConfig returns the configuration of the stack of a group of one-off tasks.
+func NewTaskStackConfig(in *deploy.CreateTaskResourcesInput) *TaskStackConfig {
+	return &TaskStackConfig{
+		CreateTaskResourcesInput: in,
+		parser:                   template.New(),
+	}
+}
+
+// StackName returns the name of the stack of the task group.
+func (t *TaskStackConfig) StackName() string {
+	return NameForTask(t.Name)
+}
+
+// Template returns the CloudFormation template of the task group.
+func (t *TaskStackConfig) Template() (string, error) {
+	platform, err := platformOpts(t.Platform)
+	if err != nil {
+		return "", fmt.Errorf("convert the platform of task %s: %w", t.Name, err)
+	}
+	content, err := t.parser.ParseTask(template.TaskOpts{
+		EnvVars:  t.EnvVars,
+		Command:  t.Command,
+		Platform: platform,
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse task template: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values passed to the template of the task group.
+func (t *TaskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(TaskNameParamKey),
+			ParameterValue: aws.String(t.Name),
+		},
+		{
+			ParameterKey:   aws.String(TaskContainerImageParamKey),
+			ParameterValue: aws.String(t.Image),
+		},
+		{
+			ParameterKey:   aws.String(TaskCPUParamKey),
+			ParameterValue: aws.String(strconv.Itoa(t.CPU)),
+		},
+		{
+			ParameterKey:   aws.String(TaskMemoryParamKey),
+			ParameterValue: aws.String(strconv.Itoa(t.Memory)),
+		},
+		{
+			ParameterKey:   aws.String(TaskRoleParamKey),
+			ParameterValue: aws.String(t.TaskRole),
+		},
+	}, nil
+}
+
+// Tags returns the tags applied to the stack of the task group.
+func (t *TaskStackConfig) Tags() []*cloudformation.Tag {
+	tags := map[string]string{
+		deploy.TaskTagKey: t.Name,
+	}
+	if t.App != "" {
+		tags[deploy.AppTagKey] = t.App
+	}
+	if t.Env != "" {
+		tags[deploy.EnvTagKey] = t.Env
+	}
+	return mergeAndFlattenTags(nil, tags)
+}
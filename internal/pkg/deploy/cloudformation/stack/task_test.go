@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		in               *deploy.CreateTaskResourcesInput
+		mockDependencies func(m *mocks.MocktaskParser)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"runs the tasks on the default platform": {
+			in: &deploy.CreateTaskResourcesInput{
+				Name:    "db-migrate",
+				Command: []string{"./migrate", "up"},
+				EnvVars: map[string]string{"LOG_LEVEL": "DEBUG"},
+			},
+			mockDependencies: func(m *mocks.MocktaskParser) {
+				m.EXPECT().ParseTask(template.TaskOpts{
+					Command: []string{"./migrate", "up"},
+					EnvVars: map[string]string{"LOG_LEVEL": "DEBUG"},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+			},
+			wantedTemplate: "template",
+		},
+		"runs the tasks on the runtime platform of the platform": {
+			in: &deploy.CreateTaskResourcesInput{
+				Name:     "db-migrate",
+				Platform: "linux/arm64",
+			},
+			mockDependencies: func(m *mocks.MocktaskParser) {
+				m.EXPECT().ParseTask(template.TaskOpts{
+					Platform: &template.PlatformOpts{
+						OperatingSystemFamily: "LINUX",
+						CPUArchitecture:       "ARM64",
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+			},
+			wantedTemplate: "template",
+		},
+		"errors if the platform is not supported": {
+			in: &deploy.CreateTaskResourcesInput{
+				Name:     "db-migrate",
+				Platform: "windows/amd64",
+			},
+			mockDependencies: func(m *mocks.MocktaskParser) {
+				m.EXPECT().ParseTask(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("convert the platform of task db-migrate: platform windows/amd64 must be one of linux/amd64, linux/arm64"),
+		},
+		"wraps the error from parsing the template": {
+			in: &deploy.CreateTaskResourcesInput{
+				Name: "db-migrate",
+			},
+			mockDependencies: func(m *mocks.MocktaskParser) {
+				m.EXPECT().ParseTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("parse task template: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocktaskParser(ctrl)
+			tc.mockDependencies(m)
+			conf := NewTaskStackConfig(tc.in)
+			conf.parser = m
+
+			// WHEN
+			got, err := conf.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, got)
+		})
+	}
+}
+
+func TestTaskStackConfig_Parameters(t *testing.T) {
+	// GIVEN
+	conf := NewTaskStackConfig(&deploy.CreateTaskResourcesInput{
+		Name:     "db-migrate",
+		CPU:      256,
+		Memory:   512,
+		Image:    "nginx",
+		TaskRole: "arn:aws:iam::1234:role/migrate",
+	})
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*cloudformation.Parameter{
+		{ParameterKey: aws.String(TaskNameParamKey), ParameterValue: aws.String("db-migrate")},
+		{ParameterKey: aws.String(TaskContainerImageParamKey), ParameterValue: aws.String("nginx")},
+		{ParameterKey: aws.String(TaskCPUParamKey), ParameterValue: aws.String("256")},
+		{ParameterKey: aws.String(TaskMemoryParamKey), ParameterValue: aws.String("512")},
+		{ParameterKey: aws.String(TaskRoleParamKey), ParameterValue: aws.String("arn:aws:iam::1234:role/migrate")},
+	}, params)
+	require.Equal(t, "task-db-migrate", conf.StackName())
+}
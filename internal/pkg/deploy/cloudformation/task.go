@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// DeployTask creates or updates the stack of the resources of a group of one-off tasks and waits until the deployment is done.
+// If the stack is already up to date, returns nil.
+func (cf CloudFormation) DeployTask(in *deploy.CreateTaskResourcesInput) error {
+	s, err := toStack(stack.NewTaskStackConfig(in))
+	if err != nil {
+		return err
+	}
+	err = cf.cfnClient.CreateAndWait(s)
+	if err == nil {
+		return nil
+	}
+	var errAlreadyExists *cloudformation.ErrStackAlreadyExists
+	if !errors.As(err, &errAlreadyExists) {
+		return err
+	}
+	err = cf.cfnClient.UpdateAndWait(s)
+	var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+	if errors.As(err, &errChangeSetEmpty) {
+		return nil
+	}
+	return err
+}
+
+// TaskRepositoryURI returns the URI of the ECR repository of a deployed task group.
+func (cf CloudFormation) TaskRepositoryURI(taskName string) (string, error) {
+	descr, err := cf.cfnClient.Describe(stack.NameForTask(taskName))
+	if err != nil {
+		return "", err
+	}
+	for _, out := range descr.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.TaskOutputECRRepo {
+			return aws.StringValue(out.OutputValue), nil
+		}
+	}
+	return "", fmt.Errorf("stack %s doesn't have output %s", stack.NameForTask(taskName), stack.TaskOutputECRRepo)
+}
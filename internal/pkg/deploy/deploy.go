@@ -19,6 +19,8 @@ const (
 	EnvTagKey = "copilot-environment"
 	// ServiceTagKey is tag key for Copilot svc.
 	ServiceTagKey = "copilot-service"
+	// TaskTagKey is tag key for Copilot task groups.
+	TaskTagKey = "copilot-task"
 )
 
 const (
@@ -29,6 +31,8 @@ const (
 type Resource struct {
 	LogicalName string
 	Type        string
+	PhysicalID  string
+	NestedStack string // Logical name of the nested stack holding the resource, empty if the resource is in the root stack.
 }
 
 // ResourceEvent represents a status update for an AWS resource during a deployment.
@@ -16,6 +16,14 @@ type CreateEnvironmentInput struct {
 	ToolsAccountPrincipalARN string            // The Principal ARN of the tools account.
 	AppDNSName               string            // The DNS name of this application, if it exists
 	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
+	ImportVPCConfig          *ImportVPCConfig  // Optional existing VPC to deploy the environment in, instead of creating a new one.
+}
+
+// ImportVPCConfig holds the fields to deploy an environment in an existing VPC.
+type ImportVPCConfig struct {
+	ID               string   // ID of the VPC.
+	PublicSubnetIDs  []string // IDs of the public subnets of the VPC, used by the public load balancer.
+	PrivateSubnetIDs []string // IDs of the private subnets of the VPC.
 }
 
 // CreateEnvironmentResponse holds the created environment on successful deployment.
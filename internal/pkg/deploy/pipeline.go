@@ -44,6 +44,9 @@ type CreatePipelineInput struct {
 
 	// AdditionalTags are labels applied to resources under the application.
 	AdditionalTags map[string]string
+
+	// Path of the buildspec of the build stage, relative to the root of the source repository.
+	BuildspecPath string
 }
 
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
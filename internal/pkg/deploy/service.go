@@ -11,3 +11,21 @@ type DeleteServiceInput struct {
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+// RollbackServiceInput holds the fields required to redeploy a previous revision of a service.
+type RollbackServiceInput struct {
+	Name        string            // Name of the service to roll back.
+	EnvName     string            // Name of the environment the service is deployed in.
+	AppName     string            // Name of the application the service belongs to.
+	TemplateURL string            // URL of the stack template of the revision.
+	Parameters  map[string]string // Parameters of the stack of the revision.
+	Tags        map[string]string // Tags of the stack of the revision.
+}
+
+// UpdateServiceParametersInput holds the fields required to redeploy the current stack template of a service with new parameters.
+type UpdateServiceParametersInput struct {
+	Name       string            // Name of the service to update.
+	EnvName    string            // Name of the environment the service is deployed in.
+	AppName    string            // Name of the application the service belongs to.
+	Parameters map[string]string // All the parameters of the stack, including the ones that don't change.
+}
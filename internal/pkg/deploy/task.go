@@ -0,0 +1,19 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+// CreateTaskResourcesInput holds the fields required to create the resources of a group of one-off tasks.
+type CreateTaskResourcesInput struct {
+	Name     string            // Name of the task group.
+	CPU      int               // CPU units of each task.
+	Memory   int               // Memory in MiB of each task.
+	Image    string            // Optional. Image of the tasks, defaults to the latest image of the task group's repository.
+	TaskRole string            // Optional. ARN of the role assumed by the tasks.
+	Command  []string          // Optional. Command that overrides the one of the image.
+	EnvVars  map[string]string // Optional. Environment variables of the tasks.
+	Platform string            // Optional. Platform that the tasks run on, defaults to linux/amd64.
+
+	App string // Optional. Name of the application the tasks run in.
+	Env string // Optional. Name of the environment the tasks run in.
+}
@@ -19,6 +19,7 @@ type App struct {
 	URI      string                `json:"uri"`
 	Envs     []*config.Environment `json:"environments"`
 	Services []*config.Service     `json:"services"`
+	config.Metadata
 }
 
 // JSONString returns the stringified App struct with json format.
@@ -38,6 +39,7 @@ func (a *App) HumanString() string {
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Name", a.Name)
 	fmt.Fprintf(writer, "  %s\t%s\n", "URI", a.URI)
+	writeMetadata(writer, a.Metadata)
 	fmt.Fprintf(writer, color.Bold.Sprint("\nEnvironments\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Name", "AccountID", "Region")
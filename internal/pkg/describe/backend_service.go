@@ -28,7 +28,7 @@ type BackendServiceDescriber struct {
 
 // NewBackendServiceDescriber instantiates a backend service describer.
 func NewBackendServiceDescriber(app, svc string) (*BackendServiceDescriber, error) {
-	configStore, err := config.NewStore()
+	configStore, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to store: %w", err)
 	}
@@ -143,6 +143,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: services,
 		Variables:        envVars,
 		Resources:        resources,
+		Metadata:         d.service.Metadata,
 	}, nil
 }
 
@@ -155,6 +156,7 @@ type backendSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
 	Resources        cfnResources       `json:"resources,omitempty"`
+	config.Metadata
 }
 
 // JSONString returns the stringified backendService struct with json format.
@@ -175,6 +177,7 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%s\n", "Application", w.App)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Name", w.Service)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Type", w.Type)
+	writeMetadata(writer, w.Metadata)
 	fmt.Fprintf(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
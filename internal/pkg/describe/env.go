@@ -51,7 +51,7 @@ type EnvDescriber struct {
 
 // NewEnvDescriber instantiates an environment describer.
 func NewEnvDescriber(appName, envName string) (*EnvDescriber, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 
 	if err != nil {
 		return nil, fmt.Errorf("connect to store: %w", err)
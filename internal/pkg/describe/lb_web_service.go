@@ -86,7 +86,7 @@ type WebServiceDescriber struct {
 
 // NewWebServiceDescriber instantiates a load balanced service describer.
 func NewWebServiceDescriber(app, svc string) (*WebServiceDescriber, error) {
-	configStore, err := config.NewStore()
+	configStore, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
@@ -193,6 +193,7 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Resources:        resources,
+		Metadata:         d.service.Metadata,
 	}, nil
 }
 
@@ -290,6 +291,7 @@ type webSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
 	Resources        cfnResources       `json:"resources,omitempty"`
+	config.Metadata
 }
 
 // JSONString returns the stringified webSvcDesc struct in json format.
@@ -310,6 +312,7 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%s\n", "Application", w.App)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Name", w.Service)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Type", w.Type)
+	writeMetadata(writer, w.Metadata)
 	fmt.Fprintf(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
@@ -0,0 +1,27 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// writeMetadata writes the metadata fields that are set as rows of an "About" section.
+func writeMetadata(w io.Writer, m config.Metadata) {
+	if m.Description != "" {
+		fmt.Fprintf(w, "  %s\t%s\n", "Description", m.Description)
+	}
+	if m.Owner != "" {
+		fmt.Fprintf(w, "  %s\t%s\n", "Owner", m.Owner)
+	}
+	if m.Contact != "" {
+		fmt.Fprintf(w, "  %s\t%s\n", "Contact", m.Contact)
+	}
+	if len(m.Labels) != 0 {
+		fmt.Fprintf(w, "  %s\t%s\n", "Labels", m.LabelsString())
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		in     config.Metadata
+		wanted string
+	}{
+		"writes nothing if there is no metadata": {
+			wanted: "",
+		},
+		"writes the fields that are set": {
+			in: config.Metadata{
+				Owner:  "payments",
+				Labels: map[string]string{"tier": "1", "team": "payments"},
+			},
+			wanted: "  Owner\tpayments\n  Labels\tteam=payments,tier=1\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+
+			writeMetadata(b, tc.in)
+
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
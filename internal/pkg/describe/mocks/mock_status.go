@@ -139,3 +139,71 @@ func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
 }
+
+// MockcanaryServiceDescriber is a mock of canaryServiceDescriber interface
+type MockcanaryServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockcanaryServiceDescriberMockRecorder
+}
+
+// MockcanaryServiceDescriberMockRecorder is the mock recorder for MockcanaryServiceDescriber
+type MockcanaryServiceDescriberMockRecorder struct {
+	mock *MockcanaryServiceDescriber
+}
+
+// NewMockcanaryServiceDescriber creates a new mock instance
+func NewMockcanaryServiceDescriber(ctrl *gomock.Controller) *MockcanaryServiceDescriber {
+	mock := &MockcanaryServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockcanaryServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcanaryServiceDescriber) EXPECT() *MockcanaryServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// GetServiceArn mocks base method
+func (m *MockcanaryServiceDescriber) GetServiceArn() (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceArn")
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceArn indicates an expected call of GetServiceArn
+func (mr *MockcanaryServiceDescriberMockRecorder) GetServiceArn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockcanaryServiceDescriber)(nil).GetServiceArn))
+}
+
+// CanaryServiceArn mocks base method
+func (m *MockcanaryServiceDescriber) CanaryServiceArn() (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanaryServiceArn")
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanaryServiceArn indicates an expected call of CanaryServiceArn
+func (mr *MockcanaryServiceDescriberMockRecorder) CanaryServiceArn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanaryServiceArn", reflect.TypeOf((*MockcanaryServiceDescriber)(nil).CanaryServiceArn))
+}
+
+// Params mocks base method
+func (m *MockcanaryServiceDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params
+func (mr *MockcanaryServiceDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockcanaryServiceDescriber)(nil).Params))
+}
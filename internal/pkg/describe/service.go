@@ -21,7 +21,8 @@ const (
 	waitCondition        = "AWS::CloudFormation::WaitCondition"
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 
-	serviceLogicalID = "Service"
+	serviceLogicalID       = "Service"
+	canaryServiceLogicalID = "CanaryService"
 )
 
 type stackAndResourcesDescriber interface {
@@ -63,7 +64,7 @@ type ServiceDescriber struct {
 
 // NewServiceDescriber instantiates a new service.
 func NewServiceDescriber(app, env, svc string) (*ServiceDescriber, error) {
-	store, err := config.NewStore()
+	store, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to store: %w", err)
 	}
@@ -104,12 +105,21 @@ func (d *ServiceDescriber) EnvVars() (map[string]string, error) {
 
 // GetServiceArn returns the ECS service ARN of the service in an environment.
 func (d *ServiceDescriber) GetServiceArn() (*ecs.ServiceArn, error) {
+	return d.ecsServiceArn(serviceLogicalID)
+}
+
+// CanaryServiceArn returns the ARN of the ECS service running the canary of a service deployed with canary releases.
+func (d *ServiceDescriber) CanaryServiceArn() (*ecs.ServiceArn, error) {
+	return d.ecsServiceArn(canaryServiceLogicalID)
+}
+
+func (d *ServiceDescriber) ecsServiceArn(logicalID string) (*ecs.ServiceArn, error) {
 	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
 	if err != nil {
 		return nil, err
 	}
 	for _, svcResource := range svcResources {
-		if aws.StringValue(svcResource.LogicalResourceId) == serviceLogicalID {
+		if aws.StringValue(svcResource.LogicalResourceId) == logicalID {
 			serviceArn := ecs.ServiceArn(aws.StringValue(svcResource.PhysicalResourceId))
 			return &serviceArn, nil
 		}
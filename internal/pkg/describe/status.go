@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
@@ -30,6 +32,13 @@ type serviceArnGetter interface {
 	GetServiceArn() (*ecs.ServiceArn, error)
 }
 
+// canaryServiceDescriber describes the ECS service running the canary of a service and how the traffic is split with it.
+type canaryServiceDescriber interface {
+	serviceArnGetter
+	CanaryServiceArn() (*ecs.ServiceArn, error)
+	Params() (map[string]string, error)
+}
+
 // ServiceStatus retrieves status of a service.
 type ServiceStatus struct {
 	AppName string
@@ -46,6 +55,15 @@ type ServiceStatusDesc struct {
 	Service ecs.ServiceStatus        `json:",flow"`
 	Tasks   []ecs.TaskStatus         `json:"tasks"`
 	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Canary  *CanaryStatus            `json:"canary,omitempty"`
+}
+
+// CanaryStatus contains the status of the canary of a service deployed with canary releases.
+type CanaryStatus struct {
+	StableWeight int               `json:"stableWeight"`
+	CanaryWeight int               `json:"canaryWeight"`
+	Service      ecs.ServiceStatus `json:"service"`
+	Tasks        []ecs.TaskStatus  `json:"tasks"`
 }
 
 // NewServiceStatus instantiates a new ServiceStatus struct.
@@ -54,7 +72,7 @@ func NewServiceStatus(appName, envName, svcName string) (*ServiceStatus, error)
 	if err != nil {
 		return nil, err
 	}
-	svc, err := config.NewStore()
+	svc, err := config.NewConfigStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to store: %w", err)
 	}
@@ -97,17 +115,16 @@ func (w *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
 	}
-	tasks, err := w.EcsSvc.ServiceTasks(clusterName, serviceName)
+	taskStatus, err := w.tasksStatus(clusterName, serviceName)
 	if err != nil {
-		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
+		return nil, err
 	}
-	var taskStatus []ecs.TaskStatus
-	for _, task := range tasks {
-		status, err := task.TaskStatus()
+	var canary *CanaryStatus
+	if d, ok := w.Describer.(canaryServiceDescriber); ok {
+		canary, err = w.canaryStatus(d, clusterName)
 		if err != nil {
-			return nil, fmt.Errorf("get status for task %s: %w", *task.TaskArn, err)
+			return nil, err
 		}
-		taskStatus = append(taskStatus, *status)
 	}
 	alarms, err := w.CwSvc.GetAlarmsWithTags(map[string]string{
 		deploy.AppTagKey:     w.AppName,
@@ -121,9 +138,68 @@ func (w *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 		Service: service.ServiceStatus(),
 		Tasks:   taskStatus,
 		Alarms:  alarms,
+		Canary:  canary,
 	}, nil
 }
 
+// canaryStatus returns the status of the canary of the service, or nil if it isn't deployed with canary releases.
+func (w *ServiceStatus) canaryStatus(d canaryServiceDescriber, clusterName string) (*CanaryStatus, error) {
+	params, err := d.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of service %s: %w", w.SvcName, err)
+	}
+	rawCanaryWeight, ok := params[stack.LBWebServiceCanaryWeightParamKey]
+	if !ok {
+		return nil, nil
+	}
+	canaryWeight, err := strconv.Atoi(rawCanaryWeight)
+	if err != nil {
+		return nil, fmt.Errorf("convert canary weight %s to an integer: %w", rawCanaryWeight, err)
+	}
+	stableWeight, err := strconv.Atoi(params[stack.LBWebServiceStableWeightParamKey])
+	if err != nil {
+		return nil, fmt.Errorf("convert stable weight %s to an integer: %w", params[stack.LBWebServiceStableWeightParamKey], err)
+	}
+	canaryArn, err := d.CanaryServiceArn()
+	if err != nil {
+		return nil, fmt.Errorf("get canary service ARN: %w", err)
+	}
+	serviceName, err := canaryArn.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get canary service name: %w", err)
+	}
+	service, err := w.EcsSvc.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
+	}
+	taskStatus, err := w.tasksStatus(clusterName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return &CanaryStatus{
+		StableWeight: stableWeight,
+		CanaryWeight: canaryWeight,
+		Service:      service.ServiceStatus(),
+		Tasks:        taskStatus,
+	}, nil
+}
+
+func (w *ServiceStatus) tasksStatus(clusterName, serviceName string) ([]ecs.TaskStatus, error) {
+	tasks, err := w.EcsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
+	}
+	var taskStatus []ecs.TaskStatus
+	for _, task := range tasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status for task %s: %w", *task.TaskArn, err)
+		}
+		taskStatus = append(taskStatus, *status)
+	}
+	return taskStatus, nil
+}
+
 // JSONString returns the stringified ServiceStatusDesc struct with json format.
 func (w *ServiceStatusDesc) JSONString() (string, error) {
 	b, err := json.Marshal(w)
@@ -151,6 +227,19 @@ func (w *ServiceStatusDesc) HumanString() string {
 	for _, task := range w.Tasks {
 		fmt.Fprintf(writer, task.HumanString())
 	}
+	if w.Canary != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nCanary\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d%% stable / %d%% canary\n", "Traffic Split", w.Canary.StableWeight, w.Canary.CanaryWeight)
+		fmt.Fprintf(writer, "  %s\t%v / %v running tasks\n", "Canary Tasks", w.Canary.Service.RunningCount, w.Canary.Service.DesiredCount)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Task Definition", w.Canary.Service.TaskDefinition)
+		if len(w.Canary.Tasks) > 0 {
+			fmt.Fprintf(writer, "\n  %s\t%s\t%s\t%s\t%s\t%s\n", "ID", "Image Digest", "Last Status", "Health Status", "Started At", "Stopped At")
+			for _, task := range w.Canary.Tasks {
+				fmt.Fprintf(writer, task.HumanString())
+			}
+		}
+	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nAlarms\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Health", "Last Updated", "Reason")
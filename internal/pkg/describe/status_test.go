@@ -245,6 +245,130 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestServiceStatus_canaryStatus(t *testing.T) {
+	mockCanaryArn := ecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockCanaryService")
+	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockecsSvc           func(m *mocks.MockecsServiceGetter)
+		mockServiceDescriber func(m *mocks.MockcanaryServiceDescriber)
+
+		wantedError  error
+		wantedStatus *CanaryStatus
+	}{
+		"errors if failed to get the parameters of the service": {
+			mockecsSvc: func(m *mocks.MockecsServiceGetter) {},
+			mockServiceDescriber: func(m *mocks.MockcanaryServiceDescriber) {
+				m.EXPECT().Params().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get parameters of service mockSvc: some error"),
+		},
+		"returns nil if the service is not deployed with canary releases": {
+			mockecsSvc: func(m *mocks.MockecsServiceGetter) {},
+			mockServiceDescriber: func(m *mocks.MockcanaryServiceDescriber) {
+				m.EXPECT().Params().Return(map[string]string{
+					"ContainerImage": "mockImage",
+				}, nil)
+				m.EXPECT().CanaryServiceArn().Times(0)
+			},
+		},
+		"errors if failed to get canary service ARN": {
+			mockecsSvc: func(m *mocks.MockecsServiceGetter) {},
+			mockServiceDescriber: func(m *mocks.MockcanaryServiceDescriber) {
+				m.EXPECT().Params().Return(map[string]string{
+					"StableWeight": "90",
+					"CanaryWeight": "10",
+				}, nil)
+				m.EXPECT().CanaryServiceArn().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get canary service ARN: some error"),
+		},
+		"success": {
+			mockecsSvc: func(m *mocks.MockecsServiceGetter) {
+				m.EXPECT().Service("mockCluster", "mockCanaryService").Return(&ecs.Service{
+					Status:       aws.String("ACTIVE"),
+					DesiredCount: aws.Int64(1),
+					RunningCount: aws.Int64(1),
+					Deployments: []*ecsapi.Deployment{
+						{
+							UpdatedAt:      &startTime,
+							TaskDefinition: aws.String("mockCanaryTaskDefinition"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ServiceTasks("mockCluster", "mockCanaryService").Return([]*ecs.Task{
+					{
+						TaskArn:      aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+						StartedAt:    &startTime,
+						HealthStatus: aws.String("HEALTHY"),
+						LastStatus:   aws.String("RUNNING"),
+					},
+				}, nil)
+			},
+			mockServiceDescriber: func(m *mocks.MockcanaryServiceDescriber) {
+				m.EXPECT().Params().Return(map[string]string{
+					"StableWeight": "90",
+					"CanaryWeight": "10",
+				}, nil)
+				m.EXPECT().CanaryServiceArn().Return(&mockCanaryArn, nil)
+			},
+
+			wantedStatus: &CanaryStatus{
+				StableWeight: 90,
+				CanaryWeight: 10,
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockCanaryTaskDefinition",
+				},
+				Tasks: []ecs.TaskStatus{
+					{
+						Health:     "HEALTHY",
+						LastStatus: "RUNNING",
+						ID:         "1234567890123456789",
+						StartedAt:  startTime,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockServiceDescriber := mocks.NewMockcanaryServiceDescriber(ctrl)
+			tc.mockecsSvc(mockecsSvc)
+			tc.mockServiceDescriber(mockServiceDescriber)
+
+			svcStatus := &ServiceStatus{
+				SvcName:   "mockSvc",
+				EnvName:   "mockEnv",
+				AppName:   "mockApp",
+				EcsSvc:    mockecsSvc,
+				Describer: mockServiceDescriber,
+			}
+
+			// WHEN
+			status, err := svcStatus.canaryStatus(mockServiceDescriber, "mockCluster")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.wantedStatus, status)
+			}
+		})
+	}
+}
+
 func TestServiceStatusDesc_String(t *testing.T) {
 	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
 	oldHumanize := humanizeTime
@@ -313,6 +437,52 @@ Alarms
 `,
 			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"PROVISIONING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"reason\":\"Threshold Crossed\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
 		},
+		"with a canary": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Canary: &CanaryStatus{
+					StableWeight: 90,
+					CanaryWeight: 10,
+					Service: ecs.ServiceStatus{
+						DesiredCount:     1,
+						RunningCount:     0,
+						Status:           "ACTIVE",
+						LastDeploymentAt: startTime,
+						TaskDefinition:   "mockCanaryTaskDefinition",
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+
+Canary
+
+  Traffic Split     90% stable / 10% canary
+  Canary Tasks      0 / 1 running tasks
+  Task Definition   mockCanaryTaskDefinition
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"canary\":{\"stableWeight\":90,\"canaryWeight\":10,\"service\":{\"desiredCount\":1,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockCanaryTaskDefinition\"},\"tasks\":null}}\n",
+		},
 		"running": {
 			desc: &ServiceStatusDesc{
 				Service: ecs.ServiceStatus{
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+// Names of the supported builders.
+const (
+	DockerBuilder  = "docker"
+	PodmanBuilder  = "podman"
+	BuildahBuilder = "buildah"
+	KanikoBuilder  = "kaniko"
+)
+
+// EnvVarBuilder is the environment variable that selects the builder of the images by name.
+// When it's not set, the builder is detected from the executables available on the host.
+const EnvVarBuilder = "COPILOT_BUILDER"
+
+// Builders are the names of the supported builders, in the order they're detected.
+var Builders = []string{
+	DockerBuilder,
+	PodmanBuilder,
+	BuildahBuilder,
+	KanikoBuilder,
+}
+
+// Builder builds container images and pushes them to repositories.
+type Builder interface {
+	Build(uri, imageTag, path string) error
+	Login(uri, username, password string) error
+	Push(uri, imageTag string) error
+	Tag(uri, imageTag, targetURI string) error
+	RepoDigest(uri, imageTag string) (string, error)
+	BuildPlatforms(uris []string, imageTag, path string, platforms []string) error
+	IndexDigest(uri, imageTag string) (string, error)
+}
+
+// Overridden in tests.
+var (
+	lookPath = exec.LookPath
+	statFile = os.Stat
+)
+
+// NewBuilder returns the builder selected by the EnvVarBuilder environment variable.
+// If the variable is not set, it returns the first builder available on the host, and the docker builder if none is found.
+func NewBuilder() (Builder, error) {
+	name := os.Getenv(EnvVarBuilder)
+	if name == "" {
+		name = detectBuilder()
+	}
+	switch name {
+	case DockerBuilder:
+		return New(), nil
+	case PodmanBuilder:
+		return NewPodman(), nil
+	case BuildahBuilder:
+		return NewBuildah(), nil
+	case KanikoBuilder:
+		return NewKaniko(), nil
+	default:
+		return nil, fmt.Errorf("builder %s set in %s must be one of %s", name, EnvVarBuilder, strings.Join(Builders, ", "))
+	}
+}
+
+// detectBuilder returns the name of the first builder whose executable is found on the host.
+func detectBuilder() string {
+	for _, name := range []string{DockerBuilder, PodmanBuilder, BuildahBuilder} {
+		if _, err := lookPath(name); err == nil {
+			return name
+		}
+	}
+	if _, err := statFile(kanikoExecutorPath); err == nil {
+		return KanikoBuilder
+	}
+	return DockerBuilder
+}
+
+// pushedDigests records the digests of the images pushed by a builder that doesn't keep them with its local images.
+type pushedDigests struct {
+	mu      sync.Mutex
+	digests map[string]string // Digests by image name.
+}
+
+func (d *pushedDigests) set(image, digest string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.digests == nil {
+		d.digests = make(map[string]string)
+	}
+	d.digests[image] = digest
+}
+
+func (d *pushedDigests) get(image string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.digests[image]
+}
+
+// readDigestFile calls run with the path of a temporary file and returns the digest that run wrote to it.
+func readDigestFile(fs afero.Fs, run func(digestFile string) error) (string, error) {
+	f, err := afero.TempFile(fs, "", "copilot-digest")
+	if err != nil {
+		return "", fmt.Errorf("create digest file: %w", err)
+	}
+	f.Close()
+	defer fs.Remove(f.Name())
+
+	if err := run(f.Name()); err != nil {
+		return "", err
+	}
+	digest, err := afero.ReadFile(fs, f.Name())
+	if err != nil {
+		return "", fmt.Errorf("read digest file: %w", err)
+	}
+	return strings.TrimSpace(string(digest)), nil
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := map[string]struct {
+		envVar      string
+		executables map[string]bool
+
+		wanted    Builder
+		wantedErr error
+	}{
+		"returns the builder set in the environment variable": {
+			envVar:      "podman",
+			executables: map[string]bool{"docker": true},
+			wanted:      &DaemonlessRunner{},
+		},
+		"errors if the builder in the environment variable is not supported": {
+			envVar:    "img",
+			wantedErr: errors.New("builder img set in COPILOT_BUILDER must be one of docker, podman, buildah, kaniko"),
+		},
+		"detects docker before the other builders": {
+			executables: map[string]bool{"docker": true, "buildah": true},
+			wanted:      Runner{},
+		},
+		"detects buildah if docker and podman are not found": {
+			executables: map[string]bool{"buildah": true},
+			wanted:      &DaemonlessRunner{},
+		},
+		"detects the kaniko executor": {
+			executables: map[string]bool{kanikoExecutorPath: true},
+			wanted:      &KanikoRunner{},
+		},
+		"defaults to docker if no builder is found": {
+			wanted: Runner{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			defer func(val string, look func(string) (string, error), stat func(string) (os.FileInfo, error)) {
+				os.Setenv(EnvVarBuilder, val)
+				lookPath = look
+				statFile = stat
+			}(os.Getenv(EnvVarBuilder), lookPath, statFile)
+			os.Setenv(EnvVarBuilder, tc.envVar)
+			lookPath = func(file string) (string, error) {
+				if tc.executables[file] {
+					return "/usr/bin/" + file, nil
+				}
+				return "", errors.New("executable file not found in $PATH")
+			}
+			statFile = func(name string) (os.FileInfo, error) {
+				if tc.executables[name] {
+					return nil, nil
+				}
+				return nil, os.ErrNotExist
+			}
+
+			// WHEN
+			got, err := NewBuilder()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/spf13/afero"
+)
+
+// DaemonlessRunner builds and pushes images with a CLI that doesn't need a daemon, such as podman or buildah.
+// Since these CLIs don't record the digests of pushed images, a DaemonlessRunner only knows the digests of the images it pushed.
+type DaemonlessRunner struct {
+	runner
+	bin string
+	fs  afero.Fs
+
+	pushed *pushedDigests
+}
+
+// NewPodman returns a DaemonlessRunner that runs podman commands.
+func NewPodman() *DaemonlessRunner {
+	return newDaemonlessRunner(PodmanBuilder)
+}
+
+// NewBuildah returns a DaemonlessRunner that runs buildah commands.
+func NewBuildah() *DaemonlessRunner {
+	return newDaemonlessRunner(BuildahBuilder)
+}
+
+func newDaemonlessRunner(bin string) *DaemonlessRunner {
+	return &DaemonlessRunner{
+		runner: command.New(),
+		bin:    bin,
+		fs:     afero.NewOsFs(),
+		pushed: &pushedDigests{},
+	}
+}
+
+// Build will run a `build` command with the input uri, tag, and Dockerfile path.
+func (r *DaemonlessRunner) Build(uri, imageTag, path string) error {
+	err := r.Run(r.bin, []string{"build", "-t", imageName(uri, imageTag), "-f", path, filepath.Dir(path)})
+
+	if err != nil {
+		return fmt.Errorf("building image with %s: %w", r.bin, err)
+	}
+
+	return nil
+}
+
+// Login will run a `login` command against the repository URI with the input uri and auth data.
+func (r *DaemonlessRunner) Login(uri, username, password string) error {
+	err := r.Run(r.bin,
+		[]string{"login", "-u", username, "--password-stdin", uri},
+		command.Stdin(strings.NewReader(password)))
+
+	if err != nil {
+		return fmt.Errorf("authenticate to ECR with %s: %w", r.bin, err)
+	}
+
+	return nil
+}
+
+// Push will run a `push` command against the repository URI with the input uri and image tag, and records the digest of the image.
+func (r *DaemonlessRunner) Push(uri, imageTag string) error {
+	image := imageName(uri, imageTag)
+	digest, err := readDigestFile(r.fs, func(digestFile string) error {
+		return r.Run(r.bin, []string{"push", "--digestfile", digestFile, image})
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s push: %w", r.bin, err)
+	}
+
+	r.pushed.set(image, digest)
+	return nil
+}
+
+// Tag will run a `tag` command to reference the image built for the uri and image tag under the target uri with the same tag.
+func (r *DaemonlessRunner) Tag(uri, imageTag, targetURI string) error {
+	err := r.Run(r.bin, []string{"tag", imageName(uri, imageTag), imageName(targetURI, imageTag)})
+
+	if err != nil {
+		return fmt.Errorf("tag image: %w", err)
+	}
+
+	return nil
+}
+
+// RepoDigest returns the digest that the image for the uri and image tag was pushed with by the runner,
+// or an empty string if the runner didn't push it.
+func (r *DaemonlessRunner) RepoDigest(uri, imageTag string) (string, error) {
+	return r.pushed.get(imageName(uri, imageTag)), nil
+}
+
+// BuildPlatforms will run a `build` command that builds the image for each of the platforms into a manifest list,
+// and pushes the manifest list tagged with the image tag to the repository of each uri.
+func (r *DaemonlessRunner) BuildPlatforms(uris []string, imageTag, path string, platforms []string) error {
+	list := imageName(uris[0], imageTag)
+	err := r.Run(r.bin, []string{"build", "--platform", strings.Join(platforms, ","), "--manifest", list, "-f", path, filepath.Dir(path)})
+
+	if err != nil {
+		return fmt.Errorf("building image for platforms %s with %s: %w", strings.Join(platforms, ", "), r.bin, err)
+	}
+
+	for _, uri := range uris {
+		image := imageName(uri, imageTag)
+		digest, err := readDigestFile(r.fs, func(digestFile string) error {
+			return r.Run(r.bin, []string{"manifest", "push", "--all", "--digestfile", digestFile, list, "docker://" + image})
+		})
+		if err != nil {
+			return fmt.Errorf("push manifest list %s: %w", image, err)
+		}
+		r.pushed.set(image, digest)
+	}
+	return nil
+}
+
+// IndexDigest returns the digest that the manifest list for the uri and image tag was pushed with by the runner.
+func (r *DaemonlessRunner) IndexDigest(uri, imageTag string) (string, error) {
+	digest := r.pushed.get(imageName(uri, imageTag))
+	if digest == "" {
+		return "", fmt.Errorf("manifest list %s was not pushed", imageName(uri, imageTag))
+	}
+	return digest, nil
+}
@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWriteDigest returns a Run function that writes the digest to the file following the flag in the arguments.
+func mockWriteDigest(fs afero.Fs, flag, digest string, err error) func(string, []string, ...command.Option) error {
+	return func(name string, args []string, options ...command.Option) error {
+		for i, arg := range args {
+			if arg == flag && i+1 < len(args) {
+				if err := afero.WriteFile(fs, args[i+1], []byte(digest+"\n"), 0644); err != nil {
+					return err
+				}
+			}
+		}
+		return err
+	}
+}
+
+func TestDaemonlessRunner_Push(t *testing.T) {
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockrunner, fs afero.Fs)
+
+		wantedErr    error
+		wantedDigest string
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run("podman", gomock.Any()).DoAndReturn(mockWriteDigest(fs, "--digestfile", "", mockError))
+			},
+			wantedErr: fmt.Errorf("podman push: %w", mockError),
+		},
+		"records the digest of the pushed image": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run("podman", gomock.Any()).DoAndReturn(func(name string, args []string, options ...command.Option) error {
+					require.Equal(t, "push", args[0])
+					require.Equal(t, "mockURI:mockImageTag", args[len(args)-1])
+					return mockWriteDigest(fs, "--digestfile", "sha256:1234", nil)(name, args, options...)
+				})
+			},
+			wantedDigest: "sha256:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			fs := afero.NewMemMapFs()
+			tc.setupMocks(m, fs)
+			r := &DaemonlessRunner{
+				runner: m,
+				bin:    PodmanBuilder,
+				fs:     fs,
+				pushed: &pushedDigests{},
+			}
+
+			// WHEN
+			err := r.Push("mockURI", "mockImageTag")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			digest, err := r.RepoDigest("mockURI", "mockImageTag")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+func TestDaemonlessRunner_BuildPlatforms(t *testing.T) {
+	mockError := errors.New("some error")
+	platforms := []string{"linux/amd64", "linux/arm64"}
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockrunner, fs afero.Fs)
+
+		wantedErr     error
+		wantedDigests map[string]string
+	}{
+		"wrap error returned from the build": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run("buildah", []string{"build", "--platform", "linux/amd64,linux/arm64", "--manifest", "westURI:v1",
+					"-f", "mockPath/to/Dockerfile", "mockPath/to"}).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("building image for platforms linux/amd64, linux/arm64 with buildah: %w", mockError),
+		},
+		"wrap error returned from pushing the manifest list": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run("buildah", gomock.Any()).Return(nil)
+				m.EXPECT().Run("buildah", gomock.Any()).DoAndReturn(mockWriteDigest(fs, "--digestfile", "", mockError))
+			},
+			wantedErr: fmt.Errorf("push manifest list westURI:v1: %w", mockError),
+		},
+		"pushes the manifest list to every repository": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run("buildah", gomock.Any()).Return(nil)
+				m.EXPECT().Run("buildah", gomock.Any()).DoAndReturn(mockWriteDigest(fs, "--digestfile", "sha256:1234", nil))
+				m.EXPECT().Run("buildah", gomock.Any()).DoAndReturn(mockWriteDigest(fs, "--digestfile", "sha256:5678", nil))
+			},
+			wantedDigests: map[string]string{
+				"westURI": "sha256:1234",
+				"eastURI": "sha256:5678",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			fs := afero.NewMemMapFs()
+			tc.setupMocks(m, fs)
+			r := &DaemonlessRunner{
+				runner: m,
+				bin:    BuildahBuilder,
+				fs:     fs,
+				pushed: &pushedDigests{},
+			}
+
+			// WHEN
+			err := r.BuildPlatforms([]string{"westURI", "eastURI"}, "v1", "mockPath/to/Dockerfile", platforms)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			for uri, wanted := range tc.wantedDigests {
+				digest, err := r.IndexDigest(uri, "v1")
+				require.NoError(t, err)
+				require.Equal(t, wanted, digest)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package docker
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -43,6 +45,24 @@ func (r Runner) Build(uri, imageTag, path string) error {
 	return nil
 }
 
+// BuildPlatforms will run a `docker buildx build` command that builds the image for each of the platforms with the
+// Dockerfile path, and pushes a multi-architecture manifest list tagged with the image tag to the repository of each uri.
+func (r Runner) BuildPlatforms(uris []string, imageTag, path string, platforms []string) error {
+	args := []string{"buildx", "build", "--platform", strings.Join(platforms, ","), "--push"}
+	for _, uri := range uris {
+		args = append(args, "-t", imageName(uri, imageTag))
+	}
+	args = append(args, filepath.Dir(path), "-f", path)
+
+	err := r.Run("docker", args)
+
+	if err != nil {
+		return fmt.Errorf("building image for platforms %s: %w", strings.Join(platforms, ", "), err)
+	}
+
+	return nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (r Runner) Login(uri, username, password string) error {
 	err := r.Run("docker",
@@ -74,6 +94,58 @@ func (r Runner) Push(uri, imageTag string) error {
 	return nil
 }
 
+// Tag will run a `docker tag` command to reference the image built for the uri and image tag under the target uri with the same tag.
+func (r Runner) Tag(uri, imageTag, targetURI string) error {
+	err := r.Run("docker", []string{"tag", imageName(uri, imageTag), imageName(targetURI, imageTag)})
+
+	if err != nil {
+		return fmt.Errorf("tag image: %w", err)
+	}
+
+	return nil
+}
+
+// RepoDigest will run a `docker inspect` command to return the digest that the image built for the uri and image tag
+// was pushed with to the repository of the uri. It returns an empty string if the content of the image was never pushed to the repository.
+func (r Runner) RepoDigest(uri, imageTag string) (string, error) {
+	buf := &bytes.Buffer{}
+	err := r.Run("docker", []string{"inspect", "--format", "{{json .RepoDigests}}", imageName(uri, imageTag)}, command.Stdout(buf))
+
+	if err != nil {
+		return "", fmt.Errorf("inspect image: %w", err)
+	}
+
+	var repoDigests []string
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &repoDigests); err != nil {
+		return "", fmt.Errorf("parse digests of image %s: %w", imageName(uri, imageTag), err)
+	}
+	for _, repoDigest := range repoDigests {
+		if strings.HasPrefix(repoDigest, uri+"@") {
+			return strings.TrimPrefix(repoDigest, uri+"@"), nil
+		}
+	}
+	return "", nil
+}
+
+// IndexDigest will run a `docker buildx imagetools inspect` command to return the digest of the manifest list
+// tagged with the image tag in the repository of the uri.
+func (r Runner) IndexDigest(uri, imageTag string) (string, error) {
+	buf := &bytes.Buffer{}
+	err := r.Run("docker", []string{"buildx", "imagetools", "inspect", "--format", "{{json .Manifest}}", imageName(uri, imageTag)}, command.Stdout(buf))
+
+	if err != nil {
+		return "", fmt.Errorf("inspect manifest list: %w", err)
+	}
+
+	var manifest struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &manifest); err != nil {
+		return "", fmt.Errorf("parse manifest list of image %s: %w", imageName(uri, imageTag), err)
+	}
+	return manifest.Digest, nil
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
@@ -5,9 +5,11 @@ package docker
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -153,3 +155,235 @@ func TestPush(t *testing.T) {
 		})
 	}
 }
+
+func TestTag(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockURI := "mockURI"
+	mockTargetURI := "mockTargetURI"
+	mockImageTag := "mockImageTag"
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", imageName(mockURI, mockImageTag), imageName(mockTargetURI, mockImageTag)}).Return(mockError)
+			},
+			want: fmt.Errorf("tag image: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", imageName(mockURI, mockImageTag), imageName(mockTargetURI, mockImageTag)}).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.Tag(mockURI, mockImageTag, mockTargetURI)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRepoDigest(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockURI := "mockURI"
+	mockImageTag := "mockImageTag"
+
+	var mockRunner *mocks.Mockrunner
+	mockInspect := func(out string, err error) func(string, []string, ...command.Option) error {
+		return func(name string, args []string, opts ...command.Option) error {
+			cmd := &exec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		wantDigest string
+		wantErr    error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"inspect", "--format", "{{json .RepoDigests}}", imageName(mockURI, mockImageTag)}, gomock.Any()).
+					DoAndReturn(mockInspect("", mockError))
+			},
+			wantErr: fmt.Errorf("inspect image: %w", mockError),
+		},
+		"empty digest if the image was never pushed to the repository": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"inspect", "--format", "{{json .RepoDigests}}", imageName(mockURI, mockImageTag)}, gomock.Any()).
+					DoAndReturn(mockInspect("[\"otherURI@sha256:1234\"]\n", nil))
+			},
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"inspect", "--format", "{{json .RepoDigests}}", imageName(mockURI, mockImageTag)}, gomock.Any()).
+					DoAndReturn(mockInspect("[\"otherURI@sha256:1234\",\"mockURI@sha256:5678\"]\n", nil))
+			},
+			wantDigest: "sha256:5678",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got, err := s.RepoDigest(mockURI, mockImageTag)
+
+			if test.wantErr != nil {
+				require.EqualError(t, err, test.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantDigest, got)
+			}
+		})
+	}
+}
+
+func TestBuildPlatforms(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockURIs := []string{"west/mockURI", "east/mockURI"}
+	mockImageTag := "mockImageTag"
+	mockPath := "mockPath/to/mockDockerfile"
+	mockPlatforms := []string{"linux/amd64", "linux/arm64"}
+	wantedArgs := []string{"buildx", "build", "--platform", "linux/amd64,linux/arm64", "--push",
+		"-t", "west/mockURI:mockImageTag", "-t", "east/mockURI:mockImageTag", "mockPath/to", "-f", "mockPath/to/mockDockerfile"}
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", wantedArgs).Return(mockError)
+			},
+			want: fmt.Errorf("building image for platforms linux/amd64, linux/arm64: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", wantedArgs).Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.BuildPlatforms(mockURIs, mockImageTag, mockPath, mockPlatforms)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestIndexDigest(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockURI := "mockURI"
+	mockImageTag := "mockImageTag"
+	wantedArgs := []string{"buildx", "imagetools", "inspect", "--format", "{{json .Manifest}}", imageName(mockURI, mockImageTag)}
+
+	var mockRunner *mocks.Mockrunner
+	mockInspect := func(out string, err error) func(string, []string, ...command.Option) error {
+		return func(name string, args []string, opts ...command.Option) error {
+			cmd := &exec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		wantDigest string
+		wantErr    error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", wantedArgs, gomock.Any()).DoAndReturn(mockInspect("", mockError))
+			},
+			wantErr: fmt.Errorf("inspect manifest list: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", wantedArgs, gomock.Any()).
+					DoAndReturn(mockInspect(`{"mediaType":"application/vnd.oci.image.index.v1+json","digest":"sha256:5678","size":856}`+"\n", nil))
+			},
+			wantDigest: "sha256:5678",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got, err := s.IndexDigest(mockURI, mockImageTag)
+
+			if test.wantErr != nil {
+				require.EqualError(t, err, test.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantDigest, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/spf13/afero"
+)
+
+const (
+	kanikoExecutorPath      = "/kaniko/executor"
+	kanikoDefaultConfigDir  = "/kaniko/.docker"
+	dockerConfigDirEnvVar   = "DOCKER_CONFIG"
+	dockerConfigFileName    = "config.json"
+	dockerConfigPermissions = 0600
+)
+
+// KanikoRunner builds and pushes images with the Kaniko executor, which runs without a daemon in CI containers.
+// The executor builds and pushes an image at once, so the image is only built when it's pushed.
+type KanikoRunner struct {
+	runner
+	fs        afero.Fs
+	configDir string
+
+	mu     sync.Mutex
+	builds map[string]string // Dockerfile paths by image name.
+	pushed *pushedDigests
+}
+
+// NewKaniko returns a KanikoRunner that authenticates to repositories with the Docker configuration of the executor.
+func NewKaniko() *KanikoRunner {
+	configDir := os.Getenv(dockerConfigDirEnvVar)
+	if configDir == "" {
+		configDir = kanikoDefaultConfigDir
+	}
+	return &KanikoRunner{
+		runner:    command.New(),
+		fs:        afero.NewOsFs(),
+		configDir: configDir,
+		pushed:    &pushedDigests{},
+	}
+}
+
+// Build records the Dockerfile path to build the image for the input uri and tag with when it's pushed.
+func (r *KanikoRunner) Build(uri, imageTag, path string) error {
+	r.setBuild(imageName(uri, imageTag), path)
+	return nil
+}
+
+// Login adds the auth data of the repository URI to the Docker configuration read by the executor.
+func (r *KanikoRunner) Login(uri, username, password string) error {
+	type auth struct {
+		Auth string `json:"auth"`
+	}
+	path := filepath.Join(r.configDir, dockerConfigFileName)
+	config := make(map[string]interface{})
+	if data, err := afero.ReadFile(r.fs, path); err == nil {
+		if err := json.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("parse Docker configuration %s: %w", path, err)
+		}
+	}
+	auths, ok := config["auths"].(map[string]interface{})
+	if !ok {
+		auths = make(map[string]interface{})
+	}
+	registry := strings.SplitN(uri, "/", 2)[0]
+	auths[registry] = auth{
+		Auth: base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+	config["auths"] = auths
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal Docker configuration: %w", err)
+	}
+	if err := r.fs.MkdirAll(r.configDir, 0700); err != nil {
+		return fmt.Errorf("create directory %s: %w", r.configDir, err)
+	}
+	if err := afero.WriteFile(r.fs, path, data, dockerConfigPermissions); err != nil {
+		return fmt.Errorf("write Docker configuration %s: %w", path, err)
+	}
+	return nil
+}
+
+// Push will run the executor to build the image for the input uri and tag and push it to the repository URI.
+func (r *KanikoRunner) Push(uri, imageTag string) error {
+	image := imageName(uri, imageTag)
+	path, ok := r.build(image)
+	if !ok {
+		return fmt.Errorf("image %s must be built before it's pushed", image)
+	}
+	digest, err := readDigestFile(r.fs, func(digestFile string) error {
+		return r.Run(kanikoExecutorPath, []string{"--context", filepath.Dir(path), "--dockerfile", path,
+			"--destination", image, "--digest-file", digestFile})
+	})
+
+	if err != nil {
+		return fmt.Errorf("kaniko executor: %w", err)
+	}
+
+	r.pushed.set(image, digest)
+	return nil
+}
+
+// Tag records that the image for the target uri and tag is built like the image for the input uri and tag.
+func (r *KanikoRunner) Tag(uri, imageTag, targetURI string) error {
+	path, ok := r.build(imageName(uri, imageTag))
+	if !ok {
+		return fmt.Errorf("tag image: image %s must be built before it's tagged", imageName(uri, imageTag))
+	}
+	r.setBuild(imageName(targetURI, imageTag), path)
+	return nil
+}
+
+// RepoDigest returns the digest that the image for the uri and image tag was pushed with by the runner,
+// or an empty string if the runner didn't push it.
+func (r *KanikoRunner) RepoDigest(uri, imageTag string) (string, error) {
+	return r.pushed.get(imageName(uri, imageTag)), nil
+}
+
+// BuildPlatforms will run the executor to build the image for the platform and push it to the repository of each uri.
+// The executor only builds images for a single platform.
+func (r *KanikoRunner) BuildPlatforms(uris []string, imageTag, path string, platforms []string) error {
+	if len(platforms) != 1 {
+		return fmt.Errorf("kaniko can only build an image for a single platform, not for %s", strings.Join(platforms, ", "))
+	}
+	args := []string{"--context", filepath.Dir(path), "--dockerfile", path, "--custom-platform", platforms[0]}
+	for _, uri := range uris {
+		args = append(args, "--destination", imageName(uri, imageTag))
+	}
+	digest, err := readDigestFile(r.fs, func(digestFile string) error {
+		return r.Run(kanikoExecutorPath, append(args, "--digest-file", digestFile))
+	})
+
+	if err != nil {
+		return fmt.Errorf("building image for platform %s: kaniko executor: %w", platforms[0], err)
+	}
+
+	for _, uri := range uris {
+		r.pushed.set(imageName(uri, imageTag), digest)
+	}
+	return nil
+}
+
+// IndexDigest returns the digest that the image for the uri and image tag was pushed with by the runner.
+func (r *KanikoRunner) IndexDigest(uri, imageTag string) (string, error) {
+	digest := r.pushed.get(imageName(uri, imageTag))
+	if digest == "" {
+		return "", fmt.Errorf("image %s was not pushed", imageName(uri, imageTag))
+	}
+	return digest, nil
+}
+
+func (r *KanikoRunner) setBuild(image, path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.builds == nil {
+		r.builds = make(map[string]string)
+	}
+	r.builds[image] = path
+}
+
+func (r *KanikoRunner) build(image string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	path, ok := r.builds[image]
+	return path, ok
+}
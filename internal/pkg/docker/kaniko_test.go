@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKanikoRunner_Login(t *testing.T) {
+	testCases := map[string]struct {
+		config string
+
+		wanted    string
+		wantedErr error
+	}{
+		"writes the auth data of the registry": {
+			wanted: `{
+  "auths": {
+    "12345.dkr.ecr.us-west-2.amazonaws.com": {
+      "auth": "QVdTOmh1bnRlcjI="
+    }
+  }
+}`,
+		},
+		"keeps the existing configuration": {
+			config: `{"credsStore": "ecr-login"}`,
+			wanted: `{
+  "auths": {
+    "12345.dkr.ecr.us-west-2.amazonaws.com": {
+      "auth": "QVdTOmh1bnRlcjI="
+    }
+  },
+  "credsStore": "ecr-login"
+}`,
+		},
+		"errors if the existing configuration is invalid": {
+			config:    `{`,
+			wantedErr: errors.New("parse Docker configuration /kaniko/.docker/config.json: unexpected end of JSON input"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.config != "" {
+				require.NoError(t, afero.WriteFile(fs, "/kaniko/.docker/config.json", []byte(tc.config), 0600))
+			}
+			r := &KanikoRunner{
+				fs:        fs,
+				configDir: kanikoDefaultConfigDir,
+			}
+
+			// WHEN
+			err := r.Login("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend", "AWS", "hunter2")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			got, err := afero.ReadFile(fs, "/kaniko/.docker/config.json")
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
+func TestKanikoRunner_Push(t *testing.T) {
+	testCases := map[string]struct {
+		build      bool
+		setupMocks func(m *mocks.Mockrunner, fs afero.Fs)
+
+		wantedErr    error
+		wantedDigest string
+	}{
+		"errors if the image was not built": {
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {},
+			wantedErr:  errors.New("image mockURI:v1 must be built before it's pushed"),
+		},
+		"runs the executor with the recorded Dockerfile": {
+			build: true,
+			setupMocks: func(m *mocks.Mockrunner, fs afero.Fs) {
+				m.EXPECT().Run(kanikoExecutorPath, gomock.Any()).DoAndReturn(func(name string, args []string, options ...command.Option) error {
+					require.Equal(t, []string{"--context", "mockPath/to", "--dockerfile", "mockPath/to/Dockerfile",
+						"--destination", "mockURI:v1", "--digest-file"}, args[:len(args)-1])
+					return mockWriteDigest(fs, "--digest-file", "sha256:1234", nil)(name, args, options...)
+				})
+			},
+			wantedDigest: "sha256:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			fs := afero.NewMemMapFs()
+			tc.setupMocks(m, fs)
+			r := &KanikoRunner{
+				runner: m,
+				fs:     fs,
+				pushed: &pushedDigests{},
+			}
+			if tc.build {
+				require.NoError(t, r.Build("mockURI", "v1", "mockPath/to/Dockerfile"))
+			}
+
+			// WHEN
+			err := r.Push("mockURI", "v1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			digest, err := r.RepoDigest("mockURI", "v1")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+func TestKanikoRunner_BuildPlatforms(t *testing.T) {
+	// GIVEN
+	r := &KanikoRunner{
+		fs:     afero.NewMemMapFs(),
+		pushed: &pushedDigests{},
+	}
+
+	// WHEN
+	err := r.BuildPlatforms([]string{"mockURI"}, "v1", "mockPath/to/Dockerfile", []string{"linux/amd64", "linux/arm64"})
+
+	// THEN
+	require.EqualError(t, err, "kaniko can only build an image for a single platform, not for linux/amd64, linux/arm64")
+}
@@ -119,6 +119,21 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	return &s, nil
 }
 
+// TaskPlatform returns the platform that the tasks of the service run on in the environment,
+// or an empty string if they run on the default platform.
+func (s *BackendService) TaskPlatform(envName string) (string, error) {
+	// ApplyEnv merges the overrides into the values that the fields of the manifest point to, so it's applied to a copy.
+	var cp BackendService
+	if err := deepCopy(&cp, s); err != nil {
+		return "", err
+	}
+	mft, err := cp.ApplyEnv(envName)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(mft.Platform), nil
+}
+
 // newDefaultBackendService returns a backend service with minimal task sizes and a single replica.
 func newDefaultBackendService() *BackendService {
 	return &BackendService{
@@ -353,3 +353,56 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendSvc_TaskPlatform(t *testing.T) {
+	newManifest := func() *BackendService {
+		return &BackendService{
+			BackendServiceConfig: BackendServiceConfig{
+				TaskConfig: TaskConfig{
+					Platform: aws.String("linux/arm64"),
+				},
+			},
+			Environments: map[string]*BackendServiceConfig{
+				"test": {
+					TaskConfig: TaskConfig{
+						Count: aws.Int(2),
+					},
+				},
+				"prod": {
+					TaskConfig: TaskConfig{
+						Platform: aws.String("linux/amd64"),
+					},
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		envName string
+
+		wanted string
+	}{
+		"uses the platform of the service if the environment doesn't override it": {
+			envName: "test",
+			wanted:  "linux/arm64",
+		},
+		"uses the platform of the environment override": {
+			envName: "prod",
+			wanted:  "linux/amd64",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := newManifest()
+
+			// WHEN
+			got, err := mft.TaskPlatform(tc.envName)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, newManifest(), mft, "the manifest must not be modified")
+		})
+	}
+}
@@ -16,6 +16,35 @@ const (
 	LogRetentionInDays = 30
 )
 
+// Deployment strategies of a load balanced web service.
+const (
+	// RollingDeploymentStrategy replaces the tasks of the service progressively with ECS rolling updates.
+	RollingDeploymentStrategy = "rolling"
+	// BlueGreenDeploymentStrategy starts a new set of tasks and shifts the traffic to them with CodeDeploy.
+	BlueGreenDeploymentStrategy = "blue-green"
+	// CanaryDeploymentStrategy starts the new version next to the stable one and shifts the traffic to it step by step.
+	CanaryDeploymentStrategy = "canary"
+)
+
+// DefaultCanarySteps are the percentages of the traffic sent to the new version of a canary release by default.
+var DefaultCanarySteps = []int{10, 50}
+
+// Traffic shifting patterns of a blue/green deployment.
+const (
+	AllAtOnceTrafficShifting = "all-at-once" // Shift all the traffic to the new tasks at once.
+	CanaryTrafficShifting    = "canary"      // Shift 10% of the traffic, then the rest 5 minutes later.
+	LinearTrafficShifting    = "linear"      // Shift 10% more of the traffic every minute.
+)
+
+// BlueGreenLifecycleEvents are the lifecycle events of a blue/green deployment that can invoke a hook.
+var BlueGreenLifecycleEvents = []string{
+	"BeforeInstall",
+	"AfterInstall",
+	"AfterAllowTestTraffic",
+	"BeforeAllowTraffic",
+	"AfterAllowTraffic",
+}
+
 // LoadBalancedWebService holds the configuration to build a container image with an exposed port that receives
 // requests through a load balancer with AWS Fargate as the compute engine.
 type LoadBalancedWebService struct {
@@ -34,6 +63,32 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*LogConfig  `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Deployment  *DeploymentConfig `yaml:"deployment,flow"`
+}
+
+// DeploymentConfig holds the strategy used to deploy new versions of the service.
+type DeploymentConfig struct {
+	Strategy *string `yaml:"strategy"` // Either "rolling" (default), "blue-green" or "canary".
+	Traffic  *string `yaml:"traffic"`  // Traffic shifting pattern of a blue/green deployment, "all-at-once" by default.
+	// TestPort is the port of the load balancer listener that routes test traffic to the new tasks of a blue/green deployment.
+	TestPort *uint16 `yaml:"testPort"`
+	// TestCIDRs are the CIDR blocks allowed to send test traffic. Only the tasks of the environment can by default.
+	TestCIDRs []string `yaml:"testCIDRs"`
+	// Hooks are the Lambda functions, by name or ARN, invoked at the lifecycle events of a blue/green deployment.
+	Hooks map[string]string `yaml:"hooks"`
+	// Steps are the percentages of the traffic sent to the new version of a canary release. The deployment sends
+	// the first one, and each promotion the next one until the last promotion sends all the traffic.
+	Steps []int `yaml:"steps"`
+}
+
+// IsBlueGreen returns true if new versions of the service are deployed with CodeDeploy blue/green deployments.
+func (d *DeploymentConfig) IsBlueGreen() bool {
+	return d != nil && aws.StringValue(d.Strategy) == BlueGreenDeploymentStrategy
+}
+
+// IsCanary returns true if new versions of the service are released as canaries next to the stable version.
+func (d *DeploymentConfig) IsCanary() bool {
+	return d != nil && aws.StringValue(d.Strategy) == CanaryDeploymentStrategy
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -128,3 +183,18 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	s.Environments = nil
 	return &s, nil
 }
+
+// TaskPlatform returns the platform that the tasks of the service run on in the environment,
+// or an empty string if they run on the default platform.
+func (s *LoadBalancedWebService) TaskPlatform(envName string) (string, error) {
+	// ApplyEnv merges the overrides into the values that the fields of the manifest point to, so it's applied to a copy.
+	var cp LoadBalancedWebService
+	if err := deepCopy(&cp, s); err != nil {
+		return "", err
+	}
+	mft, err := cp.ApplyEnv(envName)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(mft.Platform), nil
+}
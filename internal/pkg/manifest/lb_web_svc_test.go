@@ -244,6 +244,47 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with deployment overrides": {
+			in: &LoadBalancedWebService{
+				Service: Service{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Deployment: &DeploymentConfig{
+						Strategy: aws.String(BlueGreenDeploymentStrategy),
+						Traffic:  aws.String(CanaryTrafficShifting),
+						Hooks: map[string]string{
+							"BeforeAllowTraffic": "validate",
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						Deployment: &DeploymentConfig{
+							Traffic: aws.String(LinearTrafficShifting),
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				Service: Service{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Deployment: &DeploymentConfig{
+						Strategy: aws.String(BlueGreenDeploymentStrategy),
+						Traffic:  aws.String(LinearTrafficShifting),
+						Hooks: map[string]string{
+							"BeforeAllowTraffic": "validate",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -258,3 +299,108 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentConfig_IsBlueGreen(t *testing.T) {
+	testCases := map[string]struct {
+		in     *DeploymentConfig
+		wanted bool
+	}{
+		"without deployment configuration": {
+			wanted: false,
+		},
+		"without strategy": {
+			in:     &DeploymentConfig{},
+			wanted: false,
+		},
+		"with rolling updates": {
+			in: &DeploymentConfig{
+				Strategy: aws.String(RollingDeploymentStrategy),
+			},
+			wanted: false,
+		},
+		"with blue/green deployments": {
+			in: &DeploymentConfig{
+				Strategy: aws.String(BlueGreenDeploymentStrategy),
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsBlueGreen())
+		})
+	}
+}
+
+func TestDeploymentConfig_IsCanary(t *testing.T) {
+	testCases := map[string]struct {
+		in     *DeploymentConfig
+		wanted bool
+	}{
+		"without deployment configuration": {
+			wanted: false,
+		},
+		"with blue/green deployments": {
+			in: &DeploymentConfig{
+				Strategy: aws.String(BlueGreenDeploymentStrategy),
+			},
+			wanted: false,
+		},
+		"with canary releases": {
+			in: &DeploymentConfig{
+				Strategy: aws.String(CanaryDeploymentStrategy),
+				Steps:    []int{5, 25},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsCanary())
+		})
+	}
+}
+
+func TestLoadBalancedWebSvc_TaskPlatform(t *testing.T) {
+	newManifest := func() *LoadBalancedWebService {
+		return &LoadBalancedWebService{
+			Environments: map[string]*LoadBalancedWebServiceConfig{
+				"prod": {
+					TaskConfig: TaskConfig{
+						Platform: aws.String("linux/arm64"),
+					},
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		envName string
+
+		wanted string
+	}{
+		"runs on the default platform if none is set": {
+			envName: "test",
+		},
+		"uses the platform of the environment override": {
+			envName: "prod",
+			wanted:  "linux/arm64",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := newManifest()
+
+			// WHEN
+			got, err := mft.TaskPlatform(tc.envName)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, newManifest(), mft, "the manifest must not be modified")
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -21,6 +22,11 @@ const (
 	// BackendServiceType is a service that cannot be accessed from the internet but can be reached from other services.
 	BackendServiceType = "Backend Service"
 
+	// PlatformLinuxAMD64 runs the tasks of a service on x86_64 Fargate capacity.
+	PlatformLinuxAMD64 = "linux/amd64"
+	// PlatformLinuxARM64 runs the tasks of a service on Graviton Fargate capacity.
+	PlatformLinuxARM64 = "linux/arm64"
+
 	defaultSidecarPort    = "80"
 	defaultFluentbitImage = "amazon/aws-for-fluent-bit:latest"
 )
@@ -31,10 +37,67 @@ var ServiceTypes = []string{
 	BackendServiceType,
 }
 
+// Platforms are the supported platforms to build images for and run tasks on.
+var Platforms = []string{
+	PlatformLinuxAMD64,
+	PlatformLinuxARM64,
+}
+
 // Service holds the basic data that every service manifest file needs to have.
 type Service struct {
-	Name *string `yaml:"name"`
-	Type *string `yaml:"type"` // must be one of the supported manifest types.
+	Name      *string  `yaml:"name"`
+	Type      *string  `yaml:"type"`       // must be one of the supported manifest types.
+	DependsOn []string `yaml:"depends_on"` // Services to deploy before this service when deploying all services.
+	Hooks     *Hooks   `yaml:"hooks"`      // Commands to run before and after a new version of the service is deployed.
+}
+
+// Dependencies returns the names of the services that need to be deployed before the service.
+func (s Service) Dependencies() []string {
+	return s.DependsOn
+}
+
+// DeployHooks returns the commands to run before and after a new version of the service is deployed, if any.
+func (s Service) DeployHooks() *Hooks {
+	return s.Hooks
+}
+
+// Hooks holds the commands run, in order, before and after a new version of the service is deployed.
+type Hooks struct {
+	PreDeploy  []Hook `yaml:"pre_deploy"`  // Run once the image is pushed, before the service is updated.
+	PostDeploy []Hook `yaml:"post_deploy"` // Run once the service is updated.
+}
+
+// Validate returns an error if a hook doesn't specify exactly one of a command or a local shell command.
+func (h *Hooks) Validate() error {
+	for _, hook := range append(append([]Hook{}, h.PreDeploy...), h.PostDeploy...) {
+		if len(hook.Command) == 0 && hook.Local == nil {
+			return errors.New(`hook must specify either "command" or "local"`)
+		}
+		if len(hook.Command) != 0 && hook.Local != nil {
+			return fmt.Errorf(`hook %s cannot specify both "command" and "local"`, hook)
+		}
+	}
+	return nil
+}
+
+// Hook is a command run either as a one-off task with the image, network and secrets of the service,
+// or as a shell command on the machine that deploys the service.
+type Hook struct {
+	Command []string `yaml:"command"` // Overrides the command of the service's container in a one-off task.
+	Local   *string  `yaml:"local"`   // Shell command run locally.
+}
+
+// IsLocal returns true if the hook is a local shell command.
+func (h Hook) IsLocal() bool {
+	return h.Local != nil
+}
+
+// String returns the command of the hook.
+func (h Hook) String() string {
+	if h.IsLocal() {
+		return aws.StringValue(h.Local)
+	}
+	return strings.Join(h.Command, " ")
 }
 
 // ServiceImage represents the service's container image.
@@ -123,6 +186,7 @@ type TaskConfig struct {
 	Count     *int              `yaml:"count"` // 0 is a valid value, so we want the default value to be nil.
 	Variables map[string]string `yaml:"variables"`
 	Secrets   map[string]string `yaml:"secrets"`
+	Platform  *string           `yaml:"platform"` // Either "linux/amd64" (default) or "linux/arm64".
 }
 
 // ServiceProps contains properties for creating a new service manifest.
@@ -204,3 +268,15 @@ func parsePortMapping(s *string) (port *string, protocol *string, err error) {
 		return nil, nil, fmt.Errorf("cannot parse port mapping from %s", *s)
 	}
 }
+
+// deepCopy copies the configuration of the manifest src into dst, so that modifying dst doesn't modify src.
+func deepCopy(dst, src interface{}) error {
+	data, err := yaml.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("copy manifest: %w", err)
+	}
+	if err := yaml.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("copy manifest: %w", err)
+	}
+	return nil
+}
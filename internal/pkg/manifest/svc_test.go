@@ -114,6 +114,12 @@ environments:
 			inContent: `
 name: subscribers
 type: Backend Service
+depends_on: [users]
+hooks:
+  pre_deploy:
+    - command: ["./migrate", "up"]
+  post_deploy:
+    - local: ./smoke-test.sh
 image:
   build: ./subscribers/Dockerfile
   port: 8080
@@ -128,8 +134,13 @@ secrets:
 				require.True(t, ok)
 				wantedManifest := &BackendService{
 					Service: Service{
-						Name: aws.String("subscribers"),
-						Type: aws.String(BackendServiceType),
+						Name:      aws.String("subscribers"),
+						Type:      aws.String(BackendServiceType),
+						DependsOn: []string{"users"},
+						Hooks: &Hooks{
+							PreDeploy:  []Hook{{Command: []string{"./migrate", "up"}}},
+							PostDeploy: []Hook{{Local: aws.String("./smoke-test.sh")}},
+						},
 					},
 					BackendServiceConfig: BackendServiceConfig{
 						Image: imageWithPortAndHealthcheck{
@@ -181,3 +192,41 @@ type: 'OH NO'
 		})
 	}
 }
+
+func TestHooks_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in        *Hooks
+		wantedErr string
+	}{
+		"valid hooks": {
+			in: &Hooks{
+				PreDeploy:  []Hook{{Command: []string{"./migrate", "up"}}},
+				PostDeploy: []Hook{{Local: aws.String("./smoke-test.sh")}},
+			},
+		},
+		"hook without a command": {
+			in: &Hooks{
+				PostDeploy: []Hook{{}},
+			},
+			wantedErr: `hook must specify either "command" or "local"`,
+		},
+		"hook with both a command and a local command": {
+			in: &Hooks{
+				PreDeploy: []Hook{{Command: []string{"./migrate", "up"}, Local: aws.String("make migrate")}},
+			},
+			wantedErr: `hook make migrate cannot specify both "command" and "local"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
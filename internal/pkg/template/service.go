@@ -45,6 +45,25 @@ type LogConfigOpts struct {
 	ConfigFile     *string
 }
 
+// BlueGreenOpts holds configuration that's needed if the service is deployed with CodeDeploy blue/green deployments.
+type BlueGreenOpts struct {
+	DeploymentConfigName string   // Name of the CodeDeploy deployment configuration that shifts the traffic.
+	TestListenerPort     uint16   // Port of the load balancer listener that routes test traffic to the replacement tasks.
+	TestCIDRs            []string // CIDR blocks allowed to send test traffic, in addition to the environment's tasks.
+	HookFunctions        []string // ARNs of the Lambda functions invoked at the lifecycle events of a deployment.
+}
+
+// CanaryOpts holds configuration that's needed if the service releases new versions as canaries next to the stable version.
+type CanaryOpts struct {
+	Steps []int // Percentages of the traffic that the deployment and each promotion of a canary send to it.
+}
+
+// PlatformOpts holds the operating system and CPU architecture that the tasks of a service run on.
+type PlatformOpts struct {
+	OperatingSystemFamily string
+	CPUArchitecture       string
+}
+
 // ServiceOpts holds optional data that can be provided to enable features in a service stack template.
 type ServiceOpts struct {
 	// Additional options that're common between **all** service templates.
@@ -53,10 +72,13 @@ type ServiceOpts struct {
 	NestedStack *ServiceNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	Sidecars    []*SidecarOpts
 	LogConfig   *LogConfigOpts
+	Platform    *PlatformOpts
 
 	// Additional options that're not shared across all service templates.
 	HealthCheck        *ecs.HealthCheck
 	RulePriorityLambda string
+	BlueGreen          *BlueGreenOpts
+	Canary             *CanaryOpts
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -0,0 +1,22 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+// Path of the CloudFormation template of one-off tasks under templates/.
+const taskCFTemplatePath = "task/cf.yml"
+
+// TaskOpts holds optional data that can be provided to configure the task definition of one-off tasks.
+type TaskOpts struct {
+	EnvVars  map[string]string
+	Command  []string
+	Platform *PlatformOpts
+}
+
+// ParseTask parses the CloudFormation template of one-off tasks with the specified data object and returns its content.
+func (t *Template) ParseTask(data TaskOpts) (*Content, error) {
+	return t.Parse(taskCFTemplatePath, data, WithFuncs(map[string]interface{}{
+		"fmtSlice":   FmtSliceFunc,
+		"quoteSlice": QuoteSliceFunc,
+	}))
+}
@@ -0,0 +1,63 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/packd"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTemplate_ParseTask(t *testing.T) {
+	// GIVEN
+	data, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "templates", taskCFTemplatePath))
+	require.NoError(t, err)
+	box := packd.NewMemoryBox()
+	require.NoError(t, box.AddBytes(taskCFTemplatePath, data))
+	tpl := &Template{box: box}
+
+	// WHEN
+	content, err := tpl.ParseTask(TaskOpts{
+		EnvVars: map[string]string{"LOG_LEVEL": "DEBUG"},
+		Command: []string{"./migrate", "up"},
+		Platform: &PlatformOpts{
+			OperatingSystemFamily: "LINUX",
+			CPUArchitecture:       "ARM64",
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	var parsed struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					RuntimePlatform struct {
+						OperatingSystemFamily string `yaml:"OperatingSystemFamily"`
+						CPUArchitecture       string `yaml:"CpuArchitecture"`
+					} `yaml:"RuntimePlatform"`
+					ContainerDefinitions []struct {
+						Command     []string `yaml:"Command"`
+						Environment []struct {
+							Name  string `yaml:"Name"`
+							Value string `yaml:"Value"`
+						} `yaml:"Environment"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &parsed), content.String())
+	props := parsed.Resources.TaskDefinition.Properties
+	require.Equal(t, "LINUX", props.RuntimePlatform.OperatingSystemFamily)
+	require.Equal(t, "ARM64", props.RuntimePlatform.CPUArchitecture)
+	require.Len(t, props.ContainerDefinitions, 1)
+	require.Equal(t, []string{"./migrate", "up"}, props.ContainerDefinitions[0].Command)
+	require.Equal(t, "LOG_LEVEL", props.ContainerDefinitions[0].Environment[0].Name)
+	require.Equal(t, "DEBUG", props.ContainerDefinitions[0].Environment[0].Value)
+}
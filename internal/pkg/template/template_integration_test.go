@@ -39,6 +39,31 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"renders a valid template with blue/green deployments": {
+			opts: template.ServiceOpts{
+				BlueGreen: &template.BlueGreenOpts{
+					DeploymentConfigName: "CodeDeployDefault.ECSCanary10Percent5Minutes",
+					TestListenerPort:     10080,
+					TestCIDRs:            []string{"10.1.0.0/16"},
+					HookFunctions:        []string{"arn:${AWS::Partition}:lambda:${AWS::Region}:${AWS::AccountId}:function:validate"},
+				},
+			},
+		},
+		"renders a valid template with canary releases": {
+			opts: template.ServiceOpts{
+				Canary: &template.CanaryOpts{
+					Steps: []int{10, 50},
+				},
+			},
+		},
+		"renders a valid template with the tasks running on Graviton": {
+			opts: template.ServiceOpts{
+				Platform: &template.PlatformOpts{
+					OperatingSystemFamily: "LINUX",
+					CPUArchitecture:       "ARM64",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -60,4 +85,28 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 			require.NoError(t, err, content.String())
 		})
 	}
-}
\ No newline at end of file
+}
+func TestTemplate_ParseTaskIsValid(t *testing.T) {
+	// GIVEN
+	sess, err := session.NewProvider().Default()
+	require.NoError(t, err)
+	cfn := cloudformation.New(sess)
+	tpl := template.New()
+
+	// WHEN
+	content, err := tpl.ParseTask(template.TaskOpts{
+		EnvVars: map[string]string{"LOG_LEVEL": "DEBUG"},
+		Command: []string{"./migrate", "up"},
+		Platform: &template.PlatformOpts{
+			OperatingSystemFamily: "LINUX",
+			CPUArchitecture:       "ARM64",
+		},
+	})
+	require.NoError(t, err)
+
+	// THEN
+	_, err = cfn.ValidateTemplate(&cloudformation.ValidateTemplateInput{
+		TemplateBody: aws.String(content.String()),
+	})
+	require.NoError(t, err, content.String())
+}
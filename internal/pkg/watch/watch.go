@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watch detects changes to files in the workspace by periodically comparing their
+// names, sizes and modification times.
+package watch
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	defaultInterval = time.Second
+	defaultQuiet    = 2 * time.Second
+)
+
+// Watcher polls a list of files and directories for changes.
+type Watcher struct {
+	fs       afero.Fs
+	paths    []string
+	skipDirs map[string]bool
+	interval time.Duration
+	quiet    time.Duration
+
+	fingerprints map[string]string // Last reported fingerprint of each path.
+
+	sleep func(time.Duration) // Overridden in tests.
+	now   func() time.Time    // Overridden in tests.
+}
+
+// Option configures a watcher.
+type Option func(w *Watcher)
+
+// WithInterval sets how often the paths are checked for changes.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithQuietPeriod sets how long the paths have to stay unchanged before changes are reported,
+// so that a burst of changes, like saving several files, is reported once.
+func WithQuietPeriod(quiet time.Duration) Option {
+	return func(w *Watcher) {
+		w.quiet = quiet
+	}
+}
+
+// WithSkippedDirs ignores the changes to the directories with the names, like ".git", under the watched paths.
+func WithSkippedDirs(names ...string) Option {
+	return func(w *Watcher) {
+		for _, name := range names {
+			w.skipDirs[name] = true
+		}
+	}
+}
+
+// New returns a watcher of the paths with their current state as the starting point.
+func New(fs afero.Fs, paths []string, opts ...Option) (*Watcher, error) {
+	w := &Watcher{
+		fs:       fs,
+		paths:    paths,
+		skipDirs: make(map[string]bool),
+		interval: defaultInterval,
+		quiet:    defaultQuiet,
+		sleep:    time.Sleep,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	fingerprints, err := w.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	w.fingerprints = fingerprints
+	return w, nil
+}
+
+// Wait blocks until some of the paths change and then stay unchanged for the quiet period.
+// It returns the paths that changed since the last call.
+func (w *Watcher) Wait() ([]string, error) {
+	latest := w.fingerprints
+	var lastChangeAt time.Time
+	for {
+		w.sleep(w.interval)
+		current, err := w.snapshot()
+		if err != nil {
+			return nil, err
+		}
+		if !equal(current, latest) {
+			latest = current
+			lastChangeAt = w.now()
+			continue
+		}
+		if lastChangeAt.IsZero() || w.now().Sub(lastChangeAt) < w.quiet {
+			continue
+		}
+		var changed []string
+		for _, path := range w.paths {
+			if latest[path] != w.fingerprints[path] {
+				changed = append(changed, path)
+			}
+		}
+		w.fingerprints = latest
+		if len(changed) == 0 {
+			// The paths changed back to their previous state.
+			lastChangeAt = time.Time{}
+			continue
+		}
+		return changed, nil
+	}
+}
+
+func (w *Watcher) snapshot() (map[string]string, error) {
+	fingerprints := make(map[string]string)
+	for _, path := range w.paths {
+		fingerprint, err := w.fingerprint(path)
+		if err != nil {
+			return nil, err
+		}
+		fingerprints[path] = fingerprint
+	}
+	return fingerprints, nil
+}
+
+// fingerprint returns a digest of the names, sizes and modification times of the files under the path.
+func (w *Watcher) fingerprint(root string) (string, error) {
+	h := sha256.New()
+	err := afero.Walk(w.fs, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The file was removed while walking the directory.
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			if path != root && w.skipDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("read files under %s: %w", root, err)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func equal(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Wait(t *testing.T) {
+	startTime := time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)
+	touch := func(fs afero.Fs, name, content string, at int) {
+		afero.WriteFile(fs, name, []byte(content), 0644)
+		fs.Chtimes(name, startTime.Add(time.Duration(at)*time.Second), startTime.Add(time.Duration(at)*time.Second))
+	}
+	testCases := map[string]struct {
+		changes map[int]func(fs afero.Fs) // Changes to apply after each poll.
+
+		wantedChanged []string
+		wantedPolls   int
+	}{
+		"reports the changed paths once they stop changing": {
+			changes: map[int]func(fs afero.Fs){
+				2: func(fs afero.Fs) {
+					touch(fs, "/src/main.go", "package main // edited", 2)
+				},
+			},
+			wantedChanged: []string{"/src"},
+			wantedPolls:   4, // The change and then the quiet period of 2 polls.
+		},
+		"debounces a burst of changes": {
+			changes: map[int]func(fs afero.Fs){
+				1: func(fs afero.Fs) {
+					touch(fs, "/src/main.go", "package main // edited", 1)
+				},
+				2: func(fs afero.Fs) {
+					touch(fs, "/copilot/api/manifest.yml", "name: api\ncount: 2\n", 2)
+				},
+				3: func(fs afero.Fs) {
+					touch(fs, "/src/util.go", "package main", 3)
+				},
+			},
+			wantedChanged: []string{"/src", "/copilot/api/manifest.yml"},
+			wantedPolls:   5,
+		},
+		"ignores skipped directories": {
+			changes: map[int]func(fs afero.Fs){
+				1: func(fs afero.Fs) {
+					touch(fs, "/src/.git/HEAD", "ref: refs/heads/dev", 1)
+				},
+				4: func(fs afero.Fs) {
+					touch(fs, "/copilot/api/manifest.yml", "name: api\ncount: 2\n", 4)
+				},
+			},
+			wantedChanged: []string{"/copilot/api/manifest.yml"},
+			wantedPolls:   6,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			touch(fs, "/src/main.go", "package main", 0)
+			touch(fs, "/src/.git/HEAD", "ref: refs/heads/master", 0)
+			touch(fs, "/copilot/api/manifest.yml", "name: api\n", 0)
+			w, err := New(fs, []string{"/src", "/copilot/api/manifest.yml"},
+				WithInterval(time.Second), WithQuietPeriod(2*time.Second), WithSkippedDirs(".git"))
+			require.NoError(t, err)
+
+			now, polls := startTime, 0
+			w.now = func() time.Time { return now }
+			w.sleep = func(d time.Duration) {
+				polls++
+				now = now.Add(d)
+				if change, ok := tc.changes[polls]; ok {
+					change(fs)
+				}
+			}
+
+			// WHEN
+			changed, err := w.Wait()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChanged, changed)
+			require.Equal(t, tc.wantedPolls, polls)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package workspace
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ErrNoPipelineInWorkspace means there was no pipeline manifest in the workspace dir.
@@ -22,16 +23,14 @@ func (e *ErrFileExists) Error() string {
 
 // errWorkspaceNotFound means we couldn't locate a workspace root.
 type errWorkspaceNotFound struct {
-	CurrentDirectory      string
 	ManifestDirectoryName string
-	NumberOfLevelsChecked int
+	CheckedDirectories    []string
 }
 
 func (e *errWorkspaceNotFound) Error() string {
-	return fmt.Sprintf("couldn't find a directory called %s up to %d levels up from %s",
+	return fmt.Sprintf("couldn't find a directory called %s in any of the directories checked: %s",
 		e.ManifestDirectoryName,
-		e.NumberOfLevelsChecked,
-		e.CurrentDirectory)
+		strings.Join(e.CheckedDirectories, ", "))
 }
 
 // errNoAssociatedApplication means we couldn't locate a workspace summary file.
@@ -41,11 +40,11 @@ func (e *errNoAssociatedApplication) Error() string {
 	return fmt.Sprint("couldn't find an application associated with this workspace")
 }
 
-// errHasExistingApplication means we tried to create a workspace that belongs to another application.
-type errHasExistingApplication struct {
-	existingAppName string
+// errMultipleApplications means the workspace holds several applications and none was selected.
+type errMultipleApplications struct {
+	names []string
 }
 
-func (e *errHasExistingApplication) Error() string {
-	return fmt.Sprintf("this workspace is already registered with application %s", e.existingAppName)
+func (e *errMultipleApplications) Error() string {
+	return fmt.Sprintf("found applications %s in the workspace, please select one", strings.Join(e.names, ", "))
 }
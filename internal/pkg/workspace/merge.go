@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const diffContextLines = 3
+
+// Markers surrounding the regions of a merged file that both the user and the new version changed.
+const (
+	conflictStartMarker     = "<<<<<<< yours\n"
+	conflictSeparatorMarker = "=======\n"
+	conflictEndMarker       = ">>>>>>> generated\n"
+)
+
+// mergeLines applies the changes between the base and the new content on top of the current content.
+// Regions of base changed both in current and in the new content are conflicts, they keep both the current and the new lines
+// between conflict markers so that the user resolves them. Lines added at the same position on both sides are all kept.
+// It returns the merged content along with the number of conflicts.
+func mergeLines(base, current, new []byte) ([]byte, int) {
+	baseLines, currentLines, newLines := splitLines(base), splitLines(current), splitLines(new)
+	toCurrent, toNew := matchingLines(baseLines, currentLines), matchingLines(baseLines, newLines)
+
+	var merged []string
+	var conflicts int
+	i, j, k := 0, 0, 0 // Positions in the base, current and new lines.
+	for {
+		// Copy the lines that are unchanged on both sides.
+		for i < len(baseLines) && isMatch(toCurrent, i, j) && isMatch(toNew, i, k) {
+			merged = append(merged, baseLines[i])
+			i, j, k = i+1, j+1, k+1
+		}
+		if i == len(baseLines) && j == len(currentLines) && k == len(newLines) {
+			break
+		}
+		// Find the next base line that is kept on both sides, everything before it has changed.
+		nextI, nextJ, nextK := len(baseLines), len(currentLines), len(newLines)
+		for b := i; b < len(baseLines); b++ {
+			jj, inCurrent := toCurrent[b]
+			kk, inNew := toNew[b]
+			if inCurrent && inNew {
+				nextI, nextJ, nextK = b, jj, kk
+				break
+			}
+		}
+		baseChunk, currentChunk, newChunk := baseLines[i:nextI], currentLines[j:nextJ], newLines[k:nextK]
+		switch {
+		case equalLines(currentChunk, baseChunk):
+			merged = append(merged, newChunk...)
+		case equalLines(newChunk, baseChunk), equalLines(newChunk, currentChunk):
+			merged = append(merged, currentChunk...)
+		case len(baseChunk) == 0:
+			// Both sides only added lines at the same position, keep the generated lines first.
+			merged = append(merged, newChunk...)
+			merged = append(merged, currentChunk...)
+		default:
+			merged = append(merged, conflictStartMarker)
+			merged = append(merged, terminateLines(currentChunk)...)
+			merged = append(merged, conflictSeparatorMarker)
+			merged = append(merged, terminateLines(newChunk)...)
+			merged = append(merged, conflictEndMarker)
+			conflicts++
+		}
+		i, j, k = nextI, nextJ, nextK
+	}
+	return []byte(strings.Join(merged, "")), conflicts
+}
+
+// unifiedDiff returns the unified diff between the previous and the new content of a file.
+// If the contents are the same, it returns an empty string.
+func unifiedDiff(filename string, previous, new []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        terminateLines(splitLines(previous)),
+		B:        terminateLines(splitLines(new)),
+		FromFile: filename,
+		ToFile:   filename,
+		Context:  diffContextLines,
+	})
+}
+
+// splitLines splits data into lines that keep their line endings.
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// terminateLines adds a line ending to the last line if it's missing so that it's printed on its own line.
+func terminateLines(lines []string) []string {
+	if len(lines) == 0 || strings.HasSuffix(lines[len(lines)-1], "\n") {
+		return lines
+	}
+	terminated := append([]string{}, lines...)
+	terminated[len(terminated)-1] += "\n"
+	return terminated
+}
+
+// matchingLines returns the positions of the lines in other that are kept from base, indexed by their position in base.
+func matchingLines(base, other []string) map[int]int {
+	matches := make(map[int]int)
+	for _, block := range difflib.NewMatcherWithJunk(base, other, false, nil).GetMatchingBlocks() {
+		for n := 0; n < block.Size; n++ {
+			matches[block.A+n] = block.B + n
+		}
+	}
+	return matches
+}
+
+func isMatch(matches map[int]int, from, to int) bool {
+	got, ok := matches[from]
+	return ok && got == to
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeLines(t *testing.T) {
+	testCases := map[string]struct {
+		base    string
+		current string
+		new     string
+
+		wantedMerged    string
+		wantedConflicts int
+	}{
+		"takes the new content if the user didn't edit the file": {
+			base:         "name: api\nport: 80\n",
+			current:      "name: api\nport: 80\n",
+			new:          "name: api\nport: 80\ncount: 1\n",
+			wantedMerged: "name: api\nport: 80\ncount: 1\n",
+		},
+		"keeps the user's edits if the generated content didn't change": {
+			base:         "name: api\nport: 80\n",
+			current:      "name: api\nport: 8080\n",
+			new:          "name: api\nport: 80\n",
+			wantedMerged: "name: api\nport: 8080\n",
+		},
+		"merges edits to different lines": {
+			base:         "name: api\nport: 80\ncpu: 256\nmemory: 512\n",
+			current:      "name: api\nport: 8080\ncpu: 256\nmemory: 512\n",
+			new:          "name: api\nport: 80\ncpu: 256\nmemory: 1024\ncount: 1\n",
+			wantedMerged: "name: api\nport: 8080\ncpu: 256\nmemory: 1024\ncount: 1\n",
+		},
+		"marks conflicts with both sides": {
+			base:            "name: api\nport: 80\ncpu: 256\n",
+			current:         "name: api\nport: 8080\ncpu: 256\n",
+			new:             "name: api\nport: 443\ncpu: 256\n",
+			wantedMerged:    "name: api\n<<<<<<< yours\nport: 8080\n=======\nport: 443\n>>>>>>> generated\ncpu: 256\n",
+			wantedConflicts: 1,
+		},
+		"marks conflicts on the last line without a line ending": {
+			base:            "name: api\nport: 80",
+			current:         "name: api\nport: 8080",
+			new:             "name: api\nport: 443",
+			wantedMerged:    "name: api\n<<<<<<< yours\nport: 8080\n=======\nport: 443\n>>>>>>> generated\n",
+			wantedConflicts: 1,
+		},
+		"does not conflict when both sides make the same edit": {
+			base:         "name: api\nport: 80\n",
+			current:      "name: api\nport: 443\n",
+			new:          "name: api\nport: 443\n",
+			wantedMerged: "name: api\nport: 443\n",
+		},
+		"keeps lines added by the user": {
+			base:         "name: api\nport: 80\n",
+			current:      "# My service.\nname: api\nport: 80\nvariables:\n  LOG_LEVEL: debug\n",
+			new:          "name: api\nport: 80\ncount: 1\n",
+			wantedMerged: "# My service.\nname: api\nport: 80\ncount: 1\nvariables:\n  LOG_LEVEL: debug\n",
+		},
+		"drops lines removed by the user": {
+			base:         "name: api\nport: 80\ncpu: 256\ncount: 1\n",
+			current:      "name: api\ncpu: 256\ncount: 1\n",
+			new:          "name: api\nport: 80\ncpu: 256\ncount: 2\n",
+			wantedMerged: "name: api\ncpu: 256\ncount: 2\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			merged, conflicts := mergeLines([]byte(tc.base), []byte(tc.current), []byte(tc.new))
+
+			// THEN
+			require.Equal(t, tc.wantedMerged, string(merged))
+			require.Equal(t, tc.wantedConflicts, conflicts)
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	// WHEN
+	diff, err := unifiedDiff("copilot/api/manifest.yml", []byte("name: api\nport: 80"), []byte("name: api\nport: 8080\n"))
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `--- copilot/api/manifest.yml
++++ copilot/api/manifest.yml
+@@ -1,2 +1,2 @@
+ name: api
+-port: 80
++port: 8080
+`, diff)
+}
@@ -12,6 +12,19 @@
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
 //  │   └── pipeline.yml               (pipeline manifest)
 //  └── my-service-src                 (customer service code)
+//
+// A workspace can also hold several applications, in which case each application has its own directory
+// under the copilot directory:
+//  .
+//  └── copilot
+//      ├── my-app                     (application directory)
+//      │   ├── .workspace             (workspace summary)
+//      │   ├── my-service
+//      │   │   └── manifest.yml       (service manifest)
+//      │   └── pipeline.yml           (pipeline manifest)
+//      └── my-other-app
+//          ├── .workspace
+//          └── ...
 package workspace
 
 import (
@@ -20,6 +33,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v3"
@@ -30,12 +44,21 @@ const (
 	CopilotDirName = "copilot"
 	// SummaryFileName is the name of the file that is associated with the application.
 	SummaryFileName = ".workspace"
-
-	addonsDirName             = "addons"
-	maximumParentDirsToSearch = 5
-	pipelineFileName          = "pipeline.yml"
-	manifestFileName          = "manifest.yml"
-	buildspecFileName         = "buildspec.yml"
+	// BuildspecFileName is the name of the buildspec of the pipeline's build stage in the application directory.
+	BuildspecFileName = "buildspec.yml"
+	// EnvVarWorkspace is the environment variable holding the root of the workspace.
+	// When it's not set, the workspace is searched from the current directory up to the root of the git repository.
+	EnvVarWorkspace = "COPILOT_WORKSPACE"
+
+	addonsDirName    = "addons"
+	gitDirName       = ".git"
+	pipelineFileName = "pipeline.yml"
+	manifestFileName = "manifest.yml"
+
+	generatedFileSuffix = ".generated" // Suffix of the hidden copy of a file as it was last generated.
+	backupFileSuffix    = ".bak"
+	newFileSuffix       = ".new" // Suffix of the new version of a file written next to it when it can't be merged.
+	migrationDirSuffix  = ".migration" // Suffix of the hidden directory holding the files of an application while they're moved.
 
 	ymlFileExtension = ".yml"
 )
@@ -45,15 +68,52 @@ type Summary struct {
 	Application string `yaml:"application"` // Name of the application.
 }
 
+// FileUpdate holds the changes to regenerate a file of the workspace, which are written by ApplyUpdate.
+type FileUpdate struct {
+	Path       string // Path of the file.
+	Created    bool   // True if the file doesn't exist yet.
+	NewPath    string // Path next to the file where the new version is written instead, if there's no generated copy to merge it with.
+	BackupPath string // Path of the copy of the file before it's overwritten, empty if the file isn't overwritten.
+	Diff       string // Unified diff between the current file and its new content.
+	Conflicts  int    // Number of regions changed both by the user and in the new version, surrounded by conflict markers.
+
+	previous  []byte // Current content of the file.
+	content   []byte // New content of the file, or of the file next to it.
+	generated []byte // New version as generated, to merge the user's changes with the next version.
+}
+
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
 type Workspace struct {
 	workingDir string
+	rootDir    string // Root of the workspace set by the user, if any.
 	copilotDir string
+	appDir     string                              // Directory holding the files of the application, either the copilot directory or copilot/{app}.
+	appName    func() string                       // Name of the application to use when the workspace holds several applications.
+	selectApp  func(apps []string) (string, error) // Selects the application to use when it can't be inferred otherwise.
 	fsUtils    *afero.Afero
 }
 
+// Option configures a workspace.
+type Option func(ws *Workspace)
+
+// WithApplication scopes a workspace that holds several applications to the application returned by appName.
+// The function is only called when the workspace is first read, so the application can be selected after the workspace is created.
+func WithApplication(appName func() string) Option {
+	return func(ws *Workspace) {
+		ws.appName = appName
+	}
+}
+
+// WithApplicationSelector sets the function called to select one of the applications of a workspace that holds several
+// applications, when the application can't be inferred from the application the workspace is scoped to or the current directory.
+func WithApplicationSelector(selectApp func(apps []string) (string, error)) Option {
+	return func(ws *Workspace) {
+		ws.selectApp = selectApp
+	}
+}
+
 // New returns a workspace, used for reading and writing to user's local workspace.
-func New() (*Workspace, error) {
+func New(opts ...Option) (*Workspace, error) {
 	fs := afero.NewOsFs()
 	fsUtils := &afero.Afero{Fs: fs}
 
@@ -65,36 +125,155 @@ func New() (*Workspace, error) {
 		workingDir: workingDir,
 		fsUtils:    fsUtils,
 	}
+	if root := os.Getenv(EnvVarWorkspace); root != "" {
+		rootDir, err := filepath.Abs(root)
+		if err != nil {
+			return nil, fmt.Errorf("get absolute path of workspace root %s: %w", root, err)
+		}
+		ws.rootDir = rootDir
+	}
+	for _, opt := range opts {
+		opt(&ws)
+	}
 
 	return &ws, nil
 }
 
 // Create creates the copilot directory (if it doesn't already exist) in the current working directory,
 // and saves a summary with the application name.
+// If the workspace already holds applications in their own directories, the application is added next to them.
+// If the workspace holds a single application at the root of the copilot directory, the files of that application
+// are first moved to their own directory.
 func (ws *Workspace) Create(appName string) error {
 	// Create an application directory, if one doesn't exist
 	if err := ws.createCopilotDir(); err != nil {
 		return err
 	}
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return err
+	}
 
 	// Grab an existing workspace summary, if one exists.
-	summary, err := ws.Summary()
+	summary, err := ws.readSummary(filepath.Join(copilotPath, SummaryFileName))
 	if err == nil {
-		// If a summary exists, but is registered to a different application, throw an error.
-		if summary.Application != appName {
-			return &errHasExistingApplication{existingAppName: summary.Application}
+		if summary.Application == appName {
+			// Our work is all done.
+			return nil
+		}
+		// The summary is registered to a different application, move it to its own directory to add the new application next to it.
+		if err := ws.moveToApplicationDir(copilotPath, summary.Application); err != nil {
+			return err
+		}
+	} else {
+		var notFound *errNoAssociatedApplication
+		if !errors.As(err, &notFound) {
+			return err
+		}
+	}
+
+	apps, err := ws.applicationDirs(copilotPath)
+	if err != nil {
+		return err
+	}
+	// If there isn't any application in the workspace, store the summary at the root of the copilot directory.
+	appDir := copilotPath
+	if len(apps) != 0 {
+		appDir = filepath.Join(copilotPath, appName)
+		if err := ws.fsUtils.MkdirAll(appDir, 0755); err != nil {
+			return fmt.Errorf("create directory %s: %w", appDir, err)
+		}
+	}
+	for _, app := range apps {
+		if app == appName {
+			ws.appDir = appDir
+			return nil
+		}
+	}
+	if err := ws.writeSummary(filepath.Join(appDir, SummaryFileName), appName); err != nil {
+		return err
+	}
+	ws.appDir = appDir
+	return nil
+}
+
+// moveToApplicationDir moves the files of the application at the root of the copilot directory to copilot/{appName}.
+// The files are first moved to a temporary directory, since one of them can be named after the application.
+func (ws *Workspace) moveToApplicationDir(copilotPath, appName string) error {
+	tmpDir := filepath.Join(copilotPath, "."+appName+migrationDirSuffix)
+	if err := ws.moveFiles(copilotPath, tmpDir); err != nil {
+		return err
+	}
+	return ws.moveFiles(tmpDir, filepath.Join(copilotPath, appName))
+}
+
+// moveFiles moves the files under the from directory to the same relative paths under the to directory,
+// then removes the directories left under from.
+func (ws *Workspace) moveFiles(from, to string) error {
+	var files []string
+	err := ws.fsUtils.Walk(from, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == to {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() {
+			files = append(files, path)
 		}
-		// Otherwise our work is all done.
 		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("list files under %s: %w", from, err)
+	}
+	for _, file := range files {
+		rel, err := filepath.Rel(from, file)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(to, rel)
+		if err := ws.fsUtils.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return fmt.Errorf("create directory %s: %w", filepath.Dir(dst), err)
+		}
+		if err := ws.fsUtils.Rename(file, dst); err != nil {
+			return fmt.Errorf("move %s to %s: %w", file, dst, err)
+		}
+	}
+	entries, err := ws.fsUtils.ReadDir(from)
+	if err != nil {
+		return fmt.Errorf("read directory %s: %w", from, err)
+	}
+	for _, entry := range entries {
+		path := filepath.Join(from, entry.Name())
+		if path == to {
+			continue
+		}
+		if err := ws.fsUtils.RemoveAll(path); err != nil {
+			return fmt.Errorf("remove %s: %w", path, err)
+		}
+	}
+	if !strings.HasPrefix(to, from+string(filepath.Separator)) {
+		// The from directory is left empty.
+		return ws.fsUtils.RemoveAll(from)
 	}
+	return nil
+}
 
-	// If there isn't an existing workspace summary, create it.
+// Applications returns the names of the applications in the workspace.
+func (ws *Workspace) Applications() ([]string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	summary, err := ws.readSummary(filepath.Join(copilotPath, SummaryFileName))
+	if err == nil {
+		return []string{summary.Application}, nil
+	}
 	var notFound *errNoAssociatedApplication
-	if errors.As(err, &notFound) {
-		return ws.writeSummary(appName)
+	if !errors.As(err, &notFound) {
+		return nil, err
 	}
-
-	return err
+	return ws.applicationDirs(copilotPath)
 }
 
 // Summary returns a summary of the workspace - including the application name.
@@ -103,34 +282,25 @@ func (ws *Workspace) Summary() (*Summary, error) {
 	if err != nil {
 		return nil, err
 	}
-	summaryFileExists, err := ws.fsUtils.Exists(summaryPath)
-	if summaryFileExists {
-		value, err := ws.fsUtils.ReadFile(summaryPath)
-		if err != nil {
-			return nil, err
-		}
-		wsSummary := Summary{}
-		return &wsSummary, yaml.Unmarshal(value, &wsSummary)
-	}
-	return nil, &errNoAssociatedApplication{}
+	return ws.readSummary(summaryPath)
 }
 
-// ServiceNames returns the names of the services in the workspace.
+// ServiceNames returns the names of the services of the application in the workspace.
 func (ws *Workspace) ServiceNames() ([]string, error) {
-	copilotPath, err := ws.copilotDirPath()
+	appPath, err := ws.appDirPath()
 	if err != nil {
 		return nil, err
 	}
-	files, err := ws.fsUtils.ReadDir(copilotPath)
+	files, err := ws.fsUtils.ReadDir(appPath)
 	if err != nil {
-		return nil, fmt.Errorf("read directory %s: %w", copilotPath, err)
+		return nil, fmt.Errorf("read directory %s: %w", appPath, err)
 	}
 	var names []string
 	for _, f := range files {
 		if !f.IsDir() {
 			continue
 		}
-		if exists, _ := ws.fsUtils.Exists(filepath.Join(copilotPath, f.Name(), manifestFileName)); !exists {
+		if exists, _ := ws.fsUtils.Exists(filepath.Join(appPath, f.Name(), manifestFileName)); !exists {
 			// Swallow the error because we don't want to include any services that we don't have permissions to read.
 			continue
 		}
@@ -139,12 +309,39 @@ func (ws *Workspace) ServiceNames() ([]string, error) {
 	return names, nil
 }
 
-// ReadServiceManifest returns the contents of the service manifest under copilot/{name}/manifest.yml.
+// ReadServiceManifest returns the contents of the service manifest under the application's {name}/manifest.yml.
 func (ws *Workspace) ReadServiceManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
-// ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
+// ServiceManifestPath returns the path of the service's manifest under the application's {name}/manifest.yml.
+func (ws *Workspace) ServiceManifestPath(name string) (string, error) {
+	appPath, err := ws.appDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(appPath, name, manifestFileName), nil
+}
+
+// AppDirRelPath returns the path of the application directory relative to the root of the workspace, with forward
+// slashes so that it can be used in pipelines, for example "copilot" or "copilot/my-app".
+func (ws *Workspace) AppDirRelPath() (string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	appPath, err := ws.appDirPath()
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(filepath.Dir(copilotPath), appPath)
+	if err != nil {
+		return "", fmt.Errorf("get path of application directory %s: %w", appPath, err)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// ReadPipelineManifest returns the contents of the application's pipeline manifest.
 func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
 	pmPath, err := ws.pipelineManifestPath()
 	if err != nil {
@@ -167,7 +364,17 @@ func (ws *Workspace) WriteServiceManifest(marshaler encoding.BinaryMarshaler, na
 	if err != nil {
 		return "", fmt.Errorf("marshal service %s manifest to binary: %w", name, err)
 	}
-	return ws.write(data, name, manifestFileName)
+	return ws.writeGenerated(data, name, manifestFileName)
+}
+
+// UpdateServiceManifest returns the changes to regenerate the service's manifest under the copilot/{name}/ directory.
+// The changes the user made to the manifest since it was last generated are kept.
+func (ws *Workspace) UpdateServiceManifest(marshaler encoding.BinaryMarshaler, name string) (*FileUpdate, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal service %s manifest to binary: %w", name, err)
+	}
+	return ws.update(data, name, manifestFileName)
 }
 
 // WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
@@ -177,7 +384,17 @@ func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler)
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
 	}
-	return ws.write(data, buildspecFileName)
+	return ws.writeGenerated(data, BuildspecFileName)
+}
+
+// UpdatePipelineBuildspec returns the changes to regenerate the pipeline buildspec under the copilot/ directory.
+// The changes the user made to the buildspec since it was last generated are kept.
+func (ws *Workspace) UpdatePipelineBuildspec(marshaler encoding.BinaryMarshaler) (*FileUpdate, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
+	}
+	return ws.update(data, BuildspecFileName)
 }
 
 // WritePipelineManifest writes the pipeline manifest under the copilot directory.
@@ -187,24 +404,38 @@ func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline manifest to binary: %w", err)
 	}
-	return ws.write(data, pipelineFileName)
+	return ws.writeGenerated(data, pipelineFileName)
 }
 
-// DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
+// UpdatePipelineManifest returns the changes to regenerate the pipeline manifest under the copilot directory.
+// The changes the user made to the manifest since it was last generated are kept.
+func (ws *Workspace) UpdatePipelineManifest(marshaler encoding.BinaryMarshaler) (*FileUpdate, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal pipeline manifest to binary: %w", err)
+	}
+	return ws.update(data, pipelineFileName)
+}
+
+// DeleteWorkspaceFile removes the application's .workspace file.
 // This will be called during app delete, we do not want to delete any other generated files
 func (ws *Workspace) DeleteWorkspaceFile() error {
-	return ws.fsUtils.Remove(filepath.Join(CopilotDirName, SummaryFileName))
+	summaryPath, err := ws.summaryPath()
+	if err != nil {
+		return err
+	}
+	return ws.fsUtils.Remove(summaryPath)
 }
 
 // ReadAddonsDir returns a list of file names under a service's "addons/" directory.
 func (ws *Workspace) ReadAddonsDir(svcName string) ([]string, error) {
-	copilotPath, err := ws.copilotDirPath()
+	appPath, err := ws.appDirPath()
 	if err != nil {
 		return nil, err
 	}
 
 	var names []string
-	files, err := ws.fsUtils.ReadDir(filepath.Join(copilotPath, svcName, addonsDirName))
+	files, err := ws.fsUtils.ReadDir(filepath.Join(appPath, svcName, addonsDirName))
 	if err != nil {
 		return nil, err
 	}
@@ -230,12 +461,23 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
-func (ws *Workspace) writeSummary(appName string) error {
-	summaryPath, err := ws.summaryPath()
+func (ws *Workspace) readSummary(summaryPath string) (*Summary, error) {
+	summaryFileExists, err := ws.fsUtils.Exists(summaryPath)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if summaryFileExists {
+		value, err := ws.fsUtils.ReadFile(summaryPath)
+		if err != nil {
+			return nil, err
+		}
+		wsSummary := Summary{}
+		return &wsSummary, yaml.Unmarshal(value, &wsSummary)
 	}
+	return nil, &errNoAssociatedApplication{}
+}
 
+func (ws *Workspace) writeSummary(summaryPath, appName string) error {
 	workspaceSummary := Summary{
 		Application: appName,
 	}
@@ -249,20 +491,20 @@ func (ws *Workspace) writeSummary(appName string) error {
 }
 
 func (ws *Workspace) pipelineManifestPath() (string, error) {
-	copilotPath, err := ws.copilotDirPath()
+	appPath, err := ws.appDirPath()
 	if err != nil {
 		return "", err
 	}
-	pipelineManifestPath := filepath.Join(copilotPath, pipelineFileName)
+	pipelineManifestPath := filepath.Join(appPath, pipelineFileName)
 	return pipelineManifestPath, nil
 }
 
 func (ws *Workspace) summaryPath() (string, error) {
-	copilotPath, err := ws.copilotDirPath()
+	appPath, err := ws.appDirPath()
 	if err != nil {
 		return "", err
 	}
-	workspaceSummaryPath := filepath.Join(copilotPath, SummaryFileName)
+	workspaceSummaryPath := filepath.Join(appPath, SummaryFileName)
 	return workspaceSummaryPath, nil
 }
 
@@ -272,13 +514,22 @@ func (ws *Workspace) createCopilotDir() error {
 	if existingWorkspace != "" {
 		return nil
 	}
-	return ws.fsUtils.Mkdir(CopilotDirName, 0755)
+	dir := ws.rootDir
+	if dir == "" {
+		dir = ws.workingDir
+	}
+	return ws.fsUtils.Mkdir(filepath.Join(dir, CopilotDirName), 0755)
 }
 
+// copilotDirPath returns the path of the copilot directory under the workspace root if it's set.
+// Otherwise, the directory is searched from the working directory up to the root of the git repository.
 func (ws *Workspace) copilotDirPath() (string, error) {
 	if ws.copilotDir != "" {
 		return ws.copilotDir, nil
 	}
+	if ws.rootDir != "" {
+		return ws.rootCopilotDirPath()
+	}
 	// Are we in the application directory?
 	inCopilotDir := filepath.Base(ws.workingDir) == CopilotDirName
 	if inCopilotDir {
@@ -286,8 +537,10 @@ func (ws *Workspace) copilotDirPath() (string, error) {
 		return ws.copilotDir, nil
 	}
 
-	searchingDir := ws.workingDir
-	for try := 0; try < maximumParentDirsToSearch; try++ {
+	var checkedDirs []string
+	searchingDir := filepath.Clean(ws.workingDir)
+	for {
+		checkedDirs = append(checkedDirs, searchingDir)
 		currentDirectoryPath := filepath.Join(searchingDir, CopilotDirName)
 		inCurrentDirPath, err := ws.fsUtils.DirExists(currentDirectoryPath)
 		if err != nil {
@@ -297,22 +550,136 @@ func (ws *Workspace) copilotDirPath() (string, error) {
 			ws.copilotDir = currentDirectoryPath
 			return ws.copilotDir, nil
 		}
-		searchingDir = filepath.Dir(searchingDir)
+		// The workspace can't be outside of the git repository.
+		if isRepoRoot, _ := ws.fsUtils.Exists(filepath.Join(searchingDir, gitDirName)); isRepoRoot {
+			break
+		}
+		parentDir := filepath.Dir(searchingDir)
+		if parentDir == searchingDir {
+			break
+		}
+		searchingDir = parentDir
 	}
 	return "", &errWorkspaceNotFound{
-		CurrentDirectory:      ws.workingDir,
 		ManifestDirectoryName: CopilotDirName,
-		NumberOfLevelsChecked: maximumParentDirsToSearch,
+		CheckedDirectories:    checkedDirs,
 	}
 }
 
-// write flushes the data to a file under the copilot directory joined by path elements.
-func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
+func (ws *Workspace) rootCopilotDirPath() (string, error) {
+	dir := ws.rootDir
+	if filepath.Base(dir) != CopilotDirName {
+		dir = filepath.Join(dir, CopilotDirName)
+	}
+	exists, err := ws.fsUtils.DirExists(dir)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", &errWorkspaceNotFound{
+			ManifestDirectoryName: CopilotDirName,
+			CheckedDirectories:    []string{ws.rootDir},
+		}
+	}
+	ws.copilotDir = dir
+	return ws.copilotDir, nil
+}
+
+// appDirPath returns the directory holding the files of the application.
+// If the workspace holds several applications, the application is picked in order from
+// the application the workspace is scoped to, the current directory, or the only application in the workspace.
+func (ws *Workspace) appDirPath() (string, error) {
+	if ws.appDir != "" {
+		return ws.appDir, nil
+	}
 	copilotPath, err := ws.copilotDirPath()
 	if err != nil {
 		return "", err
 	}
-	pathElems := append([]string{copilotPath}, elem...)
+	// A summary at the root of the copilot directory means that the workspace holds a single application.
+	if exists, _ := ws.fsUtils.Exists(filepath.Join(copilotPath, SummaryFileName)); exists {
+		ws.appDir = copilotPath
+		return ws.appDir, nil
+	}
+	apps, err := ws.applicationDirs(copilotPath)
+	if err != nil {
+		return "", err
+	}
+	if len(apps) == 0 {
+		// The workspace isn't associated with an application yet.
+		return copilotPath, nil
+	}
+	name, err := ws.selectApplication(copilotPath, apps)
+	if err != nil {
+		return "", err
+	}
+	ws.appDir = filepath.Join(copilotPath, name)
+	return ws.appDir, nil
+}
+
+func (ws *Workspace) selectApplication(copilotPath string, apps []string) (string, error) {
+	if ws.appName != nil {
+		if name := ws.appName(); name != "" {
+			for _, app := range apps {
+				if app == name {
+					return name, nil
+				}
+			}
+			return "", &errNoAssociatedApplication{}
+		}
+	}
+	if rel, err := filepath.Rel(copilotPath, ws.workingDir); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+		dir := strings.Split(rel, string(filepath.Separator))[0]
+		for _, app := range apps {
+			if app == dir {
+				return dir, nil
+			}
+		}
+	}
+	if len(apps) == 1 {
+		return apps[0], nil
+	}
+	if ws.selectApp == nil {
+		return "", &errMultipleApplications{names: apps}
+	}
+	name, err := ws.selectApp(apps)
+	if err != nil {
+		return "", err
+	}
+	for _, app := range apps {
+		if app == name {
+			return name, nil
+		}
+	}
+	return "", &errNoAssociatedApplication{}
+}
+
+// applicationDirs returns the names of the directories under the copilot directory that hold an application.
+func (ws *Workspace) applicationDirs(copilotPath string) ([]string, error) {
+	files, err := ws.fsUtils.ReadDir(copilotPath)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", copilotPath, err)
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		if exists, _ := ws.fsUtils.Exists(filepath.Join(copilotPath, f.Name(), SummaryFileName)); !exists {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	return names, nil
+}
+
+// write flushes the data to a file under the application directory joined by path elements.
+func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
+	appPath, err := ws.appDirPath()
+	if err != nil {
+		return "", err
+	}
+	pathElems := append([]string{appPath}, elem...)
 	filename := filepath.Join(pathElems...)
 
 	if err := ws.fsUtils.MkdirAll(filepath.Dir(filename), 0755 /* -rwxr-xr-x */); err != nil {
@@ -331,12 +698,104 @@ func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
 	return filename, nil
 }
 
-// read returns the contents of the file under the copilot directory joined by path elements.
+// writeGenerated writes a file generated by copilot like write, and keeps a copy of it
+// to merge the user's changes when the file is regenerated.
+func (ws *Workspace) writeGenerated(data []byte, elem ...string) (string, error) {
+	filename, err := ws.write(data, elem...)
+	if err != nil {
+		return "", err
+	}
+	if err := ws.fsUtils.WriteFile(generatedFilePath(filename), data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write generated copy of file %s: %w", filename, err)
+	}
+	return filename, nil
+}
+
+// update returns the changes to regenerate a file under the application directory joined by path elements.
+// If the file already exists, the new content is merged with the changes made to the file since it was last generated.
+// Files generated before copilot kept a copy of them can't be merged, so the new version is written next to them instead.
+func (ws *Workspace) update(data []byte, elem ...string) (*FileUpdate, error) {
+	appPath, err := ws.appDirPath()
+	if err != nil {
+		return nil, err
+	}
+	filename := filepath.Join(append([]string{appPath}, elem...)...)
+	exist, err := ws.fsUtils.Exists(filename)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", filename, err)
+	}
+	if !exist {
+		return &FileUpdate{Path: filename, Created: true, content: data, generated: data}, nil
+	}
+	current, err := ws.fsUtils.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", filename, err)
+	}
+	update := &FileUpdate{
+		Path:      filename,
+		previous:  current,
+		content:   data,
+		generated: data,
+	}
+	base, err := ws.fsUtils.ReadFile(generatedFilePath(filename))
+	switch {
+	case err == nil:
+		update.content, update.Conflicts = mergeLines(base, current, data)
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("read generated copy of file %s: %w", filename, err)
+	}
+	update.Diff, err = unifiedDiff(filename, current, update.content)
+	if err != nil {
+		return nil, fmt.Errorf("compute diff of file %s: %w", filename, err)
+	}
+	if update.Diff == "" {
+		return update, nil
+	}
+	if base == nil {
+		update.NewPath = filename + newFileSuffix
+	} else {
+		update.BackupPath = filename + backupFileSuffix
+	}
+	return update, nil
+}
+
+// ApplyUpdate writes the changes to regenerate a file of the workspace. An overwritten file is backed up first.
+func (ws *Workspace) ApplyUpdate(update *FileUpdate) error {
+	if err := ws.fsUtils.MkdirAll(filepath.Dir(update.Path), 0755 /* -rwxr-xr-x */); err != nil {
+		return fmt.Errorf("create directories for file %s: %w", update.Path, err)
+	}
+	switch {
+	case update.NewPath != "":
+		if err := ws.fsUtils.WriteFile(update.NewPath, update.content, 0644 /* -rw-r--r-- */); err != nil {
+			return fmt.Errorf("write new version of file %s: %w", update.Path, err)
+		}
+	case update.Created || update.Diff != "":
+		if update.BackupPath != "" {
+			if err := ws.fsUtils.WriteFile(update.BackupPath, update.previous, 0644 /* -rw-r--r-- */); err != nil {
+				return fmt.Errorf("back up file %s: %w", update.Path, err)
+			}
+		}
+		if err := ws.fsUtils.WriteFile(update.Path, update.content, 0644 /* -rw-r--r-- */); err != nil {
+			return fmt.Errorf("write file %s: %w", update.Path, err)
+		}
+	}
+	if err := ws.fsUtils.WriteFile(generatedFilePath(update.Path), update.generated, 0644 /* -rw-r--r-- */); err != nil {
+		return fmt.Errorf("write generated copy of file %s: %w", update.Path, err)
+	}
+	return nil
+}
+
+// generatedFilePath returns the path of the hidden copy of a file as it was last generated, next to the file.
+func generatedFilePath(filename string) string {
+	return filepath.Join(filepath.Dir(filename), "."+filepath.Base(filename)+generatedFileSuffix)
+}
+
+// read returns the contents of the file under the application directory joined by path elements.
 func (ws *Workspace) read(elem ...string) ([]byte, error) {
-	copilotPath, err := ws.copilotDirPath()
+	appPath, err := ws.appDirPath()
 	if err != nil {
 		return nil, err
 	}
-	pathElems := append([]string{copilotPath}, elem...)
+	pathElems := append([]string{appPath}, elem...)
 	return ws.fsUtils.ReadFile(filepath.Join(pathElems...))
 }
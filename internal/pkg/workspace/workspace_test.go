@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
@@ -35,6 +36,7 @@ func TestWorkspace_copilotDirPath(t *testing.T) {
 		expectedManifestDir string
 		presetManifestDir   string
 		workingDir          string
+		rootDir             string
 		expectedError       error
 		mockFileSystem      func(fs afero.Fs)
 	}{
@@ -63,23 +65,61 @@ func TestWorkspace_copilotDirPath(t *testing.T) {
 			},
 		},
 
-		"too many levels deep": {
-			expectedError: fmt.Errorf("couldn't find a directory called copilot up to 5 levels up from " + filepath.FromSlash("test/1/2/3/4/5")),
-			workingDir:    filepath.FromSlash("test/1/2/3/4/5"),
+		"many levels deep": {
+			expectedManifestDir: manifestDir,
+			workingDir:          filepath.FromSlash("test/1/2/3/4/5/6"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				fs.MkdirAll("test/1/2/3/4/5/6", 0755)
+			},
+		},
+
+		"stops at the root of the git repository": {
+			expectedError: fmt.Errorf("couldn't find a directory called copilot in any of the directories checked: " +
+				strings.Join([]string{filepath.FromSlash("test/repo/1"), filepath.FromSlash("test/repo")}, ", ")),
+			workingDir: filepath.FromSlash("test/repo/1"),
 			mockFileSystem: func(fs afero.Fs) {
 				fs.MkdirAll("test/copilot", 0755)
-				fs.MkdirAll("test/1/2/3/4/5", 0755)
+				fs.MkdirAll("test/repo/1", 0755)
+				fs.MkdirAll("test/repo/.git", 0755)
 			},
 		},
 
 		"out of a workspace": {
-			expectedError: fmt.Errorf("couldn't find a directory called copilot up to 5 levels up from " + filepath.FromSlash("/")),
+			expectedError: fmt.Errorf("couldn't find a directory called copilot in any of the directories checked: " + filepath.FromSlash("/")),
 			workingDir:    filepath.FromSlash("/"),
 			mockFileSystem: func(fs afero.Fs) {
 				fs.MkdirAll("test/copilot", 0755)
 			},
 		},
 
+		"uses the workspace root": {
+			expectedManifestDir: filepath.FromSlash("/code/copilot"),
+			workingDir:          filepath.FromSlash("/"),
+			rootDir:             filepath.FromSlash("/code"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("/code/copilot", 0755)
+			},
+		},
+
+		"uses the workspace root pointing at the copilot directory": {
+			expectedManifestDir: filepath.FromSlash("/code/copilot"),
+			workingDir:          filepath.FromSlash("/"),
+			rootDir:             filepath.FromSlash("/code/copilot"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("/code/copilot", 0755)
+			},
+		},
+
+		"workspace root without a copilot directory": {
+			expectedError: fmt.Errorf("couldn't find a directory called copilot in any of the directories checked: " + filepath.FromSlash("/code")),
+			workingDir:    filepath.FromSlash("test/"),
+			rootDir:       filepath.FromSlash("/code"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+			},
+		},
+
 		"uses precomputed manifest path": {
 			expectedManifestDir: manifestDir,
 			workingDir:          filepath.FromSlash("/"),
@@ -96,6 +136,7 @@ func TestWorkspace_copilotDirPath(t *testing.T) {
 
 			ws := Workspace{
 				workingDir: tc.workingDir,
+				rootDir:    tc.rootDir,
 				fsUtils:    &afero.Afero{Fs: fs},
 				copilotDir: tc.presetManifestDir,
 			}
@@ -134,7 +175,7 @@ func TestWorkspace_Summary(t *testing.T) {
 		},
 		"no existing manifest dir": {
 			workingDir:     "test/",
-			expectedError:  fmt.Errorf("couldn't find a directory called copilot up to 5 levels up from test/"),
+			expectedError:  fmt.Errorf("couldn't find a directory called copilot in any of the directories checked: test, ."),
 			mockFileSystem: func(fs afero.Fs) {},
 		},
 	}
@@ -188,9 +229,8 @@ func TestWorkspace_Create(t *testing.T) {
 			},
 		},
 		"existing workspace and different application": {
-			workingDir:    "test/",
-			appName:       "DavidsApp",
-			expectedError: fmt.Errorf("this workspace is already registered with application DavidsOtherApp"),
+			workingDir: "test/",
+			appName:    "DavidsApp",
 			mockFileSystem: func(fs afero.Fs) {
 				fs.MkdirAll("test/copilot", 0755)
 				afero.WriteFile(fs, "test/copilot/.workspace", []byte(fmt.Sprintf("---\napplication: %s", "DavidsOtherApp")), 0644)
@@ -391,6 +431,150 @@ func TestWorkspace_write(t *testing.T) {
 	}
 }
 
+func TestWorkspace_update(t *testing.T) {
+	testCases := map[string]struct {
+		mockFileSystem func(fs afero.Fs)
+		data           string
+
+		wantedUpdate    *FileUpdate
+		wantedContent   string
+		wantedNew       string
+		wantedBackup    string
+		wantedGenerated string
+	}{
+		"writes the file if it doesn't exist": {
+			mockFileSystem: func(fs afero.Fs) {},
+			data:           "name: api\n",
+
+			wantedUpdate:    &FileUpdate{Path: "/copilot/api/manifest.yml", Created: true},
+			wantedContent:   "name: api\n",
+			wantedGenerated: "name: api\n",
+		},
+		"writes the new version next to a file generated before copies were kept": {
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api\nport: 80\n"), 0644)
+			},
+			data: "name: api\nport: 8080\n",
+
+			wantedUpdate: &FileUpdate{
+				Path:    "/copilot/api/manifest.yml",
+				NewPath: "/copilot/api/manifest.yml.new",
+				Diff: `--- /copilot/api/manifest.yml
++++ /copilot/api/manifest.yml
+@@ -1,2 +1,2 @@
+ name: api
+-port: 80
++port: 8080
+`,
+			},
+			wantedContent:   "name: api\nport: 80\n",
+			wantedNew:       "name: api\nport: 8080\n",
+			wantedGenerated: "name: api\nport: 8080\n",
+		},
+		"keeps the user's changes": {
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/copilot/api/.manifest.yml.generated", []byte("port: 80\ncpu: 256\ncount: 1\n"), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("port: 80\ncpu: 256\ncount: 3\n"), 0644)
+			},
+			data: "port: 8080\ncpu: 256\ncount: 1\n",
+
+			wantedUpdate: &FileUpdate{
+				Path:       "/copilot/api/manifest.yml",
+				BackupPath: "/copilot/api/manifest.yml.bak",
+				Diff: `--- /copilot/api/manifest.yml
++++ /copilot/api/manifest.yml
+@@ -1,3 +1,3 @@
+-port: 80
++port: 8080
+ cpu: 256
+ count: 3
+`,
+			},
+			wantedContent:   "port: 8080\ncpu: 256\ncount: 3\n",
+			wantedBackup:    "port: 80\ncpu: 256\ncount: 3\n",
+			wantedGenerated: "port: 8080\ncpu: 256\ncount: 1\n",
+		},
+		"marks conflicts": {
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/copilot/api/.manifest.yml.generated", []byte("name: api\nport: 80\n"), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api\nport: 443\n"), 0644)
+			},
+			data: "name: api\nport: 8080\n",
+
+			wantedUpdate: &FileUpdate{
+				Path:       "/copilot/api/manifest.yml",
+				BackupPath: "/copilot/api/manifest.yml.bak",
+				Diff: `--- /copilot/api/manifest.yml
++++ /copilot/api/manifest.yml
+@@ -1,2 +1,6 @@
+ name: api
++<<<<<<< yours
+ port: 443
++=======
++port: 8080
++>>>>>>> generated
+`,
+				Conflicts: 1,
+			},
+			wantedContent:   "name: api\n<<<<<<< yours\nport: 443\n=======\nport: 8080\n>>>>>>> generated\n",
+			wantedBackup:    "name: api\nport: 443\n",
+			wantedGenerated: "name: api\nport: 8080\n",
+		},
+		"leaves an up to date file unchanged": {
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/copilot/api/.manifest.yml.generated", []byte("name: api\n"), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api\n"), 0644)
+			},
+			data: "name: api\n",
+
+			wantedUpdate:    &FileUpdate{Path: "/copilot/api/manifest.yml"},
+			wantedContent:   "name: api\n",
+			wantedGenerated: "name: api\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			utils := &afero.Afero{Fs: fs}
+			utils.MkdirAll("/copilot/api", 0755)
+			tc.mockFileSystem(fs)
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			before, _ := utils.ReadFile("/copilot/api/manifest.yml")
+
+			// WHEN
+			update, err := ws.update([]byte(tc.data), "api", manifestFileName)
+			require.NoError(t, err)
+			after, _ := utils.ReadFile("/copilot/api/manifest.yml")
+			require.Equal(t, string(before), string(after), "nothing is written before the update is applied")
+			err = ws.ApplyUpdate(update)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUpdate.Path, update.Path)
+			require.Equal(t, tc.wantedUpdate.Created, update.Created)
+			require.Equal(t, tc.wantedUpdate.NewPath, update.NewPath)
+			require.Equal(t, tc.wantedUpdate.BackupPath, update.BackupPath)
+			require.Equal(t, tc.wantedUpdate.Diff, update.Diff)
+			require.Equal(t, tc.wantedUpdate.Conflicts, update.Conflicts)
+			content, _ := utils.ReadFile("/copilot/api/manifest.yml")
+			require.Equal(t, tc.wantedContent, string(content))
+			newContent, _ := utils.ReadFile("/copilot/api/manifest.yml.new")
+			require.Equal(t, tc.wantedNew, string(newContent))
+			generated, _ := utils.ReadFile("/copilot/api/.manifest.yml.generated")
+			require.Equal(t, tc.wantedGenerated, string(generated))
+			backup, _ := utils.ReadFile("/copilot/api/manifest.yml.bak")
+			require.Equal(t, tc.wantedBackup, string(backup))
+		})
+	}
+}
+
 func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	testCases := map[string]struct {
 		svcName        string
@@ -607,3 +791,231 @@ func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspace_MultipleApplications(t *testing.T) {
+	multiAppFs := func() afero.Fs {
+		fs := afero.NewMemMapFs()
+		fs.MkdirAll("/repo/copilot/phonetool/frontend", 0755)
+		afero.WriteFile(fs, "/repo/copilot/phonetool/.workspace", []byte("application: phonetool\n"), 0644)
+		fs.Create("/repo/copilot/phonetool/frontend/manifest.yml")
+		fs.MkdirAll("/repo/copilot/payments/api", 0755)
+		afero.WriteFile(fs, "/repo/copilot/payments/.workspace", []byte("application: payments\n"), 0644)
+		fs.Create("/repo/copilot/payments/api/manifest.yml")
+		fs.Create("/repo/copilot/payments/pipeline.yml")
+		fs.MkdirAll("/repo/src", 0755)
+		return fs
+	}
+	testCases := map[string]struct {
+		workingDir string
+		appName    string
+		selectApp  func(apps []string) (string, error)
+		fs         func() afero.Fs
+
+		wantedApp      string
+		wantedServices []string
+		wantedAppDir   string
+		wantedErr      error
+	}{
+		"selects the application the workspace is scoped to": {
+			workingDir:     "/repo/src",
+			appName:        "payments",
+			fs:             multiAppFs,
+			wantedApp:      "payments",
+			wantedServices: []string{"api"},
+			wantedAppDir:   "copilot/payments",
+		},
+		"selects the application from the current directory": {
+			workingDir:     "/repo/copilot/phonetool/frontend",
+			fs:             multiAppFs,
+			wantedApp:      "phonetool",
+			wantedServices: []string{"frontend"},
+			wantedAppDir:   "copilot/phonetool",
+		},
+		"the application the workspace is scoped to takes precedence over the current directory": {
+			workingDir:     "/repo/copilot/phonetool",
+			appName:        "payments",
+			fs:             multiAppFs,
+			wantedApp:      "payments",
+			wantedServices: []string{"api"},
+		},
+		"selects the only application": {
+			workingDir: "/repo/src",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/repo/copilot/phonetool", 0755)
+				afero.WriteFile(fs, "/repo/copilot/phonetool/.workspace", []byte("application: phonetool\n"), 0644)
+				fs.MkdirAll("/repo/src", 0755)
+				return fs
+			},
+			wantedApp: "phonetool",
+		},
+		"errors if the application can't be selected": {
+			workingDir: "/repo/src",
+			fs:         multiAppFs,
+			wantedErr:  errors.New("found applications payments, phonetool in the workspace, please select one"),
+		},
+		"selects the application with the selector if it can't be inferred": {
+			workingDir: "/repo/src",
+			fs:         multiAppFs,
+			selectApp: func(apps []string) (string, error) {
+				require.Equal(t, []string{"payments", "phonetool"}, apps)
+				return "phonetool", nil
+			},
+			wantedApp:      "phonetool",
+			wantedServices: []string{"frontend"},
+		},
+		"returns the error from the selector": {
+			workingDir: "/repo/src",
+			fs:         multiAppFs,
+			selectApp: func(apps []string) (string, error) {
+				return "", errors.New("some error")
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"errors if the application isn't in the workspace": {
+			workingDir: "/repo/src",
+			appName:    "inventory",
+			fs:         multiAppFs,
+			wantedErr:  errors.New("couldn't find an application associated with this workspace"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				workingDir: tc.workingDir,
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+			WithApplication(func() string { return tc.appName })(ws)
+			if tc.selectApp != nil {
+				WithApplicationSelector(tc.selectApp)(ws)
+			}
+
+			summary, err := ws.Summary()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, summary.Application)
+			names, err := ws.ServiceNames()
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.wantedServices, names)
+			if tc.wantedAppDir != "" {
+				appDir, err := ws.AppDirRelPath()
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppDir, appDir)
+			}
+		})
+	}
+}
+
+func TestWorkspace_Applications(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wanted []string
+	}{
+		"single application": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/frontend", 0755)
+				afero.WriteFile(fs, "/copilot/.workspace", []byte("application: phonetool\n"), 0644)
+				return fs
+			},
+			wanted: []string{"phonetool"},
+		},
+		"several applications": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/phonetool", 0755)
+				fs.MkdirAll("/copilot/payments", 0755)
+				fs.MkdirAll("/copilot/not-an-app", 0755)
+				fs.Create("/copilot/phonetool/.workspace")
+				fs.Create("/copilot/payments/.workspace")
+				return fs
+			},
+			wanted: []string{"payments", "phonetool"},
+		},
+		"no application": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			apps, err := ws.Applications()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, apps)
+		})
+	}
+}
+
+func TestWorkspace_CreateInMultiApplicationWorkspace(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	fs.MkdirAll("/repo/copilot/phonetool", 0755)
+	afero.WriteFile(fs, "/repo/copilot/phonetool/.workspace", []byte("application: phonetool\n"), 0644)
+	ws := &Workspace{
+		workingDir: "/repo",
+		copilotDir: "/repo/copilot",
+		fsUtils:    &afero.Afero{Fs: fs},
+	}
+
+	err := ws.Create("payments")
+
+	require.NoError(t, err)
+	summary, err := ws.Summary()
+	require.NoError(t, err)
+	require.Equal(t, "payments", summary.Application)
+	exists, err := ws.fsUtils.Exists("/repo/copilot/payments/.workspace")
+	require.NoError(t, err)
+	require.True(t, exists)
+	path, err := ws.WriteServiceManifest(mockBinaryMarshaler{content: []byte("name: api")}, "api")
+	require.NoError(t, err)
+	require.Equal(t, filepath.FromSlash("/repo/copilot/payments/api/manifest.yml"), path)
+}
+
+func TestWorkspace_CreateMovesSingleApplication(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	fs.MkdirAll("/repo/copilot/phonetool", 0755)
+	afero.WriteFile(fs, "/repo/copilot/.workspace", []byte("application: phonetool\n"), 0644)
+	afero.WriteFile(fs, "/repo/copilot/phonetool/manifest.yml", []byte("name: phonetool"), 0644)
+	afero.WriteFile(fs, "/repo/copilot/pipeline.yml", []byte("name: pipeline"), 0644)
+	ws := &Workspace{
+		workingDir: "/repo",
+		copilotDir: "/repo/copilot",
+		fsUtils:    &afero.Afero{Fs: fs},
+	}
+
+	err := ws.Create("payments")
+
+	require.NoError(t, err)
+	apps, err := ws.Applications()
+	require.NoError(t, err)
+	require.Equal(t, []string{"payments", "phonetool"}, apps)
+	for path, wanted := range map[string]string{
+		"/repo/copilot/phonetool/.workspace":             "application: phonetool\n",
+		"/repo/copilot/phonetool/phonetool/manifest.yml": "name: phonetool",
+		"/repo/copilot/phonetool/pipeline.yml":           "name: pipeline",
+	} {
+		content, err := ws.fsUtils.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, wanted, string(content))
+	}
+	exists, err := ws.fsUtils.Exists("/repo/copilot/.workspace")
+	require.NoError(t, err)
+	require.False(t, exists)
+	summary, err := ws.Summary()
+	require.NoError(t, err)
+	require.Equal(t, "payments", summary.Application)
+}